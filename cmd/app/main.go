@@ -3,15 +3,23 @@ package main
 import (
 	"context"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/auth"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/contentfilter"
 	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/database"
 	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/email"
 	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/encryption"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/lastfmclient"
 	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/logging"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/markdown"
 	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/repository_impls/postgresql"
 	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/spotifyclient"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/storage"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/topsterimage"
 	v1 "github.com/myjinjin/sonic-odyssey-backend/internal/controller/http/v1"
 	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
 	"go.uber.org/zap"
@@ -64,10 +72,153 @@ func main() {
 		logging.Log().Fatal("failed to create spotify client: ", zap.Error(err))
 	}
 
+	spotifyAuth := spotifyclient.NewUserAuthenticator(os.Getenv("SPOTIFY_ID"), os.Getenv("SPOTIFY_SECRET"), os.Getenv("SPOTIFY_REDIRECT_URL"))
+
+	lastfmOpts := []lastfmclient.Option{}
+	if baseURL := os.Getenv("LASTFM_BASE_URL"); baseURL != "" {
+		lastfmOpts = append(lastfmOpts, lastfmclient.WithBaseURL(baseURL))
+	}
+	lastfmClient, err := lastfmclient.New(os.Getenv("LASTFM_API_KEY"), lastfmOpts...)
+	if err != nil {
+		logging.Log().Fatal("failed to create lastfm client: ", zap.Error(err))
+	}
+
+	storageDir := os.Getenv("STORAGE_DIR")
+	if storageDir == "" {
+		storageDir = "storage"
+	}
+	storageBaseURL := os.Getenv("STORAGE_BASE_URL")
+	if storageBaseURL == "" {
+		storageBaseURL = "/static"
+	}
+	fileStorage, err := storage.NewLocalStorage(storageDir, storageBaseURL)
+	if err != nil {
+		logging.Log().Fatal("failed to create storage: ", zap.Error(err))
+	}
+
+	// Album titles are often Korean, so TOPSTER_FONT_PATHS must include a
+	// font with Hangul, such as Noto Sans KR.
+	topsterImageOpts := []topsterimage.Option{topsterimage.RequireHangul()}
+	if format := os.Getenv("TOPSTER_IMAGE_FORMAT"); format != "" {
+		topsterImageOpts = append(topsterImageOpts, topsterimage.WithFormat(topsterimage.Format(format)))
+	}
+	if fontPaths := os.Getenv("TOPSTER_FONT_PATHS"); fontPaths != "" {
+		topsterImageOpts = append(topsterImageOpts, topsterimage.WithFontFiles(strings.Split(fontPaths, ",")...))
+	}
+	topsterRenderer, err := topsterimage.New(topsterimage.NewHTTPCoverFetcher(nil), topsterImageOpts...)
+	if err != nil {
+		logging.Log().Fatal("failed to create topster renderer: ", zap.Error(err))
+	}
+
+	previewDomains := markdown.DefaultPreviewDomains
+	if domains := os.Getenv("LINK_PREVIEW_DOMAINS"); domains != "" {
+		previewDomains = strings.Split(domains, ",")
+	}
+	previewFetcher := markdown.NewHTTPPreviewFetcher(nil, previewDomains)
+
+	commentMaxDepth := usecase.DefaultCommentMaxDepth
+	if maxDepth := os.Getenv("COMMENT_MAX_DEPTH"); maxDepth != "" {
+		commentMaxDepth, err = strconv.Atoi(maxDepth)
+		if err != nil {
+			logging.Log().Fatal("invalid COMMENT_MAX_DEPTH: ", zap.Error(err))
+		}
+	}
+
+	feedFanOutLimit := usecase.DefaultFeedFanOutLimit
+	if fanOutLimit := os.Getenv("FEED_FAN_OUT_LIMIT"); fanOutLimit != "" {
+		feedFanOutLimit, err = strconv.Atoi(fanOutLimit)
+		if err != nil {
+			logging.Log().Fatal("invalid FEED_FAN_OUT_LIMIT: ", zap.Error(err))
+		}
+	}
+
+	profanityWords := contentfilter.DefaultWords()
+	if path := os.Getenv("PROFANITY_WORD_LIST"); path != "" {
+		f, err := os.Open(path)
+		if err != nil {
+			logging.Log().Fatal("failed to open profanity word list: ", zap.Error(err))
+		}
+		profanityWords, err = contentfilter.LoadWordList(f)
+		f.Close()
+		if err != nil {
+			logging.Log().Fatal("failed to read profanity word list: ", zap.Error(err))
+		}
+	}
+	contentMaxLinks := contentfilter.DefaultMaxLinks
+	if maxLinks := os.Getenv("CONTENT_MAX_LINKS"); maxLinks != "" {
+		contentMaxLinks, err = strconv.Atoi(maxLinks)
+		if err != nil {
+			logging.Log().Fatal("invalid CONTENT_MAX_LINKS: ", zap.Error(err))
+		}
+	}
+	duplicateWindow := contentfilter.DefaultDuplicateWindow
+	if window := os.Getenv("CONTENT_DUPLICATE_WINDOW"); window != "" {
+		duplicateWindow, err = time.ParseDuration(window)
+		if err != nil {
+			logging.Log().Fatal("invalid CONTENT_DUPLICATE_WINDOW: ", zap.Error(err))
+		}
+	}
+
 	userRepo := postgresql.NewUserRepository(db.GetDB())
 	passwordResetRepo := postgresql.NewPasswordResetFlowRepository(db.GetDB())
-	userUsecase := usecase.NewUserUsecase(userRepo, passwordResetRepo, encryptor, emailSender)
-	musicUsecase := usecase.NewMusicUsecase(ctx, spotifyClient)
+	musicRepo := postgresql.NewMusicRepository(db.GetDB())
+	albumRepo := postgresql.NewAlbumRepository(db.GetDB())
+	artistRepo := postgresql.NewArtistRepository(db.GetDB())
+	musicArtistRepo := postgresql.NewMusicArtistMappingRepository(db.GetDB())
+	genreRepo := postgresql.NewGenreRepository(db.GetDB())
+	genreAliasRepo := postgresql.NewGenreAliasRepository(db.GetDB())
+	musicGenreRepo := postgresql.NewMusicGenreMappingRepository(db.GetDB())
+	genreCommunityRepo := postgresql.NewGenreCommunityRepository(db.GetDB())
+	userLikeRepo := postgresql.NewUserLikeRepository(db.GetDB())
+	collectionMusicRepo := postgresql.NewCollectionMusicMappingRepository(db.GetDB())
+	postRepo := postgresql.NewPostRepository(db.GetDB())
+	commentRepo := postgresql.NewCommentRepository(db.GetDB())
+	socialAccountRepo := postgresql.NewUserSocialAccountRepository(db.GetDB())
+	linkFlowRepo := postgresql.NewSocialLinkFlowRepository(db.GetDB())
+	importJobRepo := postgresql.NewSpotifyImportJobRepository(db.GetDB())
+	collectionRepo := postgresql.NewMusicCollectionRepository(db.GetDB())
+	syncRepo := postgresql.NewCollectionSpotifySyncRepository(db.GetDB())
+	collectionMemberRepo := postgresql.NewCollectionMemberRepository(db.GetDB())
+	collectionInviteRepo := postgresql.NewCollectionInviteRepository(db.GetDB())
+	topsterRepo := postgresql.NewUserTopsterRepository(db.GetDB())
+	topsterAlbumRepo := postgresql.NewTopsterAlbumRepository(db.GetDB())
+	communityMemberRepo := postgresql.NewCommunityMemberRepository(db.GetDB())
+	musicAttachmentRepo := postgresql.NewMusicAttachmentRepository(db.GetDB())
+	linkPreviewRepo := postgresql.NewLinkPreviewRepository(db.GetDB())
+	reportRepo := postgresql.NewReportRepository(db.GetDB())
+	moderationActionRepo := postgresql.NewModerationActionRepository(db.GetDB())
+	communityBanRepo := postgresql.NewCommunityBanRepository(db.GetDB())
+	userFollowRepo := postgresql.NewUserFollowRepository(db.GetDB())
+	userBlockRepo := postgresql.NewUserBlockRepository(db.GetDB())
+	userMuteRepo := postgresql.NewUserMuteRepository(db.GetDB())
+	activityRepo := postgresql.NewActivityRepository(db.GetDB())
+	notificationRepo := postgresql.NewNotificationRepository(db.GetDB())
+	notificationPreferenceRepo := postgresql.NewNotificationPreferenceRepository(db.GetDB())
+	contentFilter := contentfilter.NewPipeline(
+		contentfilter.NewProfanityFilter(profanityWords, contentfilter.DefaultAllowlist()),
+		contentfilter.NewLinkSpamFilter(contentMaxLinks, contentfilter.DefaultShortenerDomains),
+		contentfilter.NewDuplicateFilter(usecase.NewContentHistory(postRepo, commentRepo), duplicateWindow),
+	)
+	contentScreener := usecase.NewContentScreener(contentFilter, reportRepo)
+	activityRecorder := usecase.NewActivityRecorder(activityRepo, userFollowRepo, feedFanOutLimit)
+	notifier := usecase.NewNotifier(notificationRepo, notificationPreferenceRepo, userRepo, userBlockRepo, encryptor, emailSender)
+	userUsecase := usecase.NewUserUsecase(userRepo, passwordResetRepo, userFollowRepo, encryptor, emailSender, contentScreener)
+	musicUsecase := usecase.NewMusicUsecase(ctx, spotifyClient, lastfmClient, musicRepo, albumRepo, artistRepo, musicArtistRepo, genreRepo, genreAliasRepo, musicGenreRepo, userLikeRepo, collectionMusicRepo)
+	genreUsecase := usecase.NewGenreUsecase(genreRepo, genreAliasRepo, musicRepo, genreCommunityRepo)
+	likeUsecase := usecase.NewLikeUsecase(userLikeRepo, musicRepo, postRepo, commentRepo, activityRecorder, notifier)
+	spotifyUsecase := usecase.NewSpotifyUsecase(spotifyAuth, spotifyClient, socialAccountRepo, linkFlowRepo, importJobRepo, collectionRepo, collectionMusicRepo, syncRepo, musicRepo, albumRepo, artistRepo, musicArtistRepo, genreRepo, genreAliasRepo, musicGenreRepo, encryptor)
+	collectionUsecase := usecase.NewCollectionUsecase(collectionRepo, collectionMusicRepo, collectionMemberRepo, collectionInviteRepo, musicRepo, albumRepo, userRepo, userBlockRepo, activityRecorder)
+	topsterUsecase := usecase.NewTopsterUsecase(topsterRepo, topsterAlbumRepo, albumRepo, userRepo, userBlockRepo, collectionRepo, collectionMemberRepo, genreRepo, topsterRenderer, fileStorage, activityRecorder)
+	communityUsecase := usecase.NewCommunityUsecase(genreCommunityRepo, communityMemberRepo, communityBanRepo, genreRepo, userRepo, fileStorage)
+	musicEmbedder := usecase.NewMusicEmbedder(spotifyClient, musicRepo, albumRepo, artistRepo, musicArtistRepo, genreRepo, genreAliasRepo, musicGenreRepo, userLikeRepo, musicAttachmentRepo)
+	postUsecase := usecase.NewPostUsecase(postRepo, genreCommunityRepo, communityMemberRepo, linkPreviewRepo, musicEmbedder, markdown.New(), previewFetcher, contentScreener, activityRecorder, notifier)
+	commentUsecase := usecase.NewCommentUsecase(commentRepo, postRepo, communityBanRepo, userBlockRepo, musicEmbedder, commentMaxDepth, contentScreener, notifier)
+	moderationUsecase := usecase.NewModerationUsecase(reportRepo, moderationActionRepo, communityBanRepo, postRepo, commentRepo, genreCommunityRepo, communityMemberRepo, userRepo, notifier)
+	followUsecase := usecase.NewFollowUsecase(userFollowRepo, userRepo, userBlockRepo, activityRecorder, notifier)
+	blockUsecase := usecase.NewBlockUsecase(userBlockRepo, userMuteRepo, userRepo)
+	profileUsecase := usecase.NewProfileUsecase(userRepo, userFollowRepo, userBlockRepo, topsterRepo, collectionRepo, postRepo)
+	feedUsecase := usecase.NewFeedUsecase(activityRepo)
+	notificationUsecase := usecase.NewNotificationUsecase(notificationRepo, notificationPreferenceRepo)
 	userJwt := auth.NewUserJWT(userRepo)
 
 	jwtAuth, err := auth.NewJWTMiddleware(
@@ -82,7 +233,10 @@ func main() {
 	if err != nil {
 		logging.Log().Fatal("failed to create jwt auth middleware: ", zap.Error(err))
 	}
-	router := v1.SetupRouter(userUsecase, musicUsecase, jwtAuth)
+	router := v1.SetupRouter(userUsecase, musicUsecase, genreUsecase, likeUsecase, spotifyUsecase, collectionUsecase, topsterUsecase, communityUsecase, postUsecase, commentUsecase, moderationUsecase, followUsecase, profileUsecase, blockUsecase, feedUsecase, notificationUsecase, jwtAuth)
+	if !strings.Contains(storageBaseURL, "://") {
+		router.Static(storageBaseURL, storageDir)
+	}
 
 	err = router.Run(":8081")
 	if err != nil {
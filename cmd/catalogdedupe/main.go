@@ -0,0 +1,67 @@
+package main
+
+import (
+	"flag"
+	"os"
+
+	"github.com/joho/godotenv"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/database"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/logging"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/repository_impls/postgresql"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+	"go.uber.org/zap"
+)
+
+// catalogdedupe merges albums sharing a UPC and tracks sharing an ISRC. Run it
+// with -dry-run first to review the groups that would be merged.
+func main() {
+	dryRun := flag.Bool("dry-run", false, "report duplicates without merging them")
+	flag.Parse()
+
+	err := godotenv.Load()
+	if err != nil {
+		logging.Log().Fatal("failed to load .env file: %v", zap.Error(err))
+	}
+	db, err := database.NewDB(
+		database.WithHost(os.Getenv("DB_HOST")),
+		database.WithPort(os.Getenv("DB_PORT")),
+		database.WithUsername(os.Getenv("DB_USERNAME")),
+		database.WithPassword(os.Getenv("DB_PASSWORD")),
+		database.WithDBName(os.Getenv("DB_NAME")),
+	)
+	if err != nil {
+		logging.Log().Fatal("faied to connect to the database: %v", zap.Error(err))
+	}
+	defer db.Close()
+
+	catalogUsecase := usecase.NewCatalogUsecase(
+		postgresql.NewMusicRepository(db.GetDB()),
+		postgresql.NewAlbumRepository(db.GetDB()),
+	)
+	output, err := catalogUsecase.MergeDuplicates(*dryRun)
+	if err != nil {
+		logging.Log().Fatal("failed to merge duplicates: ", zap.Error(err))
+	}
+
+	for _, g := range output.Albums {
+		logging.Log().Info("duplicate albums",
+			zap.Bool("dry_run", output.DryRun),
+			zap.String("upc", g.Key),
+			zap.Uint("canonical_id", g.CanonicalID),
+			zap.Uints("duplicate_ids", g.DuplicateIDs),
+		)
+	}
+	for _, g := range output.Tracks {
+		logging.Log().Info("duplicate tracks",
+			zap.Bool("dry_run", output.DryRun),
+			zap.String("isrc", g.Key),
+			zap.Uint("canonical_id", g.CanonicalID),
+			zap.Uints("duplicate_ids", g.DuplicateIDs),
+		)
+	}
+	logging.Log().Info("catalog deduplication finished",
+		zap.Bool("dry_run", output.DryRun),
+		zap.Int("album_groups", len(output.Albums)),
+		zap.Int("track_groups", len(output.Tracks)),
+	)
+}
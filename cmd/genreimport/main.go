@@ -0,0 +1,65 @@
+package main
+
+import (
+	"flag"
+	"os"
+
+	"github.com/joho/godotenv"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/database"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/logging"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/repository_impls/postgresql"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/taxonomy"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+	"go.uber.org/zap"
+)
+
+// genreimport seeds the genre taxonomy. Without -file, the taxonomy bundled in
+// infrastructure/taxonomy/genres.json is imported.
+func main() {
+	file := flag.String("file", "", "path to a genre taxonomy JSON file")
+	flag.Parse()
+
+	err := godotenv.Load()
+	if err != nil {
+		logging.Log().Fatal("failed to load .env file: %v", zap.Error(err))
+	}
+	db, err := database.NewDB(
+		database.WithHost(os.Getenv("DB_HOST")),
+		database.WithPort(os.Getenv("DB_PORT")),
+		database.WithUsername(os.Getenv("DB_USERNAME")),
+		database.WithPassword(os.Getenv("DB_PASSWORD")),
+		database.WithDBName(os.Getenv("DB_NAME")),
+	)
+	if err != nil {
+		logging.Log().Fatal("faied to connect to the database: %v", zap.Error(err))
+	}
+	defer db.Close()
+
+	var genres []taxonomy.Genre
+	if *file != "" {
+		genres, err = taxonomy.LoadFile(*file)
+	} else {
+		genres, err = taxonomy.Default()
+	}
+	if err != nil {
+		logging.Log().Fatal("failed to load genre taxonomy: ", zap.Error(err))
+	}
+
+	genreUsecase := usecase.NewGenreUsecase(
+		postgresql.NewGenreRepository(db.GetDB()),
+		postgresql.NewGenreAliasRepository(db.GetDB()),
+		postgresql.NewMusicRepository(db.GetDB()),
+		postgresql.NewGenreCommunityRepository(db.GetDB()),
+	)
+	output, err := genreUsecase.ImportTaxonomy(genres)
+	if err != nil {
+		logging.Log().Fatal("failed to import genre taxonomy: ", zap.Error(err))
+	}
+
+	logging.Log().Info("genre taxonomy imported",
+		zap.Int("genres_created", output.GenresCreated),
+		zap.Int("genres_updated", output.GenresUpdated),
+		zap.Int("aliases_created", output.AliasesCreated),
+		zap.Strings("conflicting_aliases", output.ConflictingAliases),
+	)
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/database"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/encryption"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/logging"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/repository_impls/postgresql"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/spotifyclient"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+	"go.uber.org/zap"
+)
+
+// spotifysync reconciles collections exported to Spotify with their playlists.
+// It runs once by default; with -interval it keeps reconciling periodically.
+func main() {
+	interval := flag.Duration("interval", 0, "reconcile periodically at this interval (e.g. 15m) instead of once")
+	flag.Parse()
+
+	err := godotenv.Load()
+	if err != nil {
+		logging.Log().Fatal("failed to load .env file: %v", zap.Error(err))
+	}
+	db, err := database.NewDB(
+		database.WithHost(os.Getenv("DB_HOST")),
+		database.WithPort(os.Getenv("DB_PORT")),
+		database.WithUsername(os.Getenv("DB_USERNAME")),
+		database.WithPassword(os.Getenv("DB_PASSWORD")),
+		database.WithDBName(os.Getenv("DB_NAME")),
+	)
+	if err != nil {
+		logging.Log().Fatal("faied to connect to the database: %v", zap.Error(err))
+	}
+	defer db.Close()
+
+	encryptor, err := encryption.NewAESEncryptor(os.Getenv("DB_ENCRYPTION_KEY"))
+	if err != nil {
+		logging.Log().Fatal("failed to create encryptor: ", zap.Error(err))
+	}
+
+	ctx := context.Background()
+	spotifyClient, err := spotifyclient.New(ctx, os.Getenv("SPOTIFY_ID"), os.Getenv("SPOTIFY_SECRET"))
+	if err != nil {
+		logging.Log().Fatal("failed to create spotify client: ", zap.Error(err))
+	}
+	spotifyAuth := spotifyclient.NewUserAuthenticator(os.Getenv("SPOTIFY_ID"), os.Getenv("SPOTIFY_SECRET"), os.Getenv("SPOTIFY_REDIRECT_URL"))
+
+	spotifyUsecase := usecase.NewSpotifyUsecase(
+		spotifyAuth,
+		spotifyClient,
+		postgresql.NewUserSocialAccountRepository(db.GetDB()),
+		postgresql.NewSocialLinkFlowRepository(db.GetDB()),
+		postgresql.NewSpotifyImportJobRepository(db.GetDB()),
+		postgresql.NewMusicCollectionRepository(db.GetDB()),
+		postgresql.NewCollectionMusicMappingRepository(db.GetDB()),
+		postgresql.NewCollectionSpotifySyncRepository(db.GetDB()),
+		postgresql.NewMusicRepository(db.GetDB()),
+		postgresql.NewAlbumRepository(db.GetDB()),
+		postgresql.NewArtistRepository(db.GetDB()),
+		postgresql.NewMusicArtistMappingRepository(db.GetDB()),
+		postgresql.NewGenreRepository(db.GetDB()),
+		postgresql.NewGenreAliasRepository(db.GetDB()),
+		postgresql.NewMusicGenreMappingRepository(db.GetDB()),
+		encryptor,
+	)
+
+	reconcile(ctx, spotifyUsecase)
+	if *interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(*interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		reconcile(ctx, spotifyUsecase)
+	}
+}
+
+func reconcile(ctx context.Context, spotifyUsecase usecase.SpotifyUsecase) {
+	output, err := spotifyUsecase.ReconcileCollections(ctx)
+	if err != nil {
+		logging.Log().Error("failed to reconcile collections", zap.Error(err))
+		return
+	}
+	logging.Log().Info("collection reconcile finished",
+		zap.Int("synced", output.Synced),
+		zap.Int("failed", output.Failed),
+		zap.Int("remote_deleted", output.RemoteDeleted),
+	)
+}
@@ -0,0 +1,360 @@
+package contentfilter
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProfanityFilter(t *testing.T) {
+	f := NewProfanityFilter(DefaultWords(), DefaultAllowlist())
+
+	testCases := []struct {
+		name     string
+		content  Content
+		action   Action
+		expected string
+	}{
+		{
+			name:     "Clean",
+			content:  Content{Kind: KindComment, Body: "좋은 앨범 추천 감사합니다"},
+			action:   ActionAllow,
+			expected: "좋은 앨범 추천 감사합니다",
+		},
+		{
+			name:     "English",
+			content:  Content{Kind: KindComment, Body: "what the fuck"},
+			action:   ActionMask,
+			expected: "what the ****",
+		},
+		{
+			name:     "SpacingAndPunctuation",
+			content:  Content{Kind: KindComment, Body: "f.u c-k this"},
+			action:   ActionMask,
+			expected: "*** *** this",
+		},
+		{
+			name:     "Leetspeak",
+			content:  Content{Kind: KindComment, Body: "sh1t"},
+			action:   ActionMask,
+			expected: "****",
+		},
+		{
+			name:     "FullWidth",
+			content:  Content{Kind: KindComment, Body: "ｓｈｉｔ"},
+			action:   ActionMask,
+			expected: "****",
+		},
+		{
+			name:     "Korean",
+			content:  Content{Kind: KindComment, Body: "아 씨발 진짜"},
+			action:   ActionMask,
+			expected: "아 ** 진짜",
+		},
+		{
+			name:     "KoreanSpacing",
+			content:  Content{Kind: KindComment, Body: "씨 발"},
+			action:   ActionMask,
+			expected: "* *",
+		},
+		{
+			name:     "JamoSplitting",
+			content:  Content{Kind: KindComment, Body: "씨ㅂㅏㄹ"},
+			action:   ActionMask,
+			expected: "****",
+		},
+		{
+			name:     "NoMatchAcrossSyllables",
+			content:  Content{Kind: KindComment, Body: "조지 해리슨"},
+			action:   ActionAllow,
+			expected: "조지 해리슨",
+		},
+		{
+			name:     "Title",
+			content:  Content{Kind: KindPost, Title: "병신 같은 믹스", Body: "본문"},
+			action:   ActionMask,
+			expected: "본문",
+		},
+		{
+			name:    "NicknameRejected",
+			content: Content{Kind: KindNickname, Body: "shithead"},
+			action:  ActionReject,
+		},
+		{
+			name:     "NoMatchAcrossWords",
+			content:  Content{Kind: KindComment, Body: "Push It by Salt-N-Pepa"},
+			action:   ActionAllow,
+			expected: "Push It by Salt-N-Pepa",
+		},
+		{
+			name:     "NoMatchAcrossWordEnd",
+			content:  Content{Kind: KindComment, Body: "let me finish it"},
+			action:   ActionAllow,
+			expected: "let me finish it",
+		},
+		{
+			name:     "NoMatchIntoNextWord",
+			content:  Content{Kind: KindComment, Body: "people who really care"},
+			action:   ActionAllow,
+			expected: "people who really care",
+		},
+		{
+			name:     "NoMatchInsideWord",
+			content:  Content{Kind: KindComment, Body: "Scunthorpe United"},
+			action:   ActionAllow,
+			expected: "Scunthorpe United",
+		},
+		{
+			name:     "NicknameContainingWord",
+			content:  Content{Kind: KindNickname, Body: "pushit"},
+			action:   ActionAllow,
+			expected: "pushit",
+		},
+		{
+			name:     "KoreanAllowlisted",
+			content:  Content{Kind: KindComment, Body: "이 앨범이 시발점이었고, 시발역에서 들었어요"},
+			action:   ActionAllow,
+			expected: "이 앨범이 시발점이었고, 시발역에서 들었어요",
+		},
+		{
+			name:     "KoreanNextToAllowlisted",
+			content:  Content{Kind: KindComment, Body: "시발 시발점"},
+			action:   ActionMask,
+			expected: "** 시발점",
+		},
+		{
+			name:     "KoreanAllowlistedOnlyAsWritten",
+			content:  Content{Kind: KindComment, Body: "시발 점점 싫어"},
+			action:   ActionMask,
+			expected: "** 점점 싫어",
+		},
+		{
+			name:     "KoreanWithParticle",
+			content:  Content{Kind: KindComment, Body: "병신이"},
+			action:   ActionMask,
+			expected: "**이",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Execute
+			result, err := f.Check(tc.content)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tc.action, result.Action)
+			if tc.expected != "" {
+				assert.Equal(t, tc.expected, result.Body)
+			}
+			if tc.action != ActionAllow {
+				assert.True(t, result.HasReason(ReasonProfanity))
+			}
+		})
+	}
+
+	t.Run("TitleMasked", func(t *testing.T) {
+		result, err := f.Check(Content{Kind: KindPost, Title: "병신 같은 믹스"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "** 같은 믹스", result.Title)
+	})
+}
+
+func TestLoadWordList(t *testing.T) {
+	// Execute
+	words, err := LoadWordList(strings.NewReader("# comment\n\n  darn \nheck\n"))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"darn", "heck"}, words)
+
+	result, err := NewProfanityFilter(words, nil).Check(Content{Kind: KindComment, Body: "oh darn"})
+	assert.NoError(t, err)
+	assert.Equal(t, "oh ****", result.Body)
+}
+
+func TestLinkSpamFilter(t *testing.T) {
+	f := NewLinkSpamFilter(2, DefaultShortenerDomains)
+
+	testCases := []struct {
+		name    string
+		content Content
+		action  Action
+	}{
+		{
+			name:    "NoLinks",
+			content: Content{Kind: KindPost, Title: "추천", Body: "이 앨범 들어보세요"},
+			action:  ActionAllow,
+		},
+		{
+			name:    "FewLinks",
+			content: Content{Kind: KindPost, Body: "https://open.spotify.com/track/1 and www.example.com"},
+			action:  ActionAllow,
+		},
+		{
+			name:    "TooManyLinks",
+			content: Content{Kind: KindComment, Body: "http://a.com http://b.com http://c.com"},
+			action:  ActionHold,
+		},
+		{
+			name:    "Shortener",
+			content: Content{Kind: KindComment, Body: "free tickets https://bit.ly/abc"},
+			action:  ActionHold,
+		},
+		{
+			name:    "ShortenerWithoutScheme",
+			content: Content{Kind: KindBio, Body: "www.tinyurl.com/abc"},
+			action:  ActionHold,
+		},
+		{
+			name:    "NicknameRejected",
+			content: Content{Kind: KindNickname, Body: "www.example.com"},
+			action:  ActionReject,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Execute
+			result, err := f.Check(tc.content)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tc.action, result.Action)
+			assert.Equal(t, tc.action != ActionAllow, result.HasReason(ReasonLinkSpam))
+		})
+	}
+}
+
+type stubHistory struct {
+	contents []Content
+	err      error
+	since    time.Time
+}
+
+func (h *stubHistory) Recent(userID uint, kind string, since time.Time) ([]Content, error) {
+	h.since = since
+	return h.contents, h.err
+}
+
+func TestDuplicateFilter(t *testing.T) {
+	history := &stubHistory{contents: []Content{
+		{Kind: KindPost, ID: 1, UserID: 1, Title: "Best albums", Body: "OK Computer, Kid A"},
+		{Kind: KindComment, ID: 2, UserID: 1, PostID: 10, Body: "좋아요 +1"},
+	}}
+	f := NewDuplicateFilter(history, time.Hour)
+
+	testCases := []struct {
+		name    string
+		content Content
+		action  Action
+	}{
+		{
+			name:    "Duplicate",
+			content: Content{Kind: KindPost, UserID: 1, Title: "best albums", Body: "OK computer kid a!!"},
+			action:  ActionReject,
+		},
+		{
+			name:    "SameItemEdited",
+			content: Content{Kind: KindPost, ID: 1, UserID: 1, Title: "Best albums", Body: "OK Computer, Kid A"},
+			action:  ActionAllow,
+		},
+		{
+			name:    "Different",
+			content: Content{Kind: KindPost, UserID: 1, Title: "Best albums", Body: "In Rainbows"},
+			action:  ActionAllow,
+		},
+		{
+			name:    "CommentOnSamePost",
+			content: Content{Kind: KindComment, UserID: 1, PostID: 10, Body: "좋아요! +1"},
+			action:  ActionReject,
+		},
+		{
+			name:    "ShortCommentOnAnotherPost",
+			content: Content{Kind: KindComment, UserID: 1, PostID: 11, Body: "좋아요 +1"},
+			action:  ActionAllow,
+		},
+		{
+			name:    "ProfileNotChecked",
+			content: Content{Kind: KindBio, UserID: 1, Body: "Best albums OK Computer, Kid A"},
+			action:  ActionAllow,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Execute
+			result, err := f.Check(tc.content)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tc.action, result.Action)
+			assert.Equal(t, tc.action == ActionReject, result.HasReason(ReasonDuplicate))
+		})
+	}
+
+	t.Run("Window", func(t *testing.T) {
+		_, err := f.Check(Content{Kind: KindComment, UserID: 1, Body: "hi"})
+
+		assert.NoError(t, err)
+		assert.WithinDuration(t, time.Now().Add(-time.Hour), history.since, time.Minute)
+	})
+
+	t.Run("HistoryError", func(t *testing.T) {
+		f := NewDuplicateFilter(&stubHistory{err: errors.New("db error")}, 0)
+
+		result, err := f.Check(Content{Kind: KindComment, UserID: 1, Body: "hi"})
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestPipeline(t *testing.T) {
+	history := &stubHistory{contents: []Content{
+		{Kind: KindComment, ID: 1, UserID: 1, Body: "spam ****"},
+	}}
+	p := NewPipeline(
+		NewProfanityFilter(DefaultWords(), DefaultAllowlist()),
+		NewLinkSpamFilter(1, DefaultShortenerDomains),
+		NewDuplicateFilter(history, time.Hour),
+	)
+
+	t.Run("Allow", func(t *testing.T) {
+		result, err := p.Check(Content{Kind: KindComment, UserID: 1, Body: "nice"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, ActionAllow, result.Action)
+		assert.Equal(t, "nice", result.Body)
+		assert.Empty(t, result.Reasons)
+	})
+
+	t.Run("MaskAndHold", func(t *testing.T) {
+		result, err := p.Check(Content{Kind: KindComment, UserID: 1, Body: "shit http://a.com http://b.com"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, ActionHold, result.Action)
+		assert.Equal(t, "**** http://a.com http://b.com", result.Body)
+		assert.Equal(t, []string{ReasonProfanity, ReasonLinkSpam}, result.Reasons)
+	})
+
+	t.Run("DuplicateOfMaskedText", func(t *testing.T) {
+		result, err := p.Check(Content{Kind: KindComment, UserID: 1, Body: "spam fuck"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, ActionReject, result.Action)
+		assert.True(t, result.HasReason(ReasonDuplicate))
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		result, err := NewPipeline().Check(Content{Kind: KindComment, Body: "shit"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, ActionAllow, result.Action)
+		assert.Equal(t, "shit", result.Body)
+	})
+}
@@ -0,0 +1,70 @@
+package contentfilter
+
+import (
+	"strings"
+	"time"
+	"unicode"
+)
+
+// DefaultDuplicateWindow is how far back DuplicateFilter looks for identical
+// posts and comments.
+const DefaultDuplicateWindow = 24 * time.Hour
+
+// History looks up what a user has written recently.
+type History interface {
+	// Recent returns the user's posts or comments written since the given
+	// time.
+	Recent(userID uint, kind string, since time.Time) ([]Content, error)
+}
+
+// DuplicateFilter rejects posts and comments that repeat one the user wrote
+// within the window. Case, spacing and punctuation are ignored when comparing.
+// Comments only repeat comments on the same post, so that short replies such
+// as "+1" can be left on different posts.
+type DuplicateFilter struct {
+	history History
+	window  time.Duration
+}
+
+// NewDuplicateFilter creates a duplicate filter. A non-positive window falls
+// back to DefaultDuplicateWindow.
+func NewDuplicateFilter(history History, window time.Duration) *DuplicateFilter {
+	if window <= 0 {
+		window = DefaultDuplicateWindow
+	}
+	return &DuplicateFilter{history: history, window: window}
+}
+
+func (f *DuplicateFilter) Check(content Content) (*Result, error) {
+	if content.Kind != KindPost && content.Kind != KindComment {
+		return allow(content), nil
+	}
+	fp := fingerprint(content)
+	if fp == "" {
+		return allow(content), nil
+	}
+
+	recent, err := f.history.Recent(content.UserID, content.Kind, time.Now().Add(-f.window))
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range recent {
+		if r.ID == content.ID || (content.Kind == KindComment && r.PostID != content.PostID) {
+			continue
+		}
+		if fingerprint(r) == fp {
+			return &Result{Action: ActionReject, Title: content.Title, Body: content.Body, Reasons: []string{ReasonDuplicate}}, nil
+		}
+	}
+	return allow(content), nil
+}
+
+func fingerprint(content Content) string {
+	var b strings.Builder
+	for _, r := range content.Title + "\n" + content.Body {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(unicode.ToLower(r))
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,106 @@
+package contentfilter
+
+// Action is a filter's verdict on content. Actions are ordered by severity,
+// so that the pipeline can keep the most severe one.
+type Action int
+
+const (
+	// ActionAllow stores the content as written.
+	ActionAllow Action = iota
+	// ActionMask stores the content with the offending parts masked.
+	ActionMask
+	// ActionHold stores the content hidden until a moderator reviews it.
+	ActionHold
+	// ActionReject refuses to store the content.
+	ActionReject
+)
+
+// Kinds of content that are filtered.
+const (
+	KindPost     = "post"
+	KindComment  = "comment"
+	KindBio      = "bio"
+	KindNickname = "nickname"
+)
+
+// Reasons reported by the built-in filters.
+const (
+	ReasonProfanity = "profanity"
+	ReasonLinkSpam  = "link_spam"
+	ReasonDuplicate = "duplicate"
+)
+
+// Content is user-written text to be checked. Title is only set for posts.
+// ID is the ID of the post or comment being edited, or zero for new content.
+// PostID is the post a comment is written on.
+type Content struct {
+	Kind   string
+	ID     uint
+	UserID uint
+	PostID uint
+	Title  string
+	Body   string
+}
+
+// Result is the verdict on content, with the text to store in its place.
+type Result struct {
+	Action  Action
+	Title   string
+	Body    string
+	Reasons []string
+}
+
+// HasReason reports whether the result was caused by the given reason.
+func (r *Result) HasReason(reason string) bool {
+	for _, rr := range r.Reasons {
+		if rr == reason {
+			return true
+		}
+	}
+	return false
+}
+
+// Filter checks content before it is stored.
+type Filter interface {
+	Check(content Content) (*Result, error)
+}
+
+// Pipeline runs filters in order. Each filter sees the text masked by the
+// filters before it, the most severe action wins, and the pipeline stops at
+// the first rejection.
+type Pipeline struct {
+	filters []Filter
+}
+
+func NewPipeline(filters ...Filter) *Pipeline {
+	return &Pipeline{filters: filters}
+}
+
+func (p *Pipeline) Check(content Content) (*Result, error) {
+	result := &Result{Action: ActionAllow, Title: content.Title, Body: content.Body}
+	for _, filter := range p.filters {
+		content.Title, content.Body = result.Title, result.Body
+		r, err := filter.Check(content)
+		if err != nil {
+			return nil, err
+		}
+		if r.Action == ActionAllow {
+			continue
+		}
+		result.Reasons = append(result.Reasons, r.Reasons...)
+		if r.Action > result.Action {
+			result.Action = r.Action
+		}
+		if r.Action == ActionMask {
+			result.Title, result.Body = r.Title, r.Body
+		}
+		if result.Action == ActionReject {
+			break
+		}
+	}
+	return result, nil
+}
+
+func allow(content Content) *Result {
+	return &Result{Action: ActionAllow, Title: content.Title, Body: content.Body}
+}
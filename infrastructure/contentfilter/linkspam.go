@@ -0,0 +1,83 @@
+package contentfilter
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// DefaultMaxLinks is the number of links content can have before it is held
+// for review.
+const DefaultMaxLinks = 3
+
+// DefaultShortenerDomains are URL shorteners, which hide where a link leads
+// and are mostly used by spam.
+var DefaultShortenerDomains = []string{
+	"bit.ly",
+	"buff.ly",
+	"cutt.ly",
+	"goo.gl",
+	"is.gd",
+	"ow.ly",
+	"rebrand.ly",
+	"shorturl.at",
+	"t.co",
+	"tinyurl.com",
+}
+
+var linkPattern = regexp.MustCompile(`(?i)\b(?:https?://|www\.)[^\s<>()\[\]"']+`)
+
+// LinkSpamFilter holds content with too many links or with links through URL
+// shorteners for review. Nicknames cannot contain links at all.
+type LinkSpamFilter struct {
+	maxLinks   int
+	shorteners []string
+}
+
+// NewLinkSpamFilter creates a link spam filter. A non-positive maxLinks falls
+// back to DefaultMaxLinks.
+func NewLinkSpamFilter(maxLinks int, shorteners []string) *LinkSpamFilter {
+	if maxLinks <= 0 {
+		maxLinks = DefaultMaxLinks
+	}
+	return &LinkSpamFilter{maxLinks: maxLinks, shorteners: shorteners}
+}
+
+func (f *LinkSpamFilter) Check(content Content) (*Result, error) {
+	links := linkPattern.FindAllString(content.Title+"\n"+content.Body, -1)
+	if len(links) == 0 {
+		return allow(content), nil
+	}
+
+	result := &Result{Action: ActionHold, Title: content.Title, Body: content.Body, Reasons: []string{ReasonLinkSpam}}
+	if content.Kind == KindNickname {
+		result.Action = ActionReject
+		return result, nil
+	}
+	if len(links) > f.maxLinks {
+		return result, nil
+	}
+	for _, link := range links {
+		if f.shortened(link) {
+			return result, nil
+		}
+	}
+	return allow(content), nil
+}
+
+func (f *LinkSpamFilter) shortened(link string) bool {
+	if !strings.Contains(link, "://") {
+		link = "http://" + link
+	}
+	u, err := url.Parse(link)
+	if err != nil {
+		return false
+	}
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	for _, domain := range f.shorteners {
+		if host == domain {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,94 @@
+package contentfilter
+
+import "unicode"
+
+const (
+	hangulBase  = 0xAC00
+	hangulLast  = 0xD7A3
+	jungCount   = 21
+	jongCount   = 28
+	syllableLen = jungCount * jongCount
+)
+
+// Compatibility jamo for the initial, medial and final positions of Hangul
+// syllables, so that "시발" and "ㅅㅣㅂㅏㄹ" normalize to the same runes.
+var (
+	choseong  = []rune("ㄱㄲㄴㄷㄸㄹㅁㅂㅃㅅㅆㅇㅈㅉㅊㅋㅌㅍㅎ")
+	jungseong = []rune("ㅏㅐㅑㅒㅓㅔㅕㅖㅗㅘㅙㅚㅛㅜㅝㅞㅟㅠㅡㅢㅣ")
+	jongseong = []rune(" ㄱㄲㄳㄴㄵㄶㄷㄹㄺㄻㄼㄽㄾㄿㅀㅁㅂㅄㅅㅆㅇㅈㅊㅋㅌㅍㅎ")
+)
+
+// leetspeak maps look-alike digits and symbols to the letters they stand
+// for.
+var leetspeak = map[rune]rune{
+	'0': 'o',
+	'1': 'i',
+	'!': 'i',
+	'3': 'e',
+	'4': 'a',
+	'@': 'a',
+	'5': 's',
+	'$': 's',
+	'7': 't',
+}
+
+// normalized is text reduced to the runes that matter for matching words.
+// Every normalized rune remembers the rune of the original text it came from,
+// so that matches can be mapped back for masking.
+type normalized struct {
+	runes  []rune
+	origin []int  // index of the original rune
+	start  []bool // the rune starts an original rune's expansion
+	end    []bool // the rune ends an original rune's expansion
+}
+
+// normalize lowercases the text, folds full-width and leetspeak characters,
+// splits Hangul syllables into jamo, and drops everything that is not a
+// letter or digit, such as spacing and punctuation used to break words up.
+func normalize(text string) *normalized {
+	n := &normalized{}
+	for i, r := range []rune(text) {
+		expanded := normalizeRune(r)
+		for j, e := range expanded {
+			n.runes = append(n.runes, e)
+			n.origin = append(n.origin, i)
+			n.start = append(n.start, j == 0)
+			n.end = append(n.end, j == len(expanded)-1)
+		}
+	}
+	return n
+}
+
+func normalizeRune(r rune) []rune {
+	if r >= 0xFF01 && r <= 0xFF5E {
+		r -= 0xFEE0
+	}
+	if l, ok := leetspeak[r]; ok {
+		r = l
+	}
+	if r >= hangulBase && r <= hangulLast {
+		s := r - hangulBase
+		jamo := []rune{choseong[s/syllableLen], jungseong[s%syllableLen/jongCount]}
+		if t := s % jongCount; t != 0 {
+			jamo = append(jamo, jongseong[t])
+		}
+		return jamo
+	}
+	if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+		return nil
+	}
+	return []rune{unicode.ToLower(r)}
+}
+
+// isWordRune reports whether r belongs to a word for the purpose of word
+// boundaries. Hangul does not, since Korean attaches particles and endings
+// directly to words.
+func isWordRune(r rune) bool {
+	expanded := normalizeRune(r)
+	return len(expanded) > 0 && !unicode.Is(unicode.Hangul, expanded[0])
+}
+
+// normalizeWord normalizes a word of the word list.
+func normalizeWord(word string) []rune {
+	return normalize(word).runes
+}
@@ -0,0 +1,197 @@
+package contentfilter
+
+import (
+	"bufio"
+	_ "embed"
+	"io"
+	"strings"
+	"unicode"
+)
+
+//go:embed profanity_words.txt
+var defaultWordList string
+
+//go:embed profanity_allowlist.txt
+var defaultAllowlist string
+
+// DefaultWords returns the built-in Korean and English profanity word list.
+func DefaultWords() []string {
+	words, _ := LoadWordList(strings.NewReader(defaultWordList))
+	return words
+}
+
+// DefaultAllowlist returns the built-in list of innocent words that contain
+// profanity, such as "시발점".
+func DefaultAllowlist() []string {
+	words, _ := LoadWordList(strings.NewReader(defaultAllowlist))
+	return words
+}
+
+// LoadWordList reads a word list with one word per line. Blank lines and
+// lines starting with # are skipped.
+func LoadWordList(r io.Reader) ([]string, error) {
+	var words []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		words = append(words, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return words, nil
+}
+
+// ProfanityFilter masks the words of a word list. Nicknames cannot be shown
+// masked, so nicknames containing a word are rejected instead. Words of the
+// allowlist are left alone even though they contain a listed word.
+type ProfanityFilter struct {
+	words   [][]rune
+	allowed [][]rune
+}
+
+func NewProfanityFilter(words, allowed []string) *ProfanityFilter {
+	f := &ProfanityFilter{}
+	for _, w := range words {
+		if nw := normalizeWord(w); len(nw) > 0 {
+			f.words = append(f.words, nw)
+		}
+	}
+	for _, w := range allowed {
+		if lw := lowerRunes([]rune(w)); len(lw) > 0 {
+			f.allowed = append(f.allowed, lw)
+		}
+	}
+	return f
+}
+
+func (f *ProfanityFilter) Check(content Content) (*Result, error) {
+	// Nicknames are written without spaces, so a word at the start of a
+	// nickname counts even when more letters follow, as in "shitlord".
+	endOnBoundary := content.Kind != KindNickname
+	title, titleMatched := f.mask(content.Title, endOnBoundary)
+	body, bodyMatched := f.mask(content.Body, endOnBoundary)
+	if !titleMatched && !bodyMatched {
+		return allow(content), nil
+	}
+
+	action := ActionMask
+	if content.Kind == KindNickname {
+		action = ActionReject
+	}
+	return &Result{Action: action, Title: title, Body: body, Reasons: []string{ReasonProfanity}}, nil
+}
+
+// mask replaces the letters of every listed word in the text with asterisks.
+// Matches must start and end on whole characters of the original text, so
+// that a word's jamo are not matched across the syllables of other words.
+// Latin words must also be whole words of the text, ending on a word boundary
+// unless endOnBoundary is false, so that "Scunthorpe" and "push it" are left
+// alone. Korean words match inside longer words, which the allowlist makes up
+// for.
+func (f *ProfanityFilter) mask(text string, endOnBoundary bool) (string, bool) {
+	n := normalize(text)
+	original := []rune(text)
+	masked := []rune(text)
+	allowed := f.allowedRunes(original)
+	matched := false
+	for _, word := range f.words {
+		bounded := !unicode.Is(unicode.Hangul, word[0])
+		for i := 0; i+len(word) <= len(n.runes); i++ {
+			last := i + len(word) - 1
+			if !n.start[i] || !n.end[last] || !equalRunes(n.runes[i:last+1], word) {
+				continue
+			}
+			if bounded && !onWordBoundaries(original, n.origin[i], n.origin[last], endOnBoundary) {
+				continue
+			}
+			if covered(allowed, n.origin[i], n.origin[last]) {
+				continue
+			}
+			matched = true
+			for j := n.origin[i]; j <= n.origin[last]; j++ {
+				if !unicode.IsSpace(masked[j]) {
+					masked[j] = '*'
+				}
+			}
+		}
+	}
+	if !matched {
+		return text, false
+	}
+	return string(masked), true
+}
+
+// onWordBoundaries reports whether the runes from first to last of the text
+// start on a word boundary and, if endOnBoundary is set, end on one. A match
+// may only span several words when they are single characters, as in
+// "f u c k", so that it does not run across ordinary words.
+func onWordBoundaries(text []rune, first, last int, endOnBoundary bool) bool {
+	if first > 0 && isWordRune(text[first-1]) {
+		return false
+	}
+	if endOnBoundary && last+1 < len(text) && isWordRune(text[last+1]) {
+		return false
+	}
+
+	words, length, singles := 0, 0, true
+	for j := first; j <= last+1; j++ {
+		if j <= last && isWordRune(text[j]) {
+			length++
+			continue
+		}
+		if length > 0 {
+			words++
+			singles = singles && length == 1
+		}
+		length = 0
+	}
+	return words == 1 || singles
+}
+
+// allowedRunes flags the runes of the text that are part of an allowlisted
+// word. Allowlisted words are matched as written, ignoring case only.
+func (f *ProfanityFilter) allowedRunes(text []rune) []bool {
+	allowed := make([]bool, len(text))
+	lower := lowerRunes(text)
+	for _, word := range f.allowed {
+		for i := 0; i+len(word) <= len(lower); i++ {
+			if equalRunes(lower[i:i+len(word)], word) {
+				for j := i; j < i+len(word); j++ {
+					allowed[j] = true
+				}
+			}
+		}
+	}
+	return allowed
+}
+
+// covered reports whether all runes from first to last are flagged.
+func covered(flags []bool, first, last int) bool {
+	for j := first; j <= last; j++ {
+		if !flags[j] {
+			return false
+		}
+	}
+	return true
+}
+
+func lowerRunes(runes []rune) []rune {
+	lower := make([]rune, len(runes))
+	for i, r := range runes {
+		lower[i] = unicode.ToLower(r)
+	}
+	return lower
+}
+
+func equalRunes(a, b []rune) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -18,6 +18,7 @@ var embeddedTemplates embed.FS
 const (
 	TemplateWelcome       = "welcome.html"
 	TemplatePasswordReset = "password_reset.html"
+	TemplateNotification  = "notification.html"
 )
 
 type WelcomeData struct {
@@ -29,6 +30,11 @@ type PasswordResetData struct {
 	ResetLink string
 }
 
+type NotificationData struct {
+	Name    string
+	Message string
+}
+
 type EmailSender interface {
 	SendEmail(to, templateName string, data interface{}) error
 }
@@ -0,0 +1,191 @@
+package lastfmclient
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const DefaultBaseURL = "https://ws.audioscrobbler.com/2.0/"
+
+// errorCodeInvalidParameters is returned by Last.fm when the requested track does not exist.
+const errorCodeInvalidParameters = 6
+
+type LastfmClient interface {
+	GetTrackInfo(ctx context.Context, artist, track string) (*Track, error)
+	GetTrackInfoByMBID(ctx context.Context, mbid string) (*Track, error)
+	GetTrackTopTags(ctx context.Context, artist, track string) ([]Tag, error)
+}
+
+type Track struct {
+	Name      string
+	MBID      string
+	URL       string
+	Artist    string
+	Duration  time.Duration
+	Listeners int64
+	Playcount int64
+}
+
+type Tag struct {
+	Name  string
+	Count int
+}
+
+type lastfmClient struct {
+	apiKey     string
+	baseURL    string
+	httpClient *http.Client
+}
+
+type Option func(*lastfmClient)
+
+func WithBaseURL(baseURL string) Option {
+	return func(c *lastfmClient) {
+		c.baseURL = baseURL
+	}
+}
+
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *lastfmClient) {
+		c.httpClient = httpClient
+	}
+}
+
+func New(apiKey string, opts ...Option) (LastfmClient, error) {
+	if apiKey == "" {
+		return nil, ErrAPIKeyRequired
+	}
+
+	client := &lastfmClient{
+		apiKey:     apiKey,
+		baseURL:    DefaultBaseURL,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+	for _, opt := range opts {
+		opt(client)
+	}
+	return client, nil
+}
+
+func (c *lastfmClient) GetTrackInfo(ctx context.Context, artist, track string) (*Track, error) {
+	params := url.Values{}
+	params.Set("artist", artist)
+	params.Set("track", track)
+	params.Set("autocorrect", "1")
+	return c.getTrackInfo(ctx, params)
+}
+
+func (c *lastfmClient) GetTrackInfoByMBID(ctx context.Context, mbid string) (*Track, error) {
+	params := url.Values{}
+	params.Set("mbid", mbid)
+	return c.getTrackInfo(ctx, params)
+}
+
+func (c *lastfmClient) getTrackInfo(ctx context.Context, params url.Values) (*Track, error) {
+	var res trackInfoResponse
+	if err := c.call(ctx, "track.getInfo", params, &res); err != nil {
+		return nil, err
+	}
+
+	duration, _ := strconv.ParseInt(res.Track.Duration, 10, 64)
+	listeners, _ := strconv.ParseInt(res.Track.Listeners, 10, 64)
+	playcount, _ := strconv.ParseInt(res.Track.Playcount, 10, 64)
+	return &Track{
+		Name:      res.Track.Name,
+		MBID:      res.Track.MBID,
+		URL:       res.Track.URL,
+		Artist:    res.Track.Artist.Name,
+		Duration:  time.Duration(duration) * time.Millisecond,
+		Listeners: listeners,
+		Playcount: playcount,
+	}, nil
+}
+
+func (c *lastfmClient) GetTrackTopTags(ctx context.Context, artist, track string) ([]Tag, error) {
+	params := url.Values{}
+	params.Set("artist", artist)
+	params.Set("track", track)
+	params.Set("autocorrect", "1")
+
+	var res topTagsResponse
+	if err := c.call(ctx, "track.getTopTags", params, &res); err != nil {
+		return nil, err
+	}
+
+	tags := make([]Tag, len(res.TopTags.Tag))
+	for i, t := range res.TopTags.Tag {
+		tags[i] = Tag{Name: t.Name, Count: t.Count}
+	}
+	return tags, nil
+}
+
+func (c *lastfmClient) call(ctx context.Context, method string, params url.Values, out interface{}) error {
+	params.Set("method", method)
+	params.Set("api_key", c.apiKey)
+	params.Set("format", "json")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"?"+params.Encode(), nil)
+	if err != nil {
+		return ErrRequest
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return ErrRequest
+	}
+	defer resp.Body.Close()
+
+	var raw json.RawMessage
+	if err := json.NewDecoder(resp.Body).Decode(&raw); err != nil {
+		return ErrDecodingBody
+	}
+
+	var apiErr errorResponse
+	if err := json.Unmarshal(raw, &apiErr); err == nil && apiErr.Error != 0 {
+		if apiErr.Error == errorCodeInvalidParameters {
+			return ErrTrackNotFound
+		}
+		return ErrUnexpectedError
+	}
+	if resp.StatusCode != http.StatusOK {
+		return ErrUnexpectedError
+	}
+
+	if err := json.Unmarshal(raw, out); err != nil {
+		return ErrDecodingBody
+	}
+	return nil
+}
+
+type errorResponse struct {
+	Error   int    `json:"error"`
+	Message string `json:"message"`
+}
+
+// Last.fm encodes most numeric fields of track.getInfo as strings.
+type trackInfoResponse struct {
+	Track struct {
+		Name      string `json:"name"`
+		MBID      string `json:"mbid"`
+		URL       string `json:"url"`
+		Duration  string `json:"duration"`
+		Listeners string `json:"listeners"`
+		Playcount string `json:"playcount"`
+		Artist    struct {
+			Name string `json:"name"`
+		} `json:"artist"`
+	} `json:"track"`
+}
+
+type topTagsResponse struct {
+	TopTags struct {
+		Tag []struct {
+			Name  string `json:"name"`
+			Count int    `json:"count"`
+		} `json:"tag"`
+	} `json:"toptags"`
+}
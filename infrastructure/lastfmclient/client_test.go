@@ -0,0 +1,85 @@
+package lastfmclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newFakeServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		assert.Equal(t, "test-key", q.Get("api_key"))
+		assert.Equal(t, "json", q.Get("format"))
+
+		w.Header().Set("Content-Type", "application/json")
+		switch q.Get("method") {
+		case "track.getInfo":
+			if q.Get("track") == "Unknown" {
+				w.Write([]byte(`{"error":6,"message":"Track not found"}`))
+				return
+			}
+			w.Write([]byte(`{"track":{"name":"Believe","mbid":"32ca187e-ee25-4f18-b7d0-3b6713f24635","url":"https://www.last.fm/music/Cher/_/Believe","duration":"240000","listeners":"1027457","playcount":"7863914","artist":{"name":"Cher"}}}`))
+		case "track.getTopTags":
+			w.Write([]byte(`{"toptags":{"tag":[{"count":100,"name":"pop"},{"count":41,"name":"dance"}]}}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":3,"message":"Invalid Method"}`))
+		}
+	}))
+}
+
+func TestNew_APIKeyRequired(t *testing.T) {
+	client, err := New("")
+	assert.ErrorIs(t, err, ErrAPIKeyRequired)
+	assert.Nil(t, client)
+}
+
+func TestLastfmClient_GetTrackInfo(t *testing.T) {
+	server := newFakeServer(t)
+	defer server.Close()
+
+	client, err := New("test-key", WithBaseURL(server.URL))
+	assert.NoError(t, err)
+
+	t.Run("Success", func(t *testing.T) {
+		track, err := client.GetTrackInfo(context.Background(), "Cher", "Believe")
+		assert.NoError(t, err)
+		assert.Equal(t, "Believe", track.Name)
+		assert.Equal(t, "Cher", track.Artist)
+		assert.Equal(t, "32ca187e-ee25-4f18-b7d0-3b6713f24635", track.MBID)
+		assert.Equal(t, 240*time.Second, track.Duration)
+		assert.Equal(t, int64(1027457), track.Listeners)
+		assert.Equal(t, int64(7863914), track.Playcount)
+	})
+
+	t.Run("TrackNotFound", func(t *testing.T) {
+		track, err := client.GetTrackInfo(context.Background(), "Cher", "Unknown")
+		assert.ErrorIs(t, err, ErrTrackNotFound)
+		assert.Nil(t, track)
+	})
+}
+
+func TestLastfmClient_GetTrackTopTags(t *testing.T) {
+	server := newFakeServer(t)
+	defer server.Close()
+
+	client, err := New("test-key", WithBaseURL(server.URL))
+	assert.NoError(t, err)
+
+	tags, err := client.GetTrackTopTags(context.Background(), "Cher", "Believe")
+	assert.NoError(t, err)
+	assert.Equal(t, []Tag{{Name: "pop", Count: 100}, {Name: "dance", Count: 41}}, tags)
+}
+
+func TestLastfmClient_RequestError(t *testing.T) {
+	client, err := New("test-key", WithBaseURL("http://127.0.0.1:0"))
+	assert.NoError(t, err)
+
+	_, err = client.GetTrackInfoByMBID(context.Background(), "32ca187e-ee25-4f18-b7d0-3b6713f24635")
+	assert.ErrorIs(t, err, ErrRequest)
+}
@@ -0,0 +1,11 @@
+package lastfmclient
+
+import "errors"
+
+var (
+	ErrAPIKeyRequired  = errors.New("lastfm api key is required")
+	ErrRequest         = errors.New("failed to request lastfm api")
+	ErrDecodingBody    = errors.New("failed to decode lastfm response")
+	ErrTrackNotFound   = errors.New("lastfm track not found")
+	ErrUnexpectedError = errors.New("lastfm api returned an error")
+)
@@ -0,0 +1,8 @@
+package markdown
+
+import "errors"
+
+var (
+	ErrPreviewNotAllowed  = errors.New("link preview is not allowed for the url")
+	ErrPreviewUnavailable = errors.New("link preview is unavailable")
+)
@@ -0,0 +1,114 @@
+package markdown
+
+import (
+	"bytes"
+	"net/url"
+	"regexp"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/text"
+)
+
+// Document is a rendered Markdown source.
+type Document struct {
+	// HTML is the sanitized rendering of the source.
+	HTML string
+	// Links holds the absolute http(s) URLs the source links to, in order of
+	// first appearance.
+	Links []string
+}
+
+type Renderer interface {
+	// Render converts CommonMark, plus strikethrough and bare URLs, to HTML.
+	// Raw HTML in the source is dropped rather than passed through, and the
+	// output is sanitized: only formatting elements survive, links are
+	// limited to http, https and mailto, and every link gets rel="nofollow".
+	Render(source string) (*Document, error)
+}
+
+type renderer struct {
+	md     goldmark.Markdown
+	policy *bluemonday.Policy
+}
+
+func New() Renderer {
+	return &renderer{
+		md: goldmark.New(
+			goldmark.WithExtensions(extension.Strikethrough, extension.Linkify),
+		),
+		policy: newPolicy(),
+	}
+}
+
+var codeLanguageClass = regexp.MustCompile(`^language-[\w+-]+$`)
+
+// newPolicy allows the elements goldmark produces for the supported syntax,
+// except images, which would let posts embed third-party trackers.
+func newPolicy() *bluemonday.Policy {
+	p := bluemonday.NewPolicy()
+	p.AllowElements(
+		"p", "br", "hr", "blockquote", "pre", "code",
+		"em", "strong", "del",
+		"ul", "ol", "li",
+		"h1", "h2", "h3", "h4", "h5", "h6",
+	)
+	p.AllowAttrs("start").Matching(bluemonday.Integer).OnElements("ol")
+	p.AllowAttrs("class").Matching(codeLanguageClass).OnElements("code")
+
+	p.AllowAttrs("href").OnElements("a")
+	p.AllowURLSchemes("http", "https", "mailto")
+	p.AllowRelativeURLs(false)
+	p.RequireParseableURLs(true)
+	p.RequireNoFollowOnLinks(true)
+	return p
+}
+
+func (r *renderer) Render(source string) (*Document, error) {
+	src := []byte(source)
+	doc := r.md.Parser().Parse(text.NewReader(src))
+
+	var buf bytes.Buffer
+	if err := r.md.Renderer().Render(&buf, src, doc); err != nil {
+		return nil, err
+	}
+	return &Document{
+		HTML:  r.policy.Sanitize(buf.String()),
+		Links: collectLinks(doc, src),
+	}, nil
+}
+
+func collectLinks(doc ast.Node, src []byte) []string {
+	links := []string{}
+	seen := make(map[string]bool)
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		var link string
+		switch n := n.(type) {
+		case *ast.Link:
+			link = string(n.Destination)
+		case *ast.AutoLink:
+			if n.AutoLinkType != ast.AutoLinkURL {
+				return ast.WalkContinue, nil
+			}
+			link = string(n.URL(src))
+		default:
+			return ast.WalkContinue, nil
+		}
+
+		if u, err := url.Parse(link); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return ast.WalkContinue, nil
+		}
+		if !seen[link] {
+			seen[link] = true
+			links = append(links, link)
+		}
+		return ast.WalkContinue, nil
+	})
+	return links
+}
@@ -0,0 +1,128 @@
+package markdown
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRender(t *testing.T) {
+	testCases := []struct {
+		name     string
+		source   string
+		expected string
+	}{
+		{
+			name:     "Formatting",
+			source:   "**bold** _em_ ~~del~~ `code`",
+			expected: "<p><strong>bold</strong> <em>em</em> <del>del</del> <code>code</code></p>\n",
+		},
+		{
+			name:     "LinkGetsNofollow",
+			source:   "[album](https://example.com/a)",
+			expected: `<p><a href="https://example.com/a" rel="nofollow">album</a></p>` + "\n",
+		},
+		{
+			name:     "JavascriptLinkDropped",
+			source:   "[click](javascript:alert(1))",
+			expected: "<p>click</p>\n",
+		},
+		{
+			name:     "RawHTMLDropped",
+			source:   "<script>alert(1)</script>\n\nhi <b onclick=\"x()\">there</b>",
+			expected: "\n<p>hi there</p>\n",
+		},
+		{
+			name:     "ImagesDropped",
+			source:   "![cover](https://example.com/cover.jpg)",
+			expected: "<p></p>\n",
+		},
+		{
+			name:     "FencedCode",
+			source:   "```go\nfmt.Println(1)\n```",
+			expected: "<pre><code class=\"language-go\">fmt.Println(1)\n</code></pre>\n",
+		},
+	}
+
+	r := New()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			doc, err := r.Render(tc.source)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, doc.HTML)
+		})
+	}
+}
+
+func TestRender_Links(t *testing.T) {
+	doc, err := New().Render("See [this](https://youtu.be/abc), https://youtu.be/abc, www.example.com and [rel](/local)")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"https://youtu.be/abc", "http://www.example.com"}, doc.Links)
+}
+
+func TestHTTPPreviewFetcher(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/video":
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			w.Write([]byte(`<html><head><title>Fallback</title>
+				<meta property="og:title" content="Aimee Mann - Wise Up">
+				<meta property="og:description" content="Live at the Troubadour">
+				<meta property="og:image" content="https://example.com/thumb.jpg">
+				<meta property="og:site_name" content="YouTube">
+				</head><body><meta property="og:title" content="ignored"></body></html>`))
+		case "/title-only":
+			w.Header().Set("Content-Type", "text/html")
+			w.Write([]byte(`<html><head><title> Just a title </title></head></html>`))
+		case "/redirect":
+			http.Redirect(w, r, "https://evil.example/", http.StatusFound)
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{}`))
+		}
+	}))
+	defer server.Close()
+
+	u, _ := url.Parse(server.URL)
+	fetcher := NewHTTPPreviewFetcher(server.Client(), []string{u.Hostname()})
+
+	t.Run("OpenGraph", func(t *testing.T) {
+		preview, err := fetcher.Fetch(context.Background(), server.URL+"/video")
+		assert.NoError(t, err)
+		assert.Equal(t, &LinkPreview{
+			URL:         server.URL + "/video",
+			Title:       "Aimee Mann - Wise Up",
+			Description: "Live at the Troubadour",
+			ImageURL:    "https://example.com/thumb.jpg",
+			SiteName:    "YouTube",
+		}, preview)
+	})
+
+	t.Run("TitleFallback", func(t *testing.T) {
+		preview, err := fetcher.Fetch(context.Background(), server.URL+"/title-only")
+		assert.NoError(t, err)
+		assert.Equal(t, "Just a title", preview.Title)
+	})
+
+	t.Run("NotHTML", func(t *testing.T) {
+		_, err := fetcher.Fetch(context.Background(), server.URL+"/data")
+		assert.ErrorIs(t, err, ErrPreviewUnavailable)
+	})
+
+	t.Run("RedirectOutsideWhitelist", func(t *testing.T) {
+		_, err := fetcher.Fetch(context.Background(), server.URL+"/redirect")
+		assert.ErrorIs(t, err, ErrPreviewNotAllowed)
+	})
+
+	t.Run("Allowed", func(t *testing.T) {
+		youtube := NewHTTPPreviewFetcher(nil, DefaultPreviewDomains)
+		assert.True(t, youtube.Allowed("https://www.youtube.com/watch?v=abc"))
+		assert.False(t, youtube.Allowed("http://www.youtube.com/watch?v=abc"))
+		assert.False(t, youtube.Allowed("https://notyoutube.com/watch?v=abc"))
+		assert.False(t, youtube.Allowed("https://youtube.com.evil.example/"))
+	})
+}
@@ -0,0 +1,180 @@
+package markdown
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html"
+)
+
+// maxPreviewPageSize limits how much of a page is read looking for its
+// metadata, which lives in the <head>.
+const maxPreviewPageSize = 1 << 20
+
+// DefaultPreviewDomains are the sites link previews are generated for when no
+// other list is configured. Subdomains are included.
+var DefaultPreviewDomains = []string{
+	"youtube.com",
+	"youtu.be",
+	"open.spotify.com",
+	"soundcloud.com",
+	"bandcamp.com",
+	"music.apple.com",
+}
+
+// LinkPreview is the Open Graph summary of a page.
+type LinkPreview struct {
+	URL         string
+	Title       string
+	Description string
+	ImageURL    string
+	SiteName    string
+}
+
+type PreviewFetcher interface {
+	// Allowed reports whether previews are generated for the URL.
+	Allowed(rawURL string) bool
+	// Fetch reads the page's Open Graph metadata, falling back to its <title>.
+	Fetch(ctx context.Context, rawURL string) (*LinkPreview, error)
+}
+
+type httpPreviewFetcher struct {
+	httpClient *http.Client
+	domains    []string
+}
+
+// NewHTTPPreviewFetcher creates a fetcher that only requests https pages on
+// the given domains, following redirects only within them.
+func NewHTTPPreviewFetcher(httpClient *http.Client, domains []string) PreviewFetcher {
+	f := &httpPreviewFetcher{domains: domains}
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 5 * time.Second}
+	}
+	client := *httpClient
+	client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if len(via) >= 5 {
+			return errors.New("too many redirects fetching link preview")
+		}
+		if !f.Allowed(req.URL.String()) {
+			return ErrPreviewNotAllowed
+		}
+		return nil
+	}
+	f.httpClient = &client
+	return f
+}
+
+func (f *httpPreviewFetcher) Allowed(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Scheme != "https" {
+		return false
+	}
+	host := strings.ToLower(u.Hostname())
+	for _, domain := range f.domains {
+		domain = strings.ToLower(strings.TrimSpace(domain))
+		if domain != "" && (host == domain || strings.HasSuffix(host, "."+domain)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *httpPreviewFetcher) Fetch(ctx context.Context, rawURL string) (*LinkPreview, error) {
+	if !f.Allowed(rawURL) {
+		return nil, ErrPreviewNotAllowed
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "text/html")
+	res, err := f.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status fetching link preview: %d", res.StatusCode)
+	}
+	if mediaType, _, _ := mime.ParseMediaType(res.Header.Get("Content-Type")); mediaType != "text/html" {
+		return nil, ErrPreviewUnavailable
+	}
+
+	preview := parsePreview(io.LimitReader(res.Body, maxPreviewPageSize))
+	if preview.Title == "" {
+		return nil, ErrPreviewUnavailable
+	}
+	preview.URL = rawURL
+	return preview, nil
+}
+
+// parsePreview reads the page's <head> and stops at <body>.
+func parsePreview(r io.Reader) *LinkPreview {
+	preview := &LinkPreview{}
+	var title string
+	inTitle := false
+
+	z := html.NewTokenizer(r)
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			if preview.Title == "" {
+				preview.Title = title
+			}
+			return preview
+		case html.StartTagToken, html.SelfClosingTagToken:
+			t := z.Token()
+			switch t.Data {
+			case "body":
+				if preview.Title == "" {
+					preview.Title = title
+				}
+				return preview
+			case "title":
+				inTitle = true
+			case "meta":
+				applyMeta(preview, t.Attr)
+			}
+		case html.EndTagToken:
+			inTitle = false
+		case html.TextToken:
+			if inTitle && title == "" {
+				title = strings.TrimSpace(string(z.Text()))
+			}
+		}
+	}
+}
+
+func applyMeta(preview *LinkPreview, attrs []html.Attribute) {
+	var property, content string
+	for _, a := range attrs {
+		switch a.Key {
+		case "property", "name":
+			property = a.Val
+		case "content":
+			content = strings.TrimSpace(a.Val)
+		}
+	}
+
+	switch property {
+	case "og:title":
+		preview.Title = content
+	case "og:description":
+		preview.Description = content
+	case "og:image":
+		if u, err := url.Parse(content); err == nil && u.Scheme == "https" {
+			preview.ImageURL = content
+		}
+	case "og:site_name":
+		preview.SiteName = content
+	}
+}
@@ -0,0 +1,108 @@
+package playlistformat
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"strings"
+)
+
+var csvHeader = []string{"title", "artist", "album", "isrc", "spotify_id"}
+
+// csvColumns maps the header names we accept to our fields. Besides our own
+// header, the names used by common Spotify playlist exporters are recognized.
+var csvColumns = map[string]string{
+	"title":            "title",
+	"track name":       "title",
+	"name":             "title",
+	"artist":           "artist",
+	"artists":          "artist",
+	"artist name(s)":   "artist",
+	"album":            "album",
+	"album name":       "album",
+	"isrc":             "isrc",
+	"spotify_id":       "spotify_id",
+	"spotify id":       "spotify_id",
+	"track uri":        "spotify_id",
+	"spotify uri":      "spotify_id",
+	"spotify_track_id": "spotify_id",
+}
+
+func encodeCSV(w io.Writer, p *Playlist) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, t := range p.Tracks {
+		if err := cw.Write([]string{t.Title, t.Artist, t.Album, t.ISRC, t.SpotifyID}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// decodeCSV reads a CSV file with a header row. Columns are matched by name,
+// so their order does not matter and unknown columns are ignored.
+func decodeCSV(r io.Reader) (*Playlist, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, ErrInvalidPlaylist
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		if field, ok := csvColumns[name]; ok {
+			if _, dup := columns[field]; !dup {
+				columns[field] = i
+			}
+		}
+	}
+	_, hasTitle := columns["title"]
+	_, hasISRC := columns["isrc"]
+	_, hasSpotifyID := columns["spotify_id"]
+	if !hasTitle && !hasISRC && !hasSpotifyID {
+		return nil, ErrInvalidPlaylist
+	}
+
+	p := &Playlist{}
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, ErrInvalidPlaylist
+		}
+		get := func(field string) string {
+			i, ok := columns[field]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		line, _ := cr.FieldPos(0)
+		t := Track{
+			Title:  get("title"),
+			Artist: get("artist"),
+			Album:  get("album"),
+			ISRC:   strings.ToUpper(get("isrc")),
+			Line:   line,
+		}
+		if id := get("spotify_id"); id != "" {
+			if parsed := ParseSpotifyTrackID(id); parsed != "" {
+				id = parsed
+			}
+			t.SpotifyID = id
+		}
+		if t.Title == "" && t.ISRC == "" && t.SpotifyID == "" {
+			continue
+		}
+		p.Tracks = append(p.Tracks, t)
+	}
+	return p, nil
+}
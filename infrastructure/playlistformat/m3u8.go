@@ -0,0 +1,100 @@
+package playlistformat
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+)
+
+const m3u8Header = "#EXTM3U"
+
+// encodeM3U8 writes an extended M3U playlist. The catalog has no durations, so
+// every entry is written with the "unknown" duration of -1.
+func encodeM3U8(w io.Writer, p *Playlist) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, m3u8Header)
+	if p.Title != "" {
+		fmt.Fprintf(bw, "#PLAYLIST:%s\n", oneLine(p.Title))
+	}
+	for _, t := range p.Tracks {
+		fmt.Fprintf(bw, "#EXTINF:-1,%s\n", oneLine(displayName(t)))
+		if t.Album != "" {
+			fmt.Fprintf(bw, "#EXTALB:%s\n", oneLine(t.Album))
+		}
+		fmt.Fprintln(bw, location(t))
+	}
+	return bw.Flush()
+}
+
+// decodeM3U8 reads both plain and extended M3U playlists. Entries without an
+// #EXTINF line take their title from the file name, e.g. "Artist - Title.mp3".
+func decodeM3U8(r io.Reader) (*Playlist, error) {
+	p := &Playlist{}
+	scanner := bufio.NewScanner(r)
+
+	var pending *Track
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if lineNo == 1 {
+			line = strings.TrimPrefix(line, "\ufeff")
+		}
+		switch {
+		case line == "" || line == m3u8Header:
+			continue
+		case strings.HasPrefix(line, "#PLAYLIST:"):
+			p.Title = strings.TrimSpace(strings.TrimPrefix(line, "#PLAYLIST:"))
+		case strings.HasPrefix(line, "#EXTINF:"):
+			pending = &Track{Line: lineNo}
+			// The display name follows the first comma, after the duration
+			// and any attributes.
+			if _, name, ok := strings.Cut(line, ","); ok {
+				pending.Artist, pending.Title = splitDisplayName(name)
+			}
+		case strings.HasPrefix(line, "#EXTALB:"):
+			if pending != nil {
+				pending.Album = strings.TrimSpace(strings.TrimPrefix(line, "#EXTALB:"))
+			}
+		case strings.HasPrefix(line, "#"):
+			continue
+		default:
+			t := Track{Line: lineNo}
+			if pending != nil {
+				t = *pending
+			}
+			applyLocation(&t, line)
+			if t.Title == "" && t.SpotifyID == "" && t.ISRC == "" {
+				name := path.Base(strings.ReplaceAll(line, "\\", "/"))
+				t.Artist, t.Title = splitDisplayName(strings.TrimSuffix(name, path.Ext(name)))
+			}
+			p.Tracks = append(p.Tracks, t)
+			pending = nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, ErrInvalidPlaylist
+	}
+	return p, nil
+}
+
+func displayName(t Track) string {
+	if t.Artist == "" {
+		return t.Title
+	}
+	return t.Artist + " - " + t.Title
+}
+
+func splitDisplayName(name string) (artist, title string) {
+	name = strings.TrimSpace(name)
+	if artist, title, ok := strings.Cut(name, " - "); ok {
+		return strings.TrimSpace(artist), strings.TrimSpace(title)
+	}
+	return "", name
+}
+
+func oneLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
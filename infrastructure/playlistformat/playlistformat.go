@@ -0,0 +1,152 @@
+package playlistformat
+
+import (
+	"errors"
+	"io"
+	"net/url"
+	"strings"
+)
+
+type Format string
+
+const (
+	FormatM3U8 Format = "m3u8"
+	FormatXSPF Format = "xspf"
+	FormatJSPF Format = "jspf"
+	FormatCSV  Format = "csv"
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported playlist format")
+	ErrInvalidPlaylist   = errors.New("invalid playlist file")
+)
+
+// Playlist is the format independent representation of a playlist file.
+type Playlist struct {
+	Title       string
+	Description string
+	Tracks      []Track
+}
+
+// Track is a single playlist entry. Line is the 1-based line (or entry)
+// number the track was read from, so unresolved entries can be reported back.
+type Track struct {
+	Title     string
+	Artist    string
+	Album     string
+	ISRC      string
+	SpotifyID string
+	Line      int
+}
+
+// ParseFormat accepts a format name or file extension, e.g. "xspf" or ".m3u".
+func ParseFormat(s string) (Format, error) {
+	switch strings.TrimPrefix(strings.ToLower(strings.TrimSpace(s)), ".") {
+	case "m3u8", "m3u":
+		return FormatM3U8, nil
+	case "xspf":
+		return FormatXSPF, nil
+	case "jspf":
+		return FormatJSPF, nil
+	case "csv":
+		return FormatCSV, nil
+	}
+	return "", ErrUnsupportedFormat
+}
+
+func (f Format) ContentType() string {
+	switch f {
+	case FormatM3U8:
+		return "audio/x-mpegurl; charset=utf-8"
+	case FormatXSPF:
+		return "application/xspf+xml"
+	case FormatJSPF:
+		return "application/json"
+	case FormatCSV:
+		return "text/csv; charset=utf-8"
+	}
+	return "application/octet-stream"
+}
+
+func (f Format) Extension() string {
+	return "." + string(f)
+}
+
+func Encode(w io.Writer, format Format, p *Playlist) error {
+	switch format {
+	case FormatM3U8:
+		return encodeM3U8(w, p)
+	case FormatXSPF:
+		return encodeXSPF(w, p)
+	case FormatJSPF:
+		return encodeJSPF(w, p)
+	case FormatCSV:
+		return encodeCSV(w, p)
+	}
+	return ErrUnsupportedFormat
+}
+
+func Decode(r io.Reader, format Format) (*Playlist, error) {
+	switch format {
+	case FormatM3U8:
+		return decodeM3U8(r)
+	case FormatXSPF:
+		return decodeXSPF(r)
+	case FormatJSPF:
+		return decodeJSPF(r)
+	case FormatCSV:
+		return decodeCSV(r)
+	}
+	return nil, ErrUnsupportedFormat
+}
+
+const isrcScheme = "isrc:"
+
+// location returns the URI used to identify a track in formats that only
+// carry a location, preferring the Spotify URL over the ISRC.
+func location(t Track) string {
+	if t.SpotifyID != "" {
+		return SpotifyTrackURL(t.SpotifyID)
+	}
+	if t.ISRC != "" {
+		return isrcScheme + t.ISRC
+	}
+	return ""
+}
+
+// applyLocation fills in the Spotify ID or ISRC identified by the URI, if any.
+func applyLocation(t *Track, uri string) {
+	uri = strings.TrimSpace(uri)
+	if id := ParseSpotifyTrackID(uri); id != "" {
+		t.SpotifyID = id
+		return
+	}
+	if strings.HasPrefix(strings.ToLower(uri), isrcScheme) {
+		t.ISRC = strings.ToUpper(strings.TrimSpace(uri[len(isrcScheme):]))
+	}
+}
+
+func SpotifyTrackURL(id string) string {
+	return "https://open.spotify.com/track/" + id
+}
+
+// ParseSpotifyTrackID extracts the track ID from a Spotify track URI
+// ("spotify:track:ID") or URL ("https://open.spotify.com/track/ID?si=...").
+// It returns an empty string for anything else.
+func ParseSpotifyTrackID(s string) string {
+	if id, ok := strings.CutPrefix(s, "spotify:track:"); ok {
+		return id
+	}
+	u, err := url.Parse(s)
+	if err != nil || u.Host != "open.spotify.com" {
+		return ""
+	}
+	// Localized links look like /intl-ko/track/ID.
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	for i := 0; i+1 < len(segments); i++ {
+		if segments[i] == "track" {
+			return segments[i+1]
+		}
+	}
+	return ""
+}
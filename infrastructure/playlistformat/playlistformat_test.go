@@ -0,0 +1,103 @@
+package playlistformat
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testPlaylist = &Playlist{
+	Title:       "Night Drive",
+	Description: "late",
+	Tracks: []Track{
+		{Title: "Midnight City", Artist: "M83", Album: "Hurry Up, We're Dreaming", ISRC: "FR6V81141065", SpotifyID: "1eyzqe2QqGZUmfcPZtrIyt"},
+		{Title: "Nightcall", Artist: "Kavinsky", ISRC: "FR9W11013375"},
+	},
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, format := range []Format{FormatXSPF, FormatJSPF, FormatCSV} {
+		t.Run(string(format), func(t *testing.T) {
+			var buf bytes.Buffer
+			assert.NoError(t, Encode(&buf, format, testPlaylist))
+
+			decoded, err := Decode(&buf, format)
+			assert.NoError(t, err)
+			assert.Len(t, decoded.Tracks, 2)
+			for i, track := range decoded.Tracks {
+				expected := testPlaylist.Tracks[i]
+				expected.Line = track.Line
+				assert.Equal(t, expected, track)
+			}
+		})
+	}
+}
+
+func TestM3U8(t *testing.T) {
+	t.Run("RoundTrip", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, Encode(&buf, FormatM3U8, testPlaylist))
+		assert.True(t, strings.HasPrefix(buf.String(), "#EXTM3U\n#PLAYLIST:Night Drive\n#EXTINF:-1,M83 - Midnight City\n"))
+
+		decoded, err := Decode(&buf, FormatM3U8)
+		assert.NoError(t, err)
+		assert.Equal(t, "Night Drive", decoded.Title)
+		// M3U8 carries only one location per entry, so the Spotify ID wins
+		// over the ISRC.
+		assert.Equal(t, []Track{
+			{Title: "Midnight City", Artist: "M83", Album: "Hurry Up, We're Dreaming", SpotifyID: "1eyzqe2QqGZUmfcPZtrIyt", Line: 3},
+			{Title: "Nightcall", Artist: "Kavinsky", ISRC: "FR9W11013375", Line: 6},
+		}, decoded.Tracks)
+	})
+
+	t.Run("PlainEntries", func(t *testing.T) {
+		decoded, err := Decode(strings.NewReader("\ufeffMusic\\Daft Punk - One More Time.mp3\n\n# comment\nspotify:track:abc\n"), FormatM3U8)
+		assert.NoError(t, err)
+		assert.Equal(t, []Track{
+			{Title: "One More Time", Artist: "Daft Punk", Line: 1},
+			{SpotifyID: "abc", Line: 4},
+		}, decoded.Tracks)
+	})
+}
+
+func TestDecodeCSV(t *testing.T) {
+	t.Run("SpotifyExporterHeader", func(t *testing.T) {
+		data := "Track URI,Track Name,Artist Name(s),Album Name,Popularity\n" +
+			"spotify:track:abc,Digital Love,Daft Punk,Discovery,70\n" +
+			",,,,\n"
+		decoded, err := Decode(strings.NewReader(data), FormatCSV)
+		assert.NoError(t, err)
+		assert.Equal(t, []Track{{Title: "Digital Love", Artist: "Daft Punk", Album: "Discovery", SpotifyID: "abc", Line: 2}}, decoded.Tracks)
+	})
+
+	t.Run("NoKnownColumns", func(t *testing.T) {
+		_, err := Decode(strings.NewReader("foo,bar\n1,2\n"), FormatCSV)
+		assert.ErrorIs(t, err, ErrInvalidPlaylist)
+	})
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	_, err := Decode(strings.NewReader("<playlist><trackList>"), FormatXSPF)
+	assert.ErrorIs(t, err, ErrInvalidPlaylist)
+
+	_, err = Decode(strings.NewReader(`{"playlist":`), FormatJSPF)
+	assert.ErrorIs(t, err, ErrInvalidPlaylist)
+}
+
+func TestParseFormat(t *testing.T) {
+	format, err := ParseFormat(".M3U")
+	assert.NoError(t, err)
+	assert.Equal(t, FormatM3U8, format)
+
+	_, err = ParseFormat("pls")
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
+}
+
+func TestParseSpotifyTrackID(t *testing.T) {
+	assert.Equal(t, "abc", ParseSpotifyTrackID("spotify:track:abc"))
+	assert.Equal(t, "abc", ParseSpotifyTrackID("https://open.spotify.com/intl-ko/track/abc?si=123"))
+	assert.Equal(t, "", ParseSpotifyTrackID("https://open.spotify.com/album/abc"))
+	assert.Equal(t, "", ParseSpotifyTrackID("https://example.com/track/abc"))
+}
@@ -0,0 +1,119 @@
+package playlistformat
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"io"
+)
+
+const xspfNamespace = "http://xspf.org/ns/0/"
+
+type xspfPlaylist struct {
+	XMLName    xml.Name    `xml:"playlist"`
+	Version    string      `xml:"version,attr"`
+	Namespace  string      `xml:"xmlns,attr,omitempty"`
+	Title      string      `xml:"title,omitempty"`
+	Annotation string      `xml:"annotation,omitempty"`
+	Tracks     []xspfTrack `xml:"trackList>track"`
+}
+
+// xspfTrack is shared by XSPF and JSPF, which use the same field names.
+type xspfTrack struct {
+	Locations   []string `xml:"location,omitempty" json:"location,omitempty"`
+	Identifiers []string `xml:"identifier,omitempty" json:"identifier,omitempty"`
+	Title       string   `xml:"title,omitempty" json:"title,omitempty"`
+	Creator     string   `xml:"creator,omitempty" json:"creator,omitempty"`
+	Album       string   `xml:"album,omitempty" json:"album,omitempty"`
+}
+
+// jspfDocument is the JSON form of XSPF, see https://www.xspf.org/jspf.
+type jspfDocument struct {
+	Playlist jspfPlaylist `json:"playlist"`
+}
+
+type jspfPlaylist struct {
+	Title      string      `json:"title,omitempty"`
+	Annotation string      `json:"annotation,omitempty"`
+	Tracks     []xspfTrack `json:"track"`
+}
+
+func encodeXSPF(w io.Writer, p *Playlist) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	playlist := xspfPlaylist{
+		Version:    "1",
+		Namespace:  xspfNamespace,
+		Title:      p.Title,
+		Annotation: p.Description,
+		Tracks:     toXSPFTracks(p.Tracks),
+	}
+	if err := enc.Encode(playlist); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func decodeXSPF(r io.Reader) (*Playlist, error) {
+	var playlist xspfPlaylist
+	if err := xml.NewDecoder(r).Decode(&playlist); err != nil {
+		return nil, ErrInvalidPlaylist
+	}
+	return &Playlist{
+		Title:       playlist.Title,
+		Description: playlist.Annotation,
+		Tracks:      fromXSPFTracks(playlist.Tracks),
+	}, nil
+}
+
+func encodeJSPF(w io.Writer, p *Playlist) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(jspfDocument{Playlist: jspfPlaylist{
+		Title:      p.Title,
+		Annotation: p.Description,
+		Tracks:     toXSPFTracks(p.Tracks),
+	}})
+}
+
+func decodeJSPF(r io.Reader) (*Playlist, error) {
+	var doc jspfDocument
+	if err := json.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, ErrInvalidPlaylist
+	}
+	return &Playlist{
+		Title:       doc.Playlist.Title,
+		Description: doc.Playlist.Annotation,
+		Tracks:      fromXSPFTracks(doc.Playlist.Tracks),
+	}, nil
+}
+
+func toXSPFTracks(tracks []Track) []xspfTrack {
+	result := make([]xspfTrack, len(tracks))
+	for i, t := range tracks {
+		result[i] = xspfTrack{Title: t.Title, Creator: t.Artist, Album: t.Album}
+		if t.SpotifyID != "" {
+			result[i].Locations = []string{SpotifyTrackURL(t.SpotifyID)}
+		}
+		if t.ISRC != "" {
+			result[i].Identifiers = []string{isrcScheme + t.ISRC}
+		}
+	}
+	return result
+}
+
+// fromXSPFTracks numbers the tracks by their position in the track list,
+// since XML and JSON documents have no meaningful line per entry.
+func fromXSPFTracks(tracks []xspfTrack) []Track {
+	result := make([]Track, len(tracks))
+	for i, t := range tracks {
+		result[i] = Track{Title: t.Title, Artist: t.Creator, Album: t.Album, Line: i + 1}
+		for _, uri := range append(t.Locations, t.Identifiers...) {
+			applyLocation(&result[i], uri)
+		}
+	}
+	return result
+}
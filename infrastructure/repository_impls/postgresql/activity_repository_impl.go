@@ -0,0 +1,87 @@
+package postgresql
+
+import (
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"gorm.io/gorm"
+)
+
+type ActivityRepository struct {
+	db *gorm.DB
+}
+
+func NewActivityRepository(db *gorm.DB) repositories.ActivityRepository {
+	return &ActivityRepository{db: db}
+}
+
+func (r *ActivityRepository) Create(activity *entities.Activity) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// Nil subject IDs are left out of the struct condition, so this
+		// matches on the one subject the activity has.
+		earlier := &entities.Activity{
+			ActorID:      activity.ActorID,
+			Type:         activity.Type,
+			PostID:       activity.PostID,
+			TopsterID:    activity.TopsterID,
+			CollectionID: activity.CollectionID,
+			MusicID:      activity.MusicID,
+			TargetUserID: activity.TargetUserID,
+		}
+		if err := tx.Where(earlier).Delete(&entities.Activity{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Omit("Actor", "Post", "Topster", "Collection", "Music", "TargetUser").Create(activity).Error; err != nil {
+			return err
+		}
+		if !activity.FannedOut {
+			return nil
+		}
+		return tx.Exec(`INSERT INTO feed_items (user_id, activity_id, created_at)
+			SELECT follower_id, ?, ? FROM user_follows WHERE following_id = ?`,
+			activity.ID, activity.CreatedAt, activity.ActorID).Error
+	})
+	if err != nil {
+		return repositories.ErrCreate
+	}
+	return nil
+}
+
+// FindFeed reads the fanned out activities from the viewer's feed items and
+// only looks up the activities of accounts read on demand, so that the cost
+// of a read does not grow with the number of accounts the viewer follows.
+func (r *ActivityRepository) FindFeed(viewer repositories.Viewer, beforeID uint, limit int) ([]*entities.Activity, error) {
+	fannedOut := r.db.Table("feed_items").Select("activity_id").Where("user_id = ?", viewer.ID)
+	onDemand := r.db.Table("activities").Select("id").Where("NOT fanned_out").
+		Where("actor_id IN (SELECT following_id FROM user_follows WHERE follower_id = ?)", viewer.ID)
+	if beforeID > 0 {
+		fannedOut = fannedOut.Where("activity_id < ?", beforeID)
+		onDemand = onDemand.Where("id < ?", beforeID)
+	}
+
+	query := r.db.Preload("Actor.UserProfile").Preload("Post").Preload("Topster").Preload("Collection").
+		Preload("Music.Album").Preload("TargetUser.UserProfile").
+		Joins("JOIN users ON users.id = activities.actor_id AND users.deleted_at IS NULL").
+		Where("activities.id IN (? UNION ALL ?)", fannedOut, onDemand).
+		// Feed items stay behind when the viewer unfollows the actor.
+		Where("EXISTS (SELECT 1 FROM user_follows "+
+			"WHERE user_follows.follower_id = ? AND user_follows.following_id = activities.actor_id)", viewer.ID).
+		Where("activities.post_id IS NULL OR EXISTS (SELECT 1 FROM posts " +
+			"WHERE posts.id = activities.post_id AND NOT posts.is_hidden)").
+		Where("activities.topster_id IS NULL OR EXISTS (SELECT 1 FROM user_topsters " +
+			"WHERE user_topsters.id = activities.topster_id AND user_topsters.is_public)").
+		Where("activities.collection_id IS NULL OR EXISTS (SELECT 1 FROM music_collections " +
+			"WHERE music_collections.id = activities.collection_id AND music_collections.is_public)").
+		Where("activities.music_id IS NULL OR EXISTS (SELECT 1 FROM user_likes " +
+			"WHERE user_likes.user_id = activities.actor_id AND user_likes.music_id = activities.music_id AND user_likes.liked)").
+		Where("activities.target_user_id IS NULL OR EXISTS (SELECT 1 FROM user_follows " +
+			"JOIN users targets ON targets.id = user_follows.following_id AND targets.deleted_at IS NULL " +
+			"WHERE user_follows.follower_id = activities.actor_id AND user_follows.following_id = activities.target_user_id)").
+		Scopes(visibleTo(viewer, "activities.actor_id")).
+		Scopes(visibleTo(repositories.Viewer{ID: viewer.ID}, "activities.target_user_id"))
+
+	var activities []*entities.Activity
+	if err := query.Order("activities.id DESC").Limit(limit).Find(&activities).Error; err != nil {
+		return nil, repositories.ErrFind
+	}
+	return activities, nil
+}
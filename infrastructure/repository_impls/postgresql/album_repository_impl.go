@@ -0,0 +1,197 @@
+package postgresql
+
+import (
+	"errors"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"gorm.io/gorm"
+)
+
+type AlbumRepository struct {
+	db *gorm.DB
+}
+
+func NewAlbumRepository(db *gorm.DB) repositories.AlbumRepository {
+	return &AlbumRepository{db: db}
+}
+
+func (r *AlbumRepository) Create(album *entities.Album) error {
+	if err := r.db.Omit("Artist", "Music").Create(album).Error; err != nil {
+		return repositories.ErrCreate
+	}
+	return nil
+}
+
+func (r *AlbumRepository) FindByID(id uint) (*entities.Album, error) {
+	album := new(entities.Album)
+	err := r.db.Preload("Artist").
+		Where("albums.id = COALESCE((SELECT album_id FROM album_aliases WHERE old_album_id = ?), ?)", id, id).
+		First(&album).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, repositories.ErrFind
+	}
+	return album, nil
+}
+
+func (r *AlbumRepository) FindBySpotifyID(spotifyID string) (*entities.Album, error) {
+	album := new(entities.Album)
+	err := r.db.Preload("Artist").Where("spotify_id = ?", spotifyID).First(&album).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, repositories.ErrFind
+	}
+	return album, nil
+}
+
+func (r *AlbumRepository) FindByUPC(upc string) ([]*entities.Album, error) {
+	var albums []*entities.Album
+	if err := r.db.Preload("Artist").Where("upc = ?", upc).Order("id").Find(&albums).Error; err != nil {
+		return nil, repositories.ErrFind
+	}
+	return albums, nil
+}
+
+func (r *AlbumRepository) FindDuplicateUPCs() ([]string, error) {
+	var upcs []string
+	err := r.db.Model(&entities.Album{}).
+		Where("upc <> ''").
+		Group("upc").Having("COUNT(*) > 1").Order("upc").
+		Pluck("upc", &upcs).Error
+	if err != nil {
+		return nil, repositories.ErrFind
+	}
+	return upcs, nil
+}
+
+func (r *AlbumRepository) FindByArtistID(artistID uint, offset, limit int) ([]*entities.Album, error) {
+	var albums []*entities.Album
+	err := r.db.Preload("Artist").
+		Where("artist_id = ?", artistID).
+		Order("release_date DESC, id").Offset(offset).Limit(limit).
+		Find(&albums).Error
+	if err != nil {
+		return nil, repositories.ErrFind
+	}
+	return albums, nil
+}
+
+func (r *AlbumRepository) SearchByName(name string, offset, limit int) ([]*entities.Album, error) {
+	var albums []*entities.Album
+	err := r.db.Preload("Artist").
+		Where("name ILIKE ?", "%"+likeEscaper.Replace(name)+"%").
+		Order("id").Offset(offset).Limit(limit).
+		Find(&albums).Error
+	if err != nil {
+		return nil, repositories.ErrFind
+	}
+	return albums, nil
+}
+
+// FindMostLikedByUserID returns the albums with the most tracks the user
+// liked. Ties go to the album liked most recently.
+func (r *AlbumRepository) FindMostLikedByUserID(userID uint, limit int) ([]*entities.Album, error) {
+	var albums []*entities.Album
+	err := r.db.Preload("Artist").
+		Joins("JOIN music ON music.album_id = albums.id").
+		Joins("JOIN user_likes ON user_likes.music_id = music.id").
+		Where("user_likes.user_id = ? AND user_likes.liked", userID).
+		Group("albums.id").
+		Order("COUNT(*) DESC, MAX(user_likes.created_at) DESC, albums.id").Limit(limit).
+		Find(&albums).Error
+	if err != nil {
+		return nil, repositories.ErrFind
+	}
+	return albums, nil
+}
+
+// FindMostLikedByGenreID returns the albums of the genre's tracks, ordered by
+// how many likes those tracks received from all users.
+func (r *AlbumRepository) FindMostLikedByGenreID(genreID uint, limit int) ([]*entities.Album, error) {
+	var albums []*entities.Album
+	err := r.db.Preload("Artist").
+		Joins("JOIN music ON music.album_id = albums.id").
+		Joins("JOIN music_genre_mapping ON music_genre_mapping.music_id = music.id").
+		Joins("LEFT JOIN user_likes ON user_likes.music_id = music.id AND user_likes.liked").
+		Where("music_genre_mapping.genre_id = ?", genreID).
+		Group("albums.id").
+		Order("COUNT(user_likes.id) DESC, COUNT(DISTINCT music.id) DESC, albums.id").Limit(limit).
+		Find(&albums).Error
+	if err != nil {
+		return nil, repositories.ErrFind
+	}
+	return albums, nil
+}
+
+// FindByCollectionID returns the albums of the collection's tracks in the
+// order they first appear in the collection.
+func (r *AlbumRepository) FindByCollectionID(collectionID uint, limit int) ([]*entities.Album, error) {
+	var albums []*entities.Album
+	err := r.db.Preload("Artist").
+		Joins("JOIN music ON music.album_id = albums.id").
+		Joins("JOIN collection_music_mapping ON collection_music_mapping.music_id = music.id").
+		Where("collection_music_mapping.collection_id = ?", collectionID).
+		Group("albums.id").
+		Order("MIN(collection_music_mapping.position), albums.id").Limit(limit).
+		Find(&albums).Error
+	if err != nil {
+		return nil, repositories.ErrFind
+	}
+	return albums, nil
+}
+
+func (r *AlbumRepository) Update(album *entities.Album) error {
+	if err := r.db.Omit("Artist", "Music").Save(album).Error; err != nil {
+		return repositories.ErrUpdate
+	}
+	return nil
+}
+
+func (r *AlbumRepository) Delete(id uint) error {
+	if err := r.db.Delete(&entities.Album{}, id).Error; err != nil {
+		return repositories.ErrDelete
+	}
+	return nil
+}
+
+// Merge moves the tracks and topster entries of the duplicates to the
+// canonical album, records the duplicate IDs as aliases and deletes the
+// duplicates.
+func (r *AlbumRepository) Merge(canonicalID uint, duplicateIDs []uint) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&entities.Music{}).
+			Where("album_id IN ?", duplicateIDs).
+			Update("album_id", canonicalID).Error
+		if err != nil {
+			return err
+		}
+		if err := repointRows(tx, "topster_albums", "album_id", "topster_id", canonicalID, duplicateIDs); err != nil {
+			return err
+		}
+
+		err = tx.Model(&entities.AlbumAlias{}).
+			Where("album_id IN ?", duplicateIDs).
+			Update("album_id", canonicalID).Error
+		if err != nil {
+			return err
+		}
+		aliases := make([]entities.AlbumAlias, len(duplicateIDs))
+		for i, id := range duplicateIDs {
+			aliases[i] = entities.AlbumAlias{AlbumID: canonicalID, OldAlbumID: id}
+		}
+		if err := tx.Create(&aliases).Error; err != nil {
+			return err
+		}
+
+		return tx.Delete(&entities.Album{}, duplicateIDs).Error
+	})
+	if err != nil {
+		return repositories.ErrUpdate
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package postgresql
+
+import (
+	"errors"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"gorm.io/gorm"
+)
+
+type ArtistRepository struct {
+	db *gorm.DB
+}
+
+func NewArtistRepository(db *gorm.DB) repositories.ArtistRepository {
+	return &ArtistRepository{db: db}
+}
+
+func (r *ArtistRepository) Create(artist *entities.Artist) error {
+	if err := r.db.Create(artist).Error; err != nil {
+		return repositories.ErrCreate
+	}
+	return nil
+}
+
+func (r *ArtistRepository) FindByID(id uint) (*entities.Artist, error) {
+	artist := new(entities.Artist)
+	err := r.db.First(&artist, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, repositories.ErrFind
+	}
+	return artist, nil
+}
+
+func (r *ArtistRepository) FindByName(name string) ([]*entities.Artist, error) {
+	var artists []*entities.Artist
+	if err := r.db.Where("name = ?", name).Find(&artists).Error; err != nil {
+		return nil, repositories.ErrFind
+	}
+	return artists, nil
+}
+
+func (r *ArtistRepository) FindBySpotifyID(spotifyID string) (*entities.Artist, error) {
+	artist := new(entities.Artist)
+	err := r.db.Where("spotify_id = ?", spotifyID).First(&artist).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, repositories.ErrFind
+	}
+	return artist, nil
+}
+
+func (r *ArtistRepository) Update(artist *entities.Artist) error {
+	if err := r.db.Save(artist).Error; err != nil {
+		return repositories.ErrUpdate
+	}
+	return nil
+}
+
+func (r *ArtistRepository) Delete(id uint) error {
+	if err := r.db.Delete(&entities.Artist{}, id).Error; err != nil {
+		return repositories.ErrDelete
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package postgresql
+
+import (
+	"errors"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"gorm.io/gorm"
+)
+
+type CollectionInviteRepository struct {
+	db *gorm.DB
+}
+
+func NewCollectionInviteRepository(db *gorm.DB) repositories.CollectionInviteRepository {
+	return &CollectionInviteRepository{db: db}
+}
+
+func (r *CollectionInviteRepository) Create(invite *entities.CollectionInvite) error {
+	if err := r.db.Create(invite).Error; err != nil {
+		return repositories.ErrCreate
+	}
+	return nil
+}
+
+func (r *CollectionInviteRepository) FindByToken(token string) (*entities.CollectionInvite, error) {
+	invite := new(entities.CollectionInvite)
+	err := r.db.Where("token = ?", token).First(&invite).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, repositories.ErrFind
+	}
+	return invite, nil
+}
@@ -0,0 +1,67 @@
+package postgresql
+
+import (
+	"errors"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"gorm.io/gorm"
+)
+
+type CollectionMemberRepository struct {
+	db *gorm.DB
+}
+
+func NewCollectionMemberRepository(db *gorm.DB) repositories.CollectionMemberRepository {
+	return &CollectionMemberRepository{db: db}
+}
+
+func (r *CollectionMemberRepository) Create(member *entities.CollectionMember) error {
+	if err := r.db.Omit("User").Create(member).Error; err != nil {
+		return repositories.ErrCreate
+	}
+	return nil
+}
+
+func (r *CollectionMemberRepository) FindByCollectionIDAndUserID(collectionID, userID uint) (*entities.CollectionMember, error) {
+	member := new(entities.CollectionMember)
+	err := r.db.Where("collection_id = ? AND user_id = ?", collectionID, userID).First(&member).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, repositories.ErrFind
+	}
+	return member, nil
+}
+
+func (r *CollectionMemberRepository) FindByCollectionID(collectionID uint) ([]*entities.CollectionMember, error) {
+	var members []*entities.CollectionMember
+	err := r.db.Preload("User").
+		Where("collection_id = ?", collectionID).
+		Order("created_at, id").
+		Find(&members).Error
+	if err != nil {
+		return nil, repositories.ErrFind
+	}
+	return members, nil
+}
+
+func (r *CollectionMemberRepository) Update(member *entities.CollectionMember) error {
+	if err := r.db.Omit("User").Save(member).Error; err != nil {
+		return repositories.ErrUpdate
+	}
+	return nil
+}
+
+func (r *CollectionMemberRepository) DeleteByCollectionIDAndUserID(collectionID, userID uint) error {
+	result := r.db.Where("collection_id = ? AND user_id = ?", collectionID, userID).
+		Delete(&entities.CollectionMember{})
+	if result.Error != nil {
+		return repositories.ErrDelete
+	}
+	if result.RowsAffected == 0 {
+		return repositories.ErrNotFound
+	}
+	return nil
+}
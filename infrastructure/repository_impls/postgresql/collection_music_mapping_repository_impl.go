@@ -0,0 +1,168 @@
+package postgresql
+
+import (
+	"errors"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type CollectionMusicMappingRepository struct {
+	db *gorm.DB
+}
+
+func NewCollectionMusicMappingRepository(db *gorm.DB) repositories.CollectionMusicMappingRepository {
+	return &CollectionMusicMappingRepository{db: db}
+}
+
+// Create appends the track to the end of the collection.
+func (r *CollectionMusicMappingRepository) Create(collectionMusicMapping *entities.CollectionMusicMapping) error {
+	return r.CreateBatch([]*entities.CollectionMusicMapping{collectionMusicMapping})
+}
+
+// CreateBatch appends the tracks, in the given order, to the end of a single
+// collection.
+func (r *CollectionMusicMappingRepository) CreateBatch(collectionMusicMappings []*entities.CollectionMusicMapping) error {
+	if len(collectionMusicMappings) == 0 {
+		return nil
+	}
+	collectionID := collectionMusicMappings[0].CollectionID
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := lockCollection(tx, collectionID); err != nil {
+			return err
+		}
+		var next int
+		err := tx.Model(&entities.CollectionMusicMapping{}).
+			Where("collection_id = ?", collectionID).
+			Select("COALESCE(MAX(position) + 1, 0)").
+			Scan(&next).Error
+		if err != nil {
+			return err
+		}
+		for i, m := range collectionMusicMappings {
+			m.Position = next + i
+		}
+		return tx.Create(collectionMusicMappings).Error
+	})
+	if err != nil {
+		return repositories.ErrCreate
+	}
+	return nil
+}
+
+func (r *CollectionMusicMappingRepository) FindByID(id uint) (*entities.CollectionMusicMapping, error) {
+	mapping := new(entities.CollectionMusicMapping)
+	err := r.db.First(&mapping, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, repositories.ErrFind
+	}
+	return mapping, nil
+}
+
+func (r *CollectionMusicMappingRepository) FindByCollectionID(collectionID uint) ([]*entities.CollectionMusicMapping, error) {
+	var mappings []*entities.CollectionMusicMapping
+	if err := r.db.Where("collection_id = ?", collectionID).Order("position, id").Find(&mappings).Error; err != nil {
+		return nil, repositories.ErrFind
+	}
+	return mappings, nil
+}
+
+func (r *CollectionMusicMappingRepository) FindByMusicID(musicID uint) ([]*entities.CollectionMusicMapping, error) {
+	var mappings []*entities.CollectionMusicMapping
+	if err := r.db.Where("music_id = ?", musicID).Find(&mappings).Error; err != nil {
+		return nil, repositories.ErrFind
+	}
+	return mappings, nil
+}
+
+func (r *CollectionMusicMappingRepository) CountCollectionsByMusicID(musicID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&entities.CollectionMusicMapping{}).
+		Where("music_id = ?", musicID).
+		Distinct("collection_id").
+		Count(&count).Error
+	if err != nil {
+		return 0, repositories.ErrFind
+	}
+	return count, nil
+}
+
+// Move places the track at position and shifts the tracks in between, keeping
+// the relative order of every other track. Positions past the end move the
+// track to the end.
+func (r *CollectionMusicMappingRepository) Move(collectionID, musicID uint, position int) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := lockCollection(tx, collectionID); err != nil {
+			return err
+		}
+		var mappings []*entities.CollectionMusicMapping
+		if err := tx.Where("collection_id = ?", collectionID).Order("position, id").Find(&mappings).Error; err != nil {
+			return err
+		}
+
+		from := -1
+		for i, m := range mappings {
+			if m.MusicID == musicID {
+				from = i
+				break
+			}
+		}
+		if from < 0 {
+			return gorm.ErrRecordNotFound
+		}
+		to := min(max(position, 0), len(mappings)-1)
+
+		moved := mappings[from]
+		mappings = append(mappings[:from], mappings[from+1:]...)
+		mappings = append(mappings[:to], append([]*entities.CollectionMusicMapping{moved}, mappings[to:]...)...)
+
+		// Renumbering also closes gaps left by removed tracks.
+		for i, m := range mappings {
+			if m.Position == i {
+				continue
+			}
+			if err := tx.Model(m).Update("position", i).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return repositories.ErrNotFound
+		}
+		return repositories.ErrUpdate
+	}
+	return nil
+}
+
+func (r *CollectionMusicMappingRepository) Delete(id uint) error {
+	if err := r.db.Delete(&entities.CollectionMusicMapping{}, id).Error; err != nil {
+		return repositories.ErrDelete
+	}
+	return nil
+}
+
+func (r *CollectionMusicMappingRepository) DeleteByCollectionIDAndMusicID(collectionID, musicID uint) error {
+	result := r.db.Where("collection_id = ? AND music_id = ?", collectionID, musicID).
+		Delete(&entities.CollectionMusicMapping{})
+	if result.Error != nil {
+		return repositories.ErrDelete
+	}
+	if result.RowsAffected == 0 {
+		return repositories.ErrNotFound
+	}
+	return nil
+}
+
+// lockCollection serializes changes to a collection's track order.
+func lockCollection(tx *gorm.DB, collectionID uint) error {
+	return tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id").
+		Take(&entities.MusicCollection{}, collectionID).Error
+}
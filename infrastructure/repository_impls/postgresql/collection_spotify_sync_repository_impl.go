@@ -0,0 +1,66 @@
+package postgresql
+
+import (
+	"errors"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"gorm.io/gorm"
+)
+
+type CollectionSpotifySyncRepository struct {
+	db *gorm.DB
+}
+
+func NewCollectionSpotifySyncRepository(db *gorm.DB) repositories.CollectionSpotifySyncRepository {
+	return &CollectionSpotifySyncRepository{db: db}
+}
+
+func (r *CollectionSpotifySyncRepository) FindByCollectionID(collectionID uint) (*entities.CollectionSpotifySync, error) {
+	sync := new(entities.CollectionSpotifySync)
+	err := r.db.Preload("Tracks").Where("collection_id = ?", collectionID).First(&sync).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, repositories.ErrFind
+	}
+	return sync, nil
+}
+
+// FindReconcilable pages through syncs whose playlist still exists, ordered by
+// ID so that status changes during a reconcile run do not shift the pages.
+func (r *CollectionSpotifySyncRepository) FindReconcilable(afterID uint, limit int) ([]*entities.CollectionSpotifySync, error) {
+	var syncs []*entities.CollectionSpotifySync
+	err := r.db.Preload("Tracks").
+		Where("id > ? AND status <> ?", afterID, entities.SyncStatusRemoteDeleted).
+		Order("id").Limit(limit).
+		Find(&syncs).Error
+	if err != nil {
+		return nil, repositories.ErrFind
+	}
+	return syncs, nil
+}
+
+func (r *CollectionSpotifySyncRepository) Save(sync *entities.CollectionSpotifySync) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Tracks").Save(sync).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("sync_id = ?", sync.ID).Delete(&entities.CollectionSpotifySyncTrack{}).Error; err != nil {
+			return err
+		}
+		if len(sync.Tracks) == 0 {
+			return nil
+		}
+		for i := range sync.Tracks {
+			sync.Tracks[i].ID = 0
+			sync.Tracks[i].SyncID = sync.ID
+		}
+		return tx.Create(&sync.Tracks).Error
+	})
+	if err != nil {
+		return repositories.ErrUpdate
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+package postgresql
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"gorm.io/gorm"
+)
+
+type CommentRepository struct {
+	db *gorm.DB
+}
+
+func NewCommentRepository(db *gorm.DB) repositories.CommentRepository {
+	return &CommentRepository{db: db}
+}
+
+// commentPathSegment pads the ID so that paths sort in creation order.
+func commentPathSegment(id uint) string {
+	return fmt.Sprintf("%010d/", id)
+}
+
+func (r *CommentRepository) Create(comment *entities.Comment) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var parentPath string
+		comment.Depth = 0
+		if comment.ParentID != nil {
+			parent := new(entities.Comment)
+			if err := tx.Select("id", "path", "depth").First(parent, *comment.ParentID).Error; err != nil {
+				return err
+			}
+			parentPath = parent.Path
+			comment.Depth = parent.Depth + 1
+		}
+
+		if err := tx.Omit("User", "UserLikes").Create(comment).Error; err != nil {
+			return err
+		}
+		comment.Path = parentPath + commentPathSegment(comment.ID)
+		if err := tx.Model(comment).UpdateColumn("path", comment.Path).Error; err != nil {
+			return err
+		}
+
+		if comment.ParentID == nil {
+			return nil
+		}
+		return tx.Model(&entities.Comment{}).Where("id = ?", *comment.ParentID).
+			UpdateColumn("reply_count", gorm.Expr("reply_count + 1")).Error
+	})
+	if err != nil {
+		return repositories.ErrCreate
+	}
+	return nil
+}
+
+func (r *CommentRepository) FindByID(id uint) (*entities.Comment, error) {
+	comment := new(entities.Comment)
+	err := r.db.Preload("User").First(&comment, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, repositories.ErrFind
+	}
+	return comment, nil
+}
+
+func (r *CommentRepository) FindByUserID(userID uint, offset, limit int) ([]*entities.Comment, error) {
+	var comments []*entities.Comment
+	err := r.db.Where("user_id = ?", userID).
+		Order("created_at DESC, id DESC").Offset(offset).Limit(limit).
+		Find(&comments).Error
+	if err != nil {
+		return nil, repositories.ErrFind
+	}
+	return comments, nil
+}
+
+func (r *CommentRepository) FindByPostID(postID uint, offset, limit int) ([]*entities.Comment, error) {
+	var comments []*entities.Comment
+	err := r.db.Where("post_id = ?", postID).
+		Order("created_at, id").Offset(offset).Limit(limit).
+		Find(&comments).Error
+	if err != nil {
+		return nil, repositories.ErrFind
+	}
+	return comments, nil
+}
+
+func (r *CommentRepository) FindRootsByPostID(viewer repositories.Viewer, postID uint, offset, limit int) ([]*entities.Comment, error) {
+	var comments []*entities.Comment
+	err := r.db.Preload("User").Scopes(selectBlocked(viewer, "comments", "comments.user_id")).
+		Where("post_id = ? AND parent_id IS NULL", postID).
+		Order("path").Offset(offset).Limit(limit).
+		Find(&comments).Error
+	if err != nil {
+		return nil, repositories.ErrFind
+	}
+	return comments, nil
+}
+
+func (r *CommentRepository) CountRootsByPostID(postID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&entities.Comment{}).
+		Where("post_id = ? AND parent_id IS NULL", postID).
+		Count(&count).Error
+	if err != nil {
+		return 0, repositories.ErrFind
+	}
+	return count, nil
+}
+
+func (r *CommentRepository) FindDescendants(viewer repositories.Viewer, postID uint, paths []string) ([]*entities.Comment, error) {
+	if len(paths) == 0 {
+		return nil, nil
+	}
+
+	// Paths only contain digits and slashes, so they need no LIKE escaping.
+	subtrees := r.db.Where("path LIKE ?", paths[0]+"_%")
+	for _, path := range paths[1:] {
+		subtrees = subtrees.Or("path LIKE ?", path+"_%")
+	}
+
+	var comments []*entities.Comment
+	err := r.db.Preload("User").Scopes(selectBlocked(viewer, "comments", "comments.user_id")).
+		Where("post_id = ?", postID).Where(subtrees).
+		Order("path").
+		Find(&comments).Error
+	if err != nil {
+		return nil, repositories.ErrFind
+	}
+	return comments, nil
+}
+
+func (r *CommentRepository) Update(comment *entities.Comment) error {
+	err := r.db.Omit("User", "UserLikes", "ParentID", "Path", "Depth", "ReplyCount").
+		Save(comment).Error
+	if err != nil {
+		return repositories.ErrUpdate
+	}
+	return nil
+}
+
+func (r *CommentRepository) Delete(id uint) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		comment := new(entities.Comment)
+		if err := tx.Select("id", "parent_id").First(comment, id).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("comment_id = ?", id).Delete(&entities.UserLike{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&entities.Comment{}, id).Error; err != nil {
+			return err
+		}
+		if comment.ParentID == nil {
+			return nil
+		}
+		return tx.Model(&entities.Comment{}).Where("id = ?", *comment.ParentID).
+			UpdateColumn("reply_count", gorm.Expr("reply_count - 1")).Error
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return repositories.ErrNotFound
+		}
+		return repositories.ErrDelete
+	}
+	return nil
+}
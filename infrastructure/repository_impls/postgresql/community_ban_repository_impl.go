@@ -0,0 +1,52 @@
+package postgresql
+
+import (
+	"errors"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type CommunityBanRepository struct {
+	db *gorm.DB
+}
+
+func NewCommunityBanRepository(db *gorm.DB) repositories.CommunityBanRepository {
+	return &CommunityBanRepository{db: db}
+}
+
+func (r *CommunityBanRepository) Create(ban *entities.CommunityBan) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(ban).Error; err != nil {
+			return err
+		}
+		result := tx.Where("community_id = ? AND user_id = ?", ban.CommunityID, ban.UserID).
+			Delete(&entities.CommunityMember{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		return tx.Model(&entities.GenreCommunity{}).Where("id = ?", ban.CommunityID).
+			UpdateColumn("member_count", gorm.Expr("member_count - 1")).Error
+	})
+	if err != nil {
+		return repositories.ErrCreate
+	}
+	return nil
+}
+
+func (r *CommunityBanRepository) FindByCommunityIDAndUserID(communityID, userID uint) (*entities.CommunityBan, error) {
+	ban := new(entities.CommunityBan)
+	err := r.db.Where("community_id = ? AND user_id = ?", communityID, userID).First(&ban).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, repositories.ErrFind
+	}
+	return ban, nil
+}
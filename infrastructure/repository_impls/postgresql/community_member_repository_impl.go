@@ -0,0 +1,86 @@
+package postgresql
+
+import (
+	"errors"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"gorm.io/gorm"
+)
+
+type CommunityMemberRepository struct {
+	db *gorm.DB
+}
+
+func NewCommunityMemberRepository(db *gorm.DB) repositories.CommunityMemberRepository {
+	return &CommunityMemberRepository{db: db}
+}
+
+func (r *CommunityMemberRepository) Create(member *entities.CommunityMember) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("User").Create(member).Error; err != nil {
+			return err
+		}
+		return tx.Model(&entities.GenreCommunity{}).Where("id = ?", member.CommunityID).
+			UpdateColumn("member_count", gorm.Expr("member_count + 1")).Error
+	})
+	if err != nil {
+		return repositories.ErrCreate
+	}
+	return nil
+}
+
+func (r *CommunityMemberRepository) FindByCommunityIDAndUserID(communityID, userID uint) (*entities.CommunityMember, error) {
+	member := new(entities.CommunityMember)
+	err := r.db.Where("community_id = ? AND user_id = ?", communityID, userID).First(&member).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, repositories.ErrFind
+	}
+	return member, nil
+}
+
+func (r *CommunityMemberRepository) FindByCommunityIDAndRole(communityID uint, role string) ([]*entities.CommunityMember, error) {
+	var members []*entities.CommunityMember
+	err := r.db.Preload("User").
+		Where("community_id = ? AND role = ?", communityID, role).
+		Order("created_at, id").
+		Find(&members).Error
+	if err != nil {
+		return nil, repositories.ErrFind
+	}
+	return members, nil
+}
+
+func (r *CommunityMemberRepository) Update(member *entities.CommunityMember) error {
+	if err := r.db.Omit("User").Save(member).Error; err != nil {
+		return repositories.ErrUpdate
+	}
+	return nil
+}
+
+func (r *CommunityMemberRepository) DeleteByCommunityIDAndUserID(communityID, userID uint) error {
+	var rowsAffected int64
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("community_id = ? AND user_id = ?", communityID, userID).
+			Delete(&entities.CommunityMember{})
+		if result.Error != nil {
+			return result.Error
+		}
+		rowsAffected = result.RowsAffected
+		if rowsAffected == 0 {
+			return nil
+		}
+		return tx.Model(&entities.GenreCommunity{}).Where("id = ?", communityID).
+			UpdateColumn("member_count", gorm.Expr("member_count - 1")).Error
+	})
+	if err != nil {
+		return repositories.ErrDelete
+	}
+	if rowsAffected == 0 {
+		return repositories.ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package postgresql
+
+import (
+	"errors"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"gorm.io/gorm"
+)
+
+type GenreAliasRepository struct {
+	db *gorm.DB
+}
+
+func NewGenreAliasRepository(db *gorm.DB) repositories.GenreAliasRepository {
+	return &GenreAliasRepository{db: db}
+}
+
+func (r *GenreAliasRepository) Create(genreAlias *entities.GenreAlias) error {
+	if err := r.db.Create(genreAlias).Error; err != nil {
+		return repositories.ErrCreate
+	}
+	return nil
+}
+
+func (r *GenreAliasRepository) FindByAlias(alias string) (*entities.GenreAlias, error) {
+	genreAlias := new(entities.GenreAlias)
+	err := r.db.Where("alias = ?", alias).First(&genreAlias).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, repositories.ErrFind
+	}
+	return genreAlias, nil
+}
+
+func (r *GenreAliasRepository) FindByGenreID(genreID uint) ([]*entities.GenreAlias, error) {
+	var aliases []*entities.GenreAlias
+	if err := r.db.Where("genre_id = ?", genreID).Order("id").Find(&aliases).Error; err != nil {
+		return nil, repositories.ErrFind
+	}
+	return aliases, nil
+}
+
+func (r *GenreAliasRepository) Delete(id uint) error {
+	if err := r.db.Delete(&entities.GenreAlias{}, id).Error; err != nil {
+		return repositories.ErrDelete
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package postgresql
+
+import (
+	"errors"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"gorm.io/gorm"
+)
+
+type GenreCommunityRepository struct {
+	db *gorm.DB
+}
+
+func NewGenreCommunityRepository(db *gorm.DB) repositories.GenresCommunityRepository {
+	return &GenreCommunityRepository{db: db}
+}
+
+func (r *GenreCommunityRepository) Create(genreCommunity *entities.GenreCommunity) error {
+	if err := r.db.Create(genreCommunity).Error; err != nil {
+		return repositories.ErrCreate
+	}
+	return nil
+}
+
+func (r *GenreCommunityRepository) FindByID(id uint) (*entities.GenreCommunity, error) {
+	genreCommunity := new(entities.GenreCommunity)
+	err := r.db.First(&genreCommunity, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, repositories.ErrFind
+	}
+	return genreCommunity, nil
+}
+
+func (r *GenreCommunityRepository) FindByGenreID(genreID uint) ([]*entities.GenreCommunity, error) {
+	var genreCommunities []*entities.GenreCommunity
+	if err := r.db.Where("genre_id = ?", genreID).Order("id").Find(&genreCommunities).Error; err != nil {
+		return nil, repositories.ErrFind
+	}
+	return genreCommunities, nil
+}
+
+func (r *GenreCommunityRepository) FindAll(genreID *uint, offset, limit int) ([]*entities.GenreCommunity, error) {
+	var genreCommunities []*entities.GenreCommunity
+	err := r.byGenre(genreID).
+		Order("member_count DESC, id").Offset(offset).Limit(limit).
+		Find(&genreCommunities).Error
+	if err != nil {
+		return nil, repositories.ErrFind
+	}
+	return genreCommunities, nil
+}
+
+func (r *GenreCommunityRepository) Count(genreID *uint) (int64, error) {
+	var count int64
+	if err := r.byGenre(genreID).Count(&count).Error; err != nil {
+		return 0, repositories.ErrFind
+	}
+	return count, nil
+}
+
+func (r *GenreCommunityRepository) byGenre(genreID *uint) *gorm.DB {
+	query := r.db.Model(&entities.GenreCommunity{})
+	if genreID != nil {
+		query = query.Where("genre_id = ?", *genreID)
+	}
+	return query
+}
+
+// Update saves everything but the member count, which is only changed by the
+// community member repository.
+func (r *GenreCommunityRepository) Update(genresCommunity *entities.GenreCommunity) error {
+	if err := r.db.Omit("MemberCount").Save(genresCommunity).Error; err != nil {
+		return repositories.ErrUpdate
+	}
+	return nil
+}
+
+func (r *GenreCommunityRepository) Delete(id uint) error {
+	if err := r.db.Delete(&entities.GenreCommunity{}, id).Error; err != nil {
+		return repositories.ErrDelete
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package postgresql
+
+import (
+	"errors"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"gorm.io/gorm"
+)
+
+type GenreRepository struct {
+	db *gorm.DB
+}
+
+func NewGenreRepository(db *gorm.DB) repositories.GenreRepository {
+	return &GenreRepository{db: db}
+}
+
+func (r *GenreRepository) Create(genre *entities.Genre) error {
+	if err := r.db.Create(genre).Error; err != nil {
+		return repositories.ErrCreate
+	}
+	return nil
+}
+
+func (r *GenreRepository) FindByID(id uint) (*entities.Genre, error) {
+	genre := new(entities.Genre)
+	err := r.db.First(&genre, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, repositories.ErrFind
+	}
+	return genre, nil
+}
+
+func (r *GenreRepository) FindByName(name string) (*entities.Genre, error) {
+	genre := new(entities.Genre)
+	err := r.db.Where("LOWER(name) = LOWER(?)", name).First(&genre).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, repositories.ErrFind
+	}
+	return genre, nil
+}
+
+func (r *GenreRepository) FindAll() ([]*entities.Genre, error) {
+	var genres []*entities.Genre
+	if err := r.db.Preload("Aliases").Order("id").Find(&genres).Error; err != nil {
+		return nil, repositories.ErrFind
+	}
+	return genres, nil
+}
+
+func (r *GenreRepository) Update(genre *entities.Genre) error {
+	if err := r.db.Omit("Aliases").Save(genre).Error; err != nil {
+		return repositories.ErrUpdate
+	}
+	return nil
+}
+
+func (r *GenreRepository) Delete(id uint) error {
+	if err := r.db.Delete(&entities.Genre{}, id).Error; err != nil {
+		return repositories.ErrDelete
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package postgresql
+
+import (
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"gorm.io/gorm"
+)
+
+type LinkPreviewRepository struct {
+	db *gorm.DB
+}
+
+func NewLinkPreviewRepository(db *gorm.DB) repositories.LinkPreviewRepository {
+	return &LinkPreviewRepository{db: db}
+}
+
+func (r *LinkPreviewRepository) ReplaceByPostID(postID uint, previews []*entities.LinkPreview) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("post_id = ?", postID).Delete(&entities.LinkPreview{}).Error; err != nil {
+			return err
+		}
+		if len(previews) == 0 {
+			return nil
+		}
+		for i, preview := range previews {
+			preview.PostID = postID
+			preview.Position = i
+		}
+		return tx.Create(&previews).Error
+	})
+	if err != nil {
+		return repositories.ErrUpdate
+	}
+	return nil
+}
+
+func (r *LinkPreviewRepository) FindByPostIDs(postIDs []uint) ([]*entities.LinkPreview, error) {
+	var previews []*entities.LinkPreview
+	if len(postIDs) == 0 {
+		return previews, nil
+	}
+
+	err := r.db.Where("post_id IN ?", postIDs).
+		Order("position, id").
+		Find(&previews).Error
+	if err != nil {
+		return nil, repositories.ErrFind
+	}
+	return previews, nil
+}
@@ -0,0 +1,25 @@
+package postgresql
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// repointRows moves the rows of table that reference one of duplicateIDs
+// through column over to canonicalID. A row is dropped instead when, after the
+// move, it would collide with a row for the same key (e.g. the same user
+// liking both the canonical and the duplicate track); the canonical row's data
+// wins, then the most recent duplicate's.
+func repointRows(tx *gorm.DB, table, column, key string, canonicalID uint, duplicateIDs []uint) error {
+	ids := append([]uint{canonicalID}, duplicateIDs...)
+	deleteSQL := fmt.Sprintf(`DELETE FROM %[1]s d WHERE d.%[2]s IN ? AND EXISTS (
+		SELECT 1 FROM %[1]s c WHERE c.%[3]s = d.%[3]s AND c.%[2]s IN ? AND (c.%[2]s = ? OR c.id > d.id)
+	)`, table, column, key)
+	if err := tx.Exec(deleteSQL, duplicateIDs, ids, canonicalID).Error; err != nil {
+		return err
+	}
+
+	updateSQL := fmt.Sprintf("UPDATE %[1]s SET %[2]s = ? WHERE %[2]s IN ?", table, column)
+	return tx.Exec(updateSQL, canonicalID, duplicateIDs).Error
+}
@@ -0,0 +1,63 @@
+package postgresql
+
+import (
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"gorm.io/gorm"
+)
+
+type ModerationActionRepository struct {
+	db *gorm.DB
+}
+
+func NewModerationActionRepository(db *gorm.DB) repositories.ModerationActionRepository {
+	return &ModerationActionRepository{db: db}
+}
+
+func (r *ModerationActionRepository) Create(action *entities.ModerationAction) error {
+	if err := r.db.Create(action).Error; err != nil {
+		return repositories.ErrCreate
+	}
+	return nil
+}
+
+func (r *ModerationActionRepository) FindByCommunityID(communityID uint, offset, limit int) ([]*entities.ModerationAction, error) {
+	var actions []*entities.ModerationAction
+	err := r.db.Where("community_id = ?", communityID).
+		Order("created_at DESC, id DESC").Offset(offset).Limit(limit).
+		Find(&actions).Error
+	if err != nil {
+		return nil, repositories.ErrFind
+	}
+	return actions, nil
+}
+
+func (r *ModerationActionRepository) CountByCommunityID(communityID uint) (int64, error) {
+	var count int64
+	if err := r.db.Model(&entities.ModerationAction{}).Where("community_id = ?", communityID).Count(&count).Error; err != nil {
+		return 0, repositories.ErrFind
+	}
+	return count, nil
+}
+
+func (r *ModerationActionRepository) FindByTargetUserID(userID uint, actions []string, offset, limit int) ([]*entities.ModerationAction, error) {
+	var result []*entities.ModerationAction
+	err := r.db.Where("target_user_id = ? AND action IN ?", userID, actions).
+		Order("created_at DESC, id DESC").Offset(offset).Limit(limit).
+		Find(&result).Error
+	if err != nil {
+		return nil, repositories.ErrFind
+	}
+	return result, nil
+}
+
+func (r *ModerationActionRepository) CountByTargetUserID(userID uint, actions []string) (int64, error) {
+	var count int64
+	err := r.db.Model(&entities.ModerationAction{}).
+		Where("target_user_id = ? AND action IN ?", userID, actions).
+		Count(&count).Error
+	if err != nil {
+		return 0, repositories.ErrFind
+	}
+	return count, nil
+}
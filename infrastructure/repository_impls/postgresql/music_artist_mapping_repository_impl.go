@@ -0,0 +1,59 @@
+package postgresql
+
+import (
+	"errors"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"gorm.io/gorm"
+)
+
+type MusicArtistMappingRepository struct {
+	db *gorm.DB
+}
+
+func NewMusicArtistMappingRepository(db *gorm.DB) repositories.MusicArtistMappingRepository {
+	return &MusicArtistMappingRepository{db: db}
+}
+
+func (r *MusicArtistMappingRepository) Create(musicArtistMapping *entities.MusicArtistMapping) error {
+	if err := r.db.Omit("Artist").Create(musicArtistMapping).Error; err != nil {
+		return repositories.ErrCreate
+	}
+	return nil
+}
+
+func (r *MusicArtistMappingRepository) FindByID(id uint) (*entities.MusicArtistMapping, error) {
+	mapping := new(entities.MusicArtistMapping)
+	err := r.db.Preload("Artist").First(&mapping, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, repositories.ErrFind
+	}
+	return mapping, nil
+}
+
+func (r *MusicArtistMappingRepository) FindByMusicID(musicID uint) ([]*entities.MusicArtistMapping, error) {
+	var mappings []*entities.MusicArtistMapping
+	if err := r.db.Preload("Artist").Where("music_id = ?", musicID).Order("id").Find(&mappings).Error; err != nil {
+		return nil, repositories.ErrFind
+	}
+	return mappings, nil
+}
+
+func (r *MusicArtistMappingRepository) FindByArtistID(artistID uint) ([]*entities.MusicArtistMapping, error) {
+	var mappings []*entities.MusicArtistMapping
+	if err := r.db.Where("artist_id = ?", artistID).Find(&mappings).Error; err != nil {
+		return nil, repositories.ErrFind
+	}
+	return mappings, nil
+}
+
+func (r *MusicArtistMappingRepository) Delete(id uint) error {
+	if err := r.db.Delete(&entities.MusicArtistMapping{}, id).Error; err != nil {
+		return repositories.ErrDelete
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package postgresql
+
+import (
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"gorm.io/gorm"
+)
+
+type MusicAttachmentRepository struct {
+	db *gorm.DB
+}
+
+func NewMusicAttachmentRepository(db *gorm.DB) repositories.MusicAttachmentRepository {
+	return &MusicAttachmentRepository{db: db}
+}
+
+func (r *MusicAttachmentRepository) ReplaceByPostID(postID uint, musicIDs []uint) error {
+	return r.replace("post_id", postID, musicIDs, func(a *entities.MusicAttachment) { a.PostID = &postID })
+}
+
+func (r *MusicAttachmentRepository) ReplaceByCommentID(commentID uint, musicIDs []uint) error {
+	return r.replace("comment_id", commentID, musicIDs, func(a *entities.MusicAttachment) { a.CommentID = &commentID })
+}
+
+// replace sets the owner of each new attachment with setOwner.
+func (r *MusicAttachmentRepository) replace(column string, ownerID uint, musicIDs []uint, setOwner func(*entities.MusicAttachment)) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where(column+" = ?", ownerID).Delete(&entities.MusicAttachment{}).Error; err != nil {
+			return err
+		}
+		if len(musicIDs) == 0 {
+			return nil
+		}
+
+		attachments := make([]*entities.MusicAttachment, len(musicIDs))
+		for i, musicID := range musicIDs {
+			attachments[i] = &entities.MusicAttachment{MusicID: musicID, Position: i}
+			setOwner(attachments[i])
+		}
+		return tx.Omit("Music").Create(&attachments).Error
+	})
+	if err != nil {
+		return repositories.ErrUpdate
+	}
+	return nil
+}
+
+func (r *MusicAttachmentRepository) FindByPostIDs(postIDs []uint) ([]*entities.MusicAttachment, error) {
+	return r.find("post_id", postIDs)
+}
+
+func (r *MusicAttachmentRepository) FindByCommentIDs(commentIDs []uint) ([]*entities.MusicAttachment, error) {
+	return r.find("comment_id", commentIDs)
+}
+
+func (r *MusicAttachmentRepository) find(column string, ownerIDs []uint) ([]*entities.MusicAttachment, error) {
+	var attachments []*entities.MusicAttachment
+	if len(ownerIDs) == 0 {
+		return attachments, nil
+	}
+
+	err := r.db.Preload("Music.Album").Preload("Music.MusicArtistMapping.Artist").
+		Where(column+" IN ?", ownerIDs).
+		Order("position, id").
+		Find(&attachments).Error
+	if err != nil {
+		return nil, repositories.ErrFind
+	}
+	return attachments, nil
+}
@@ -0,0 +1,107 @@
+package postgresql
+
+import (
+	"errors"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"gorm.io/gorm"
+)
+
+type MusicCollectionRepository struct {
+	db *gorm.DB
+}
+
+func NewMusicCollectionRepository(db *gorm.DB) repositories.MusicCollectionRepository {
+	return &MusicCollectionRepository{db: db}
+}
+
+// Create also records the owner as a member of the new collection.
+func (r *MusicCollectionRepository) Create(musicCollection *entities.MusicCollection) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("CollectionMusicMapping").Create(musicCollection).Error; err != nil {
+			return err
+		}
+		owner := &entities.CollectionMember{
+			CollectionID: musicCollection.ID,
+			UserID:       musicCollection.UserID,
+			Role:         entities.CollectionRoleOwner,
+		}
+		return tx.Omit("User").Create(owner).Error
+	})
+	if err != nil {
+		return repositories.ErrCreate
+	}
+	return nil
+}
+
+func (r *MusicCollectionRepository) FindByID(id uint) (*entities.MusicCollection, error) {
+	collection := new(entities.MusicCollection)
+	err := r.db.First(&collection, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, repositories.ErrFind
+	}
+	return collection, nil
+}
+
+func (r *MusicCollectionRepository) FindByUserID(userID uint, includePrivate bool, offset, limit int) ([]*entities.MusicCollection, error) {
+	var collections []*entities.MusicCollection
+	err := r.byUser(userID, includePrivate).
+		Order("created_at DESC, id DESC").Offset(offset).Limit(limit).
+		Find(&collections).Error
+	if err != nil {
+		return nil, repositories.ErrFind
+	}
+	return collections, nil
+}
+
+func (r *MusicCollectionRepository) CountByUserID(userID uint, includePrivate bool) (int64, error) {
+	var count int64
+	if err := r.byUser(userID, includePrivate).Count(&count).Error; err != nil {
+		return 0, repositories.ErrFind
+	}
+	return count, nil
+}
+
+func (r *MusicCollectionRepository) FindByUserIDAndSpotifyPlaylistID(userID uint, spotifyPlaylistID string) (*entities.MusicCollection, error) {
+	collection := new(entities.MusicCollection)
+	err := r.db.Where("user_id = ? AND spotify_playlist_id = ?", userID, spotifyPlaylistID).First(&collection).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, repositories.ErrFind
+	}
+	return collection, nil
+}
+
+func (r *MusicCollectionRepository) Update(musicCollection *entities.MusicCollection) error {
+	if err := r.db.Omit("CollectionMusicMapping").Save(musicCollection).Error; err != nil {
+		return repositories.ErrUpdate
+	}
+	return nil
+}
+
+func (r *MusicCollectionRepository) Delete(id uint) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("collection_id = ?", id).Delete(&entities.CollectionMusicMapping{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&entities.MusicCollection{}, id).Error
+	})
+	if err != nil {
+		return repositories.ErrDelete
+	}
+	return nil
+}
+
+func (r *MusicCollectionRepository) byUser(userID uint, includePrivate bool) *gorm.DB {
+	query := r.db.Model(&entities.MusicCollection{}).Where("user_id = ?", userID)
+	if !includePrivate {
+		query = query.Where("is_public")
+	}
+	return query
+}
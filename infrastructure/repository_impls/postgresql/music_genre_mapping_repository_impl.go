@@ -0,0 +1,59 @@
+package postgresql
+
+import (
+	"errors"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"gorm.io/gorm"
+)
+
+type MusicGenreMappingRepository struct {
+	db *gorm.DB
+}
+
+func NewMusicGenreMappingRepository(db *gorm.DB) repositories.MusicGenreMappingRepository {
+	return &MusicGenreMappingRepository{db: db}
+}
+
+func (r *MusicGenreMappingRepository) Create(musicGenreMapping *entities.MusicGenreMapping) error {
+	if err := r.db.Omit("Genre").Create(musicGenreMapping).Error; err != nil {
+		return repositories.ErrCreate
+	}
+	return nil
+}
+
+func (r *MusicGenreMappingRepository) FindByID(id uint) (*entities.MusicGenreMapping, error) {
+	mapping := new(entities.MusicGenreMapping)
+	err := r.db.Preload("Genre").First(&mapping, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, repositories.ErrFind
+	}
+	return mapping, nil
+}
+
+func (r *MusicGenreMappingRepository) FindByMusicID(musicID uint) ([]*entities.MusicGenreMapping, error) {
+	var mappings []*entities.MusicGenreMapping
+	if err := r.db.Preload("Genre").Where("music_id = ?", musicID).Find(&mappings).Error; err != nil {
+		return nil, repositories.ErrFind
+	}
+	return mappings, nil
+}
+
+func (r *MusicGenreMappingRepository) FindByGenreID(genreID uint) ([]*entities.MusicGenreMapping, error) {
+	var mappings []*entities.MusicGenreMapping
+	if err := r.db.Where("genre_id = ?", genreID).Find(&mappings).Error; err != nil {
+		return nil, repositories.ErrFind
+	}
+	return mappings, nil
+}
+
+func (r *MusicGenreMappingRepository) Delete(id uint) error {
+	if err := r.db.Delete(&entities.MusicGenreMapping{}, id).Error; err != nil {
+		return repositories.ErrDelete
+	}
+	return nil
+}
@@ -0,0 +1,291 @@
+package postgresql
+
+import (
+	"errors"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"gorm.io/gorm"
+)
+
+type MusicRepository struct {
+	db *gorm.DB
+}
+
+func NewMusicRepository(db *gorm.DB) repositories.MusicRepository {
+	return &MusicRepository{db: db}
+}
+
+func (r *MusicRepository) Create(music *entities.Music) error {
+	if err := r.db.Create(music).Error; err != nil {
+		return repositories.ErrCreate
+	}
+	return nil
+}
+
+func (r *MusicRepository) FindByID(id uint) (*entities.Music, error) {
+	music := new(entities.Music)
+	err := r.preloaded().
+		Where("music.id = COALESCE((SELECT music_id FROM music_aliases WHERE old_music_id = ?), ?)", id, id).
+		First(&music).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, repositories.ErrFind
+	}
+	return music, nil
+}
+
+func (r *MusicRepository) FindByTitle(title string) ([]*entities.Music, error) {
+	var music []*entities.Music
+	if err := r.preloaded().Where("title = ?", title).Find(&music).Error; err != nil {
+		return nil, repositories.ErrFind
+	}
+	return music, nil
+}
+
+func (r *MusicRepository) FindByAlbumID(albumID uint) ([]*entities.Music, error) {
+	var music []*entities.Music
+	if err := r.preloaded().Where("album_id = ?", albumID).Order("id").Find(&music).Error; err != nil {
+		return nil, repositories.ErrFind
+	}
+	return music, nil
+}
+
+func (r *MusicRepository) FindByGenreID(genreID uint, offset, limit int) ([]*entities.Music, error) {
+	var music []*entities.Music
+	err := r.preloaded().
+		Joins("JOIN music_genre_mapping ON music_genre_mapping.music_id = music.id").
+		Where("music_genre_mapping.genre_id = ?", genreID).
+		Order("music.id").Offset(offset).Limit(limit).
+		Find(&music).Error
+	if err != nil {
+		return nil, repositories.ErrFind
+	}
+	return music, nil
+}
+
+func (r *MusicRepository) CountByGenreID(genreID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&entities.MusicGenreMapping{}).Where("genre_id = ?", genreID).Count(&count).Error
+	if err != nil {
+		return 0, repositories.ErrFind
+	}
+	return count, nil
+}
+
+func (r *MusicRepository) FindByArtistID(artistID uint, offset, limit int) ([]*entities.Music, error) {
+	var music []*entities.Music
+	err := r.preloaded().
+		Joins("JOIN music_artist_mapping ON music_artist_mapping.music_id = music.id").
+		Where("music_artist_mapping.artist_id = ?", artistID).
+		Order("music.id").Offset(offset).Limit(limit).
+		Find(&music).Error
+	if err != nil {
+		return nil, repositories.ErrFind
+	}
+	return music, nil
+}
+
+func (r *MusicRepository) FindLikedByUserID(userID uint, offset, limit int) ([]*entities.Music, error) {
+	var music []*entities.Music
+	err := r.preloaded().
+		Joins("JOIN user_likes ON user_likes.music_id = music.id").
+		Where("user_likes.user_id = ? AND user_likes.liked", userID).
+		Order("user_likes.created_at DESC, music.id").Offset(offset).Limit(limit).
+		Find(&music).Error
+	if err != nil {
+		return nil, repositories.ErrFind
+	}
+	return music, nil
+}
+
+func (r *MusicRepository) FindByCollectionID(collectionID uint) ([]*entities.Music, error) {
+	var music []*entities.Music
+	err := r.preloaded().
+		Joins("JOIN collection_music_mapping ON collection_music_mapping.music_id = music.id").
+		Where("collection_music_mapping.collection_id = ?", collectionID).
+		Order("collection_music_mapping.position, collection_music_mapping.id").
+		Find(&music).Error
+	if err != nil {
+		return nil, repositories.ErrFind
+	}
+	return music, nil
+}
+
+func (r *MusicRepository) CountLikedByUserID(userID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&entities.UserLike{}).
+		Where("user_id = ? AND music_id IS NOT NULL AND liked", userID).
+		Count(&count).Error
+	if err != nil {
+		return 0, repositories.ErrFind
+	}
+	return count, nil
+}
+
+func (r *MusicRepository) FindBySpotifyID(spotifyID string) (*entities.Music, error) {
+	music := new(entities.Music)
+	err := r.preloaded().Where("spotify_id = ?", spotifyID).First(&music).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, repositories.ErrFind
+	}
+	return music, nil
+}
+
+func (r *MusicRepository) FindByLastfmID(lastfmID string) (*entities.Music, error) {
+	music := new(entities.Music)
+	err := r.preloaded().Where("lastfm_id = ?", lastfmID).First(&music).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, repositories.ErrFind
+	}
+	return music, nil
+}
+
+func (r *MusicRepository) FindByISRC(isrc string) ([]*entities.Music, error) {
+	var music []*entities.Music
+	if err := r.preloaded().Where("isrc = ?", isrc).Order("music.id").Find(&music).Error; err != nil {
+		return nil, repositories.ErrFind
+	}
+	return music, nil
+}
+
+func (r *MusicRepository) FindDuplicateISRCs() ([]string, error) {
+	var isrcs []string
+	err := r.db.Model(&entities.Music{}).
+		Where("isrc <> ''").
+		Group("isrc").Having("COUNT(*) > 1").Order("isrc").
+		Pluck("isrc", &isrcs).Error
+	if err != nil {
+		return nil, repositories.ErrFind
+	}
+	return isrcs, nil
+}
+
+func (r *MusicRepository) SearchByTitle(title string, offset, limit int) ([]*entities.Music, error) {
+	var music []*entities.Music
+	err := r.preloaded().
+		Where("music.title ILIKE ?", "%"+likeEscaper.Replace(title)+"%").
+		Order("music.id").Offset(offset).Limit(limit).
+		Find(&music).Error
+	if err != nil {
+		return nil, repositories.ErrFind
+	}
+	return music, nil
+}
+
+func (r *MusicRepository) SearchByArtist(artistName string, offset, limit int) ([]*entities.Music, error) {
+	var music []*entities.Music
+	err := r.preloaded().
+		Where("music.id IN (?)", r.db.Table("music_artist_mapping").
+			Select("music_artist_mapping.music_id").
+			Joins("JOIN artists ON artists.id = music_artist_mapping.artist_id").
+			Where("artists.name ILIKE ?", "%"+likeEscaper.Replace(artistName)+"%")).
+		Order("music.id").Offset(offset).Limit(limit).
+		Find(&music).Error
+	if err != nil {
+		return nil, repositories.ErrFind
+	}
+	return music, nil
+}
+
+func (r *MusicRepository) SearchByAlbum(albumName string, offset, limit int) ([]*entities.Music, error) {
+	var music []*entities.Music
+	err := r.preloaded().
+		Joins("JOIN albums ON albums.id = music.album_id").
+		Where("albums.name ILIKE ?", "%"+likeEscaper.Replace(albumName)+"%").
+		Order("music.id").Offset(offset).Limit(limit).
+		Find(&music).Error
+	if err != nil {
+		return nil, repositories.ErrFind
+	}
+	return music, nil
+}
+
+func (r *MusicRepository) Update(music *entities.Music) error {
+	if err := r.db.Omit("MusicGenreMapping", "MusicArtistMapping", "UserLikes", "CollectionMusicMapping").Save(music).Error; err != nil {
+		return repositories.ErrUpdate
+	}
+	return nil
+}
+
+func (r *MusicRepository) Delete(id uint) error {
+	if err := r.db.Delete(&entities.Music{}, id).Error; err != nil {
+		return repositories.ErrDelete
+	}
+	return nil
+}
+
+func (r *MusicRepository) CountLikesAndDislikesByID(id uint) (likes, dislikes int64, err error) {
+	var result likeCounts
+	err = r.db.Model(&entities.UserLike{}).
+		Select("COUNT(*) FILTER (WHERE liked) AS likes, COUNT(*) FILTER (WHERE NOT liked) AS dislikes").
+		Where("music_id = ?", id).
+		Scan(&result).Error
+	if err != nil {
+		return 0, 0, repositories.ErrFind
+	}
+	return result.Likes, result.Dislikes, nil
+}
+
+// Merge re-points everything that references the duplicates to the canonical
+// row, records the duplicate IDs as aliases and deletes the duplicates.
+func (r *MusicRepository) Merge(canonicalID uint, duplicateIDs []uint) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		refs := []struct{ table, key string }{
+			{"user_likes", "user_id"},
+			{"collection_music_mapping", "collection_id"},
+			{"music_genre_mapping", "genre_id"},
+			{"music_artist_mapping", "artist_id"},
+			{"activities", "actor_id"},
+		}
+		for _, ref := range refs {
+			if err := repointRows(tx, ref.table, "music_id", ref.key, canonicalID, duplicateIDs); err != nil {
+				return err
+			}
+		}
+
+		err := tx.Model(&entities.MusicAttachment{}).
+			Where("music_id IN ?", duplicateIDs).
+			Update("music_id", canonicalID).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(&entities.MusicAlias{}).
+			Where("music_id IN ?", duplicateIDs).
+			Update("music_id", canonicalID).Error
+		if err != nil {
+			return err
+		}
+		aliases := make([]entities.MusicAlias, len(duplicateIDs))
+		for i, id := range duplicateIDs {
+			aliases[i] = entities.MusicAlias{MusicID: canonicalID, OldMusicID: id}
+		}
+		if err := tx.Create(&aliases).Error; err != nil {
+			return err
+		}
+
+		return tx.Delete(&entities.Music{}, duplicateIDs).Error
+	})
+	if err != nil {
+		return repositories.ErrUpdate
+	}
+	return nil
+}
+
+func (r *MusicRepository) preloaded() *gorm.DB {
+	return r.db.Preload("MusicArtistMapping.Artist").Preload("MusicGenreMapping.Genre")
+}
+
+type likeCounts struct {
+	Likes    int64
+	Dislikes int64
+}
@@ -0,0 +1,49 @@
+package postgresql
+
+import (
+	"errors"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type NotificationPreferenceRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationPreferenceRepository(db *gorm.DB) repositories.NotificationPreferenceRepository {
+	return &NotificationPreferenceRepository{db: db}
+}
+
+func (r *NotificationPreferenceRepository) FindByUserID(userID uint) ([]*entities.NotificationPreference, error) {
+	var preferences []*entities.NotificationPreference
+	if err := r.db.Where("user_id = ?", userID).Find(&preferences).Error; err != nil {
+		return nil, repositories.ErrFind
+	}
+	return preferences, nil
+}
+
+func (r *NotificationPreferenceRepository) FindByUserIDAndType(userID uint, notificationType string) (*entities.NotificationPreference, error) {
+	preference := new(entities.NotificationPreference)
+	err := r.db.Where("user_id = ? AND type = ?", userID, notificationType).First(preference).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, repositories.ErrFind
+	}
+	return preference, nil
+}
+
+func (r *NotificationPreferenceRepository) Save(preference *entities.NotificationPreference) error {
+	err := r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "type"}},
+		DoUpdates: clause.AssignmentColumns([]string{"in_app", "email"}),
+	}).Create(preference).Error
+	if err != nil {
+		return repositories.ErrUpdate
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package postgresql
+
+import (
+	"time"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"gorm.io/gorm"
+)
+
+type NotificationRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationRepository(db *gorm.DB) repositories.NotificationRepository {
+	return &NotificationRepository{db: db}
+}
+
+func (r *NotificationRepository) Create(notification *entities.Notification) error {
+	if err := r.db.Omit("Actor", "Post", "Comment", "ModerationAction").Create(notification).Error; err != nil {
+		return repositories.ErrCreate
+	}
+	return nil
+}
+
+func (r *NotificationRepository) FindByUserID(userID uint, unreadOnly bool, offset, limit int) ([]*entities.Notification, error) {
+	var notifications []*entities.Notification
+	err := r.byUserID(userID, unreadOnly).
+		Preload("Actor.UserProfile").
+		Order("notifications.id DESC").Offset(offset).Limit(limit).Find(&notifications).Error
+	if err != nil {
+		return nil, repositories.ErrFind
+	}
+	return notifications, nil
+}
+
+func (r *NotificationRepository) CountByUserID(userID uint, unreadOnly bool) (int64, error) {
+	var count int64
+	if err := r.byUserID(userID, unreadOnly).Model(&entities.Notification{}).Count(&count).Error; err != nil {
+		return 0, repositories.ErrFind
+	}
+	return count, nil
+}
+
+func (r *NotificationRepository) byUserID(userID uint, unreadOnly bool) *gorm.DB {
+	query := r.db.Where("notifications.user_id = ?", userID).
+		Scopes(visibleTo(repositories.Viewer{ID: userID}, "notifications.actor_id"))
+	if unreadOnly {
+		query = query.Where("notifications.read_at IS NULL")
+	}
+	return query
+}
+
+func (r *NotificationRepository) MarkRead(userID uint, ids []uint) error {
+	err := r.db.Model(&entities.Notification{}).
+		Where("user_id = ? AND id IN ? AND read_at IS NULL", userID, ids).
+		Update("read_at", time.Now()).Error
+	if err != nil {
+		return repositories.ErrUpdate
+	}
+	return nil
+}
+
+func (r *NotificationRepository) MarkAllRead(userID uint) error {
+	err := r.db.Model(&entities.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		Update("read_at", time.Now()).Error
+	if err != nil {
+		return repositories.ErrUpdate
+	}
+	return nil
+}
+
+func (r *NotificationRepository) ExistsUnread(notification *entities.Notification) (bool, error) {
+	query := r.db.Model(&entities.Notification{}).
+		Where("user_id = ? AND type = ? AND read_at IS NULL", notification.UserID, notification.Type)
+	for _, field := range []struct {
+		column string
+		value  *uint
+	}{
+		{"actor_id", notification.ActorID},
+		{"post_id", notification.PostID},
+		{"comment_id", notification.CommentID},
+	} {
+		if field.value == nil {
+			query = query.Where(field.column + " IS NULL")
+		} else {
+			query = query.Where(field.column+" = ?", *field.value)
+		}
+	}
+
+	var count int64
+	if err := query.Count(&count).Error; err != nil {
+		return false, repositories.ErrFind
+	}
+	return count > 0, nil
+}
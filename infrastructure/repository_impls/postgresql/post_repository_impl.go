@@ -0,0 +1,198 @@
+package postgresql
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PostRepository struct {
+	db *gorm.DB
+}
+
+func NewPostRepository(db *gorm.DB) repositories.PostRepository {
+	return &PostRepository{db: db}
+}
+
+// hotGravity controls how fast posts fall in the hot order as they age.
+const hotGravity = 1.8
+
+// likeEscaper escapes LIKE wildcards, so that searches match them literally.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func (r *PostRepository) Create(post *entities.Post) error {
+	if err := r.db.Omit("User", "Comments", "UserLikes").Create(post).Error; err != nil {
+		return repositories.ErrCreate
+	}
+	return nil
+}
+
+func (r *PostRepository) FindByID(id uint) (*entities.Post, error) {
+	post := new(entities.Post)
+	err := r.db.Preload("User").First(&post, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, repositories.ErrFind
+	}
+	return post, nil
+}
+
+func (r *PostRepository) FindByUserID(userID uint, offset, limit int) ([]*entities.Post, error) {
+	var posts []*entities.Post
+	err := r.db.Preload("User").Where("user_id = ?", userID).
+		Order("created_at DESC, id DESC").Offset(offset).Limit(limit).
+		Find(&posts).Error
+	if err != nil {
+		return nil, repositories.ErrFind
+	}
+	return posts, nil
+}
+
+func (r *PostRepository) FindByGenreCommunityID(viewer repositories.Viewer, genreCommunityID uint, sort string, offset, limit int) ([]*entities.Post, error) {
+	var posts []*entities.Post
+	err := r.sorted(viewer, sort).
+		Where("posts.genre_community_id = ?", genreCommunityID).
+		Offset(offset).Limit(limit).
+		Find(&posts).Error
+	if err != nil {
+		return nil, repositories.ErrFind
+	}
+	return posts, nil
+}
+
+func (r *PostRepository) CountByGenreCommunityID(viewer repositories.Viewer, genreCommunityID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&entities.Post{}).Scopes(visibleTo(viewer, "posts.user_id")).
+		Where("genre_community_id = ? AND is_hidden = ?", genreCommunityID, false).
+		Count(&count).Error
+	if err != nil {
+		return 0, repositories.ErrFind
+	}
+	return count, nil
+}
+
+func (r *PostRepository) FindAll(viewer repositories.Viewer, sort string, offset, limit int) ([]*entities.Post, error) {
+	var posts []*entities.Post
+	if err := r.sorted(viewer, sort).Offset(offset).Limit(limit).Find(&posts).Error; err != nil {
+		return nil, repositories.ErrFind
+	}
+	return posts, nil
+}
+
+func (r *PostRepository) CountAll(viewer repositories.Viewer) (int64, error) {
+	var count int64
+	if err := r.db.Model(&entities.Post{}).Scopes(visibleTo(viewer, "posts.user_id")).Where("is_hidden = ?", false).Count(&count).Error; err != nil {
+		return 0, repositories.ErrFind
+	}
+	return count, nil
+}
+
+func (r *PostRepository) SearchByTitle(viewer repositories.Viewer, title string, genreCommunityID *uint, offset, limit int) ([]*entities.Post, error) {
+	return r.search(viewer, "posts.title", title, genreCommunityID, offset, limit)
+}
+
+func (r *PostRepository) CountByTitle(viewer repositories.Viewer, title string, genreCommunityID *uint) (int64, error) {
+	return r.countMatches(viewer, "posts.title", title, genreCommunityID)
+}
+
+func (r *PostRepository) SearchByContent(viewer repositories.Viewer, content string, genreCommunityID *uint, offset, limit int) ([]*entities.Post, error) {
+	return r.search(viewer, "posts.content", content, genreCommunityID, offset, limit)
+}
+
+func (r *PostRepository) CountByContent(viewer repositories.Viewer, content string, genreCommunityID *uint) (int64, error) {
+	return r.countMatches(viewer, "posts.content", content, genreCommunityID)
+}
+
+func (r *PostRepository) search(viewer repositories.Viewer, column, text string, genreCommunityID *uint, offset, limit int) ([]*entities.Post, error) {
+	var posts []*entities.Post
+	err := r.matching(r.db.Preload("User").Scopes(visibleTo(viewer, "posts.user_id")), column, text, genreCommunityID).
+		Order("posts.created_at DESC, posts.id DESC").Offset(offset).Limit(limit).
+		Find(&posts).Error
+	if err != nil {
+		return nil, repositories.ErrFind
+	}
+	return posts, nil
+}
+
+func (r *PostRepository) countMatches(viewer repositories.Viewer, column, text string, genreCommunityID *uint) (int64, error) {
+	var count int64
+	if err := r.matching(r.db.Model(&entities.Post{}).Scopes(visibleTo(viewer, "posts.user_id")), column, text, genreCommunityID).Count(&count).Error; err != nil {
+		return 0, repositories.ErrFind
+	}
+	return count, nil
+}
+
+func (r *PostRepository) matching(query *gorm.DB, column, text string, genreCommunityID *uint) *gorm.DB {
+	query = query.Where(column+" ILIKE ? AND posts.is_hidden = ?", "%"+likeEscaper.Replace(text)+"%", false)
+	if genreCommunityID != nil {
+		query = query.Where("posts.genre_community_id = ?", *genreCommunityID)
+	}
+	return query
+}
+
+// sorted orders posts by the given sort, leaving out hidden posts and those
+// the viewer cannot see. Scores are computed from user_likes in the same
+// query, so that pages stay consistent with each other.
+func (r *PostRepository) sorted(viewer repositories.Viewer, sort string) *gorm.DB {
+	query := r.db.Preload("User").Scopes(visibleTo(viewer, "posts.user_id")).Where("posts.is_hidden = ?", false)
+	if sort != repositories.PostSortTop && sort != repositories.PostSortHot {
+		return query.Order("posts.created_at DESC, posts.id DESC")
+	}
+
+	scores := r.db.Model(&entities.UserLike{}).
+		Select("post_id, COUNT(*) FILTER (WHERE liked) - COUNT(*) FILTER (WHERE NOT liked) AS score").
+		Where("post_id IS NOT NULL").
+		Group("post_id")
+	query = query.Joins("LEFT JOIN (?) AS post_scores ON post_scores.post_id = posts.id", scores)
+	if sort == repositories.PostSortTop {
+		return query.Order("COALESCE(post_scores.score, 0) DESC, posts.created_at DESC, posts.id DESC")
+	}
+	return query.Order(clause.OrderBy{Expression: clause.Expr{
+		SQL:  "COALESCE(post_scores.score, 0) / POWER(EXTRACT(EPOCH FROM (NOW() - posts.created_at)) / 3600 + 2, ?) DESC, posts.created_at DESC, posts.id DESC",
+		Vars: []interface{}{hotGravity},
+	}})
+}
+
+func (r *PostRepository) Update(post *entities.Post) error {
+	if err := r.db.Omit("User", "Comments", "UserLikes").Save(post).Error; err != nil {
+		return repositories.ErrUpdate
+	}
+	return nil
+}
+
+// Delete removes the post together with its comments and all reactions to
+// them.
+func (r *PostRepository) Delete(id uint) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		comments := tx.Model(&entities.Comment{}).Select("id").Where("post_id = ?", id)
+		if err := tx.Where("post_id = ? OR comment_id IN (?)", id, comments).Delete(&entities.UserLike{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("post_id = ?", id).Delete(&entities.Comment{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&entities.Post{}, id).Error
+	})
+	if err != nil {
+		return repositories.ErrDelete
+	}
+	return nil
+}
+
+func (r *PostRepository) CountLikesAndDislikesByID(id uint) (likes, dislikes int64, err error) {
+	var result likeCounts
+	err = r.db.Model(&entities.UserLike{}).
+		Select("COUNT(*) FILTER (WHERE liked) AS likes, COUNT(*) FILTER (WHERE NOT liked) AS dislikes").
+		Where("post_id = ?", id).
+		Scan(&result).Error
+	if err != nil {
+		return 0, 0, repositories.ErrFind
+	}
+	return result.Likes, result.Dislikes, nil
+}
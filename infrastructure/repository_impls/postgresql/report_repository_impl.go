@@ -0,0 +1,119 @@
+package postgresql
+
+import (
+	"errors"
+	"time"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"gorm.io/gorm"
+)
+
+type ReportRepository struct {
+	db *gorm.DB
+}
+
+func NewReportRepository(db *gorm.DB) repositories.ReportRepository {
+	return &ReportRepository{db: db}
+}
+
+var pendingReportStatuses = []string{entities.ReportStatusOpen, entities.ReportStatusEscalated}
+
+func (r *ReportRepository) Create(report *entities.Report) error {
+	if err := r.db.Create(report).Error; err != nil {
+		return repositories.ErrCreate
+	}
+	return nil
+}
+
+func (r *ReportRepository) FindByID(id uint) (*entities.Report, error) {
+	report := new(entities.Report)
+	if err := r.db.First(&report, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, repositories.ErrFind
+	}
+	return report, nil
+}
+
+func (r *ReportRepository) FindPendingByReporterAndTarget(reporterID uint, targetType string, targetID uint) (*entities.Report, error) {
+	report := new(entities.Report)
+	err := r.db.Where("reporter_id = ? AND target_type = ? AND target_id = ? AND status IN ?",
+		reporterID, targetType, targetID, pendingReportStatuses).
+		First(&report).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, repositories.ErrFind
+	}
+	return report, nil
+}
+
+func (r *ReportRepository) FindByCommunityID(communityID uint, status string, offset, limit int) ([]*entities.Report, error) {
+	var reports []*entities.Report
+	err := r.db.Where("community_id = ? AND status = ?", communityID, status).
+		Order("created_at, id").Offset(offset).Limit(limit).
+		Find(&reports).Error
+	if err != nil {
+		return nil, repositories.ErrFind
+	}
+	return reports, nil
+}
+
+func (r *ReportRepository) CountByCommunityID(communityID uint, status string) (int64, error) {
+	var count int64
+	err := r.db.Model(&entities.Report{}).
+		Where("community_id = ? AND status = ?", communityID, status).
+		Count(&count).Error
+	if err != nil {
+		return 0, repositories.ErrFind
+	}
+	return count, nil
+}
+
+func (r *ReportRepository) FindAdminQueue(offset, limit int) ([]*entities.Report, error) {
+	var reports []*entities.Report
+	err := r.adminQueue(r.db).
+		Order("created_at, id").Offset(offset).Limit(limit).
+		Find(&reports).Error
+	if err != nil {
+		return nil, repositories.ErrFind
+	}
+	return reports, nil
+}
+
+func (r *ReportRepository) CountAdminQueue() (int64, error) {
+	var count int64
+	if err := r.adminQueue(r.db.Model(&entities.Report{})).Count(&count).Error; err != nil {
+		return 0, repositories.ErrFind
+	}
+	return count, nil
+}
+
+func (r *ReportRepository) adminQueue(query *gorm.DB) *gorm.DB {
+	return query.Where("status = ? OR (status = ? AND community_id IS NULL)",
+		entities.ReportStatusEscalated, entities.ReportStatusOpen)
+}
+
+func (r *ReportRepository) ClosePendingByTarget(targetType string, targetID uint, status string, resolvedByID uint) error {
+	err := r.db.Model(&entities.Report{}).
+		Where("target_type = ? AND target_id = ? AND status IN ?", targetType, targetID, pendingReportStatuses).
+		Updates(map[string]interface{}{
+			"status":         status,
+			"resolved_by_id": resolvedByID,
+			"resolved_at":    time.Now(),
+		}).Error
+	if err != nil {
+		return repositories.ErrUpdate
+	}
+	return nil
+}
+
+func (r *ReportRepository) Update(report *entities.Report) error {
+	if err := r.db.Save(report).Error; err != nil {
+		return repositories.ErrUpdate
+	}
+	return nil
+}
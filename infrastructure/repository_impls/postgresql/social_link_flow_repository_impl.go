@@ -0,0 +1,43 @@
+package postgresql
+
+import (
+	"errors"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"gorm.io/gorm"
+)
+
+type SocialLinkFlowRepository struct {
+	db *gorm.DB
+}
+
+func NewSocialLinkFlowRepository(db *gorm.DB) repositories.SocialLinkFlowRepository {
+	return &SocialLinkFlowRepository{db: db}
+}
+
+func (r *SocialLinkFlowRepository) Create(flow *entities.SocialLinkFlow) error {
+	if err := r.db.Create(flow).Error; err != nil {
+		return repositories.ErrCreate
+	}
+	return nil
+}
+
+func (r *SocialLinkFlowRepository) FindByState(state string) (*entities.SocialLinkFlow, error) {
+	flow := new(entities.SocialLinkFlow)
+	err := r.db.Where("state = ?", state).First(&flow).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, repositories.ErrFind
+	}
+	return flow, nil
+}
+
+func (r *SocialLinkFlowRepository) DeleteByState(state string) error {
+	if err := r.db.Where("state = ?", state).Delete(&entities.SocialLinkFlow{}).Error; err != nil {
+		return repositories.ErrDelete
+	}
+	return nil
+}
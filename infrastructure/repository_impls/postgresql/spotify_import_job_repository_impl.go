@@ -0,0 +1,59 @@
+package postgresql
+
+import (
+	"errors"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"gorm.io/gorm"
+)
+
+type SpotifyImportJobRepository struct {
+	db *gorm.DB
+}
+
+func NewSpotifyImportJobRepository(db *gorm.DB) repositories.SpotifyImportJobRepository {
+	return &SpotifyImportJobRepository{db: db}
+}
+
+func (r *SpotifyImportJobRepository) Create(job *entities.SpotifyImportJob) error {
+	if err := r.db.Create(job).Error; err != nil {
+		return repositories.ErrCreate
+	}
+	return nil
+}
+
+func (r *SpotifyImportJobRepository) FindByID(id uint) (*entities.SpotifyImportJob, error) {
+	job := new(entities.SpotifyImportJob)
+	err := r.db.First(&job, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, repositories.ErrFind
+	}
+	return job, nil
+}
+
+// FindActiveByUserID returns the user's pending or running job, if any.
+func (r *SpotifyImportJobRepository) FindActiveByUserID(userID uint) (*entities.SpotifyImportJob, error) {
+	job := new(entities.SpotifyImportJob)
+	err := r.db.
+		Where("user_id = ? AND status IN ?", userID, []string{entities.ImportJobStatusPending, entities.ImportJobStatusRunning}).
+		Order("id DESC").
+		First(&job).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, repositories.ErrFind
+	}
+	return job, nil
+}
+
+func (r *SpotifyImportJobRepository) Update(job *entities.SpotifyImportJob) error {
+	if err := r.db.Save(job).Error; err != nil {
+		return repositories.ErrUpdate
+	}
+	return nil
+}
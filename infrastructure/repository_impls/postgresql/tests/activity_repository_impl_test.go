@@ -0,0 +1,90 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActivityRepository_FindFeed(t *testing.T) {
+	// users[0] follows users[1] and users[2]. users[2] is read on demand.
+	users := createTestUsers(t, 4)
+	assert.NoError(t, userFollowRepo.Create(&entities.UserFollow{FollowerID: users[0].ID, FollowingID: users[1].ID}))
+	assert.NoError(t, userFollowRepo.Create(&entities.UserFollow{FollowerID: users[0].ID, FollowingID: users[2].ID}))
+
+	public := &entities.UserTopster{UserID: users[1].ID, Title: "public", Template: entities.TopsterTemplateGrid, Rows: 3, Cols: 3, IsPublic: true}
+	private := &entities.MusicCollection{UserID: users[1].ID, Name: "private"}
+	assert.NoError(t, topsterRepo.Create(public))
+	assert.NoError(t, collectionRepo.Create(private))
+
+	fannedOut := &entities.Activity{ActorID: users[1].ID, Type: entities.ActivityTypeTopster, TopsterID: &public.ID, FannedOut: true}
+	hidden := &entities.Activity{ActorID: users[1].ID, Type: entities.ActivityTypeCollection, CollectionID: &private.ID, FannedOut: true}
+	onDemand := &entities.Activity{ActorID: users[2].ID, Type: entities.ActivityTypeFollow, TargetUserID: &users[3].ID}
+	for _, a := range []*entities.Activity{fannedOut, hidden, onDemand} {
+		assert.NoError(t, activityRepo.Create(a))
+	}
+	// The follow activity shows as long as users[2] follows users[3].
+	assert.NoError(t, userFollowRepo.Create(&entities.UserFollow{FollowerID: users[2].ID, FollowingID: users[3].ID}))
+
+	t.Run("MergesFanOutOnWriteAndOnRead", func(t *testing.T) {
+		activities, err := activityRepo.FindFeed(repositories.Viewer{ID: users[0].ID}, 0, 10)
+		assert.NoError(t, err)
+		assert.Len(t, activities, 2)
+		assert.Equal(t, onDemand.ID, activities[0].ID)
+		assert.Equal(t, users[3].Nickname, activities[0].TargetUser.Nickname)
+		assert.Equal(t, fannedOut.ID, activities[1].ID)
+		assert.Equal(t, "public", activities[1].Topster.Title)
+	})
+
+	t.Run("Cursor", func(t *testing.T) {
+		activities, err := activityRepo.FindFeed(repositories.Viewer{ID: users[0].ID}, onDemand.ID, 10)
+		assert.NoError(t, err)
+		assert.Len(t, activities, 1)
+		assert.Equal(t, fannedOut.ID, activities[0].ID)
+	})
+
+	t.Run("NotFannedOutBeforeFollowing", func(t *testing.T) {
+		// The topster activity was fanned out before users[3] followed
+		// users[1], so it is not in their feed.
+		assert.NoError(t, userFollowRepo.Create(&entities.UserFollow{FollowerID: users[3].ID, FollowingID: users[1].ID}))
+		activities, err := activityRepo.FindFeed(repositories.Viewer{ID: users[3].ID}, 0, 10)
+		assert.NoError(t, err)
+		assert.Empty(t, activities)
+	})
+
+	t.Run("UndoneFollow", func(t *testing.T) {
+		assert.NoError(t, userFollowRepo.DeleteByFollowerIDAndFollowingID(users[2].ID, users[3].ID))
+		activities, err := activityRepo.FindFeed(repositories.Viewer{ID: users[0].ID}, 0, 10)
+		assert.NoError(t, err)
+		assert.Len(t, activities, 1)
+		assert.Equal(t, fannedOut.ID, activities[0].ID)
+	})
+
+	t.Run("Muted", func(t *testing.T) {
+		assert.NoError(t, userMuteRepo.Create(&entities.UserMute{MuterID: users[0].ID, MutedID: users[1].ID}))
+		activities, err := activityRepo.FindFeed(repositories.Viewer{ID: users[0].ID, HideMuted: true}, 0, 10)
+		assert.NoError(t, err)
+		assert.Empty(t, activities)
+	})
+
+	t.Run("ReplacesEarlierActivity", func(t *testing.T) {
+		again := &entities.Activity{ActorID: users[1].ID, Type: entities.ActivityTypeTopster, TopsterID: &public.ID, FannedOut: true}
+		assert.NoError(t, activityRepo.Create(again))
+		activities, err := activityRepo.FindFeed(repositories.Viewer{ID: users[0].ID}, 0, 10)
+		assert.NoError(t, err)
+		assert.Len(t, activities, 1)
+		assert.Equal(t, again.ID, activities[0].ID)
+	})
+
+	t.Cleanup(func() {
+		testdb.GetDB().Where("1 = 1").Delete(&entities.FeedItem{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.Activity{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.UserMute{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.UserFollow{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.UserTopster{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.MusicCollection{})
+		testdb.GetDB().Unscoped().Where("1 = 1").Delete(&entities.User{})
+	})
+}
@@ -0,0 +1,229 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlbumRepository_FindBySpotifyID(t *testing.T) {
+	artist := &entities.Artist{Name: "Aimee Mann", SpotifyID: "6lGiIBsLhPUMFGRmh3Vqsd"}
+	assert.NoError(t, artistRepo.Create(artist))
+	album := &entities.Album{Name: "Magnolia", ArtistID: artist.ID, SpotifyID: "3M3cfh4JyWpuL0nVwEPSdo"}
+	assert.NoError(t, albumRepo.Create(album))
+
+	testCases := []struct {
+		name        string
+		spotifyID   string
+		expectedErr error
+	}{
+		{
+			name:        "Success",
+			spotifyID:   "3M3cfh4JyWpuL0nVwEPSdo",
+			expectedErr: nil,
+		},
+		{
+			name:        "NotFound",
+			spotifyID:   "unknown",
+			expectedErr: repositories.ErrNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			found, err := albumRepo.FindBySpotifyID(tc.spotifyID)
+			assert.Equal(t, tc.expectedErr, err)
+			if tc.expectedErr == nil {
+				assert.Equal(t, album.ID, found.ID)
+				assert.Equal(t, "Aimee Mann", found.Artist.Name)
+			}
+		})
+	}
+
+	t.Cleanup(cleanupTestMusic)
+}
+
+func TestArtistRepository_FindBySpotifyID(t *testing.T) {
+	artist := &entities.Artist{Name: "Aimee Mann", SpotifyID: "6lGiIBsLhPUMFGRmh3Vqsd"}
+	assert.NoError(t, artistRepo.Create(artist))
+
+	err := artistRepo.Create(&entities.Artist{Name: "Duplicate", SpotifyID: "6lGiIBsLhPUMFGRmh3Vqsd"})
+	assert.Equal(t, repositories.ErrCreate, err)
+
+	found, err := artistRepo.FindBySpotifyID("6lGiIBsLhPUMFGRmh3Vqsd")
+	assert.NoError(t, err)
+	assert.Equal(t, artist.ID, found.ID)
+
+	_, err = artistRepo.FindBySpotifyID("unknown")
+	assert.Equal(t, repositories.ErrNotFound, err)
+
+	t.Cleanup(cleanupTestMusic)
+}
+
+func TestUserLikeRepository_CountLikesAndDislikes(t *testing.T) {
+	music := createTestMusic(t, "One", "2up3OPMp9Tb4dAKM2erWXQ")
+	users := createTestUsers(t, 3)
+
+	assert.NoError(t, userLikeRepo.Create(&entities.UserLike{UserID: users[0].ID, MusicID: &music.ID, Liked: true}))
+	assert.NoError(t, userLikeRepo.Create(&entities.UserLike{UserID: users[1].ID, MusicID: &music.ID, Liked: true}))
+	assert.NoError(t, userLikeRepo.Create(&entities.UserLike{UserID: users[2].ID, MusicID: &music.ID, Liked: false}))
+
+	likes, dislikes, err := userLikeRepo.CountLikesAndDislikesByMusicID(music.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), likes)
+	assert.Equal(t, int64(1), dislikes)
+
+	likes, dislikes, err = userLikeRepo.CountLikesAndDislikesByAlbumID(music.AlbumID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), likes)
+	assert.Equal(t, int64(1), dislikes)
+
+	mappings, err := musicArtistRepo.FindByMusicID(music.ID)
+	assert.NoError(t, err)
+	likes, dislikes, err = userLikeRepo.CountLikesAndDislikesByArtistID(mappings[0].ArtistID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), likes)
+	assert.Equal(t, int64(1), dislikes)
+
+	userLike, err := userLikeRepo.FindByUserIDAndMusicID(users[2].ID, music.ID)
+	assert.NoError(t, err)
+	assert.False(t, userLike.Liked)
+
+	_, err = userLikeRepo.FindByUserIDAndMusicID(users[0].ID, music.ID+1)
+	assert.Equal(t, repositories.ErrNotFound, err)
+
+	t.Cleanup(func() {
+		testdb.GetDB().Where("1 = 1").Delete(&entities.UserLike{})
+		cleanupTestMusic()
+		testdb.GetDB().Unscoped().Where("1 = 1").Delete(&entities.User{})
+	})
+}
+
+func TestCollectionMusicMappingRepository_CountCollectionsByMusicID(t *testing.T) {
+	music := createTestMusic(t, "One", "2up3OPMp9Tb4dAKM2erWXQ")
+	users := createTestUsers(t, 1)
+
+	for _, name := range []string{"Favorites", "Late Night"} {
+		collection := &entities.MusicCollection{UserID: users[0].ID, Name: name}
+		assert.NoError(t, testdb.GetDB().Create(collection).Error)
+		assert.NoError(t, collectionMusicRepo.Create(&entities.CollectionMusicMapping{CollectionID: collection.ID, MusicID: music.ID}))
+	}
+
+	count, err := collectionMusicRepo.CountCollectionsByMusicID(music.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	t.Cleanup(func() {
+		testdb.GetDB().Where("1 = 1").Delete(&entities.CollectionMusicMapping{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.MusicCollection{})
+		cleanupTestMusic()
+		testdb.GetDB().Unscoped().Where("1 = 1").Delete(&entities.User{})
+	})
+}
+
+func createTestUsers(t *testing.T, n int) []*entities.User {
+	users := make([]*entities.User, n)
+	for i := range users {
+		users[i] = &entities.User{
+			Email:        fmt.Sprintf("catalog%d@example.com", i),
+			EmailHash:    fmt.Sprintf("catalog%dhashed", i),
+			PasswordHash: "hashedPassword1!",
+			Name:         fmt.Sprintf("catalog%d", i),
+			Nickname:     fmt.Sprintf("catalognickname%d", i),
+		}
+		assert.NoError(t, userRepo.Create(users[i]))
+	}
+	return users
+}
+
+func TestMusicRepository_Merge(t *testing.T) {
+	canonical := createTestMusic(t, "One", "2up3OPMp9Tb4dAKM2erWXQ")
+	duplicate := createTestMusic(t, "One (Remastered)", "")
+	users := createTestUsers(t, 2)
+
+	assert.NoError(t, userLikeRepo.Create(&entities.UserLike{UserID: users[0].ID, MusicID: &canonical.ID, Liked: true}))
+	assert.NoError(t, userLikeRepo.Create(&entities.UserLike{UserID: users[0].ID, MusicID: &duplicate.ID, Liked: false}))
+	assert.NoError(t, userLikeRepo.Create(&entities.UserLike{UserID: users[1].ID, MusicID: &duplicate.ID, Liked: true}))
+
+	err := musicRepo.Merge(canonical.ID, []uint{duplicate.ID})
+	assert.NoError(t, err)
+
+	likes, dislikes, err := userLikeRepo.CountLikesAndDislikesByMusicID(canonical.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), likes)
+	assert.Equal(t, int64(0), dislikes)
+
+	mappings, err := musicArtistRepo.FindByMusicID(canonical.ID)
+	assert.NoError(t, err)
+	assert.Len(t, mappings, 2)
+
+	resolved, err := musicRepo.FindByID(duplicate.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, canonical.ID, resolved.ID)
+
+	t.Cleanup(func() {
+		testdb.GetDB().Where("1 = 1").Delete(&entities.UserLike{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.MusicAlias{})
+		cleanupTestMusic()
+		testdb.GetDB().Unscoped().Where("1 = 1").Delete(&entities.User{})
+	})
+}
+
+func TestMusicRepository_Merge_Attachments(t *testing.T) {
+	canonical := createTestMusic(t, "One", "2up3OPMp9Tb4dAKM2erWXQ")
+	duplicate := createTestMusic(t, "One (Remastered)", "")
+	users := createTestUsers(t, 1)
+	genre := &entities.Genre{Name: "Metal"}
+	assert.NoError(t, genreRepo.Create(genre))
+	community := &entities.GenreCommunity{GenreID: genre.ID, Name: "Metal Club"}
+	assert.NoError(t, genreCommunityRepo.Create(community))
+	post := &entities.Post{UserID: users[0].ID, GenreCommunityID: community.ID, Title: "Favourite ballad"}
+	assert.NoError(t, postRepo.Create(post))
+	assert.NoError(t, musicAttachmentRepo.ReplaceByPostID(post.ID, []uint{duplicate.ID}))
+
+	err := musicRepo.Merge(canonical.ID, []uint{duplicate.ID})
+	assert.NoError(t, err)
+
+	attachments, err := musicAttachmentRepo.FindByPostIDs([]uint{post.ID})
+	assert.NoError(t, err)
+	assert.Len(t, attachments, 1)
+	assert.Equal(t, canonical.ID, attachments[0].MusicID)
+
+	t.Cleanup(func() {
+		testdb.GetDB().Where("1 = 1").Delete(&entities.MusicAttachment{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.MusicAlias{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.Post{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.GenreCommunity{})
+		cleanupTestMusic()
+		testdb.GetDB().Unscoped().Where("1 = 1").Delete(&entities.User{})
+	})
+}
+
+func TestAlbumRepository_Merge(t *testing.T) {
+	music := createTestMusic(t, "One", "2up3OPMp9Tb4dAKM2erWXQ")
+	album, err := albumRepo.FindByID(music.AlbumID)
+	assert.NoError(t, err)
+	duplicate := &entities.Album{Name: "Magnolia (Deluxe)", ArtistID: album.ArtistID, UPC: "00602537518357"}
+	assert.NoError(t, albumRepo.Create(duplicate))
+	moved := &entities.Music{Title: "Wise Up", AlbumID: duplicate.ID}
+	assert.NoError(t, musicRepo.Create(moved))
+
+	err = albumRepo.Merge(album.ID, []uint{duplicate.ID})
+	assert.NoError(t, err)
+
+	tracks, err := musicRepo.FindByAlbumID(album.ID)
+	assert.NoError(t, err)
+	assert.Len(t, tracks, 2)
+
+	resolved, err := albumRepo.FindByID(duplicate.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, album.ID, resolved.ID)
+
+	t.Cleanup(func() {
+		testdb.GetDB().Where("1 = 1").Delete(&entities.AlbumAlias{})
+		cleanupTestMusic()
+	})
+}
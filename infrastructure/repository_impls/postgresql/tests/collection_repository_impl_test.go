@@ -0,0 +1,132 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMusicCollectionRepository_FindByUserID(t *testing.T) {
+	users := createTestUsers(t, 1)
+
+	assert.NoError(t, collectionRepo.Create(&entities.MusicCollection{UserID: users[0].ID, Name: "Public", IsPublic: true}))
+	assert.NoError(t, collectionRepo.Create(&entities.MusicCollection{UserID: users[0].ID, Name: "Private"}))
+
+	testCases := []struct {
+		name           string
+		includePrivate bool
+		expectedCount  int
+	}{
+		{name: "Owner", includePrivate: true, expectedCount: 2},
+		{name: "PublicOnly", includePrivate: false, expectedCount: 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			collections, err := collectionRepo.FindByUserID(users[0].ID, tc.includePrivate, 0, 10)
+			assert.NoError(t, err)
+			assert.Len(t, collections, tc.expectedCount)
+
+			count, err := collectionRepo.CountByUserID(users[0].ID, tc.includePrivate)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(tc.expectedCount), count)
+		})
+	}
+
+	t.Cleanup(func() {
+		testdb.GetDB().Where("1 = 1").Delete(&entities.MusicCollection{})
+		testdb.GetDB().Unscoped().Where("1 = 1").Delete(&entities.User{})
+	})
+}
+
+func TestCollectionMusicMappingRepository_Move(t *testing.T) {
+	users := createTestUsers(t, 1)
+	music := []*entities.Music{
+		createTestMusic(t, "One", ""),
+		createTestMusic(t, "Two", ""),
+		createTestMusic(t, "Three", ""),
+		createTestMusic(t, "Four", ""),
+	}
+
+	collection := &entities.MusicCollection{UserID: users[0].ID, Name: "Road Trip"}
+	assert.NoError(t, collectionRepo.Create(collection))
+
+	mappings := make([]*entities.CollectionMusicMapping, len(music))
+	for i, m := range music {
+		mappings[i] = &entities.CollectionMusicMapping{CollectionID: collection.ID, MusicID: m.ID}
+	}
+	assert.NoError(t, collectionMusicRepo.CreateBatch(mappings[:3]))
+	assert.NoError(t, collectionMusicRepo.Create(mappings[3]))
+	assert.Equal(t, 3, mappings[3].Position)
+
+	order := func() []uint {
+		found, err := collectionMusicRepo.FindByCollectionID(collection.ID)
+		assert.NoError(t, err)
+		ids := make([]uint, len(found))
+		for i, m := range found {
+			ids[i] = m.MusicID
+		}
+		return ids
+	}
+
+	// Removing a track leaves a gap that the next move closes.
+	assert.NoError(t, collectionMusicRepo.DeleteByCollectionIDAndMusicID(collection.ID, music[1].ID))
+	assert.NoError(t, collectionMusicRepo.Move(collection.ID, music[3].ID, 0))
+	assert.Equal(t, []uint{music[3].ID, music[0].ID, music[2].ID}, order())
+
+	// Positions past the end move the track to the end.
+	assert.NoError(t, collectionMusicRepo.Move(collection.ID, music[3].ID, 10))
+	assert.Equal(t, []uint{music[0].ID, music[2].ID, music[3].ID}, order())
+
+	err := collectionMusicRepo.Move(collection.ID, music[1].ID, 0)
+	assert.ErrorIs(t, err, repositories.ErrNotFound)
+
+	err = collectionMusicRepo.DeleteByCollectionIDAndMusicID(collection.ID, music[1].ID)
+	assert.ErrorIs(t, err, repositories.ErrNotFound)
+
+	t.Cleanup(func() {
+		testdb.GetDB().Where("1 = 1").Delete(&entities.CollectionMusicMapping{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.MusicCollection{})
+		testdb.GetDB().Unscoped().Where("1 = 1").Delete(&entities.User{})
+		cleanupTestMusic()
+	})
+}
+
+func TestCollectionMemberRepository(t *testing.T) {
+	users := createTestUsers(t, 2)
+
+	collection := &entities.MusicCollection{UserID: users[0].ID, Name: "Road Trip"}
+	assert.NoError(t, collectionRepo.Create(collection))
+
+	// Creating a collection makes its creator the owner.
+	owner, err := memberRepo.FindByCollectionIDAndUserID(collection.ID, users[0].ID)
+	assert.NoError(t, err)
+	assert.Equal(t, entities.CollectionRoleOwner, owner.Role)
+
+	expiresAt := time.Now().Add(time.Hour)
+	invite := &entities.CollectionInvite{CollectionID: collection.ID, Token: "invite-token", Role: entities.CollectionRoleEditor, CreatedBy: users[0].ID, ExpiresAt: &expiresAt}
+	assert.NoError(t, inviteRepo.Create(invite))
+
+	found, err := inviteRepo.FindByToken("invite-token")
+	assert.NoError(t, err)
+	assert.Equal(t, collection.ID, found.CollectionID)
+
+	assert.NoError(t, memberRepo.Create(&entities.CollectionMember{CollectionID: collection.ID, UserID: users[1].ID, Role: found.Role}))
+
+	members, err := memberRepo.FindByCollectionID(collection.ID)
+	assert.NoError(t, err)
+	assert.Len(t, members, 2)
+	assert.Equal(t, users[1].Nickname, members[1].User.Nickname)
+
+	assert.NoError(t, memberRepo.DeleteByCollectionIDAndUserID(collection.ID, users[1].ID))
+	err = memberRepo.DeleteByCollectionIDAndUserID(collection.ID, users[1].ID)
+	assert.ErrorIs(t, err, repositories.ErrNotFound)
+
+	t.Cleanup(func() {
+		testdb.GetDB().Where("1 = 1").Delete(&entities.MusicCollection{})
+		testdb.GetDB().Unscoped().Where("1 = 1").Delete(&entities.User{})
+	})
+}
@@ -0,0 +1,166 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenreRepository_Create(t *testing.T) {
+	err := genreRepo.Create(&entities.Genre{Name: "rock"})
+	assert.NoError(t, err)
+
+	err = genreRepo.Create(&entities.Genre{Name: "rock"})
+	assert.Equal(t, repositories.ErrCreate, err)
+
+	t.Cleanup(func() {
+		testdb.GetDB().Where("1 = 1").Delete(&entities.Genre{})
+	})
+}
+
+func TestGenreRepository_FindByName(t *testing.T) {
+	genre := &entities.Genre{Name: "Hip Hop"}
+	assert.NoError(t, genreRepo.Create(genre))
+
+	testCases := []struct {
+		name        string
+		genreName   string
+		expectedErr error
+	}{
+		{
+			name:        "Success",
+			genreName:   "Hip Hop",
+			expectedErr: nil,
+		},
+		{
+			name:        "CaseInsensitive",
+			genreName:   "hip hop",
+			expectedErr: nil,
+		},
+		{
+			name:        "NotFound",
+			genreName:   "seen live",
+			expectedErr: repositories.ErrNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			found, err := genreRepo.FindByName(tc.genreName)
+			assert.Equal(t, tc.expectedErr, err)
+			if err == nil {
+				assert.Equal(t, genre.ID, found.ID)
+			}
+		})
+	}
+
+	t.Cleanup(func() {
+		testdb.GetDB().Where("1 = 1").Delete(&entities.Genre{})
+	})
+}
+
+func TestGenreRepository_FindAll(t *testing.T) {
+	rock := &entities.Genre{Name: "Rock"}
+	assert.NoError(t, genreRepo.Create(rock))
+	indie := &entities.Genre{Name: "Indie Rock", ParentID: &rock.ID}
+	assert.NoError(t, genreRepo.Create(indie))
+	assert.NoError(t, genreAliasRepo.Create(&entities.GenreAlias{GenreID: indie.ID, Alias: "k indie"}))
+
+	genres, err := genreRepo.FindAll()
+	assert.NoError(t, err)
+	assert.Len(t, genres, 2)
+	assert.Nil(t, genres[0].ParentID)
+	assert.Equal(t, rock.ID, *genres[1].ParentID)
+	assert.Equal(t, "k indie", genres[1].Aliases[0].Alias)
+
+	t.Cleanup(func() {
+		testdb.GetDB().Where("1 = 1").Delete(&entities.GenreAlias{})
+		testdb.GetDB().Where("parent_id IS NOT NULL").Delete(&entities.Genre{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.Genre{})
+	})
+}
+
+func TestGenreAliasRepository_FindByAlias(t *testing.T) {
+	genre := &entities.Genre{Name: "Hip Hop"}
+	assert.NoError(t, genreRepo.Create(genre))
+	assert.NoError(t, genreAliasRepo.Create(&entities.GenreAlias{GenreID: genre.ID, Alias: "rap"}))
+
+	err := genreAliasRepo.Create(&entities.GenreAlias{GenreID: genre.ID, Alias: "rap"})
+	assert.Equal(t, repositories.ErrCreate, err)
+
+	alias, err := genreAliasRepo.FindByAlias("rap")
+	assert.NoError(t, err)
+	assert.Equal(t, genre.ID, alias.GenreID)
+
+	_, err = genreAliasRepo.FindByAlias("trot")
+	assert.Equal(t, repositories.ErrNotFound, err)
+
+	t.Cleanup(func() {
+		testdb.GetDB().Where("1 = 1").Delete(&entities.GenreAlias{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.Genre{})
+	})
+}
+
+func TestGenreCommunityRepository_FindByGenreID(t *testing.T) {
+	genre := &entities.Genre{Name: "Jazz"}
+	assert.NoError(t, genreRepo.Create(genre))
+	assert.NoError(t, genreCommunityRepo.Create(&entities.GenreCommunity{GenreID: genre.ID, Name: "Jazz Club"}))
+
+	communities, err := genreCommunityRepo.FindByGenreID(genre.ID)
+	assert.NoError(t, err)
+	assert.Len(t, communities, 1)
+	assert.Equal(t, "Jazz Club", communities[0].Name)
+
+	t.Cleanup(func() {
+		testdb.GetDB().Where("1 = 1").Delete(&entities.GenreCommunity{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.Genre{})
+	})
+}
+
+func TestCommunityMemberRepository_MemberCount(t *testing.T) {
+	users := createTestUsers(t, 2)
+	genre := &entities.Genre{Name: "Jazz"}
+	assert.NoError(t, genreRepo.Create(genre))
+	community := &entities.GenreCommunity{GenreID: genre.ID, Name: "Jazz Club"}
+	assert.NoError(t, genreCommunityRepo.Create(community))
+
+	for _, u := range users {
+		assert.NoError(t, communityMemberRepo.Create(&entities.CommunityMember{CommunityID: community.ID, UserID: u.ID, Role: entities.CommunityRoleMember}))
+	}
+	member, err := communityMemberRepo.FindByCommunityIDAndUserID(community.ID, users[1].ID)
+	assert.NoError(t, err)
+	member.Role = entities.CommunityRoleModerator
+	assert.NoError(t, communityMemberRepo.Update(member))
+	assert.NoError(t, communityMemberRepo.DeleteByCommunityIDAndUserID(community.ID, users[0].ID))
+	assert.ErrorIs(t, communityMemberRepo.DeleteByCommunityIDAndUserID(community.ID, users[0].ID), repositories.ErrNotFound)
+
+	// Saving a stale copy of the community must not overwrite the count.
+	community.Name = "Jazz Lounge"
+	assert.NoError(t, genreCommunityRepo.Update(community))
+
+	found, err := genreCommunityRepo.FindByID(community.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "Jazz Lounge", found.Name)
+	assert.Equal(t, int64(1), found.MemberCount)
+
+	moderators, err := communityMemberRepo.FindByCommunityIDAndRole(community.ID, entities.CommunityRoleModerator)
+	assert.NoError(t, err)
+	assert.Len(t, moderators, 1)
+	assert.Equal(t, users[1].Nickname, moderators[0].User.Nickname)
+
+	communities, err := genreCommunityRepo.FindAll(&genre.ID, 0, 10)
+	assert.NoError(t, err)
+	assert.Len(t, communities, 1)
+	count, err := genreCommunityRepo.Count(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	t.Cleanup(func() {
+		testdb.GetDB().Where("1 = 1").Delete(&entities.CommunityMember{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.GenreCommunity{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.Genre{})
+		testdb.GetDB().Unscoped().Where("1 = 1").Delete(&entities.User{})
+	})
+}
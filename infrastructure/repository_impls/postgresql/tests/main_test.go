@@ -15,10 +15,40 @@ import (
 )
 
 var (
-	userRepo repositories.UserRepository
-	flowRepo repositories.PasswordResetFlowRepository
-	testdb   *database.Database
-	logger   logging.Logger
+	userRepo             repositories.UserRepository
+	flowRepo             repositories.PasswordResetFlowRepository
+	musicRepo            repositories.MusicRepository
+	albumRepo            repositories.AlbumRepository
+	artistRepo           repositories.ArtistRepository
+	musicArtistRepo      repositories.MusicArtistMappingRepository
+	genreRepo            repositories.GenreRepository
+	genreAliasRepo       repositories.GenreAliasRepository
+	musicGenreRepo       repositories.MusicGenreMappingRepository
+	genreCommunityRepo   repositories.GenresCommunityRepository
+	userLikeRepo         repositories.UserLikeRepository
+	collectionMusicRepo  repositories.CollectionMusicMappingRepository
+	socialAccountRepo    repositories.UserSocialAccountRepository
+	collectionRepo       repositories.MusicCollectionRepository
+	syncRepo             repositories.CollectionSpotifySyncRepository
+	memberRepo           repositories.CollectionMemberRepository
+	inviteRepo           repositories.CollectionInviteRepository
+	topsterRepo          repositories.UserTopsterRepository
+	topsterAlbumRepo     repositories.TopsterAlbumRepository
+	communityMemberRepo  repositories.CommunityMemberRepository
+	postRepo             repositories.PostRepository
+	commentRepo          repositories.CommentRepository
+	musicAttachmentRepo  repositories.MusicAttachmentRepository
+	linkPreviewRepo      repositories.LinkPreviewRepository
+	reportRepo           repositories.ReportRepository
+	communityBanRepo     repositories.CommunityBanRepository
+	userFollowRepo       repositories.UserFollowRepository
+	userBlockRepo        repositories.UserBlockRepository
+	userMuteRepo         repositories.UserMuteRepository
+	activityRepo         repositories.ActivityRepository
+	notificationRepo     repositories.NotificationRepository
+	notificationPrefRepo repositories.NotificationPreferenceRepository
+	testdb               *database.Database
+	logger               logging.Logger
 )
 
 func init() {
@@ -44,6 +74,36 @@ func TestMain(m *testing.M) {
 
 	userRepo = postgresql.NewUserRepository(testdb.GetDB())
 	flowRepo = postgresql.NewPasswordResetFlowRepository(testdb.GetDB())
+	musicRepo = postgresql.NewMusicRepository(testdb.GetDB())
+	albumRepo = postgresql.NewAlbumRepository(testdb.GetDB())
+	artistRepo = postgresql.NewArtistRepository(testdb.GetDB())
+	musicArtistRepo = postgresql.NewMusicArtistMappingRepository(testdb.GetDB())
+	genreRepo = postgresql.NewGenreRepository(testdb.GetDB())
+	genreAliasRepo = postgresql.NewGenreAliasRepository(testdb.GetDB())
+	musicGenreRepo = postgresql.NewMusicGenreMappingRepository(testdb.GetDB())
+	genreCommunityRepo = postgresql.NewGenreCommunityRepository(testdb.GetDB())
+	userLikeRepo = postgresql.NewUserLikeRepository(testdb.GetDB())
+	collectionMusicRepo = postgresql.NewCollectionMusicMappingRepository(testdb.GetDB())
+	socialAccountRepo = postgresql.NewUserSocialAccountRepository(testdb.GetDB())
+	collectionRepo = postgresql.NewMusicCollectionRepository(testdb.GetDB())
+	syncRepo = postgresql.NewCollectionSpotifySyncRepository(testdb.GetDB())
+	memberRepo = postgresql.NewCollectionMemberRepository(testdb.GetDB())
+	inviteRepo = postgresql.NewCollectionInviteRepository(testdb.GetDB())
+	topsterRepo = postgresql.NewUserTopsterRepository(testdb.GetDB())
+	topsterAlbumRepo = postgresql.NewTopsterAlbumRepository(testdb.GetDB())
+	communityMemberRepo = postgresql.NewCommunityMemberRepository(testdb.GetDB())
+	postRepo = postgresql.NewPostRepository(testdb.GetDB())
+	commentRepo = postgresql.NewCommentRepository(testdb.GetDB())
+	musicAttachmentRepo = postgresql.NewMusicAttachmentRepository(testdb.GetDB())
+	linkPreviewRepo = postgresql.NewLinkPreviewRepository(testdb.GetDB())
+	reportRepo = postgresql.NewReportRepository(testdb.GetDB())
+	communityBanRepo = postgresql.NewCommunityBanRepository(testdb.GetDB())
+	userFollowRepo = postgresql.NewUserFollowRepository(testdb.GetDB())
+	userBlockRepo = postgresql.NewUserBlockRepository(testdb.GetDB())
+	userMuteRepo = postgresql.NewUserMuteRepository(testdb.GetDB())
+	activityRepo = postgresql.NewActivityRepository(testdb.GetDB())
+	notificationRepo = postgresql.NewNotificationRepository(testdb.GetDB())
+	notificationPrefRepo = postgresql.NewNotificationPreferenceRepository(testdb.GetDB())
 	code := m.Run()
 
 	os.Exit(code)
@@ -0,0 +1,92 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReportRepository_Queues(t *testing.T) {
+	users := createTestUsers(t, 3)
+	genre := &entities.Genre{Name: "Metal"}
+	assert.NoError(t, genreRepo.Create(genre))
+	community := &entities.GenreCommunity{GenreID: genre.ID, Name: "Metal Club"}
+	assert.NoError(t, genreCommunityRepo.Create(community))
+	post := &entities.Post{UserID: users[2].ID, GenreCommunityID: community.ID, Title: "Free downloads"}
+	assert.NoError(t, postRepo.Create(post))
+
+	postReports := []*entities.Report{
+		{ReporterID: &users[0].ID, TargetType: entities.ReportTargetPost, TargetID: post.ID, TargetUserID: users[2].ID, CommunityID: &community.ID, Reason: entities.ReportReasonSpam, Status: entities.ReportStatusOpen},
+		{ReporterID: &users[1].ID, TargetType: entities.ReportTargetPost, TargetID: post.ID, TargetUserID: users[2].ID, CommunityID: &community.ID, Reason: entities.ReportReasonSpam, Status: entities.ReportStatusEscalated},
+	}
+	profileReport := &entities.Report{ReporterID: &users[0].ID, TargetType: entities.ReportTargetUser, TargetID: users[2].ID, TargetUserID: users[2].ID, Reason: entities.ReportReasonHarassment, Status: entities.ReportStatusOpen}
+	for _, r := range append(postReports, profileReport) {
+		assert.NoError(t, reportRepo.Create(r))
+	}
+
+	open, err := reportRepo.FindByCommunityID(community.ID, entities.ReportStatusOpen, 0, 10)
+	assert.NoError(t, err)
+	assert.Len(t, open, 1)
+
+	queue, err := reportRepo.FindAdminQueue(0, 10)
+	assert.NoError(t, err)
+	assert.Len(t, queue, 2)
+	count, err := reportRepo.CountAdminQueue()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	found, err := reportRepo.FindPendingByReporterAndTarget(users[0].ID, entities.ReportTargetPost, post.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, postReports[0].ID, found.ID)
+
+	t.Run("ClosePendingByTarget", func(t *testing.T) {
+		assert.NoError(t, reportRepo.ClosePendingByTarget(entities.ReportTargetPost, post.ID, entities.ReportStatusResolved, users[1].ID))
+		count, err := reportRepo.CountByCommunityID(community.ID, entities.ReportStatusResolved)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+
+		profile, err := reportRepo.FindByID(profileReport.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, entities.ReportStatusOpen, profile.Status)
+	})
+
+	t.Cleanup(func() {
+		testdb.GetDB().Where("1 = 1").Delete(&entities.Report{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.Post{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.GenreCommunity{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.Genre{})
+		testdb.GetDB().Unscoped().Where("1 = 1").Delete(&entities.User{})
+	})
+}
+
+func TestCommunityBanRepository_Create(t *testing.T) {
+	users := createTestUsers(t, 2)
+	genre := &entities.Genre{Name: "Metal"}
+	assert.NoError(t, genreRepo.Create(genre))
+	community := &entities.GenreCommunity{GenreID: genre.ID, Name: "Metal Club"}
+	assert.NoError(t, genreCommunityRepo.Create(community))
+	assert.NoError(t, communityMemberRepo.Create(&entities.CommunityMember{CommunityID: community.ID, UserID: users[0].ID, Role: entities.CommunityRoleMember}))
+
+	ban := &entities.CommunityBan{CommunityID: community.ID, UserID: users[0].ID, ModeratorID: users[1].ID, Reason: "spam"}
+	assert.NoError(t, communityBanRepo.Create(ban))
+	assert.NoError(t, communityBanRepo.Create(&entities.CommunityBan{CommunityID: community.ID, UserID: users[0].ID, ModeratorID: users[1].ID}))
+
+	_, err := communityMemberRepo.FindByCommunityIDAndUserID(community.ID, users[0].ID)
+	assert.Error(t, err)
+	found, err := genreCommunityRepo.FindByID(community.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), found.MemberCount)
+
+	banned, err := communityBanRepo.FindByCommunityIDAndUserID(community.ID, users[0].ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "spam", banned.Reason)
+
+	t.Cleanup(func() {
+		testdb.GetDB().Where("1 = 1").Delete(&entities.CommunityBan{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.CommunityMember{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.GenreCommunity{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.Genre{})
+		testdb.GetDB().Unscoped().Where("1 = 1").Delete(&entities.User{})
+	})
+}
@@ -0,0 +1,137 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestMusic(t *testing.T, title, spotifyID string) *entities.Music {
+	artist := &entities.Artist{Name: title + " artist"}
+	assert.NoError(t, testdb.GetDB().Create(artist).Error)
+
+	album := &entities.Album{Name: title + " album", ArtistID: artist.ID}
+	assert.NoError(t, testdb.GetDB().Create(album).Error)
+
+	music := &entities.Music{Title: title, AlbumID: album.ID, SpotifyID: spotifyID}
+	assert.NoError(t, musicRepo.Create(music))
+
+	mapping := &entities.MusicArtistMapping{MusicID: music.ID, ArtistID: artist.ID}
+	assert.NoError(t, testdb.GetDB().Omit("Artist").Create(mapping).Error)
+	return music
+}
+
+func cleanupTestMusic() {
+	testdb.GetDB().Where("1 = 1").Delete(&entities.MusicGenreMapping{})
+	testdb.GetDB().Where("1 = 1").Delete(&entities.MusicArtistMapping{})
+	testdb.GetDB().Where("1 = 1").Delete(&entities.Music{})
+	testdb.GetDB().Where("1 = 1").Delete(&entities.Album{})
+	testdb.GetDB().Where("1 = 1").Delete(&entities.Artist{})
+	testdb.GetDB().Where("1 = 1").Delete(&entities.Genre{})
+}
+
+func TestMusicRepository_FindByID(t *testing.T) {
+	music := createTestMusic(t, "Believe", "2goLf5JmZ4vu0QBBOBNBSM")
+
+	testCases := []struct {
+		name        string
+		musicID     uint
+		expectedErr error
+	}{
+		{
+			name:        "Success",
+			musicID:     music.ID,
+			expectedErr: nil,
+		},
+		{
+			name:        "NotFound",
+			musicID:     10000,
+			expectedErr: repositories.ErrNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			found, err := musicRepo.FindByID(tc.musicID)
+			assert.Equal(t, tc.expectedErr, err)
+			if err == nil {
+				assert.Equal(t, "Believe", found.Title)
+				assert.Len(t, found.MusicArtistMapping, 1)
+				assert.Equal(t, "Believe artist", found.MusicArtistMapping[0].Artist.Name)
+			}
+		})
+	}
+
+	t.Cleanup(cleanupTestMusic)
+}
+
+func TestMusicRepository_FindBySpotifyID(t *testing.T) {
+	music := createTestMusic(t, "Believe", "2goLf5JmZ4vu0QBBOBNBSM")
+
+	found, err := musicRepo.FindBySpotifyID("2goLf5JmZ4vu0QBBOBNBSM")
+	assert.NoError(t, err)
+	assert.Equal(t, music.ID, found.ID)
+
+	_, err = musicRepo.FindBySpotifyID("notexist")
+	assert.Equal(t, repositories.ErrNotFound, err)
+
+	t.Cleanup(cleanupTestMusic)
+}
+
+func TestMusicRepository_FindByGenreID(t *testing.T) {
+	music := createTestMusic(t, "Believe", "2goLf5JmZ4vu0QBBOBNBSM")
+	createTestMusic(t, "Strong Enough", "")
+
+	genre := &entities.Genre{Name: "pop"}
+	assert.NoError(t, genreRepo.Create(genre))
+	assert.NoError(t, musicGenreRepo.Create(&entities.MusicGenreMapping{MusicID: music.ID, GenreID: genre.ID}))
+
+	found, err := musicRepo.FindByGenreID(genre.ID, 0, 10)
+	assert.NoError(t, err)
+	assert.Len(t, found, 1)
+	assert.Equal(t, music.ID, found[0].ID)
+	assert.Equal(t, "pop", found[0].MusicGenreMapping[0].Genre.Name)
+
+	t.Cleanup(cleanupTestMusic)
+}
+
+func TestMusicRepository_SearchEscapesWildcards(t *testing.T) {
+	music := createTestMusic(t, "100% Pure_Love", "")
+	createTestMusic(t, "1000 Pure Love", "")
+
+	found, err := musicRepo.SearchByTitle("100%", 0, 10)
+	assert.NoError(t, err)
+	assert.Len(t, found, 1)
+	assert.Equal(t, music.ID, found[0].ID)
+
+	found, err = musicRepo.SearchByArtist("e_l", 0, 10)
+	assert.NoError(t, err)
+	assert.Len(t, found, 1)
+	assert.Equal(t, music.ID, found[0].ID)
+
+	found, err = musicRepo.SearchByAlbum("Pure_", 0, 10)
+	assert.NoError(t, err)
+	assert.Len(t, found, 1)
+	assert.Equal(t, music.ID, found[0].ID)
+
+	t.Cleanup(cleanupTestMusic)
+}
+
+func TestMusicRepository_Update(t *testing.T) {
+	music := createTestMusic(t, "Believe", "2goLf5JmZ4vu0QBBOBNBSM")
+
+	music.LastfmID = "32ca187e-ee25-4f18-b7d0-3b6713f24635"
+	music.LastfmListeners = 1027457
+	music.LastfmPlaycount = 7863914
+	assert.NoError(t, musicRepo.Update(music))
+
+	found, err := musicRepo.FindByLastfmID(music.LastfmID)
+	assert.NoError(t, err)
+	assert.Equal(t, music.ID, found.ID)
+	assert.Equal(t, int64(1027457), found.LastfmListeners)
+	assert.Equal(t, int64(7863914), found.LastfmPlaycount)
+
+	t.Cleanup(cleanupTestMusic)
+}
@@ -0,0 +1,92 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationRepository(t *testing.T) {
+	users := createTestUsers(t, 3)
+	follow := &entities.Notification{UserID: users[0].ID, ActorID: &users[1].ID, Type: entities.NotificationTypeFollow}
+	mention := &entities.Notification{UserID: users[0].ID, ActorID: &users[2].ID, Type: entities.NotificationTypeMention}
+	other := &entities.Notification{UserID: users[1].ID, ActorID: &users[0].ID, Type: entities.NotificationTypeFollow}
+	for _, n := range []*entities.Notification{follow, mention, other} {
+		assert.NoError(t, notificationRepo.Create(n))
+	}
+
+	t.Run("FindByUserID", func(t *testing.T) {
+		notifications, err := notificationRepo.FindByUserID(users[0].ID, false, 0, 10)
+		assert.NoError(t, err)
+		assert.Len(t, notifications, 2)
+		assert.Equal(t, mention.ID, notifications[0].ID)
+		assert.Equal(t, users[2].Nickname, notifications[0].Actor.Nickname)
+	})
+
+	t.Run("MarkRead", func(t *testing.T) {
+		// The other user's notification is left unread.
+		assert.NoError(t, notificationRepo.MarkRead(users[0].ID, []uint{follow.ID, other.ID}))
+
+		unread, err := notificationRepo.FindByUserID(users[0].ID, true, 0, 10)
+		assert.NoError(t, err)
+		assert.Len(t, unread, 1)
+		assert.Equal(t, mention.ID, unread[0].ID)
+
+		count, err := notificationRepo.CountByUserID(users[1].ID, true)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+	})
+
+	t.Run("ExistsUnread", func(t *testing.T) {
+		exists, err := notificationRepo.ExistsUnread(&entities.Notification{UserID: users[0].ID, ActorID: &users[2].ID, Type: entities.NotificationTypeMention})
+		assert.NoError(t, err)
+		assert.True(t, exists)
+
+		// The follow notification was read.
+		exists, err = notificationRepo.ExistsUnread(&entities.Notification{UserID: users[0].ID, ActorID: &users[1].ID, Type: entities.NotificationTypeFollow})
+		assert.NoError(t, err)
+		assert.False(t, exists)
+
+		postID := uint(1)
+		exists, err = notificationRepo.ExistsUnread(&entities.Notification{UserID: users[0].ID, ActorID: &users[2].ID, Type: entities.NotificationTypeMention, PostID: &postID})
+		assert.NoError(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("Blocked", func(t *testing.T) {
+		assert.NoError(t, userBlockRepo.Create(&entities.UserBlock{BlockerID: users[0].ID, BlockedID: users[2].ID}))
+		count, err := notificationRepo.CountByUserID(users[0].ID, false)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+	})
+
+	t.Run("MarkAllRead", func(t *testing.T) {
+		assert.NoError(t, notificationRepo.MarkAllRead(users[1].ID))
+		count, err := notificationRepo.CountByUserID(users[1].ID, true)
+		assert.NoError(t, err)
+		assert.Zero(t, count)
+	})
+
+	t.Run("Preferences", func(t *testing.T) {
+		preference := &entities.NotificationPreference{UserID: users[0].ID, Type: entities.NotificationTypeFollow, InApp: true, Email: true}
+		assert.NoError(t, notificationPrefRepo.Save(preference))
+		preference.Email = false
+		assert.NoError(t, notificationPrefRepo.Save(preference))
+
+		found, err := notificationPrefRepo.FindByUserIDAndType(users[0].ID, entities.NotificationTypeFollow)
+		assert.NoError(t, err)
+		assert.False(t, found.Email)
+
+		preferences, err := notificationPrefRepo.FindByUserID(users[0].ID)
+		assert.NoError(t, err)
+		assert.Len(t, preferences, 1)
+	})
+
+	t.Cleanup(func() {
+		testdb.GetDB().Where("1 = 1").Delete(&entities.NotificationPreference{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.Notification{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.UserBlock{})
+		testdb.GetDB().Unscoped().Where("1 = 1").Delete(&entities.User{})
+	})
+}
@@ -0,0 +1,238 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostRepository_FindByGenreCommunityID_Sort(t *testing.T) {
+	users := createTestUsers(t, 3)
+	genre := &entities.Genre{Name: "Jazz"}
+	assert.NoError(t, genreRepo.Create(genre))
+	community := &entities.GenreCommunity{GenreID: genre.ID, Name: "Jazz Club"}
+	assert.NoError(t, genreCommunityRepo.Create(community))
+
+	now := time.Now()
+	old := &entities.Post{UserID: users[0].ID, GenreCommunityID: community.ID, Title: "Old favorite", CreatedAt: now.Add(-72 * time.Hour)}
+	recent := &entities.Post{UserID: users[0].ID, GenreCommunityID: community.ID, Title: "Recent hit", CreatedAt: now.Add(-1 * time.Hour)}
+	newest := &entities.Post{UserID: users[1].ID, GenreCommunityID: community.ID, Title: "100% new_post", CreatedAt: now}
+	for _, p := range []*entities.Post{old, recent, newest} {
+		assert.NoError(t, postRepo.Create(p))
+	}
+	// old: +3, recent: +2, newest: -1
+	for _, u := range users {
+		assert.NoError(t, userLikeRepo.Create(&entities.UserLike{UserID: u.ID, PostID: &old.ID, Liked: true}))
+	}
+	for _, u := range users[:2] {
+		assert.NoError(t, userLikeRepo.Create(&entities.UserLike{UserID: u.ID, PostID: &recent.ID, Liked: true}))
+	}
+	assert.NoError(t, userLikeRepo.Create(&entities.UserLike{UserID: users[2].ID, PostID: &newest.ID, Liked: false}))
+
+	testCases := []struct {
+		sort     string
+		expected []uint
+	}{
+		{sort: repositories.PostSortNew, expected: []uint{newest.ID, recent.ID, old.ID}},
+		{sort: repositories.PostSortTop, expected: []uint{old.ID, recent.ID, newest.ID}},
+		{sort: repositories.PostSortHot, expected: []uint{recent.ID, old.ID, newest.ID}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.sort, func(t *testing.T) {
+			posts, err := postRepo.FindByGenreCommunityID(repositories.Viewer{}, community.ID, tc.sort, 0, 10)
+			assert.NoError(t, err)
+			ids := make([]uint, len(posts))
+			for i, p := range posts {
+				ids[i] = p.ID
+			}
+			assert.Equal(t, tc.expected, ids)
+			assert.NotEmpty(t, posts[0].User.Nickname)
+		})
+	}
+
+	t.Run("SearchEscapesWildcards", func(t *testing.T) {
+		posts, err := postRepo.SearchByTitle(repositories.Viewer{}, "100%", &community.ID, 0, 10)
+		assert.NoError(t, err)
+		assert.Len(t, posts, 1)
+
+		count, err := postRepo.CountByTitle(repositories.Viewer{}, "w_p", nil)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), count)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		assert.NoError(t, postRepo.Delete(old.ID))
+		_, err := postRepo.FindByID(old.ID)
+		assert.ErrorIs(t, err, repositories.ErrNotFound)
+	})
+
+	t.Cleanup(func() {
+		testdb.GetDB().Where("1 = 1").Delete(&entities.UserLike{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.Post{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.GenreCommunity{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.Genre{})
+		testdb.GetDB().Unscoped().Where("1 = 1").Delete(&entities.User{})
+	})
+}
+
+func TestCommentRepository_Threads(t *testing.T) {
+	users := createTestUsers(t, 2)
+	genre := &entities.Genre{Name: "Jazz"}
+	assert.NoError(t, genreRepo.Create(genre))
+	community := &entities.GenreCommunity{GenreID: genre.ID, Name: "Jazz Club"}
+	assert.NoError(t, genreCommunityRepo.Create(community))
+	post := &entities.Post{UserID: users[0].ID, GenreCommunityID: community.ID, Title: "Kind of Blue"}
+	assert.NoError(t, postRepo.Create(post))
+
+	first := &entities.Comment{UserID: users[0].ID, PostID: post.ID, Content: "first"}
+	second := &entities.Comment{UserID: users[0].ID, PostID: post.ID, Content: "second"}
+	assert.NoError(t, commentRepo.Create(first))
+	assert.NoError(t, commentRepo.Create(second))
+	reply := &entities.Comment{UserID: users[0].ID, PostID: post.ID, ParentID: &first.ID, Content: "reply"}
+	assert.NoError(t, commentRepo.Create(reply))
+	nested := &entities.Comment{UserID: users[0].ID, PostID: post.ID, ParentID: &reply.ID, Content: "nested"}
+	assert.NoError(t, commentRepo.Create(nested))
+
+	assert.Equal(t, 2, nested.Depth)
+	assert.Equal(t, reply.Path, nested.Path[:len(reply.Path)])
+
+	roots, err := commentRepo.FindRootsByPostID(repositories.Viewer{}, post.ID, 0, 10)
+	assert.NoError(t, err)
+	assert.Len(t, roots, 2)
+	assert.Equal(t, first.ID, roots[0].ID)
+	assert.Equal(t, int64(1), roots[0].ReplyCount)
+
+	count, err := commentRepo.CountRootsByPostID(post.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	descendants, err := commentRepo.FindDescendants(repositories.Viewer{}, post.ID, []string{first.Path})
+	assert.NoError(t, err)
+	assert.Len(t, descendants, 2)
+	assert.Equal(t, reply.ID, descendants[0].ID)
+	assert.Equal(t, nested.ID, descendants[1].ID)
+
+	t.Run("BlockedAuthor", func(t *testing.T) {
+		assert.NoError(t, userBlockRepo.Create(&entities.UserBlock{BlockerID: users[1].ID, BlockedID: users[0].ID}))
+		viewer := repositories.Viewer{ID: users[1].ID}
+
+		// Comments of blocked users are kept in place and marked.
+		roots, err := commentRepo.FindRootsByPostID(viewer, post.ID, 0, 10)
+		assert.NoError(t, err)
+		assert.Len(t, roots, 2)
+		assert.True(t, roots[0].Blocked)
+
+		descendants, err := commentRepo.FindDescendants(viewer, post.ID, []string{first.Path})
+		assert.NoError(t, err)
+		assert.Len(t, descendants, 2)
+		assert.True(t, descendants[1].Blocked)
+
+		roots, err = commentRepo.FindRootsByPostID(repositories.Viewer{}, post.ID, 0, 10)
+		assert.NoError(t, err)
+		assert.False(t, roots[0].Blocked)
+	})
+
+	t.Run("DeleteDecrementsReplyCount", func(t *testing.T) {
+		assert.NoError(t, commentRepo.Delete(nested.ID))
+		found, err := commentRepo.FindByID(reply.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), found.ReplyCount)
+	})
+
+	t.Cleanup(func() {
+		testdb.GetDB().Where("parent_id IS NOT NULL").Delete(&entities.Comment{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.Comment{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.Post{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.GenreCommunity{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.Genre{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.UserBlock{})
+		testdb.GetDB().Unscoped().Where("1 = 1").Delete(&entities.User{})
+	})
+}
+
+func TestMusicAttachmentRepository_ReplaceByPostID(t *testing.T) {
+	users := createTestUsers(t, 1)
+	genre := &entities.Genre{Name: "Rock"}
+	assert.NoError(t, genreRepo.Create(genre))
+	community := &entities.GenreCommunity{GenreID: genre.ID, Name: "Rock Club"}
+	assert.NoError(t, genreCommunityRepo.Create(community))
+	post := &entities.Post{UserID: users[0].ID, GenreCommunityID: community.ID, Title: "Magnolia"}
+	assert.NoError(t, postRepo.Create(post))
+
+	artist := &entities.Artist{Name: "Aimee Mann"}
+	assert.NoError(t, artistRepo.Create(artist))
+	album := &entities.Album{Name: "Magnolia", ArtistID: artist.ID, ImageURL: "https://example.com/cover.jpg"}
+	assert.NoError(t, albumRepo.Create(album))
+	wiseUp := &entities.Music{Title: "Wise Up", AlbumID: album.ID}
+	saveMe := &entities.Music{Title: "Save Me", AlbumID: album.ID}
+	assert.NoError(t, musicRepo.Create(wiseUp))
+	assert.NoError(t, musicRepo.Create(saveMe))
+	assert.NoError(t, musicArtistRepo.Create(&entities.MusicArtistMapping{MusicID: wiseUp.ID, ArtistID: artist.ID}))
+
+	assert.NoError(t, musicAttachmentRepo.ReplaceByPostID(post.ID, []uint{wiseUp.ID, saveMe.ID}))
+	assert.NoError(t, musicAttachmentRepo.ReplaceByPostID(post.ID, []uint{saveMe.ID, wiseUp.ID}))
+
+	attachments, err := musicAttachmentRepo.FindByPostIDs([]uint{post.ID})
+	assert.NoError(t, err)
+	assert.Len(t, attachments, 2)
+	assert.Equal(t, saveMe.ID, attachments[0].MusicID)
+	assert.Equal(t, "Magnolia", attachments[1].Music.Album.Name)
+	assert.Equal(t, "Aimee Mann", attachments[1].Music.MusicArtistMapping[0].Artist.Name)
+
+	t.Run("DeletedWithPost", func(t *testing.T) {
+		assert.NoError(t, postRepo.Delete(post.ID))
+		attachments, err := musicAttachmentRepo.FindByPostIDs([]uint{post.ID})
+		assert.NoError(t, err)
+		assert.Empty(t, attachments)
+	})
+
+	t.Cleanup(func() {
+		testdb.GetDB().Where("1 = 1").Delete(&entities.MusicAttachment{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.MusicArtistMapping{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.Music{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.Album{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.Artist{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.Post{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.GenreCommunity{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.Genre{})
+		testdb.GetDB().Unscoped().Where("1 = 1").Delete(&entities.User{})
+	})
+}
+
+func TestLinkPreviewRepository_ReplaceByPostID(t *testing.T) {
+	users := createTestUsers(t, 1)
+	genre := &entities.Genre{Name: "Folk"}
+	assert.NoError(t, genreRepo.Create(genre))
+	community := &entities.GenreCommunity{GenreID: genre.ID, Name: "Folk Club"}
+	assert.NoError(t, genreCommunityRepo.Create(community))
+	post := &entities.Post{UserID: users[0].ID, GenreCommunityID: community.ID, Title: "Live sets", Content: "**hi**", ContentHTML: "<p><strong>hi</strong></p>"}
+	assert.NoError(t, postRepo.Create(post))
+
+	assert.NoError(t, linkPreviewRepo.ReplaceByPostID(post.ID, []*entities.LinkPreview{{URL: "https://youtu.be/old", Title: "Old"}}))
+	assert.NoError(t, linkPreviewRepo.ReplaceByPostID(post.ID, []*entities.LinkPreview{
+		{URL: "https://youtu.be/a", Title: "A"},
+		{URL: "https://youtu.be/b", Title: "B"},
+	}))
+
+	previews, err := linkPreviewRepo.FindByPostIDs([]uint{post.ID})
+	assert.NoError(t, err)
+	assert.Len(t, previews, 2)
+	assert.Equal(t, "A", previews[0].Title)
+	assert.Equal(t, 1, previews[1].Position)
+
+	found, err := postRepo.FindByID(post.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "<p><strong>hi</strong></p>", found.ContentHTML)
+
+	t.Cleanup(func() {
+		testdb.GetDB().Where("1 = 1").Delete(&entities.LinkPreview{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.Post{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.GenreCommunity{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.Genre{})
+		testdb.GetDB().Unscoped().Where("1 = 1").Delete(&entities.User{})
+	})
+}
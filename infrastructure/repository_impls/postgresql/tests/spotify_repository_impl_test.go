@@ -0,0 +1,119 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserSocialAccountRepository_FindByProvider(t *testing.T) {
+	users := createTestUsers(t, 2)
+
+	account := &entities.UserSocialAccount{
+		UserID:         users[0].ID,
+		Provider:       entities.SocialProviderSpotify,
+		ProviderUserID: "wizzler",
+		AccessToken:    "encrypted-access",
+		RefreshToken:   "encrypted-refresh",
+	}
+	assert.NoError(t, socialAccountRepo.Create(account))
+
+	found, err := socialAccountRepo.FindByUserIDAndProvider(users[0].ID, entities.SocialProviderSpotify)
+	assert.NoError(t, err)
+	assert.Equal(t, "wizzler", found.ProviderUserID)
+	assert.Equal(t, "encrypted-refresh", found.RefreshToken)
+
+	found, err = socialAccountRepo.FindByProviderAndProviderUserID(entities.SocialProviderSpotify, "wizzler")
+	assert.NoError(t, err)
+	assert.Equal(t, users[0].ID, found.UserID)
+
+	_, err = socialAccountRepo.FindByUserIDAndProvider(users[1].ID, entities.SocialProviderSpotify)
+	assert.Equal(t, repositories.ErrNotFound, err)
+
+	duplicate := &entities.UserSocialAccount{UserID: users[1].ID, Provider: entities.SocialProviderSpotify, ProviderUserID: "wizzler"}
+	assert.Equal(t, repositories.ErrCreate, socialAccountRepo.Create(duplicate))
+
+	// Unlinking frees the Spotify account for another user.
+	assert.NoError(t, socialAccountRepo.Delete(account.ID))
+	_, err = socialAccountRepo.FindByProviderAndProviderUserID(entities.SocialProviderSpotify, "wizzler")
+	assert.Equal(t, repositories.ErrNotFound, err)
+	assert.NoError(t, socialAccountRepo.Create(duplicate))
+
+	t.Cleanup(func() {
+		testdb.GetDB().Unscoped().Where("1 = 1").Delete(&entities.UserSocialAccount{})
+		testdb.GetDB().Unscoped().Where("1 = 1").Delete(&entities.User{})
+	})
+}
+
+func TestMusicCollectionRepository_FindByUserIDAndSpotifyPlaylistID(t *testing.T) {
+	music := createTestMusic(t, "One", "2up3OPMp9Tb4dAKM2erWXQ")
+	users := createTestUsers(t, 1)
+
+	collection := &entities.MusicCollection{UserID: users[0].ID, Name: "Road Trip", SpotifyPlaylistID: "37i9dQZF1DXcBWIGoYBM5M"}
+	assert.NoError(t, collectionRepo.Create(collection))
+	assert.NoError(t, collectionMusicRepo.Create(&entities.CollectionMusicMapping{CollectionID: collection.ID, MusicID: music.ID}))
+
+	found, err := collectionRepo.FindByUserIDAndSpotifyPlaylistID(users[0].ID, "37i9dQZF1DXcBWIGoYBM5M")
+	assert.NoError(t, err)
+	assert.Equal(t, collection.ID, found.ID)
+
+	duplicate := &entities.MusicCollection{UserID: users[0].ID, Name: "Road Trip", SpotifyPlaylistID: "37i9dQZF1DXcBWIGoYBM5M"}
+	assert.Equal(t, repositories.ErrCreate, collectionRepo.Create(duplicate))
+
+	assert.NoError(t, collectionRepo.Delete(collection.ID))
+	_, err = collectionRepo.FindByUserIDAndSpotifyPlaylistID(users[0].ID, "37i9dQZF1DXcBWIGoYBM5M")
+	assert.Equal(t, repositories.ErrNotFound, err)
+
+	mappings, err := collectionMusicRepo.FindByCollectionID(collection.ID)
+	assert.NoError(t, err)
+	assert.Empty(t, mappings)
+
+	t.Cleanup(func() {
+		testdb.GetDB().Where("1 = 1").Delete(&entities.CollectionMusicMapping{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.MusicCollection{})
+		cleanupTestMusic()
+		testdb.GetDB().Unscoped().Where("1 = 1").Delete(&entities.User{})
+	})
+}
+
+func TestCollectionSpotifySyncRepository_Save(t *testing.T) {
+	users := createTestUsers(t, 1)
+
+	collection := &entities.MusicCollection{UserID: users[0].ID, Name: "Road Trip", SpotifyPlaylistID: "3cEYpjA9oz9GiPac4AsH4n"}
+	assert.NoError(t, collectionRepo.Create(collection))
+
+	sync := &entities.CollectionSpotifySync{
+		CollectionID: collection.ID,
+		Status:       entities.SyncStatusSynced,
+		Tracks:       []entities.CollectionSpotifySyncTrack{{SpotifyTrackID: "a"}, {SpotifyTrackID: "b"}},
+	}
+	assert.NoError(t, syncRepo.Save(sync))
+
+	// Saving again replaces the recorded tracks.
+	sync.Tracks = []entities.CollectionSpotifySyncTrack{{SpotifyTrackID: "c"}}
+	assert.NoError(t, syncRepo.Save(sync))
+
+	found, err := syncRepo.FindByCollectionID(collection.ID)
+	assert.NoError(t, err)
+	assert.Len(t, found.Tracks, 1)
+	assert.Equal(t, "c", found.Tracks[0].SpotifyTrackID)
+
+	syncs, err := syncRepo.FindReconcilable(0, 10)
+	assert.NoError(t, err)
+	assert.Len(t, syncs, 1)
+
+	found.Status = entities.SyncStatusRemoteDeleted
+	found.Tracks = nil
+	assert.NoError(t, syncRepo.Save(found))
+
+	syncs, err = syncRepo.FindReconcilable(0, 10)
+	assert.NoError(t, err)
+	assert.Empty(t, syncs)
+
+	t.Cleanup(func() {
+		testdb.GetDB().Where("1 = 1").Delete(&entities.MusicCollection{})
+		testdb.GetDB().Unscoped().Where("1 = 1").Delete(&entities.User{})
+	})
+}
@@ -0,0 +1,177 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserTopsterRepository_CreateAndFindByID(t *testing.T) {
+	users := createTestUsers(t, 1)
+	one := createTestMusic(t, "One", "")
+	two := createTestMusic(t, "Two", "")
+
+	topster := &entities.UserTopster{
+		UserID:   users[0].ID,
+		Title:    "Favorites",
+		Template: entities.TopsterTemplateGrid,
+		Rows:     3,
+		Cols:     3,
+		IsPublic: true,
+		TopsterAlbums: []entities.TopsterAlbum{
+			{AlbumID: two.AlbumID, Position: entities.TopsterPosition{Row: 1, Col: 0}},
+			{AlbumID: one.AlbumID, Position: entities.TopsterPosition{Row: 0, Col: 2}},
+		},
+	}
+	assert.NoError(t, topsterRepo.Create(topster))
+
+	found, err := topsterRepo.FindByID(topster.ID)
+	assert.NoError(t, err)
+	assert.Len(t, found.TopsterAlbums, 2)
+	assert.Equal(t, entities.TopsterPosition{Row: 0, Col: 2}, found.TopsterAlbums[0].Position)
+	assert.Equal(t, "One album", found.TopsterAlbums[0].Album.Name)
+	assert.Equal(t, "One artist", found.TopsterAlbums[0].Album.Artist.Name)
+
+	_, err = topsterRepo.FindByID(topster.ID + 1)
+	assert.ErrorIs(t, err, repositories.ErrNotFound)
+
+	t.Cleanup(func() {
+		testdb.GetDB().Where("1 = 1").Delete(&entities.TopsterAlbum{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.UserTopster{})
+		testdb.GetDB().Unscoped().Where("1 = 1").Delete(&entities.User{})
+		cleanupTestMusic()
+	})
+}
+
+func TestUserTopsterRepository_FindByUserID(t *testing.T) {
+	users := createTestUsers(t, 1)
+
+	assert.NoError(t, topsterRepo.Create(&entities.UserTopster{UserID: users[0].ID, Title: "Public", Template: entities.TopsterTemplateGrid, Rows: 3, Cols: 3, IsPublic: true}))
+	assert.NoError(t, topsterRepo.Create(&entities.UserTopster{UserID: users[0].ID, Title: "Private", Template: entities.TopsterTemplateGrid, Rows: 3, Cols: 3}))
+
+	testCases := []struct {
+		name           string
+		includePrivate bool
+		expectedCount  int
+	}{
+		{name: "Owner", includePrivate: true, expectedCount: 2},
+		{name: "PublicOnly", includePrivate: false, expectedCount: 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			topsters, err := topsterRepo.FindByUserID(users[0].ID, tc.includePrivate, 0, 10)
+			assert.NoError(t, err)
+			assert.Len(t, topsters, tc.expectedCount)
+
+			count, err := topsterRepo.CountByUserID(users[0].ID, tc.includePrivate)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(tc.expectedCount), count)
+		})
+	}
+
+	t.Cleanup(func() {
+		testdb.GetDB().Where("1 = 1").Delete(&entities.UserTopster{})
+		testdb.GetDB().Unscoped().Where("1 = 1").Delete(&entities.User{})
+	})
+}
+
+func TestTopsterAlbumRepository_ReplaceByTopsterID(t *testing.T) {
+	users := createTestUsers(t, 1)
+	one := createTestMusic(t, "One", "")
+	two := createTestMusic(t, "Two", "")
+
+	topster := &entities.UserTopster{
+		UserID:        users[0].ID,
+		Title:         "Favorites",
+		Template:      entities.TopsterTemplateGrid,
+		Rows:          3,
+		Cols:          3,
+		TopsterAlbums: []entities.TopsterAlbum{{AlbumID: one.AlbumID, Position: entities.TopsterPosition{Row: 0, Col: 0}}},
+	}
+	assert.NoError(t, topsterRepo.Create(topster))
+
+	t.Run("SwapsCells", func(t *testing.T) {
+		// The new layout reuses the cell of the removed album, which would
+		// violate the unique position index if rows were updated one by one.
+		err := topsterAlbumRepo.ReplaceByTopsterID(topster.ID, []*entities.TopsterAlbum{
+			{AlbumID: two.AlbumID, Position: entities.TopsterPosition{Row: 0, Col: 0}},
+			{AlbumID: one.AlbumID, Position: entities.TopsterPosition{Row: 2, Col: 2}},
+		})
+		assert.NoError(t, err)
+
+		found, err := topsterAlbumRepo.FindByTopsterID(topster.ID)
+		assert.NoError(t, err)
+		assert.Len(t, found, 2)
+		assert.Equal(t, two.AlbumID, found[0].AlbumID)
+		assert.Equal(t, entities.TopsterPosition{Row: 2, Col: 2}, found[1].Position)
+	})
+
+	t.Run("OverlapRollsBack", func(t *testing.T) {
+		err := topsterAlbumRepo.ReplaceByTopsterID(topster.ID, []*entities.TopsterAlbum{
+			{AlbumID: one.AlbumID, Position: entities.TopsterPosition{Row: 1, Col: 1}},
+			{AlbumID: two.AlbumID, Position: entities.TopsterPosition{Row: 1, Col: 1}},
+		})
+		assert.ErrorIs(t, err, repositories.ErrUpdate)
+
+		found, err := topsterAlbumRepo.FindByTopsterID(topster.ID)
+		assert.NoError(t, err)
+		assert.Len(t, found, 2)
+	})
+
+	t.Run("TopsterNotFound", func(t *testing.T) {
+		err := topsterAlbumRepo.ReplaceByTopsterID(topster.ID+1, nil)
+		assert.ErrorIs(t, err, repositories.ErrNotFound)
+	})
+
+	t.Cleanup(func() {
+		testdb.GetDB().Where("1 = 1").Delete(&entities.TopsterAlbum{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.UserTopster{})
+		testdb.GetDB().Unscoped().Where("1 = 1").Delete(&entities.User{})
+		cleanupTestMusic()
+	})
+}
+
+func TestAlbumRepository_FindMostLikedByUserID(t *testing.T) {
+	users := createTestUsers(t, 2)
+	one := createTestMusic(t, "One", "")
+	two := createTestMusic(t, "Two", "")
+	bonus := &entities.Music{Title: "Two (Bonus)", AlbumID: two.AlbumID}
+	assert.NoError(t, musicRepo.Create(bonus))
+
+	assert.NoError(t, userLikeRepo.Create(&entities.UserLike{UserID: users[0].ID, MusicID: &one.ID, Liked: true}))
+	assert.NoError(t, userLikeRepo.Create(&entities.UserLike{UserID: users[0].ID, MusicID: &two.ID, Liked: true}))
+	assert.NoError(t, userLikeRepo.Create(&entities.UserLike{UserID: users[0].ID, MusicID: &bonus.ID, Liked: true}))
+	assert.NoError(t, userLikeRepo.Create(&entities.UserLike{UserID: users[1].ID, MusicID: &one.ID, Liked: true}))
+	assert.NoError(t, userLikeRepo.Create(&entities.UserLike{UserID: users[1].ID, MusicID: &two.ID, Liked: false}))
+
+	testCases := []struct {
+		name     string
+		userID   uint
+		expected []uint
+	}{
+		{name: "MostLikedTracksFirst", userID: users[0].ID, expected: []uint{two.AlbumID, one.AlbumID}},
+		{name: "DislikesIgnored", userID: users[1].ID, expected: []uint{one.AlbumID}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			albums, err := albumRepo.FindMostLikedByUserID(tc.userID, 10)
+			assert.NoError(t, err)
+
+			ids := make([]uint, len(albums))
+			for i, a := range albums {
+				ids[i] = a.ID
+			}
+			assert.Equal(t, tc.expected, ids)
+		})
+	}
+
+	t.Cleanup(func() {
+		testdb.GetDB().Where("1 = 1").Delete(&entities.UserLike{})
+		testdb.GetDB().Unscoped().Where("1 = 1").Delete(&entities.User{})
+		cleanupTestMusic()
+	})
+}
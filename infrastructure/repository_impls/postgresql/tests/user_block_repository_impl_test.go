@@ -0,0 +1,91 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserBlockRepository(t *testing.T) {
+	users := createTestUsers(t, 3)
+	genre := &entities.Genre{Name: "Ambient"}
+	assert.NoError(t, genreRepo.Create(genre))
+	community := &entities.GenreCommunity{GenreID: genre.ID, Name: "Ambient Club"}
+	assert.NoError(t, genreCommunityRepo.Create(community))
+	for _, u := range users {
+		assert.NoError(t, postRepo.Create(&entities.Post{UserID: u.ID, GenreCommunityID: community.ID, Title: "post"}))
+	}
+	assert.NoError(t, userFollowRepo.Create(&entities.UserFollow{FollowerID: users[0].ID, FollowingID: users[1].ID}))
+	assert.NoError(t, userFollowRepo.Create(&entities.UserFollow{FollowerID: users[1].ID, FollowingID: users[0].ID}))
+
+	t.Run("BlockRemovesFollows", func(t *testing.T) {
+		assert.NoError(t, userBlockRepo.Create(&entities.UserBlock{BlockerID: users[0].ID, BlockedID: users[1].ID}))
+
+		_, err := userFollowRepo.FindByFollowerIDAndFollowingID(users[0].ID, users[1].ID)
+		assert.ErrorIs(t, err, repositories.ErrNotFound)
+		_, err = userFollowRepo.FindByFollowerIDAndFollowingID(users[1].ID, users[0].ID)
+		assert.ErrorIs(t, err, repositories.ErrNotFound)
+
+		exists, err := userBlockRepo.ExistsBetween(users[1].ID, users[0].ID)
+		assert.NoError(t, err)
+		assert.True(t, exists)
+	})
+
+	t.Run("BlockHidesBothWays", func(t *testing.T) {
+		for _, viewerID := range []uint{users[0].ID, users[1].ID} {
+			count, err := postRepo.CountByGenreCommunityID(repositories.Viewer{ID: viewerID}, community.ID)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(2), count)
+		}
+
+		count, err := postRepo.CountByGenreCommunityID(repositories.Viewer{}, community.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), count)
+	})
+
+	t.Run("MuteHidesOnlyWhenAsked", func(t *testing.T) {
+		assert.NoError(t, userMuteRepo.Create(&entities.UserMute{MuterID: users[0].ID, MutedID: users[2].ID}))
+
+		count, err := postRepo.CountAll(repositories.Viewer{ID: users[0].ID})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+
+		count, err = postRepo.CountAll(repositories.Viewer{ID: users[0].ID, HideMuted: true})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+	})
+
+	t.Run("List", func(t *testing.T) {
+		blocks, err := userBlockRepo.FindByBlockerID(users[0].ID, 0, 10)
+		assert.NoError(t, err)
+		assert.Len(t, blocks, 1)
+		assert.Equal(t, users[1].ID, blocks[0].Blocked.ID)
+
+		mutes, err := userMuteRepo.FindByMuterID(users[0].ID, 0, 10)
+		assert.NoError(t, err)
+		assert.Len(t, mutes, 1)
+		assert.Equal(t, users[2].ID, mutes[0].Muted.ID)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		assert.NoError(t, userBlockRepo.DeleteByBlockerIDAndBlockedID(users[0].ID, users[1].ID))
+		err := userBlockRepo.DeleteByBlockerIDAndBlockedID(users[0].ID, users[1].ID)
+		assert.ErrorIs(t, err, repositories.ErrNotFound)
+
+		assert.NoError(t, userMuteRepo.DeleteByMuterIDAndMutedID(users[0].ID, users[2].ID))
+		err = userMuteRepo.DeleteByMuterIDAndMutedID(users[0].ID, users[2].ID)
+		assert.ErrorIs(t, err, repositories.ErrNotFound)
+	})
+
+	t.Cleanup(func() {
+		testdb.GetDB().Where("1 = 1").Delete(&entities.UserBlock{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.UserMute{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.UserFollow{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.Post{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.GenreCommunity{})
+		testdb.GetDB().Where("1 = 1").Delete(&entities.Genre{})
+		testdb.GetDB().Unscoped().Where("1 = 1").Delete(&entities.User{})
+	})
+}
@@ -0,0 +1,65 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserFollowRepository(t *testing.T) {
+	users := createTestUsers(t, 3)
+	follows := []*entities.UserFollow{
+		{FollowerID: users[0].ID, FollowingID: users[2].ID},
+		{FollowerID: users[1].ID, FollowingID: users[2].ID},
+		{FollowerID: users[2].ID, FollowingID: users[0].ID},
+	}
+	for _, f := range follows {
+		assert.NoError(t, userFollowRepo.Create(f))
+	}
+
+	t.Run("Duplicate", func(t *testing.T) {
+		err := userFollowRepo.Create(&entities.UserFollow{FollowerID: users[0].ID, FollowingID: users[2].ID})
+		assert.ErrorIs(t, err, repositories.ErrCreate)
+	})
+
+	t.Run("Followers", func(t *testing.T) {
+		followers, err := userFollowRepo.FindFollowersByUserID(repositories.Viewer{}, users[2].ID, 0, 10)
+		assert.NoError(t, err)
+		assert.Len(t, followers, 2)
+		assert.Equal(t, users[1].ID, followers[0].Follower.ID)
+
+		count, err := userFollowRepo.CountFollowersByUserID(repositories.Viewer{}, users[2].ID)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+	})
+
+	t.Run("Followings", func(t *testing.T) {
+		followings, err := userFollowRepo.FindFollowingsByUserID(repositories.Viewer{}, users[0].ID, 0, 10)
+		assert.NoError(t, err)
+		assert.Len(t, followings, 1)
+		assert.Equal(t, users[2].ID, followings[0].Following.ID)
+	})
+
+	t.Run("FindIDs", func(t *testing.T) {
+		ids, err := userFollowRepo.FindFollowingIDs(users[0].ID, []uint{users[1].ID, users[2].ID})
+		assert.NoError(t, err)
+		assert.Equal(t, []uint{users[2].ID}, ids)
+
+		ids, err = userFollowRepo.FindFollowerIDs(users[0].ID, []uint{users[1].ID, users[2].ID})
+		assert.NoError(t, err)
+		assert.Equal(t, []uint{users[2].ID}, ids)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		assert.NoError(t, userFollowRepo.DeleteByFollowerIDAndFollowingID(users[1].ID, users[2].ID))
+		err := userFollowRepo.DeleteByFollowerIDAndFollowingID(users[1].ID, users[2].ID)
+		assert.ErrorIs(t, err, repositories.ErrNotFound)
+	})
+
+	t.Cleanup(func() {
+		testdb.GetDB().Where("1 = 1").Delete(&entities.UserFollow{})
+		testdb.GetDB().Unscoped().Where("1 = 1").Delete(&entities.User{})
+	})
+}
@@ -0,0 +1,73 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserLikeRepository_Create(t *testing.T) {
+	music := createTestMusic(t, "One", "2up3OPMp9Tb4dAKM2erWXQ")
+	users := createTestUsers(t, 1)
+
+	testCases := []struct {
+		name        string
+		userLike    *entities.UserLike
+		expectedErr error
+	}{
+		{
+			name:        "Success",
+			userLike:    &entities.UserLike{UserID: users[0].ID, MusicID: &music.ID, Liked: true},
+			expectedErr: nil,
+		},
+		{
+			name:        "DuplicateTarget",
+			userLike:    &entities.UserLike{UserID: users[0].ID, MusicID: &music.ID, Liked: false},
+			expectedErr: repositories.ErrCreate,
+		},
+		{
+			name:        "NoTarget",
+			userLike:    &entities.UserLike{UserID: users[0].ID, Liked: true},
+			expectedErr: repositories.ErrCreate,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := userLikeRepo.Create(tc.userLike)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+
+	t.Cleanup(func() {
+		testdb.GetDB().Where("1 = 1").Delete(&entities.UserLike{})
+		cleanupTestMusic()
+		testdb.GetDB().Unscoped().Where("1 = 1").Delete(&entities.User{})
+	})
+}
+
+func TestMusicRepository_FindLikedByUserID(t *testing.T) {
+	one := createTestMusic(t, "One", "2up3OPMp9Tb4dAKM2erWXQ")
+	wiseUp := createTestMusic(t, "Wise Up", "1wCSmrNrLdR4T8bnmHjA0N")
+	users := createTestUsers(t, 1)
+
+	assert.NoError(t, userLikeRepo.Create(&entities.UserLike{UserID: users[0].ID, MusicID: &one.ID, Liked: true}))
+	assert.NoError(t, userLikeRepo.Create(&entities.UserLike{UserID: users[0].ID, MusicID: &wiseUp.ID, Liked: false}))
+
+	music, err := musicRepo.FindLikedByUserID(users[0].ID, 0, 10)
+	assert.NoError(t, err)
+	assert.Len(t, music, 1)
+	assert.Equal(t, one.ID, music[0].ID)
+
+	count, err := musicRepo.CountLikedByUserID(users[0].ID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	t.Cleanup(func() {
+		testdb.GetDB().Where("1 = 1").Delete(&entities.UserLike{})
+		cleanupTestMusic()
+		testdb.GetDB().Unscoped().Where("1 = 1").Delete(&entities.User{})
+	})
+}
@@ -0,0 +1,96 @@
+package postgresql
+
+import (
+	"errors"
+	"time"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type TopsterAlbumRepository struct {
+	db *gorm.DB
+}
+
+func NewTopsterAlbumRepository(db *gorm.DB) repositories.TopsterAlbumRepository {
+	return &TopsterAlbumRepository{db: db}
+}
+
+func (r *TopsterAlbumRepository) Create(topsterAlbum *entities.TopsterAlbum) error {
+	if err := r.db.Omit("Album").Create(topsterAlbum).Error; err != nil {
+		return repositories.ErrCreate
+	}
+	return nil
+}
+
+func (r *TopsterAlbumRepository) FindByID(id uint) (*entities.TopsterAlbum, error) {
+	topsterAlbum := new(entities.TopsterAlbum)
+	err := r.db.First(&topsterAlbum, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, repositories.ErrFind
+	}
+	return topsterAlbum, nil
+}
+
+func (r *TopsterAlbumRepository) FindByTopsterID(topsterID uint) ([]*entities.TopsterAlbum, error) {
+	var topsterAlbums []*entities.TopsterAlbum
+	err := r.db.Where("topster_id = ?", topsterID).
+		Order("(position->>'row')::int, (position->>'col')::int").
+		Find(&topsterAlbums).Error
+	if err != nil {
+		return nil, repositories.ErrFind
+	}
+	return topsterAlbums, nil
+}
+
+// ReplaceByTopsterID replaces the whole layout of the topster in a single
+// transaction, so readers never see a partially applied layout.
+func (r *TopsterAlbumRepository) ReplaceByTopsterID(topsterID uint, topsterAlbums []*entities.TopsterAlbum) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id").
+			Take(&entities.UserTopster{}, topsterID).Error
+		if err != nil {
+			return err
+		}
+		if err := tx.Where("topster_id = ?", topsterID).Delete(&entities.TopsterAlbum{}).Error; err != nil {
+			return err
+		}
+		if len(topsterAlbums) > 0 {
+			for _, a := range topsterAlbums {
+				a.ID = 0
+				a.TopsterID = topsterID
+			}
+			if err := tx.Omit("Album").Create(topsterAlbums).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Model(&entities.UserTopster{}).Where("id = ?", topsterID).Update("updated_at", time.Now()).Error
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return repositories.ErrNotFound
+		}
+		return repositories.ErrUpdate
+	}
+	return nil
+}
+
+func (r *TopsterAlbumRepository) Update(topsterAlbum *entities.TopsterAlbum) error {
+	if err := r.db.Omit("Album").Save(topsterAlbum).Error; err != nil {
+		return repositories.ErrUpdate
+	}
+	return nil
+}
+
+func (r *TopsterAlbumRepository) Delete(id uint) error {
+	if err := r.db.Delete(&entities.TopsterAlbum{}, id).Error; err != nil {
+		return repositories.ErrDelete
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package postgresql
+
+import (
+	"errors"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"gorm.io/gorm"
+)
+
+type UserBlockRepository struct {
+	db *gorm.DB
+}
+
+func NewUserBlockRepository(db *gorm.DB) repositories.UserBlockRepository {
+	return &UserBlockRepository{db: db}
+}
+
+func (r *UserBlockRepository) Create(block *entities.UserBlock) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Blocked").Create(block).Error; err != nil {
+			return err
+		}
+		return tx.Where("(follower_id = ? AND following_id = ?) OR (follower_id = ? AND following_id = ?)",
+			block.BlockerID, block.BlockedID, block.BlockedID, block.BlockerID).
+			Delete(&entities.UserFollow{}).Error
+	})
+	if err != nil {
+		return repositories.ErrCreate
+	}
+	return nil
+}
+
+func (r *UserBlockRepository) FindByBlockerIDAndBlockedID(blockerID, blockedID uint) (*entities.UserBlock, error) {
+	block := new(entities.UserBlock)
+	err := r.db.Where("blocker_id = ? AND blocked_id = ?", blockerID, blockedID).First(&block).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, repositories.ErrFind
+	}
+	return block, nil
+}
+
+func (r *UserBlockRepository) ExistsBetween(userID, otherID uint) (bool, error) {
+	var count int64
+	err := r.db.Model(&entities.UserBlock{}).
+		Where("(blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?)", userID, otherID, otherID, userID).
+		Count(&count).Error
+	if err != nil {
+		return false, repositories.ErrFind
+	}
+	return count > 0, nil
+}
+
+func (r *UserBlockRepository) FindByBlockerID(blockerID uint, offset, limit int) ([]*entities.UserBlock, error) {
+	var blocks []*entities.UserBlock
+	err := r.db.Preload("Blocked.UserProfile").
+		Joins("JOIN users ON users.id = user_blocks.blocked_id AND users.deleted_at IS NULL").
+		Where("user_blocks.blocker_id = ?", blockerID).
+		Order("user_blocks.created_at DESC, user_blocks.id DESC").Offset(offset).Limit(limit).
+		Find(&blocks).Error
+	if err != nil {
+		return nil, repositories.ErrFind
+	}
+	return blocks, nil
+}
+
+func (r *UserBlockRepository) CountByBlockerID(blockerID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&entities.UserBlock{}).
+		Joins("JOIN users ON users.id = user_blocks.blocked_id AND users.deleted_at IS NULL").
+		Where("user_blocks.blocker_id = ?", blockerID).
+		Count(&count).Error
+	if err != nil {
+		return 0, repositories.ErrFind
+	}
+	return count, nil
+}
+
+func (r *UserBlockRepository) DeleteByBlockerIDAndBlockedID(blockerID, blockedID uint) error {
+	result := r.db.Where("blocker_id = ? AND blocked_id = ?", blockerID, blockedID).
+		Delete(&entities.UserBlock{})
+	if result.Error != nil {
+		return repositories.ErrDelete
+	}
+	if result.RowsAffected == 0 {
+		return repositories.ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package postgresql
+
+import (
+	"errors"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"gorm.io/gorm"
+)
+
+type UserFollowRepository struct {
+	db *gorm.DB
+}
+
+func NewUserFollowRepository(db *gorm.DB) repositories.UserFollowRepository {
+	return &UserFollowRepository{db: db}
+}
+
+func (r *UserFollowRepository) Create(userFollow *entities.UserFollow) error {
+	if err := r.db.Omit("Follower", "Following").Create(userFollow).Error; err != nil {
+		return repositories.ErrCreate
+	}
+	return nil
+}
+
+func (r *UserFollowRepository) FindByID(id uint) (*entities.UserFollow, error) {
+	userFollow := new(entities.UserFollow)
+	if err := r.db.First(&userFollow, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, repositories.ErrFind
+	}
+	return userFollow, nil
+}
+
+func (r *UserFollowRepository) FindByFollowerIDAndFollowingID(followerID, followingID uint) (*entities.UserFollow, error) {
+	userFollow := new(entities.UserFollow)
+	err := r.db.Where("follower_id = ? AND following_id = ?", followerID, followingID).First(&userFollow).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, repositories.ErrFind
+	}
+	return userFollow, nil
+}
+
+func (r *UserFollowRepository) FindFollowersByUserID(viewer repositories.Viewer, userID uint, offset, limit int) ([]*entities.UserFollow, error) {
+	var follows []*entities.UserFollow
+	err := r.db.Preload("Follower.UserProfile").Scopes(visibleTo(viewer, "user_follows.follower_id")).
+		Joins("JOIN users ON users.id = user_follows.follower_id AND users.deleted_at IS NULL").
+		Where("user_follows.following_id = ?", userID).
+		Order("user_follows.created_at DESC, user_follows.id DESC").Offset(offset).Limit(limit).
+		Find(&follows).Error
+	if err != nil {
+		return nil, repositories.ErrFind
+	}
+	return follows, nil
+}
+
+func (r *UserFollowRepository) FindFollowingsByUserID(viewer repositories.Viewer, userID uint, offset, limit int) ([]*entities.UserFollow, error) {
+	var follows []*entities.UserFollow
+	err := r.db.Preload("Following.UserProfile").Scopes(visibleTo(viewer, "user_follows.following_id")).
+		Joins("JOIN users ON users.id = user_follows.following_id AND users.deleted_at IS NULL").
+		Where("user_follows.follower_id = ?", userID).
+		Order("user_follows.created_at DESC, user_follows.id DESC").Offset(offset).Limit(limit).
+		Find(&follows).Error
+	if err != nil {
+		return nil, repositories.ErrFind
+	}
+	return follows, nil
+}
+
+func (r *UserFollowRepository) CountFollowersByUserID(viewer repositories.Viewer, userID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&entities.UserFollow{}).Scopes(visibleTo(viewer, "user_follows.follower_id")).
+		Joins("JOIN users ON users.id = user_follows.follower_id AND users.deleted_at IS NULL").
+		Where("user_follows.following_id = ?", userID).
+		Count(&count).Error
+	if err != nil {
+		return 0, repositories.ErrFind
+	}
+	return count, nil
+}
+
+func (r *UserFollowRepository) CountFollowingsByUserID(viewer repositories.Viewer, userID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&entities.UserFollow{}).Scopes(visibleTo(viewer, "user_follows.following_id")).
+		Joins("JOIN users ON users.id = user_follows.following_id AND users.deleted_at IS NULL").
+		Where("user_follows.follower_id = ?", userID).
+		Count(&count).Error
+	if err != nil {
+		return 0, repositories.ErrFind
+	}
+	return count, nil
+}
+
+func (r *UserFollowRepository) FindFollowingIDs(followerID uint, userIDs []uint) ([]uint, error) {
+	ids := []uint{}
+	if len(userIDs) == 0 {
+		return ids, nil
+	}
+	err := r.db.Model(&entities.UserFollow{}).
+		Where("follower_id = ? AND following_id IN ?", followerID, userIDs).
+		Pluck("following_id", &ids).Error
+	if err != nil {
+		return nil, repositories.ErrFind
+	}
+	return ids, nil
+}
+
+func (r *UserFollowRepository) FindFollowerIDs(followingID uint, userIDs []uint) ([]uint, error) {
+	ids := []uint{}
+	if len(userIDs) == 0 {
+		return ids, nil
+	}
+	err := r.db.Model(&entities.UserFollow{}).
+		Where("following_id = ? AND follower_id IN ?", followingID, userIDs).
+		Pluck("follower_id", &ids).Error
+	if err != nil {
+		return nil, repositories.ErrFind
+	}
+	return ids, nil
+}
+
+func (r *UserFollowRepository) Delete(id uint) error {
+	result := r.db.Delete(&entities.UserFollow{}, id)
+	if result.Error != nil {
+		return repositories.ErrDelete
+	}
+	if result.RowsAffected == 0 {
+		return repositories.ErrNotFound
+	}
+	return nil
+}
+
+func (r *UserFollowRepository) DeleteByFollowerIDAndFollowingID(followerID, followingID uint) error {
+	result := r.db.Where("follower_id = ? AND following_id = ?", followerID, followingID).
+		Delete(&entities.UserFollow{})
+	if result.Error != nil {
+		return repositories.ErrDelete
+	}
+	if result.RowsAffected == 0 {
+		return repositories.ErrNotFound
+	}
+	return nil
+}
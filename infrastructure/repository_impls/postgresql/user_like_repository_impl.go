@@ -0,0 +1,108 @@
+package postgresql
+
+import (
+	"errors"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"gorm.io/gorm"
+)
+
+type UserLikeRepository struct {
+	db *gorm.DB
+}
+
+func NewUserLikeRepository(db *gorm.DB) repositories.UserLikeRepository {
+	return &UserLikeRepository{db: db}
+}
+
+func (r *UserLikeRepository) Create(userLike *entities.UserLike) error {
+	if err := r.db.Create(userLike).Error; err != nil {
+		return repositories.ErrCreate
+	}
+	return nil
+}
+
+func (r *UserLikeRepository) FindByID(id uint) (*entities.UserLike, error) {
+	userLike := new(entities.UserLike)
+	err := r.db.First(&userLike, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, repositories.ErrFind
+	}
+	return userLike, nil
+}
+
+func (r *UserLikeRepository) FindByUserIDAndMusicID(userID, musicID uint) (*entities.UserLike, error) {
+	return r.findByUserIDAndTarget(userID, "music_id", musicID)
+}
+
+func (r *UserLikeRepository) FindByUserIDAndPostID(userID, postID uint) (*entities.UserLike, error) {
+	return r.findByUserIDAndTarget(userID, "post_id", postID)
+}
+
+func (r *UserLikeRepository) FindByUserIDAndCommentID(userID, commentID uint) (*entities.UserLike, error) {
+	return r.findByUserIDAndTarget(userID, "comment_id", commentID)
+}
+
+func (r *UserLikeRepository) CountLikesAndDislikesByMusicID(musicID uint) (likes int64, dislikes int64, err error) {
+	return r.countLikesAndDislikes(r.db.Where("music_id = ?", musicID))
+}
+
+func (r *UserLikeRepository) CountLikesAndDislikesByAlbumID(albumID uint) (likes int64, dislikes int64, err error) {
+	return r.countLikesAndDislikes(r.db.Where("music_id IN (?)",
+		r.db.Model(&entities.Music{}).Select("id").Where("album_id = ?", albumID)))
+}
+
+func (r *UserLikeRepository) CountLikesAndDislikesByArtistID(artistID uint) (likes int64, dislikes int64, err error) {
+	return r.countLikesAndDislikes(r.db.Where("music_id IN (?)",
+		r.db.Model(&entities.MusicArtistMapping{}).Select("music_id").Where("artist_id = ?", artistID)))
+}
+
+func (r *UserLikeRepository) CountLikesAndDislikesByPostID(postID uint) (likes int64, dislikes int64, err error) {
+	return r.countLikesAndDislikes(r.db.Where("post_id = ?", postID))
+}
+
+func (r *UserLikeRepository) CountLikesAndDislikesByCommentID(commentID uint) (likes int64, dislikes int64, err error) {
+	return r.countLikesAndDislikes(r.db.Where("comment_id = ?", commentID))
+}
+
+func (r *UserLikeRepository) Update(userLike *entities.UserLike) error {
+	if err := r.db.Save(userLike).Error; err != nil {
+		return repositories.ErrUpdate
+	}
+	return nil
+}
+
+func (r *UserLikeRepository) Delete(id uint) error {
+	if err := r.db.Delete(&entities.UserLike{}, id).Error; err != nil {
+		return repositories.ErrDelete
+	}
+	return nil
+}
+
+func (r *UserLikeRepository) findByUserIDAndTarget(userID uint, column string, targetID uint) (*entities.UserLike, error) {
+	userLike := new(entities.UserLike)
+	err := r.db.Where("user_id = ?", userID).Where(column+" = ?", targetID).First(&userLike).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, repositories.ErrFind
+	}
+	return userLike, nil
+}
+
+func (r *UserLikeRepository) countLikesAndDislikes(scope *gorm.DB) (likes int64, dislikes int64, err error) {
+	var result likeCounts
+	err = r.db.Model(&entities.UserLike{}).
+		Select("COUNT(*) FILTER (WHERE liked) AS likes, COUNT(*) FILTER (WHERE NOT liked) AS dislikes").
+		Where(scope).
+		Scan(&result).Error
+	if err != nil {
+		return 0, 0, repositories.ErrFind
+	}
+	return result.Likes, result.Dislikes, nil
+}
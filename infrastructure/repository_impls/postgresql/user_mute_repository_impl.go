@@ -0,0 +1,73 @@
+package postgresql
+
+import (
+	"errors"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"gorm.io/gorm"
+)
+
+type UserMuteRepository struct {
+	db *gorm.DB
+}
+
+func NewUserMuteRepository(db *gorm.DB) repositories.UserMuteRepository {
+	return &UserMuteRepository{db: db}
+}
+
+func (r *UserMuteRepository) Create(mute *entities.UserMute) error {
+	if err := r.db.Omit("Muted").Create(mute).Error; err != nil {
+		return repositories.ErrCreate
+	}
+	return nil
+}
+
+func (r *UserMuteRepository) FindByMuterIDAndMutedID(muterID, mutedID uint) (*entities.UserMute, error) {
+	mute := new(entities.UserMute)
+	err := r.db.Where("muter_id = ? AND muted_id = ?", muterID, mutedID).First(&mute).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, repositories.ErrFind
+	}
+	return mute, nil
+}
+
+func (r *UserMuteRepository) FindByMuterID(muterID uint, offset, limit int) ([]*entities.UserMute, error) {
+	var mutes []*entities.UserMute
+	err := r.db.Preload("Muted.UserProfile").
+		Joins("JOIN users ON users.id = user_mutes.muted_id AND users.deleted_at IS NULL").
+		Where("user_mutes.muter_id = ?", muterID).
+		Order("user_mutes.created_at DESC, user_mutes.id DESC").Offset(offset).Limit(limit).
+		Find(&mutes).Error
+	if err != nil {
+		return nil, repositories.ErrFind
+	}
+	return mutes, nil
+}
+
+func (r *UserMuteRepository) CountByMuterID(muterID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&entities.UserMute{}).
+		Joins("JOIN users ON users.id = user_mutes.muted_id AND users.deleted_at IS NULL").
+		Where("user_mutes.muter_id = ?", muterID).
+		Count(&count).Error
+	if err != nil {
+		return 0, repositories.ErrFind
+	}
+	return count, nil
+}
+
+func (r *UserMuteRepository) DeleteByMuterIDAndMutedID(muterID, mutedID uint) error {
+	result := r.db.Where("muter_id = ? AND muted_id = ?", muterID, mutedID).
+		Delete(&entities.UserMute{})
+	if result.Error != nil {
+		return repositories.ErrDelete
+	}
+	if result.RowsAffected == 0 {
+		return repositories.ErrNotFound
+	}
+	return nil
+}
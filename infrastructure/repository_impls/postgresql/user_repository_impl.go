@@ -49,7 +49,7 @@ func (r *UserRepository) FindByEmailHash(hashedEmail string) (*entities.User, er
 
 func (r *UserRepository) FindByNickname(nickname string) (*entities.User, error) {
 	user := new(entities.User)
-	err := r.db.Where("nickname = ?", nickname).First(&user).Error
+	err := r.db.Preload("UserProfile").Where("nickname = ?", nickname).First(&user).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, repositories.ErrNotFound
@@ -59,8 +59,10 @@ func (r *UserRepository) FindByNickname(nickname string) (*entities.User, error)
 	return user, nil
 }
 
+// Update saves the user with its profile. Without FullSaveAssociations, GORM
+// would only upsert the profile's foreign key and drop edits to its fields.
 func (r *UserRepository) Update(user *entities.User) error {
-	if err := r.db.Save(user).Error; err != nil {
+	if err := r.db.Session(&gorm.Session{FullSaveAssociations: true}).Save(user).Error; err != nil {
 		return repositories.ErrUpdate
 	}
 	return nil
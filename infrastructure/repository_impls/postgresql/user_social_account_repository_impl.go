@@ -0,0 +1,74 @@
+package postgresql
+
+import (
+	"errors"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"gorm.io/gorm"
+)
+
+type UserSocialAccountRepository struct {
+	db *gorm.DB
+}
+
+func NewUserSocialAccountRepository(db *gorm.DB) repositories.UserSocialAccountRepository {
+	return &UserSocialAccountRepository{db: db}
+}
+
+func (r *UserSocialAccountRepository) Create(userSocialAccount *entities.UserSocialAccount) error {
+	if err := r.db.Create(userSocialAccount).Error; err != nil {
+		return repositories.ErrCreate
+	}
+	return nil
+}
+
+func (r *UserSocialAccountRepository) FindByID(id uint) (*entities.UserSocialAccount, error) {
+	account := new(entities.UserSocialAccount)
+	err := r.db.First(&account, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, repositories.ErrFind
+	}
+	return account, nil
+}
+
+func (r *UserSocialAccountRepository) FindByUserIDAndProvider(userID uint, provider string) (*entities.UserSocialAccount, error) {
+	account := new(entities.UserSocialAccount)
+	err := r.db.Where("user_id = ? AND provider = ?", userID, provider).First(&account).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, repositories.ErrFind
+	}
+	return account, nil
+}
+
+func (r *UserSocialAccountRepository) FindByProviderAndProviderUserID(provider, providerUserID string) (*entities.UserSocialAccount, error) {
+	account := new(entities.UserSocialAccount)
+	err := r.db.Where("provider = ? AND provider_user_id = ?", provider, providerUserID).First(&account).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, repositories.ErrFind
+	}
+	return account, nil
+}
+
+func (r *UserSocialAccountRepository) Update(userSocialAccount *entities.UserSocialAccount) error {
+	if err := r.db.Save(userSocialAccount).Error; err != nil {
+		return repositories.ErrUpdate
+	}
+	return nil
+}
+
+func (r *UserSocialAccountRepository) Delete(id uint) error {
+	if err := r.db.Delete(&entities.UserSocialAccount{}, id).Error; err != nil {
+		return repositories.ErrDelete
+	}
+	return nil
+}
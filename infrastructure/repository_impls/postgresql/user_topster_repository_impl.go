@@ -0,0 +1,102 @@
+package postgresql
+
+import (
+	"errors"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"gorm.io/gorm"
+)
+
+type UserTopsterRepository struct {
+	db *gorm.DB
+}
+
+func NewUserTopsterRepository(db *gorm.DB) repositories.UserTopsterRepository {
+	return &UserTopsterRepository{db: db}
+}
+
+// Create also places the topster's albums, if any.
+func (r *UserTopsterRepository) Create(userTopster *entities.UserTopster) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("TopsterAlbums").Create(userTopster).Error; err != nil {
+			return err
+		}
+		if len(userTopster.TopsterAlbums) == 0 {
+			return nil
+		}
+		for i := range userTopster.TopsterAlbums {
+			userTopster.TopsterAlbums[i].TopsterID = userTopster.ID
+		}
+		return tx.Omit("Album").Create(&userTopster.TopsterAlbums).Error
+	})
+	if err != nil {
+		return repositories.ErrCreate
+	}
+	return nil
+}
+
+// FindByID returns the topster with its albums ordered by row, then column.
+func (r *UserTopsterRepository) FindByID(id uint) (*entities.UserTopster, error) {
+	topster := new(entities.UserTopster)
+	err := r.db.
+		Preload("TopsterAlbums", func(db *gorm.DB) *gorm.DB {
+			return db.Order("(position->>'row')::int, (position->>'col')::int")
+		}).
+		Preload("TopsterAlbums.Album.Artist").
+		First(&topster, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, repositories.ErrFind
+	}
+	return topster, nil
+}
+
+func (r *UserTopsterRepository) FindByUserID(userID uint, includePrivate bool, offset, limit int) ([]*entities.UserTopster, error) {
+	var topsters []*entities.UserTopster
+	err := r.byUser(userID, includePrivate).
+		Order("created_at DESC, id DESC").Offset(offset).Limit(limit).
+		Find(&topsters).Error
+	if err != nil {
+		return nil, repositories.ErrFind
+	}
+	return topsters, nil
+}
+
+func (r *UserTopsterRepository) CountByUserID(userID uint, includePrivate bool) (int64, error) {
+	var count int64
+	if err := r.byUser(userID, includePrivate).Count(&count).Error; err != nil {
+		return 0, repositories.ErrFind
+	}
+	return count, nil
+}
+
+func (r *UserTopsterRepository) Update(userTopster *entities.UserTopster) error {
+	if err := r.db.Omit("TopsterAlbums").Save(userTopster).Error; err != nil {
+		return repositories.ErrUpdate
+	}
+	return nil
+}
+
+func (r *UserTopsterRepository) Delete(id uint) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("topster_id = ?", id).Delete(&entities.TopsterAlbum{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&entities.UserTopster{}, id).Error
+	})
+	if err != nil {
+		return repositories.ErrDelete
+	}
+	return nil
+}
+
+func (r *UserTopsterRepository) byUser(userID uint, includePrivate bool) *gorm.DB {
+	query := r.db.Model(&entities.UserTopster{}).Where("user_id = ?", userID)
+	if !includePrivate {
+		query = query.Where("is_public")
+	}
+	return query
+}
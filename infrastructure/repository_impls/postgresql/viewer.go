@@ -0,0 +1,43 @@
+package postgresql
+
+import (
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"gorm.io/gorm"
+)
+
+// visibleTo is the block and mute filter shared by list queries. userColumn is
+// the qualified column holding the user each row belongs to, such as
+// posts.user_id.
+func visibleTo(viewer repositories.Viewer, userColumn string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if viewer.ID == 0 {
+			return db
+		}
+		db = db.Where("NOT "+blockExists(userColumn), viewer.ID, viewer.ID)
+		if viewer.HideMuted {
+			db = db.Where("NOT EXISTS (SELECT 1 FROM user_mutes WHERE "+
+				"user_mutes.muter_id = ? AND user_mutes.muted_id = "+userColumn+")", viewer.ID)
+		}
+		return db
+	}
+}
+
+// selectBlocked selects the rows of table with a blocked column that is true
+// for rows of users the viewer blocked or who blocked the viewer. It is used
+// instead of visibleTo where the rows have to stay in place.
+func selectBlocked(viewer repositories.Viewer, table, userColumn string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if viewer.ID == 0 {
+			return db
+		}
+		return db.Select(table+".*, "+blockExists(userColumn)+" AS blocked", viewer.ID, viewer.ID)
+	}
+}
+
+// blockExists is the block condition between the viewer, bound twice, and
+// the user in userColumn.
+func blockExists(userColumn string) string {
+	return "EXISTS (SELECT 1 FROM user_blocks WHERE " +
+		"(user_blocks.blocker_id = ? AND user_blocks.blocked_id = " + userColumn + ") OR " +
+		"(user_blocks.blocker_id = " + userColumn + " AND user_blocks.blocked_id = ?))"
+}
@@ -10,6 +10,9 @@ import (
 
 type SpotifyClient interface {
 	Search(ctx context.Context, query string, t spotify.SearchType, opts ...spotify.RequestOption) (*spotify.SearchResult, error)
+	GetTrack(ctx context.Context, id spotify.ID) (*spotify.FullTrack, error)
+	GetAlbum(ctx context.Context, id spotify.ID) (*spotify.FullAlbum, error)
+	GetArtist(ctx context.Context, id spotify.ID) (*spotify.FullArtist, error)
 }
 
 type spotifyClient struct {
@@ -41,3 +44,27 @@ func (c *spotifyClient) Search(ctx context.Context, query string, t spotify.Sear
 	}
 	return result, nil
 }
+
+func (c *spotifyClient) GetTrack(ctx context.Context, id spotify.ID) (*spotify.FullTrack, error) {
+	track, err := c.client.GetTrack(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return track, nil
+}
+
+func (c *spotifyClient) GetAlbum(ctx context.Context, id spotify.ID) (*spotify.FullAlbum, error) {
+	album, err := c.client.GetAlbum(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return album, nil
+}
+
+func (c *spotifyClient) GetArtist(ctx context.Context, id spotify.ID) (*spotify.FullArtist, error) {
+	artist, err := c.client.GetArtist(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return artist, nil
+}
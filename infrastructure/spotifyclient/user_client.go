@@ -0,0 +1,149 @@
+package spotifyclient
+
+import (
+	"context"
+
+	"github.com/zmb3/spotify/v2"
+	spotifyauth "github.com/zmb3/spotify/v2/auth"
+	"golang.org/x/oauth2"
+)
+
+// UserAuthenticator runs the authorization code flow that lets the service act
+// on behalf of a Spotify user.
+type UserAuthenticator interface {
+	AuthURL(state string) string
+	Exchange(ctx context.Context, code string) (*oauth2.Token, error)
+	NewUserClient(ctx context.Context, token *oauth2.Token) UserClient
+}
+
+// UserClient calls the Spotify API with a user's token. Expired access tokens
+// are refreshed transparently; Token returns the one currently in use.
+type UserClient interface {
+	CurrentUser(ctx context.Context) (*spotify.PrivateUser, error)
+	CurrentUsersPlaylists(ctx context.Context, opts ...spotify.RequestOption) (*spotify.SimplePlaylistPage, error)
+	GetPlaylistItems(ctx context.Context, playlistID spotify.ID, opts ...spotify.RequestOption) (*spotify.PlaylistItemPage, error)
+	CurrentUsersTracks(ctx context.Context, opts ...spotify.RequestOption) (*spotify.SavedTrackPage, error)
+	GetPlaylist(ctx context.Context, playlistID spotify.ID) (*spotify.FullPlaylist, error)
+	CreatePlaylist(ctx context.Context, userID, name, description string, public bool) (*spotify.FullPlaylist, error)
+	UpdatePlaylistDetails(ctx context.Context, playlistID spotify.ID, name, description string, public bool) error
+	AddTracksToPlaylist(ctx context.Context, playlistID spotify.ID, trackIDs ...spotify.ID) (string, error)
+	RemoveTracksFromPlaylist(ctx context.Context, playlistID spotify.ID, trackIDs ...spotify.ID) (string, error)
+	Token() (*oauth2.Token, error)
+}
+
+type userAuthenticator struct {
+	auth *spotifyauth.Authenticator
+}
+
+type userClient struct {
+	client *spotify.Client
+}
+
+func NewUserAuthenticator(clientID, clientSecret, redirectURL string) UserAuthenticator {
+	auth := spotifyauth.New(
+		spotifyauth.WithClientID(clientID),
+		spotifyauth.WithClientSecret(clientSecret),
+		spotifyauth.WithRedirectURL(redirectURL),
+		spotifyauth.WithScopes(
+			spotifyauth.ScopeUserReadPrivate,
+			spotifyauth.ScopePlaylistReadPrivate,
+			spotifyauth.ScopePlaylistReadCollaborative,
+			spotifyauth.ScopeUserLibraryRead,
+			spotifyauth.ScopePlaylistModifyPrivate,
+			spotifyauth.ScopePlaylistModifyPublic,
+		),
+	)
+	return &userAuthenticator{auth: auth}
+}
+
+func (a *userAuthenticator) AuthURL(state string) string {
+	return a.auth.AuthURL(state)
+}
+
+func (a *userAuthenticator) Exchange(ctx context.Context, code string) (*oauth2.Token, error) {
+	token, err := a.auth.Exchange(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+func (a *userAuthenticator) NewUserClient(ctx context.Context, token *oauth2.Token) UserClient {
+	return &userClient{client: spotify.New(a.auth.Client(ctx, token))}
+}
+
+func (c *userClient) CurrentUser(ctx context.Context) (*spotify.PrivateUser, error) {
+	user, err := c.client.CurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (c *userClient) CurrentUsersPlaylists(ctx context.Context, opts ...spotify.RequestOption) (*spotify.SimplePlaylistPage, error) {
+	page, err := c.client.CurrentUsersPlaylists(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+func (c *userClient) GetPlaylistItems(ctx context.Context, playlistID spotify.ID, opts ...spotify.RequestOption) (*spotify.PlaylistItemPage, error) {
+	page, err := c.client.GetPlaylistItems(ctx, playlistID, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+func (c *userClient) CurrentUsersTracks(ctx context.Context, opts ...spotify.RequestOption) (*spotify.SavedTrackPage, error) {
+	page, err := c.client.CurrentUsersTracks(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+func (c *userClient) GetPlaylist(ctx context.Context, playlistID spotify.ID) (*spotify.FullPlaylist, error) {
+	playlist, err := c.client.GetPlaylist(ctx, playlistID)
+	if err != nil {
+		return nil, err
+	}
+	return playlist, nil
+}
+
+func (c *userClient) CreatePlaylist(ctx context.Context, userID, name, description string, public bool) (*spotify.FullPlaylist, error) {
+	playlist, err := c.client.CreatePlaylistForUser(ctx, userID, name, description, public, false)
+	if err != nil {
+		return nil, err
+	}
+	return playlist, nil
+}
+
+func (c *userClient) UpdatePlaylistDetails(ctx context.Context, playlistID spotify.ID, name, description string, public bool) error {
+	return c.client.ChangePlaylistNameAccessAndDescription(ctx, playlistID, name, description, public)
+}
+
+func (c *userClient) AddTracksToPlaylist(ctx context.Context, playlistID spotify.ID, trackIDs ...spotify.ID) (string, error) {
+	snapshotID, err := c.client.AddTracksToPlaylist(ctx, playlistID, trackIDs...)
+	if err != nil {
+		return "", err
+	}
+	return snapshotID, nil
+}
+
+func (c *userClient) RemoveTracksFromPlaylist(ctx context.Context, playlistID spotify.ID, trackIDs ...spotify.ID) (string, error) {
+	snapshotID, err := c.client.RemoveTracksFromPlaylist(ctx, playlistID, trackIDs...)
+	if err != nil {
+		return "", err
+	}
+	return snapshotID, nil
+}
+
+func (c *userClient) Token() (*oauth2.Token, error) {
+	token, err := c.client.Token()
+	if err != nil {
+		return nil, err
+	}
+	return token, nil
+}
@@ -0,0 +1,38 @@
+package storage
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalStorage_Put(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewLocalStorage(dir, "https://cdn.example.com/static/")
+	assert.NoError(t, err)
+
+	t.Run("Success", func(t *testing.T) {
+		url, err := s.Put(context.Background(), "topsters/1/abc.png", []byte("image"), "image/png")
+		assert.NoError(t, err)
+		assert.Equal(t, "https://cdn.example.com/static/topsters/1/abc.png", url)
+
+		data, err := os.ReadFile(filepath.Join(dir, "topsters", "1", "abc.png"))
+		assert.NoError(t, err)
+		assert.Equal(t, "image", string(data))
+	})
+
+	t.Run("StaysInsideDir", func(t *testing.T) {
+		url, err := s.Put(context.Background(), "../../escape.png", []byte("image"), "image/png")
+		assert.NoError(t, err)
+		assert.Equal(t, "https://cdn.example.com/static/escape.png", url)
+		assert.FileExists(t, filepath.Join(dir, "escape.png"))
+	})
+
+	t.Run("EmptyKey", func(t *testing.T) {
+		_, err := s.Put(context.Background(), "/", []byte("image"), "image/png")
+		assert.ErrorIs(t, err, ErrInvalidKey)
+	})
+}
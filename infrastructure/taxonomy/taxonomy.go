@@ -0,0 +1,78 @@
+package taxonomy
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+//go:embed genres.json
+var embeddedGenres []byte
+
+var (
+	ErrDecodingTaxonomy = errors.New("failed to decode genre taxonomy")
+	ErrEmptyGenreName   = errors.New("genre name must not be empty")
+)
+
+// Genre is a node of the genre taxonomy. Aliases are alternative names used by
+// external providers (Spotify artist genres, Last.fm tags) for the same genre.
+type Genre struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Aliases     []string `json:"aliases,omitempty"`
+	Children    []Genre  `json:"children,omitempty"`
+}
+
+// Default returns the taxonomy bundled with the application.
+func Default() ([]Genre, error) {
+	return Load(strings.NewReader(string(embeddedGenres)))
+}
+
+func LoadFile(path string) ([]Genre, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return Load(f)
+}
+
+func Load(r io.Reader) ([]Genre, error) {
+	var genres []Genre
+	if err := json.NewDecoder(r).Decode(&genres); err != nil {
+		return nil, ErrDecodingTaxonomy
+	}
+	if err := validate(genres, map[string]bool{}); err != nil {
+		return nil, err
+	}
+	return genres, nil
+}
+
+func validate(genres []Genre, seen map[string]bool) error {
+	for _, g := range genres {
+		if strings.TrimSpace(g.Name) == "" {
+			return ErrEmptyGenreName
+		}
+		key := Normalize(g.Name)
+		if seen[key] {
+			return fmt.Errorf("duplicated genre name: %s", g.Name)
+		}
+		seen[key] = true
+		if err := validate(g.Children, seen); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Normalize converts a genre name or alias into the form used for matching,
+// so that "Hip-Hop", "hip hop" and "HIP_HOP" are treated the same.
+func Normalize(name string) string {
+	name = strings.ToLower(name)
+	name = strings.NewReplacer("-", " ", "_", " ").Replace(name)
+	return strings.Join(strings.Fields(name), " ")
+}
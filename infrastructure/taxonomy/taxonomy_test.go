@@ -0,0 +1,49 @@
+package taxonomy
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefault(t *testing.T) {
+	genres, err := Default()
+	assert.NoError(t, err)
+	assert.NotEmpty(t, genres)
+
+	for _, g := range genres {
+		assert.NotEmpty(t, g.Name)
+		assert.LessOrEqual(t, len(g.Name), 50)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		genres, err := Load(strings.NewReader(`[{"name":"Rock","aliases":["rock music"],"children":[{"name":"Punk"}]}]`))
+		assert.NoError(t, err)
+		assert.Equal(t, []Genre{{Name: "Rock", Aliases: []string{"rock music"}, Children: []Genre{{Name: "Punk"}}}}, genres)
+	})
+
+	t.Run("InvalidJSON", func(t *testing.T) {
+		_, err := Load(strings.NewReader(`{"name":`))
+		assert.ErrorIs(t, err, ErrDecodingTaxonomy)
+	})
+
+	t.Run("EmptyName", func(t *testing.T) {
+		_, err := Load(strings.NewReader(`[{"name":"Rock","children":[{"name":" "}]}]`))
+		assert.ErrorIs(t, err, ErrEmptyGenreName)
+	})
+
+	t.Run("DuplicatedName", func(t *testing.T) {
+		_, err := Load(strings.NewReader(`[{"name":"Hip Hop"},{"name":"Pop","children":[{"name":"hip-hop"}]}]`))
+		assert.Error(t, err)
+	})
+}
+
+func TestNormalize(t *testing.T) {
+	assert.Equal(t, "hip hop", Normalize("Hip-Hop"))
+	assert.Equal(t, "hip hop", Normalize("  HIP_HOP "))
+	assert.Equal(t, "r&b", Normalize("R&B"))
+	assert.Equal(t, "k pop", Normalize("K-Pop"))
+}
@@ -0,0 +1,56 @@
+package topsterimage
+
+import (
+	"context"
+	"fmt"
+	"image"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"net/http"
+	"time"
+)
+
+// maxCoverSize limits downloaded cover images. Spotify's largest covers are
+// 640x640 JPEGs of well under 1MB.
+const maxCoverSize = 10 << 20
+
+// CoverFetcher loads album cover images by URL.
+type CoverFetcher interface {
+	Fetch(ctx context.Context, url string) (image.Image, error)
+}
+
+type httpCoverFetcher struct {
+	httpClient *http.Client
+}
+
+func NewHTTPCoverFetcher(httpClient *http.Client) CoverFetcher {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &httpCoverFetcher{httpClient: httpClient}
+}
+
+func (f *httpCoverFetcher) Fetch(ctx context.Context, url string) (image.Image, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := f.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, ErrCoverNotFound
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status fetching album cover: %d", res.StatusCode)
+	}
+	img, _, err := image.Decode(io.LimitReader(res.Body, maxCoverSize))
+	if err != nil {
+		return nil, err
+	}
+	return img, nil
+}
@@ -0,0 +1,11 @@
+package topsterimage
+
+import "errors"
+
+var (
+	ErrCoverNotFound     = errors.New("album cover not found")
+	ErrUnsupportedFormat = errors.New("unsupported topster image format")
+	ErrInvalidCellSize   = errors.New("invalid topster cell size")
+	ErrInvalidLayout     = errors.New("invalid topster layout")
+	ErrNoHangulFont      = errors.New("no topster font supports Hangul")
+)
@@ -0,0 +1,130 @@
+package topsterimage
+
+import (
+	"image"
+	"os"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+)
+
+// loadFonts parses the given TrueType/OpenType files and appends the bundled
+// Go font as the last fallback. The Go font has no Hangul, so a Korean-capable
+// font such as Noto Sans KR must be configured for album titles in Korean;
+// see RequireHangul.
+func loadFonts(paths []string) ([]*sfnt.Font, error) {
+	fonts := make([]*sfnt.Font, 0, len(paths)+1)
+	for _, p := range paths {
+		data, err := os.ReadFile(p)
+		if err != nil {
+			return nil, err
+		}
+		f, err := parseFont(data)
+		if err != nil {
+			return nil, err
+		}
+		fonts = append(fonts, f)
+	}
+	f, err := opentype.Parse(goregular.TTF)
+	if err != nil {
+		return nil, err
+	}
+	return append(fonts, f), nil
+}
+
+// supportsRune reports whether any of the fonts has a glyph for r.
+func supportsRune(fonts []*sfnt.Font, r rune) bool {
+	var buf sfnt.Buffer
+	for _, f := range fonts {
+		if idx, err := f.GlyphIndex(&buf, r); err == nil && idx != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// parseFont accepts both single fonts and font collections (.ttc), in which
+// case the first font of the collection is used.
+func parseFont(data []byte) (*sfnt.Font, error) {
+	if f, err := opentype.Parse(data); err == nil {
+		return f, nil
+	}
+	collection, err := opentype.ParseCollection(data)
+	if err != nil {
+		return nil, err
+	}
+	return collection.Font(0)
+}
+
+// fallbackFace draws each rune with the first font that has a glyph for it.
+type fallbackFace struct {
+	fonts []*sfnt.Font
+	faces []font.Face
+	buf   sfnt.Buffer
+}
+
+func newFallbackFace(fonts []*sfnt.Font, size float64) (*fallbackFace, error) {
+	faces := make([]font.Face, len(fonts))
+	for i, f := range fonts {
+		face, err := opentype.NewFace(f, &opentype.FaceOptions{Size: size, DPI: 72, Hinting: font.HintingFull})
+		if err != nil {
+			return nil, err
+		}
+		faces[i] = face
+	}
+	return &fallbackFace{fonts: fonts, faces: faces}, nil
+}
+
+// faceFor returns the face to draw r with. Runes no font supports are drawn
+// with the primary font's missing glyph box.
+func (f *fallbackFace) faceFor(r rune) font.Face {
+	for i, fnt := range f.fonts {
+		if idx, err := fnt.GlyphIndex(&f.buf, r); err == nil && idx != 0 {
+			return f.faces[i]
+		}
+	}
+	return f.faces[0]
+}
+
+func (f *fallbackFace) Close() error {
+	for _, face := range f.faces {
+		face.Close()
+	}
+	return nil
+}
+
+func (f *fallbackFace) Glyph(dot fixed.Point26_6, r rune) (image.Rectangle, image.Image, image.Point, fixed.Int26_6, bool) {
+	return f.faceFor(r).Glyph(dot, r)
+}
+
+func (f *fallbackFace) GlyphBounds(r rune) (fixed.Rectangle26_6, fixed.Int26_6, bool) {
+	return f.faceFor(r).GlyphBounds(r)
+}
+
+func (f *fallbackFace) GlyphAdvance(r rune) (fixed.Int26_6, bool) {
+	return f.faceFor(r).GlyphAdvance(r)
+}
+
+func (f *fallbackFace) Kern(r0, r1 rune) fixed.Int26_6 {
+	face := f.faceFor(r0)
+	if face != f.faceFor(r1) {
+		return 0
+	}
+	return face.Kern(r0, r1)
+}
+
+// Metrics uses the largest ascent and descent of all fonts, so lines mixing
+// scripts do not overlap.
+func (f *fallbackFace) Metrics() font.Metrics {
+	m := f.faces[0].Metrics()
+	for _, face := range f.faces[1:] {
+		other := face.Metrics()
+		m.Height = max(m.Height, other.Height)
+		m.Ascent = max(m.Ascent, other.Ascent)
+		m.Descent = max(m.Descent, other.Descent)
+	}
+	return m
+}
@@ -0,0 +1,345 @@
+package topsterimage
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"slices"
+	"sort"
+	"sync"
+
+	"golang.org/x/image/draw"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+)
+
+type Format string
+
+const (
+	FormatPNG  Format = "png"
+	FormatJPEG Format = "jpeg"
+)
+
+// renderVersion is part of every fingerprint. Bump it whenever the output of
+// Render changes, so existing images are rendered again on their next save.
+const renderVersion = 2
+
+const (
+	defaultCellSize    = 300
+	defaultConcurrency = 8
+	jpegQuality        = 90
+	lineSpacing        = 1.5
+)
+
+var (
+	backgroundColor  = color.RGBA{R: 0x11, G: 0x11, B: 0x11, A: 0xff}
+	emptyCellColor   = color.RGBA{R: 0x22, G: 0x22, B: 0x22, A: 0xff}
+	missingCellColor = color.RGBA{R: 0x3a, G: 0x3a, B: 0x3a, A: 0xff}
+	textColor        = color.RGBA{R: 0xee, G: 0xee, B: 0xee, A: 0xff}
+)
+
+// Layout describes a topster to render. RowWidths holds the number of cells in
+// each row; rows with fewer cells get larger cells, so that every row spans
+// the full width of the image. Rows and columns are zero-based.
+type Layout struct {
+	RowWidths  []int
+	ShowTitles bool
+	Albums     []Album
+}
+
+type Album struct {
+	Row      int
+	Col      int
+	CoverURL string
+	Title    string
+	Artist   string
+}
+
+type Renderer interface {
+	// Render composes the topster image. Covers that cannot be fetched are
+	// drawn as blank cells instead of failing the whole image.
+	Render(ctx context.Context, layout *Layout) ([]byte, error)
+	// Fingerprint identifies the image Render would produce for the layout,
+	// so callers can skip rendering layouts that did not change.
+	Fingerprint(layout *Layout) string
+	ContentType() string
+	Extension() string
+}
+
+type renderer struct {
+	fetcher     CoverFetcher
+	format      Format
+	cellSize    int
+	concurrency int
+	fontPaths   []string
+	fonts       []*sfnt.Font
+	hangul      bool
+}
+
+type Option func(*renderer)
+
+func WithFormat(format Format) Option {
+	return func(r *renderer) {
+		r.format = format
+	}
+}
+
+func WithCellSize(cellSize int) Option {
+	return func(r *renderer) {
+		r.cellSize = cellSize
+	}
+}
+
+// WithFontFiles sets the fonts used for the titles sidebar, in order of
+// preference. The bundled Go font is always used as the last fallback.
+func WithFontFiles(paths ...string) Option {
+	return func(r *renderer) {
+		r.fontPaths = paths
+	}
+}
+
+// RequireHangul makes New fail with ErrNoHangulFont unless one of the font
+// files can draw Hangul, so that Korean titles are never rendered as missing
+// glyph boxes.
+func RequireHangul() Option {
+	return func(r *renderer) {
+		r.hangul = true
+	}
+}
+
+func New(fetcher CoverFetcher, opts ...Option) (Renderer, error) {
+	r := &renderer{
+		fetcher:     fetcher,
+		format:      FormatPNG,
+		cellSize:    defaultCellSize,
+		concurrency: defaultConcurrency,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	if r.format != FormatPNG && r.format != FormatJPEG {
+		return nil, ErrUnsupportedFormat
+	}
+	if r.cellSize <= 0 {
+		return nil, ErrInvalidCellSize
+	}
+
+	fonts, err := loadFonts(r.fontPaths)
+	if err != nil {
+		return nil, err
+	}
+	if r.hangul && !supportsRune(fonts, '가') {
+		return nil, ErrNoHangulFont
+	}
+	r.fonts = fonts
+	return r, nil
+}
+
+func (r *renderer) ContentType() string {
+	return "image/" + string(r.format)
+}
+
+func (r *renderer) Extension() string {
+	if r.format == FormatJPEG {
+		return ".jpg"
+	}
+	return ".png"
+}
+
+func (r *renderer) Fingerprint(layout *Layout) string {
+	albums := sortedAlbums(layout.Albums)
+	b, _ := json.Marshal(struct {
+		Version    int
+		Format     Format
+		CellSize   int
+		FontPaths  []string
+		RowWidths  []int
+		ShowTitles bool
+		Albums     []Album
+	}{renderVersion, r.format, r.cellSize, r.fontPaths, layout.RowWidths, layout.ShowTitles, albums})
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:16])
+}
+
+func (r *renderer) Render(ctx context.Context, layout *Layout) ([]byte, error) {
+	if len(layout.RowWidths) == 0 || slices.Min(layout.RowWidths) <= 0 {
+		return nil, ErrInvalidLayout
+	}
+	for _, a := range layout.Albums {
+		if a.Row < 0 || a.Row >= len(layout.RowWidths) || a.Col < 0 || a.Col >= layout.RowWidths[a.Row] {
+			return nil, ErrInvalidLayout
+		}
+	}
+
+	covers, err := r.fetchCovers(ctx, layout.Albums)
+	if err != nil {
+		return nil, err
+	}
+
+	cell := r.cellSize
+	gap := max(2, cell/60)
+	maxCols := slices.Max(layout.RowWidths)
+	gridWidth := maxCols*cell + (maxCols+1)*gap
+
+	// Rows are laid out top to bottom, each with square cells sized to fill
+	// the grid width. Pixels left over by rounding are split to both sides.
+	type row struct{ y, size, offset int }
+	rows := make([]row, len(layout.RowWidths))
+	height := gap
+	for i, w := range layout.RowWidths {
+		size := (gridWidth - (w+1)*gap) / w
+		rows[i] = row{y: height, size: size, offset: (gridWidth - w*size - (w+1)*gap) / 2}
+		height += size + gap
+	}
+	width := gridWidth
+	if layout.ShowTitles {
+		width += 2 * cell
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(img, img.Bounds(), image.NewUniform(backgroundColor), image.Point{}, draw.Src)
+	cellRect := func(r, col int) image.Rectangle {
+		min := image.Pt(rows[r].offset+gap+col*(rows[r].size+gap), rows[r].y)
+		return image.Rectangle{Min: min, Max: min.Add(image.Pt(rows[r].size, rows[r].size))}
+	}
+	for r, w := range layout.RowWidths {
+		for col := 0; col < w; col++ {
+			draw.Draw(img, cellRect(r, col), image.NewUniform(emptyCellColor), image.Point{}, draw.Src)
+		}
+	}
+	for i, a := range layout.Albums {
+		rect := cellRect(a.Row, a.Col)
+		if covers[i] == nil {
+			draw.Draw(img, rect, image.NewUniform(missingCellColor), image.Point{}, draw.Src)
+			continue
+		}
+		draw.CatmullRom.Scale(img, rect, covers[i], squareCrop(covers[i].Bounds()), draw.Src, nil)
+	}
+
+	if layout.ShowTitles {
+		sidebar := image.Rect(gridWidth, 0, width, height)
+		if err := r.drawTitles(img, sidebar, layout.Albums); err != nil {
+			return nil, err
+		}
+	}
+
+	var buf bytes.Buffer
+	if r.format == FormatJPEG {
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality})
+	} else {
+		err = png.Encode(&buf, img)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// fetchCovers loads the covers concurrently. Covers that fail to load are
+// left nil; only a cancelled context fails the render.
+func (r *renderer) fetchCovers(ctx context.Context, albums []Album) ([]image.Image, error) {
+	covers := make([]image.Image, len(albums))
+	sem := make(chan struct{}, r.concurrency)
+	var wg sync.WaitGroup
+	for i, a := range albums {
+		if a.CoverURL == "" {
+			continue
+		}
+		wg.Add(1)
+		go func(i int, url string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			if img, err := r.fetcher.Fetch(ctx, url); err == nil {
+				covers[i] = img
+			}
+		}(i, a.CoverURL)
+	}
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return covers, nil
+}
+
+// drawTitles lists "Artist - Album" for every album in reading order, with a
+// blank line between grid rows. The font shrinks to fit larger grids.
+func (r *renderer) drawTitles(img *image.RGBA, area image.Rectangle, albums []Album) error {
+	albums = sortedAlbums(albums)
+	if len(albums) == 0 {
+		return nil
+	}
+
+	lines := len(albums)
+	for i := 1; i < len(albums); i++ {
+		if albums[i].Row != albums[i-1].Row {
+			lines++
+		}
+	}
+	padding := r.cellSize / 10
+	size := max(6, min(float64(r.cellSize)/10, float64(area.Dy()-2*padding)/(float64(lines)*lineSpacing)))
+	face, err := newFallbackFace(r.fonts, size)
+	if err != nil {
+		return err
+	}
+	defer face.Close()
+
+	d := &font.Drawer{Dst: img, Src: image.NewUniform(textColor), Face: face}
+	lineHeight := fixed.Int26_6(size * lineSpacing * 64)
+	maxWidth := fixed.I(area.Dx() - 2*padding)
+	y := fixed.I(area.Min.Y+padding) + face.Metrics().Ascent
+	for i, a := range albums {
+		if i > 0 && a.Row != albums[i-1].Row {
+			y += lineHeight
+		}
+		text := a.Title
+		if a.Artist != "" {
+			text = a.Artist + " - " + a.Title
+		}
+		d.Dot = fixed.Point26_6{X: fixed.I(area.Min.X + padding), Y: y}
+		d.DrawString(truncate(d, text, maxWidth))
+		y += lineHeight
+	}
+	return nil
+}
+
+// truncate shortens text with an ellipsis so that it fits in width.
+func truncate(d *font.Drawer, text string, width fixed.Int26_6) string {
+	if d.MeasureString(text) <= width {
+		return text
+	}
+	runes := []rune(text)
+	for n := len(runes) - 1; n > 0; n-- {
+		s := string(runes[:n]) + "…"
+		if d.MeasureString(s) <= width {
+			return s
+		}
+	}
+	return ""
+}
+
+func sortedAlbums(albums []Album) []Album {
+	sorted := append([]Album(nil), albums...)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Row != sorted[j].Row {
+			return sorted[i].Row < sorted[j].Row
+		}
+		return sorted[i].Col < sorted[j].Col
+	})
+	return sorted
+}
+
+// squareCrop returns the centered square of the bounds, so covers that are
+// not square are cropped instead of stretched.
+func squareCrop(b image.Rectangle) image.Rectangle {
+	side := min(b.Dx(), b.Dy())
+	min := image.Pt(b.Min.X+(b.Dx()-side)/2, b.Min.Y+(b.Dy()-side)/2)
+	return image.Rectangle{Min: min, Max: min.Add(image.Pt(side, side))}
+}
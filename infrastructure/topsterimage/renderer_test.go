@@ -0,0 +1,140 @@
+package topsterimage
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fixtureFetcher serves covers from testdata instead of the network.
+type fixtureFetcher struct{}
+
+func (fixtureFetcher) Fetch(ctx context.Context, url string) (image.Image, error) {
+	f, err := os.Open(filepath.Join("testdata", url))
+	if err != nil {
+		return nil, ErrCoverNotFound
+	}
+	defer f.Close()
+	img, _, err := image.Decode(f)
+	return img, err
+}
+
+func testLayout() *Layout {
+	return &Layout{
+		RowWidths: []int{3, 3, 3},
+		Albums: []Album{
+			{Row: 0, Col: 0, CoverURL: "red.png", Title: "Discovery", Artist: "Daft Punk"},
+			{Row: 1, Col: 2, CoverURL: "blue_wide.png", Title: "정규 1집", Artist: "아이유"},
+			{Row: 2, Col: 1, CoverURL: "missing.png", Title: "Homework", Artist: "Daft Punk"},
+		},
+	}
+}
+
+func TestRenderer_Render(t *testing.T) {
+	r, err := New(fixtureFetcher{}, WithCellSize(60))
+	assert.NoError(t, err)
+
+	t.Run("Grid", func(t *testing.T) {
+		data, err := r.Render(context.Background(), testLayout())
+		assert.NoError(t, err)
+
+		img, err := png.Decode(bytes.NewReader(data))
+		assert.NoError(t, err)
+		// 3 cells of 60px plus 4 gaps of 2px.
+		assert.Equal(t, image.Rect(0, 0, 188, 188), img.Bounds())
+		assertColor(t, color.RGBA{R: 0xff, A: 0xff}, img.At(32, 32))
+		assertColor(t, color.RGBA{B: 0xff, A: 0xff}, img.At(154, 92))
+		assertColor(t, missingCellColor, img.At(92, 154))
+		assertColor(t, emptyCellColor, img.At(92, 32))
+	})
+
+	t.Run("WithTitles", func(t *testing.T) {
+		layout := testLayout()
+		layout.ShowTitles = true
+
+		data, err := r.Render(context.Background(), layout)
+		assert.NoError(t, err)
+
+		img, err := png.Decode(bytes.NewReader(data))
+		assert.NoError(t, err)
+		assert.Equal(t, image.Rect(0, 0, 308, 188), img.Bounds())
+	})
+
+	t.Run("Tiered", func(t *testing.T) {
+		data, err := r.Render(context.Background(), &Layout{
+			RowWidths: []int{2, 3},
+			Albums:    []Album{{Row: 0, Col: 1, CoverURL: "red.png"}, {Row: 1, Col: 0, CoverURL: "blue_wide.png"}},
+		})
+		assert.NoError(t, err)
+
+		img, err := png.Decode(bytes.NewReader(data))
+		assert.NoError(t, err)
+		// The top row has two 91px cells spanning the width of three 60px cells.
+		assert.Equal(t, image.Rect(0, 0, 188, 157), img.Bounds())
+		assertColor(t, color.RGBA{R: 0xff, A: 0xff}, img.At(140, 48))
+		assertColor(t, color.RGBA{B: 0xff, A: 0xff}, img.At(32, 125))
+		assertColor(t, emptyCellColor, img.At(48, 48))
+	})
+
+	t.Run("OutOfBounds", func(t *testing.T) {
+		_, err := r.Render(context.Background(), &Layout{RowWidths: []int{2, 3}, Albums: []Album{{Row: 0, Col: 2}}})
+		assert.ErrorIs(t, err, ErrInvalidLayout)
+	})
+
+	t.Run("Cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := r.Render(ctx, testLayout())
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestRenderer_Fingerprint(t *testing.T) {
+	r, err := New(fixtureFetcher{})
+	assert.NoError(t, err)
+
+	base := r.Fingerprint(testLayout())
+
+	reordered := testLayout()
+	reordered.Albums[0], reordered.Albums[2] = reordered.Albums[2], reordered.Albums[0]
+	assert.Equal(t, base, r.Fingerprint(reordered))
+
+	moved := testLayout()
+	moved.Albums[0].Col = 1
+	assert.NotEqual(t, base, r.Fingerprint(moved))
+
+	titled := testLayout()
+	titled.ShowTitles = true
+	assert.NotEqual(t, base, r.Fingerprint(titled))
+
+	jpegRenderer, err := New(fixtureFetcher{}, WithFormat(FormatJPEG))
+	assert.NoError(t, err)
+	assert.NotEqual(t, base, jpegRenderer.Fingerprint(testLayout()))
+	assert.Equal(t, ".jpg", jpegRenderer.Extension())
+}
+
+func TestNew_InvalidOptions(t *testing.T) {
+	_, err := New(fixtureFetcher{}, WithFormat("gif"))
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
+
+	_, err = New(fixtureFetcher{}, WithCellSize(0))
+	assert.ErrorIs(t, err, ErrInvalidCellSize)
+
+	// The bundled Go font has no Hangul.
+	_, err = New(fixtureFetcher{}, RequireHangul())
+	assert.ErrorIs(t, err, ErrNoHangulFont)
+}
+
+func assertColor(t *testing.T, expected color.RGBA, actual color.Color) {
+	t.Helper()
+	r, g, b, a := actual.RGBA()
+	assert.Equal(t, expected, color.RGBA{R: uint8(r >> 8), G: uint8(g >> 8), B: uint8(b >> 8), A: uint8(a >> 8)})
+}
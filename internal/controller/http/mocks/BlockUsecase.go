@@ -0,0 +1,159 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	usecase "github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// BlockUsecase is an autogenerated mock type for the BlockUsecase type
+type BlockUsecase struct {
+	mock.Mock
+}
+
+// Block provides a mock function with given fields: userID, targetID
+func (_m *BlockUsecase) Block(userID uint, targetID uint) error {
+	ret := _m.Called(userID, targetID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Block")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint) error); ok {
+		r0 = rf(userID, targetID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListBlocked provides a mock function with given fields: userID, limit, offset
+func (_m *BlockUsecase) ListBlocked(userID uint, limit *int, offset *int) (*usecase.ListRestrictedUsersOutput, error) {
+	ret := _m.Called(userID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBlocked")
+	}
+
+	var r0 *usecase.ListRestrictedUsersOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, *int, *int) (*usecase.ListRestrictedUsersOutput, error)); ok {
+		return rf(userID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(uint, *int, *int) *usecase.ListRestrictedUsersOutput); ok {
+		r0 = rf(userID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ListRestrictedUsersOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, *int, *int) error); ok {
+		r1 = rf(userID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListMuted provides a mock function with given fields: userID, limit, offset
+func (_m *BlockUsecase) ListMuted(userID uint, limit *int, offset *int) (*usecase.ListRestrictedUsersOutput, error) {
+	ret := _m.Called(userID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMuted")
+	}
+
+	var r0 *usecase.ListRestrictedUsersOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, *int, *int) (*usecase.ListRestrictedUsersOutput, error)); ok {
+		return rf(userID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(uint, *int, *int) *usecase.ListRestrictedUsersOutput); ok {
+		r0 = rf(userID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ListRestrictedUsersOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, *int, *int) error); ok {
+		r1 = rf(userID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Mute provides a mock function with given fields: userID, targetID
+func (_m *BlockUsecase) Mute(userID uint, targetID uint) error {
+	ret := _m.Called(userID, targetID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Mute")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint) error); ok {
+		r0 = rf(userID, targetID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Unblock provides a mock function with given fields: userID, targetID
+func (_m *BlockUsecase) Unblock(userID uint, targetID uint) error {
+	ret := _m.Called(userID, targetID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unblock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint) error); ok {
+		r0 = rf(userID, targetID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Unmute provides a mock function with given fields: userID, targetID
+func (_m *BlockUsecase) Unmute(userID uint, targetID uint) error {
+	ret := _m.Called(userID, targetID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unmute")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint) error); ok {
+		r0 = rf(userID, targetID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewBlockUsecase creates a new instance of BlockUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBlockUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BlockUsecase {
+	mock := &BlockUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,429 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	usecase "github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CollectionUsecase is an autogenerated mock type for the CollectionUsecase type
+type CollectionUsecase struct {
+	mock.Mock
+}
+
+// AcceptInvite provides a mock function with given fields: userID, token
+func (_m *CollectionUsecase) AcceptInvite(userID uint, token string) (*usecase.CollectionMemberOutput, error) {
+	ret := _m.Called(userID, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcceptInvite")
+	}
+
+	var r0 *usecase.CollectionMemberOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string) (*usecase.CollectionMemberOutput, error)); ok {
+		return rf(userID, token)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string) *usecase.CollectionMemberOutput); ok {
+		r0 = rf(userID, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.CollectionMemberOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string) error); ok {
+		r1 = rf(userID, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddTracks provides a mock function with given fields: userID, collectionID, musicIDs
+func (_m *CollectionUsecase) AddTracks(userID uint, collectionID uint, musicIDs []uint) (*usecase.AddCollectionTracksOutput, error) {
+	ret := _m.Called(userID, collectionID, musicIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddTracks")
+	}
+
+	var r0 *usecase.AddCollectionTracksOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, []uint) (*usecase.AddCollectionTracksOutput, error)); ok {
+		return rf(userID, collectionID, musicIDs)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, []uint) *usecase.AddCollectionTracksOutput); ok {
+		r0 = rf(userID, collectionID, musicIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.AddCollectionTracksOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, []uint) error); ok {
+		r1 = rf(userID, collectionID, musicIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateCollection provides a mock function with given fields: userID, input
+func (_m *CollectionUsecase) CreateCollection(userID uint, input *usecase.CreateCollectionInput) (*usecase.CollectionOutput, error) {
+	ret := _m.Called(userID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCollection")
+	}
+
+	var r0 *usecase.CollectionOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, *usecase.CreateCollectionInput) (*usecase.CollectionOutput, error)); ok {
+		return rf(userID, input)
+	}
+	if rf, ok := ret.Get(0).(func(uint, *usecase.CreateCollectionInput) *usecase.CollectionOutput); ok {
+		r0 = rf(userID, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.CollectionOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, *usecase.CreateCollectionInput) error); ok {
+		r1 = rf(userID, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateInvite provides a mock function with given fields: userID, collectionID, input
+func (_m *CollectionUsecase) CreateInvite(userID uint, collectionID uint, input *usecase.CreateCollectionInviteInput) (*usecase.CollectionInviteOutput, error) {
+	ret := _m.Called(userID, collectionID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateInvite")
+	}
+
+	var r0 *usecase.CollectionInviteOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, *usecase.CreateCollectionInviteInput) (*usecase.CollectionInviteOutput, error)); ok {
+		return rf(userID, collectionID, input)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, *usecase.CreateCollectionInviteInput) *usecase.CollectionInviteOutput); ok {
+		r0 = rf(userID, collectionID, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.CollectionInviteOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, *usecase.CreateCollectionInviteInput) error); ok {
+		r1 = rf(userID, collectionID, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteCollection provides a mock function with given fields: userID, collectionID
+func (_m *CollectionUsecase) DeleteCollection(userID uint, collectionID uint) error {
+	ret := _m.Called(userID, collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCollection")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint) error); ok {
+		r0 = rf(userID, collectionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExportCollection provides a mock function with given fields: viewerID, collectionID, format
+func (_m *CollectionUsecase) ExportCollection(viewerID uint, collectionID uint, format string) (*usecase.ExportCollectionOutput, error) {
+	ret := _m.Called(viewerID, collectionID, format)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportCollection")
+	}
+
+	var r0 *usecase.ExportCollectionOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, string) (*usecase.ExportCollectionOutput, error)); ok {
+		return rf(viewerID, collectionID, format)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, string) *usecase.ExportCollectionOutput); ok {
+		r0 = rf(viewerID, collectionID, format)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ExportCollectionOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, string) error); ok {
+		r1 = rf(viewerID, collectionID, format)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCollection provides a mock function with given fields: viewerID, collectionID
+func (_m *CollectionUsecase) GetCollection(viewerID uint, collectionID uint) (*usecase.GetCollectionOutput, error) {
+	ret := _m.Called(viewerID, collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollection")
+	}
+
+	var r0 *usecase.GetCollectionOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint) (*usecase.GetCollectionOutput, error)); ok {
+		return rf(viewerID, collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint) *usecase.GetCollectionOutput); ok {
+		r0 = rf(viewerID, collectionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.GetCollectionOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(viewerID, collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportCollection provides a mock function with given fields: userID, input
+func (_m *CollectionUsecase) ImportCollection(userID uint, input *usecase.ImportCollectionInput) (*usecase.ImportCollectionOutput, error) {
+	ret := _m.Called(userID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportCollection")
+	}
+
+	var r0 *usecase.ImportCollectionOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, *usecase.ImportCollectionInput) (*usecase.ImportCollectionOutput, error)); ok {
+		return rf(userID, input)
+	}
+	if rf, ok := ret.Get(0).(func(uint, *usecase.ImportCollectionInput) *usecase.ImportCollectionOutput); ok {
+		r0 = rf(userID, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ImportCollectionOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, *usecase.ImportCollectionInput) error); ok {
+		r1 = rf(userID, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListMembers provides a mock function with given fields: viewerID, collectionID
+func (_m *CollectionUsecase) ListMembers(viewerID uint, collectionID uint) (*usecase.ListCollectionMembersOutput, error) {
+	ret := _m.Called(viewerID, collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMembers")
+	}
+
+	var r0 *usecase.ListCollectionMembersOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint) (*usecase.ListCollectionMembersOutput, error)); ok {
+		return rf(viewerID, collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint) *usecase.ListCollectionMembersOutput); ok {
+		r0 = rf(viewerID, collectionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ListCollectionMembersOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(viewerID, collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListUserCollections provides a mock function with given fields: viewerID, ownerID, limit, offset
+func (_m *CollectionUsecase) ListUserCollections(viewerID uint, ownerID uint, limit *int, offset *int) (*usecase.ListCollectionsOutput, error) {
+	ret := _m.Called(viewerID, ownerID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserCollections")
+	}
+
+	var r0 *usecase.ListCollectionsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, *int, *int) (*usecase.ListCollectionsOutput, error)); ok {
+		return rf(viewerID, ownerID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, *int, *int) *usecase.ListCollectionsOutput); ok {
+		r0 = rf(viewerID, ownerID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ListCollectionsOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, *int, *int) error); ok {
+		r1 = rf(viewerID, ownerID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MoveTrack provides a mock function with given fields: userID, collectionID, musicID, position
+func (_m *CollectionUsecase) MoveTrack(userID uint, collectionID uint, musicID uint, position int) error {
+	ret := _m.Called(userID, collectionID, musicID, position)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveTrack")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint, uint, int) error); ok {
+		r0 = rf(userID, collectionID, musicID, position)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PatchCollection provides a mock function with given fields: userID, collectionID, input
+func (_m *CollectionUsecase) PatchCollection(userID uint, collectionID uint, input *usecase.PatchCollectionInput) (*usecase.CollectionOutput, error) {
+	ret := _m.Called(userID, collectionID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PatchCollection")
+	}
+
+	var r0 *usecase.CollectionOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, *usecase.PatchCollectionInput) (*usecase.CollectionOutput, error)); ok {
+		return rf(userID, collectionID, input)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, *usecase.PatchCollectionInput) *usecase.CollectionOutput); ok {
+		r0 = rf(userID, collectionID, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.CollectionOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, *usecase.PatchCollectionInput) error); ok {
+		r1 = rf(userID, collectionID, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveMember provides a mock function with given fields: userID, collectionID, memberID
+func (_m *CollectionUsecase) RemoveMember(userID uint, collectionID uint, memberID uint) error {
+	ret := _m.Called(userID, collectionID, memberID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveMember")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint, uint) error); ok {
+		r0 = rf(userID, collectionID, memberID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveTrack provides a mock function with given fields: userID, collectionID, musicID
+func (_m *CollectionUsecase) RemoveTrack(userID uint, collectionID uint, musicID uint) error {
+	ret := _m.Called(userID, collectionID, musicID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveTrack")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint, uint) error); ok {
+		r0 = rf(userID, collectionID, musicID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateMemberRole provides a mock function with given fields: userID, collectionID, memberID, role
+func (_m *CollectionUsecase) UpdateMemberRole(userID uint, collectionID uint, memberID uint, role string) (*usecase.CollectionMemberOutput, error) {
+	ret := _m.Called(userID, collectionID, memberID, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMemberRole")
+	}
+
+	var r0 *usecase.CollectionMemberOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, uint, string) (*usecase.CollectionMemberOutput, error)); ok {
+		return rf(userID, collectionID, memberID, role)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, uint, string) *usecase.CollectionMemberOutput); ok {
+		r0 = rf(userID, collectionID, memberID, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.CollectionMemberOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, uint, string) error); ok {
+		r1 = rf(userID, collectionID, memberID, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewCollectionUsecase creates a new instance of CollectionUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCollectionUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CollectionUsecase {
+	mock := &CollectionUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
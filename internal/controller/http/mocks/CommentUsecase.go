@@ -0,0 +1,167 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	usecase "github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CommentUsecase is an autogenerated mock type for the CommentUsecase type
+type CommentUsecase struct {
+	mock.Mock
+}
+
+// CreateComment provides a mock function with given fields: ctx, userID, postID, input
+func (_m *CommentUsecase) CreateComment(ctx context.Context, userID uint, postID uint, input *usecase.CreateCommentInput) (*usecase.CommentOutput, error) {
+	ret := _m.Called(ctx, userID, postID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateComment")
+	}
+
+	var r0 *usecase.CommentOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint, *usecase.CreateCommentInput) (*usecase.CommentOutput, error)); ok {
+		return rf(ctx, userID, postID, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint, *usecase.CreateCommentInput) *usecase.CommentOutput); ok {
+		r0 = rf(ctx, userID, postID, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.CommentOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, uint, *usecase.CreateCommentInput) error); ok {
+		r1 = rf(ctx, userID, postID, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteComment provides a mock function with given fields: userID, commentID
+func (_m *CommentUsecase) DeleteComment(userID uint, commentID uint) error {
+	ret := _m.Called(userID, commentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteComment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint) error); ok {
+		r0 = rf(userID, commentID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetComment provides a mock function with given fields: viewerID, commentID
+func (_m *CommentUsecase) GetComment(viewerID uint, commentID uint) (*usecase.CommentOutput, error) {
+	ret := _m.Called(viewerID, commentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetComment")
+	}
+
+	var r0 *usecase.CommentOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint) (*usecase.CommentOutput, error)); ok {
+		return rf(viewerID, commentID)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint) *usecase.CommentOutput); ok {
+		r0 = rf(viewerID, commentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.CommentOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(viewerID, commentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListComments provides a mock function with given fields: viewerID, postID, limit, offset
+func (_m *CommentUsecase) ListComments(viewerID uint, postID uint, limit *int, offset *int) (*usecase.ListCommentsOutput, error) {
+	ret := _m.Called(viewerID, postID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListComments")
+	}
+
+	var r0 *usecase.ListCommentsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, *int, *int) (*usecase.ListCommentsOutput, error)); ok {
+		return rf(viewerID, postID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, *int, *int) *usecase.ListCommentsOutput); ok {
+		r0 = rf(viewerID, postID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ListCommentsOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, *int, *int) error); ok {
+		r1 = rf(viewerID, postID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PatchComment provides a mock function with given fields: ctx, userID, commentID, input
+func (_m *CommentUsecase) PatchComment(ctx context.Context, userID uint, commentID uint, input *usecase.PatchCommentInput) (*usecase.CommentOutput, error) {
+	ret := _m.Called(ctx, userID, commentID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PatchComment")
+	}
+
+	var r0 *usecase.CommentOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint, *usecase.PatchCommentInput) (*usecase.CommentOutput, error)); ok {
+		return rf(ctx, userID, commentID, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint, *usecase.PatchCommentInput) *usecase.CommentOutput); ok {
+		r0 = rf(ctx, userID, commentID, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.CommentOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, uint, *usecase.PatchCommentInput) error); ok {
+		r1 = rf(ctx, userID, commentID, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewCommentUsecase creates a new instance of CommentUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCommentUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CommentUsecase {
+	mock := &CommentUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
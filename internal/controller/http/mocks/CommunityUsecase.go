@@ -0,0 +1,303 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	usecase "github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CommunityUsecase is an autogenerated mock type for the CommunityUsecase type
+type CommunityUsecase struct {
+	mock.Mock
+}
+
+// AssignModerator provides a mock function with given fields: userID, communityID, memberID
+func (_m *CommunityUsecase) AssignModerator(userID uint, communityID uint, memberID uint) (*usecase.CommunityMemberOutput, error) {
+	ret := _m.Called(userID, communityID, memberID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignModerator")
+	}
+
+	var r0 *usecase.CommunityMemberOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, uint) (*usecase.CommunityMemberOutput, error)); ok {
+		return rf(userID, communityID, memberID)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, uint) *usecase.CommunityMemberOutput); ok {
+		r0 = rf(userID, communityID, memberID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.CommunityMemberOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, uint) error); ok {
+		r1 = rf(userID, communityID, memberID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateCommunity provides a mock function with given fields: userID, input
+func (_m *CommunityUsecase) CreateCommunity(userID uint, input *usecase.CreateCommunityInput) (*usecase.CommunityOutput, error) {
+	ret := _m.Called(userID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCommunity")
+	}
+
+	var r0 *usecase.CommunityOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, *usecase.CreateCommunityInput) (*usecase.CommunityOutput, error)); ok {
+		return rf(userID, input)
+	}
+	if rf, ok := ret.Get(0).(func(uint, *usecase.CreateCommunityInput) *usecase.CommunityOutput); ok {
+		r0 = rf(userID, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.CommunityOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, *usecase.CreateCommunityInput) error); ok {
+		r1 = rf(userID, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCommunity provides a mock function with given fields: viewerID, communityID
+func (_m *CommunityUsecase) GetCommunity(viewerID uint, communityID uint) (*usecase.GetCommunityOutput, error) {
+	ret := _m.Called(viewerID, communityID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCommunity")
+	}
+
+	var r0 *usecase.GetCommunityOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint) (*usecase.GetCommunityOutput, error)); ok {
+		return rf(viewerID, communityID)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint) *usecase.GetCommunityOutput); ok {
+		r0 = rf(viewerID, communityID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.GetCommunityOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(viewerID, communityID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JoinCommunity provides a mock function with given fields: userID, communityID
+func (_m *CommunityUsecase) JoinCommunity(userID uint, communityID uint) (*usecase.CommunityMemberOutput, error) {
+	ret := _m.Called(userID, communityID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for JoinCommunity")
+	}
+
+	var r0 *usecase.CommunityMemberOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint) (*usecase.CommunityMemberOutput, error)); ok {
+		return rf(userID, communityID)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint) *usecase.CommunityMemberOutput); ok {
+		r0 = rf(userID, communityID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.CommunityMemberOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(userID, communityID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LeaveCommunity provides a mock function with given fields: userID, communityID
+func (_m *CommunityUsecase) LeaveCommunity(userID uint, communityID uint) error {
+	ret := _m.Called(userID, communityID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LeaveCommunity")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint) error); ok {
+		r0 = rf(userID, communityID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListCommunities provides a mock function with given fields: genreID, limit, offset
+func (_m *CommunityUsecase) ListCommunities(genreID *uint, limit *int, offset *int) (*usecase.ListCommunitiesOutput, error) {
+	ret := _m.Called(genreID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCommunities")
+	}
+
+	var r0 *usecase.ListCommunitiesOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uint, *int, *int) (*usecase.ListCommunitiesOutput, error)); ok {
+		return rf(genreID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(*uint, *int, *int) *usecase.ListCommunitiesOutput); ok {
+		r0 = rf(genreID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ListCommunitiesOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uint, *int, *int) error); ok {
+		r1 = rf(genreID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListModerators provides a mock function with given fields: communityID
+func (_m *CommunityUsecase) ListModerators(communityID uint) (*usecase.ListCommunityMembersOutput, error) {
+	ret := _m.Called(communityID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListModerators")
+	}
+
+	var r0 *usecase.ListCommunityMembersOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (*usecase.ListCommunityMembersOutput, error)); ok {
+		return rf(communityID)
+	}
+	if rf, ok := ret.Get(0).(func(uint) *usecase.ListCommunityMembersOutput); ok {
+		r0 = rf(communityID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ListCommunityMembersOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(communityID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PatchCommunity provides a mock function with given fields: userID, communityID, input
+func (_m *CommunityUsecase) PatchCommunity(userID uint, communityID uint, input *usecase.PatchCommunityInput) (*usecase.CommunityOutput, error) {
+	ret := _m.Called(userID, communityID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PatchCommunity")
+	}
+
+	var r0 *usecase.CommunityOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, *usecase.PatchCommunityInput) (*usecase.CommunityOutput, error)); ok {
+		return rf(userID, communityID, input)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, *usecase.PatchCommunityInput) *usecase.CommunityOutput); ok {
+		r0 = rf(userID, communityID, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.CommunityOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, *usecase.PatchCommunityInput) error); ok {
+		r1 = rf(userID, communityID, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveModerator provides a mock function with given fields: userID, communityID, memberID
+func (_m *CommunityUsecase) RemoveModerator(userID uint, communityID uint, memberID uint) error {
+	ret := _m.Called(userID, communityID, memberID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveModerator")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint, uint) error); ok {
+		r0 = rf(userID, communityID, memberID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateCommunityImage provides a mock function with given fields: userID, communityID, kind, data
+func (_m *CommunityUsecase) UpdateCommunityImage(userID uint, communityID uint, kind string, data []byte) (*usecase.CommunityOutput, error) {
+	ret := _m.Called(userID, communityID, kind, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCommunityImage")
+	}
+
+	var r0 *usecase.CommunityOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, string, []byte) (*usecase.CommunityOutput, error)); ok {
+		return rf(userID, communityID, kind, data)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, string, []byte) *usecase.CommunityOutput); ok {
+		r0 = rf(userID, communityID, kind, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.CommunityOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, string, []byte) error); ok {
+		r1 = rf(userID, communityID, kind, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewCommunityUsecase creates a new instance of CommunityUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCommunityUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CommunityUsecase {
+	mock := &CommunityUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,57 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	usecase "github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FeedUsecase is an autogenerated mock type for the FeedUsecase type
+type FeedUsecase struct {
+	mock.Mock
+}
+
+// GetFeed provides a mock function with given fields: userID, cursor, limit
+func (_m *FeedUsecase) GetFeed(userID uint, cursor string, limit *int) (*usecase.FeedOutput, error) {
+	ret := _m.Called(userID, cursor, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFeed")
+	}
+
+	var r0 *usecase.FeedOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string, *int) (*usecase.FeedOutput, error)); ok {
+		return rf(userID, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string, *int) *usecase.FeedOutput); ok {
+		r0 = rf(userID, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.FeedOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string, *int) error); ok {
+		r1 = rf(userID, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewFeedUsecase creates a new instance of FeedUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFeedUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FeedUsecase {
+	mock := &FeedUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,147 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	usecase "github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FollowUsecase is an autogenerated mock type for the FollowUsecase type
+type FollowUsecase struct {
+	mock.Mock
+}
+
+// Follow provides a mock function with given fields: userID, targetID
+func (_m *FollowUsecase) Follow(userID uint, targetID uint) (*usecase.FollowOutput, error) {
+	ret := _m.Called(userID, targetID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Follow")
+	}
+
+	var r0 *usecase.FollowOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint) (*usecase.FollowOutput, error)); ok {
+		return rf(userID, targetID)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint) *usecase.FollowOutput); ok {
+		r0 = rf(userID, targetID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.FollowOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(userID, targetID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListFollowers provides a mock function with given fields: viewerID, userID, limit, offset
+func (_m *FollowUsecase) ListFollowers(viewerID uint, userID uint, limit *int, offset *int) (*usecase.ListFollowsOutput, error) {
+	ret := _m.Called(viewerID, userID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFollowers")
+	}
+
+	var r0 *usecase.ListFollowsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, *int, *int) (*usecase.ListFollowsOutput, error)); ok {
+		return rf(viewerID, userID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, *int, *int) *usecase.ListFollowsOutput); ok {
+		r0 = rf(viewerID, userID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ListFollowsOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, *int, *int) error); ok {
+		r1 = rf(viewerID, userID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListFollowing provides a mock function with given fields: viewerID, userID, limit, offset
+func (_m *FollowUsecase) ListFollowing(viewerID uint, userID uint, limit *int, offset *int) (*usecase.ListFollowsOutput, error) {
+	ret := _m.Called(viewerID, userID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFollowing")
+	}
+
+	var r0 *usecase.ListFollowsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, *int, *int) (*usecase.ListFollowsOutput, error)); ok {
+		return rf(viewerID, userID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, *int, *int) *usecase.ListFollowsOutput); ok {
+		r0 = rf(viewerID, userID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ListFollowsOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, *int, *int) error); ok {
+		r1 = rf(viewerID, userID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Unfollow provides a mock function with given fields: userID, targetID
+func (_m *FollowUsecase) Unfollow(userID uint, targetID uint) (*usecase.FollowOutput, error) {
+	ret := _m.Called(userID, targetID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unfollow")
+	}
+
+	var r0 *usecase.FollowOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint) (*usecase.FollowOutput, error)); ok {
+		return rf(userID, targetID)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint) *usecase.FollowOutput); ok {
+		r0 = rf(userID, targetID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.FollowOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(userID, targetID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewFollowUsecase creates a new instance of FollowUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFollowUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FollowUsecase {
+	mock := &FollowUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,148 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	taxonomy "github.com/myjinjin/sonic-odyssey-backend/infrastructure/taxonomy"
+	usecase "github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// GenreUsecase is an autogenerated mock type for the GenreUsecase type
+type GenreUsecase struct {
+	mock.Mock
+}
+
+// GetGenreCommunities provides a mock function with given fields: genreID
+func (_m *GenreUsecase) GetGenreCommunities(genreID uint) (*usecase.GetGenreCommunitiesOutput, error) {
+	ret := _m.Called(genreID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGenreCommunities")
+	}
+
+	var r0 *usecase.GetGenreCommunitiesOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (*usecase.GetGenreCommunitiesOutput, error)); ok {
+		return rf(genreID)
+	}
+	if rf, ok := ret.Get(0).(func(uint) *usecase.GetGenreCommunitiesOutput); ok {
+		r0 = rf(genreID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.GetGenreCommunitiesOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(genreID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetGenreTracks provides a mock function with given fields: genreID, limit, offset
+func (_m *GenreUsecase) GetGenreTracks(genreID uint, limit *int, offset *int) (*usecase.GetGenreTracksOutput, error) {
+	ret := _m.Called(genreID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGenreTracks")
+	}
+
+	var r0 *usecase.GetGenreTracksOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, *int, *int) (*usecase.GetGenreTracksOutput, error)); ok {
+		return rf(genreID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(uint, *int, *int) *usecase.GetGenreTracksOutput); ok {
+		r0 = rf(genreID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.GetGenreTracksOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, *int, *int) error); ok {
+		r1 = rf(genreID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportTaxonomy provides a mock function with given fields: genres
+func (_m *GenreUsecase) ImportTaxonomy(genres []taxonomy.Genre) (*usecase.ImportTaxonomyOutput, error) {
+	ret := _m.Called(genres)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportTaxonomy")
+	}
+
+	var r0 *usecase.ImportTaxonomyOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]taxonomy.Genre) (*usecase.ImportTaxonomyOutput, error)); ok {
+		return rf(genres)
+	}
+	if rf, ok := ret.Get(0).(func([]taxonomy.Genre) *usecase.ImportTaxonomyOutput); ok {
+		r0 = rf(genres)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ImportTaxonomyOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]taxonomy.Genre) error); ok {
+		r1 = rf(genres)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListGenres provides a mock function with given fields:
+func (_m *GenreUsecase) ListGenres() (*usecase.ListGenresOutput, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListGenres")
+	}
+
+	var r0 *usecase.ListGenresOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*usecase.ListGenresOutput, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *usecase.ListGenresOutput); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ListGenresOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewGenreUsecase creates a new instance of GenreUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewGenreUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *GenreUsecase {
+	mock := &GenreUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
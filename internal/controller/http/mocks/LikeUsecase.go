@@ -0,0 +1,237 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	usecase "github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// LikeUsecase is an autogenerated mock type for the LikeUsecase type
+type LikeUsecase struct {
+	mock.Mock
+}
+
+// ClearCommentReaction provides a mock function with given fields: userID, commentID
+func (_m *LikeUsecase) ClearCommentReaction(userID uint, commentID uint) (*usecase.ReactionOutput, error) {
+	ret := _m.Called(userID, commentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClearCommentReaction")
+	}
+
+	var r0 *usecase.ReactionOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint) (*usecase.ReactionOutput, error)); ok {
+		return rf(userID, commentID)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint) *usecase.ReactionOutput); ok {
+		r0 = rf(userID, commentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ReactionOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(userID, commentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClearMusicReaction provides a mock function with given fields: userID, musicID
+func (_m *LikeUsecase) ClearMusicReaction(userID uint, musicID uint) (*usecase.ReactionOutput, error) {
+	ret := _m.Called(userID, musicID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClearMusicReaction")
+	}
+
+	var r0 *usecase.ReactionOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint) (*usecase.ReactionOutput, error)); ok {
+		return rf(userID, musicID)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint) *usecase.ReactionOutput); ok {
+		r0 = rf(userID, musicID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ReactionOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(userID, musicID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClearPostReaction provides a mock function with given fields: userID, postID
+func (_m *LikeUsecase) ClearPostReaction(userID uint, postID uint) (*usecase.ReactionOutput, error) {
+	ret := _m.Called(userID, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClearPostReaction")
+	}
+
+	var r0 *usecase.ReactionOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint) (*usecase.ReactionOutput, error)); ok {
+		return rf(userID, postID)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint) *usecase.ReactionOutput); ok {
+		r0 = rf(userID, postID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ReactionOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(userID, postID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLikedTracks provides a mock function with given fields: userID, limit, offset
+func (_m *LikeUsecase) GetLikedTracks(userID uint, limit *int, offset *int) (*usecase.GetLikedTracksOutput, error) {
+	ret := _m.Called(userID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLikedTracks")
+	}
+
+	var r0 *usecase.GetLikedTracksOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, *int, *int) (*usecase.GetLikedTracksOutput, error)); ok {
+		return rf(userID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(uint, *int, *int) *usecase.GetLikedTracksOutput); ok {
+		r0 = rf(userID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.GetLikedTracksOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, *int, *int) error); ok {
+		r1 = rf(userID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReactToComment provides a mock function with given fields: userID, commentID, liked
+func (_m *LikeUsecase) ReactToComment(userID uint, commentID uint, liked bool) (*usecase.ReactionOutput, error) {
+	ret := _m.Called(userID, commentID, liked)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReactToComment")
+	}
+
+	var r0 *usecase.ReactionOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, bool) (*usecase.ReactionOutput, error)); ok {
+		return rf(userID, commentID, liked)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, bool) *usecase.ReactionOutput); ok {
+		r0 = rf(userID, commentID, liked)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ReactionOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, bool) error); ok {
+		r1 = rf(userID, commentID, liked)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReactToMusic provides a mock function with given fields: userID, musicID, liked
+func (_m *LikeUsecase) ReactToMusic(userID uint, musicID uint, liked bool) (*usecase.ReactionOutput, error) {
+	ret := _m.Called(userID, musicID, liked)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReactToMusic")
+	}
+
+	var r0 *usecase.ReactionOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, bool) (*usecase.ReactionOutput, error)); ok {
+		return rf(userID, musicID, liked)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, bool) *usecase.ReactionOutput); ok {
+		r0 = rf(userID, musicID, liked)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ReactionOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, bool) error); ok {
+		r1 = rf(userID, musicID, liked)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReactToPost provides a mock function with given fields: userID, postID, liked
+func (_m *LikeUsecase) ReactToPost(userID uint, postID uint, liked bool) (*usecase.ReactionOutput, error) {
+	ret := _m.Called(userID, postID, liked)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReactToPost")
+	}
+
+	var r0 *usecase.ReactionOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, bool) (*usecase.ReactionOutput, error)); ok {
+		return rf(userID, postID, liked)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, bool) *usecase.ReactionOutput); ok {
+		r0 = rf(userID, postID, liked)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ReactionOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, bool) error); ok {
+		r1 = rf(userID, postID, liked)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewLikeUsecase creates a new instance of LikeUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLikeUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LikeUsecase {
+	mock := &LikeUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
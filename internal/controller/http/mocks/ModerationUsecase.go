@@ -0,0 +1,207 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	usecase "github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ModerationUsecase is an autogenerated mock type for the ModerationUsecase type
+type ModerationUsecase struct {
+	mock.Mock
+}
+
+// ListAdminReports provides a mock function with given fields: userID, limit, offset
+func (_m *ModerationUsecase) ListAdminReports(userID uint, limit *int, offset *int) (*usecase.ListReportsOutput, error) {
+	ret := _m.Called(userID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAdminReports")
+	}
+
+	var r0 *usecase.ListReportsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, *int, *int) (*usecase.ListReportsOutput, error)); ok {
+		return rf(userID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(uint, *int, *int) *usecase.ListReportsOutput); ok {
+		r0 = rf(userID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ListReportsOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, *int, *int) error); ok {
+		r1 = rf(userID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListCommunityModerationLog provides a mock function with given fields: userID, communityID, limit, offset
+func (_m *ModerationUsecase) ListCommunityModerationLog(userID uint, communityID uint, limit *int, offset *int) (*usecase.ListModerationActionsOutput, error) {
+	ret := _m.Called(userID, communityID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCommunityModerationLog")
+	}
+
+	var r0 *usecase.ListModerationActionsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, *int, *int) (*usecase.ListModerationActionsOutput, error)); ok {
+		return rf(userID, communityID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, *int, *int) *usecase.ListModerationActionsOutput); ok {
+		r0 = rf(userID, communityID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ListModerationActionsOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, *int, *int) error); ok {
+		r1 = rf(userID, communityID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListCommunityReports provides a mock function with given fields: userID, communityID, status, limit, offset
+func (_m *ModerationUsecase) ListCommunityReports(userID uint, communityID uint, status string, limit *int, offset *int) (*usecase.ListReportsOutput, error) {
+	ret := _m.Called(userID, communityID, status, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCommunityReports")
+	}
+
+	var r0 *usecase.ListReportsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, string, *int, *int) (*usecase.ListReportsOutput, error)); ok {
+		return rf(userID, communityID, status, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, string, *int, *int) *usecase.ListReportsOutput); ok {
+		r0 = rf(userID, communityID, status, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ListReportsOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, string, *int, *int) error); ok {
+		r1 = rf(userID, communityID, status, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListMyModerationLog provides a mock function with given fields: userID, limit, offset
+func (_m *ModerationUsecase) ListMyModerationLog(userID uint, limit *int, offset *int) (*usecase.ListModerationActionsOutput, error) {
+	ret := _m.Called(userID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMyModerationLog")
+	}
+
+	var r0 *usecase.ListModerationActionsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, *int, *int) (*usecase.ListModerationActionsOutput, error)); ok {
+		return rf(userID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(uint, *int, *int) *usecase.ListModerationActionsOutput); ok {
+		r0 = rf(userID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ListModerationActionsOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, *int, *int) error); ok {
+		r1 = rf(userID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReportContent provides a mock function with given fields: userID, input
+func (_m *ModerationUsecase) ReportContent(userID uint, input *usecase.CreateReportInput) (*usecase.ReportOutput, error) {
+	ret := _m.Called(userID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReportContent")
+	}
+
+	var r0 *usecase.ReportOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, *usecase.CreateReportInput) (*usecase.ReportOutput, error)); ok {
+		return rf(userID, input)
+	}
+	if rf, ok := ret.Get(0).(func(uint, *usecase.CreateReportInput) *usecase.ReportOutput); ok {
+		r0 = rf(userID, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ReportOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, *usecase.CreateReportInput) error); ok {
+		r1 = rf(userID, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TakeAction provides a mock function with given fields: userID, reportID, input
+func (_m *ModerationUsecase) TakeAction(userID uint, reportID uint, input *usecase.ModerationActionInput) (*usecase.ModerationActionOutput, error) {
+	ret := _m.Called(userID, reportID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TakeAction")
+	}
+
+	var r0 *usecase.ModerationActionOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, *usecase.ModerationActionInput) (*usecase.ModerationActionOutput, error)); ok {
+		return rf(userID, reportID, input)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, *usecase.ModerationActionInput) *usecase.ModerationActionOutput); ok {
+		r0 = rf(userID, reportID, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ModerationActionOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, *usecase.ModerationActionInput) error); ok {
+		r1 = rf(userID, reportID, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewModerationUsecase creates a new instance of ModerationUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewModerationUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ModerationUsecase {
+	mock := &ModerationUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -14,6 +14,96 @@ type MusicUsecase struct {
 	mock.Mock
 }
 
+// GetAlbum provides a mock function with given fields: ctx, id
+func (_m *MusicUsecase) GetAlbum(ctx context.Context, id string) (*usecase.GetAlbumOutput, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAlbum")
+	}
+
+	var r0 *usecase.GetAlbumOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*usecase.GetAlbumOutput, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *usecase.GetAlbumOutput); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.GetAlbumOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetArtist provides a mock function with given fields: ctx, id
+func (_m *MusicUsecase) GetArtist(ctx context.Context, id string) (*usecase.GetArtistOutput, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetArtist")
+	}
+
+	var r0 *usecase.GetArtistOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*usecase.GetArtistOutput, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *usecase.GetArtistOutput); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.GetArtistOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTrack provides a mock function with given fields: ctx, userID, id
+func (_m *MusicUsecase) GetTrack(ctx context.Context, userID uint, id string) (*usecase.GetTrackOutput, error) {
+	ret := _m.Called(ctx, userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTrack")
+	}
+
+	var r0 *usecase.GetTrackOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, string) (*usecase.GetTrackOutput, error)); ok {
+		return rf(ctx, userID, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, string) *usecase.GetTrackOutput); ok {
+		r0 = rf(ctx, userID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.GetTrackOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, string) error); ok {
+		r1 = rf(ctx, userID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SearchTrack provides a mock function with given fields: ctx, keyword, limit, offset
 func (_m *MusicUsecase) SearchTrack(ctx context.Context, keyword string, limit *int, offset *int) (*usecase.SearchTrackOutput, error) {
 	ret := _m.Called(ctx, keyword, limit, offset)
@@ -44,6 +134,36 @@ func (_m *MusicUsecase) SearchTrack(ctx context.Context, keyword string, limit *
 	return r0, r1
 }
 
+// SyncLastfm provides a mock function with given fields: ctx, musicID
+func (_m *MusicUsecase) SyncLastfm(ctx context.Context, musicID uint) (*usecase.SyncLastfmOutput, error) {
+	ret := _m.Called(ctx, musicID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SyncLastfm")
+	}
+
+	var r0 *usecase.SyncLastfmOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (*usecase.SyncLastfmOutput, error)); ok {
+		return rf(ctx, musicID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) *usecase.SyncLastfmOutput); ok {
+		r0 = rf(ctx, musicID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.SyncLastfmOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, musicID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewMusicUsecase creates a new instance of MusicUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMusicUsecase(t interface {
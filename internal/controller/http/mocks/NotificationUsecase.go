@@ -0,0 +1,147 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	usecase "github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NotificationUsecase is an autogenerated mock type for the NotificationUsecase type
+type NotificationUsecase struct {
+	mock.Mock
+}
+
+// GetPreferences provides a mock function with given fields: userID
+func (_m *NotificationUsecase) GetPreferences(userID uint) (*usecase.NotificationPreferencesOutput, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPreferences")
+	}
+
+	var r0 *usecase.NotificationPreferencesOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (*usecase.NotificationPreferencesOutput, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(uint) *usecase.NotificationPreferencesOutput); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.NotificationPreferencesOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListNotifications provides a mock function with given fields: userID, unreadOnly, limit, offset
+func (_m *NotificationUsecase) ListNotifications(userID uint, unreadOnly bool, limit *int, offset *int) (*usecase.ListNotificationsOutput, error) {
+	ret := _m.Called(userID, unreadOnly, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListNotifications")
+	}
+
+	var r0 *usecase.ListNotificationsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, bool, *int, *int) (*usecase.ListNotificationsOutput, error)); ok {
+		return rf(userID, unreadOnly, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(uint, bool, *int, *int) *usecase.ListNotificationsOutput); ok {
+		r0 = rf(userID, unreadOnly, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ListNotificationsOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, bool, *int, *int) error); ok {
+		r1 = rf(userID, unreadOnly, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkRead provides a mock function with given fields: userID, input
+func (_m *NotificationUsecase) MarkRead(userID uint, input *usecase.MarkNotificationsReadInput) (*usecase.MarkNotificationsReadOutput, error) {
+	ret := _m.Called(userID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkRead")
+	}
+
+	var r0 *usecase.MarkNotificationsReadOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, *usecase.MarkNotificationsReadInput) (*usecase.MarkNotificationsReadOutput, error)); ok {
+		return rf(userID, input)
+	}
+	if rf, ok := ret.Get(0).(func(uint, *usecase.MarkNotificationsReadInput) *usecase.MarkNotificationsReadOutput); ok {
+		r0 = rf(userID, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.MarkNotificationsReadOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, *usecase.MarkNotificationsReadInput) error); ok {
+		r1 = rf(userID, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdatePreferences provides a mock function with given fields: userID, input
+func (_m *NotificationUsecase) UpdatePreferences(userID uint, input []usecase.UpdateNotificationPreferenceInput) (*usecase.NotificationPreferencesOutput, error) {
+	ret := _m.Called(userID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePreferences")
+	}
+
+	var r0 *usecase.NotificationPreferencesOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, []usecase.UpdateNotificationPreferenceInput) (*usecase.NotificationPreferencesOutput, error)); ok {
+		return rf(userID, input)
+	}
+	if rf, ok := ret.Get(0).(func(uint, []usecase.UpdateNotificationPreferenceInput) *usecase.NotificationPreferencesOutput); ok {
+		r0 = rf(userID, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.NotificationPreferencesOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, []usecase.UpdateNotificationPreferenceInput) error); ok {
+		r1 = rf(userID, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewNotificationUsecase creates a new instance of NotificationUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNotificationUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NotificationUsecase {
+	mock := &NotificationUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
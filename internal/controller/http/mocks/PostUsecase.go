@@ -0,0 +1,227 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	usecase "github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PostUsecase is an autogenerated mock type for the PostUsecase type
+type PostUsecase struct {
+	mock.Mock
+}
+
+// CreatePost provides a mock function with given fields: ctx, userID, communityID, input
+func (_m *PostUsecase) CreatePost(ctx context.Context, userID uint, communityID uint, input *usecase.CreatePostInput) (*usecase.PostOutput, error) {
+	ret := _m.Called(ctx, userID, communityID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePost")
+	}
+
+	var r0 *usecase.PostOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint, *usecase.CreatePostInput) (*usecase.PostOutput, error)); ok {
+		return rf(ctx, userID, communityID, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint, *usecase.CreatePostInput) *usecase.PostOutput); ok {
+		r0 = rf(ctx, userID, communityID, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.PostOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, uint, *usecase.CreatePostInput) error); ok {
+		r1 = rf(ctx, userID, communityID, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeletePost provides a mock function with given fields: userID, postID
+func (_m *PostUsecase) DeletePost(userID uint, postID uint) error {
+	ret := _m.Called(userID, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePost")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint) error); ok {
+		r0 = rf(userID, postID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetPost provides a mock function with given fields: postID
+func (_m *PostUsecase) GetPost(postID uint) (*usecase.PostOutput, error) {
+	ret := _m.Called(postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPost")
+	}
+
+	var r0 *usecase.PostOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (*usecase.PostOutput, error)); ok {
+		return rf(postID)
+	}
+	if rf, ok := ret.Get(0).(func(uint) *usecase.PostOutput); ok {
+		r0 = rf(postID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.PostOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(postID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListCommunityPosts provides a mock function with given fields: viewerID, communityID, sort, limit, offset
+func (_m *PostUsecase) ListCommunityPosts(viewerID uint, communityID uint, sort string, limit *int, offset *int) (*usecase.ListPostsOutput, error) {
+	ret := _m.Called(viewerID, communityID, sort, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCommunityPosts")
+	}
+
+	var r0 *usecase.ListPostsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, string, *int, *int) (*usecase.ListPostsOutput, error)); ok {
+		return rf(viewerID, communityID, sort, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, string, *int, *int) *usecase.ListPostsOutput); ok {
+		r0 = rf(viewerID, communityID, sort, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ListPostsOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, string, *int, *int) error); ok {
+		r1 = rf(viewerID, communityID, sort, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPosts provides a mock function with given fields: viewerID, sort, limit, offset
+func (_m *PostUsecase) ListPosts(viewerID uint, sort string, limit *int, offset *int) (*usecase.ListPostsOutput, error) {
+	ret := _m.Called(viewerID, sort, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPosts")
+	}
+
+	var r0 *usecase.ListPostsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string, *int, *int) (*usecase.ListPostsOutput, error)); ok {
+		return rf(viewerID, sort, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string, *int, *int) *usecase.ListPostsOutput); ok {
+		r0 = rf(viewerID, sort, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ListPostsOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string, *int, *int) error); ok {
+		r1 = rf(viewerID, sort, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PatchPost provides a mock function with given fields: ctx, userID, postID, input
+func (_m *PostUsecase) PatchPost(ctx context.Context, userID uint, postID uint, input *usecase.PatchPostInput) (*usecase.PostOutput, error) {
+	ret := _m.Called(ctx, userID, postID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PatchPost")
+	}
+
+	var r0 *usecase.PostOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint, *usecase.PatchPostInput) (*usecase.PostOutput, error)); ok {
+		return rf(ctx, userID, postID, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint, *usecase.PatchPostInput) *usecase.PostOutput); ok {
+		r0 = rf(ctx, userID, postID, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.PostOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, uint, *usecase.PatchPostInput) error); ok {
+		r1 = rf(ctx, userID, postID, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchPosts provides a mock function with given fields: viewerID, input, limit, offset
+func (_m *PostUsecase) SearchPosts(viewerID uint, input *usecase.SearchPostsInput, limit *int, offset *int) (*usecase.ListPostsOutput, error) {
+	ret := _m.Called(viewerID, input, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchPosts")
+	}
+
+	var r0 *usecase.ListPostsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, *usecase.SearchPostsInput, *int, *int) (*usecase.ListPostsOutput, error)); ok {
+		return rf(viewerID, input, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(uint, *usecase.SearchPostsInput, *int, *int) *usecase.ListPostsOutput); ok {
+		r0 = rf(viewerID, input, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ListPostsOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, *usecase.SearchPostsInput, *int, *int) error); ok {
+		r1 = rf(viewerID, input, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewPostUsecase creates a new instance of PostUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPostUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PostUsecase {
+	mock := &PostUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,57 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	usecase "github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ProfileUsecase is an autogenerated mock type for the ProfileUsecase type
+type ProfileUsecase struct {
+	mock.Mock
+}
+
+// GetPublicProfile provides a mock function with given fields: viewerID, nickname
+func (_m *ProfileUsecase) GetPublicProfile(viewerID uint, nickname string) (*usecase.PublicProfileOutput, error) {
+	ret := _m.Called(viewerID, nickname)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPublicProfile")
+	}
+
+	var r0 *usecase.PublicProfileOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string) (*usecase.PublicProfileOutput, error)); ok {
+		return rf(viewerID, nickname)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string) *usecase.PublicProfileOutput); ok {
+		r0 = rf(viewerID, nickname)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.PublicProfileOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string) error); ok {
+		r1 = rf(viewerID, nickname)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewProfileUsecase creates a new instance of ProfileUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewProfileUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ProfileUsecase {
+	mock := &ProfileUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,227 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	usecase "github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SpotifyUsecase is an autogenerated mock type for the SpotifyUsecase type
+type SpotifyUsecase struct {
+	mock.Mock
+}
+
+// CompleteLink provides a mock function with given fields: ctx, userID, state, code
+func (_m *SpotifyUsecase) CompleteLink(ctx context.Context, userID uint, state string, code string) (*usecase.CompleteSpotifyLinkOutput, error) {
+	ret := _m.Called(ctx, userID, state, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteLink")
+	}
+
+	var r0 *usecase.CompleteSpotifyLinkOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, string, string) (*usecase.CompleteSpotifyLinkOutput, error)); ok {
+		return rf(ctx, userID, state, code)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, string, string) *usecase.CompleteSpotifyLinkOutput); ok {
+		r0 = rf(ctx, userID, state, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.CompleteSpotifyLinkOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, string, string) error); ok {
+		r1 = rf(ctx, userID, state, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportCollection provides a mock function with given fields: ctx, userID, collectionID
+func (_m *SpotifyUsecase) ExportCollection(ctx context.Context, userID uint, collectionID uint) (*usecase.CollectionSyncOutput, error) {
+	ret := _m.Called(ctx, userID, collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportCollection")
+	}
+
+	var r0 *usecase.CollectionSyncOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint) (*usecase.CollectionSyncOutput, error)); ok {
+		return rf(ctx, userID, collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint) *usecase.CollectionSyncOutput); ok {
+		r0 = rf(ctx, userID, collectionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.CollectionSyncOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, uint) error); ok {
+		r1 = rf(ctx, userID, collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetImportJob provides a mock function with given fields: userID, jobID
+func (_m *SpotifyUsecase) GetImportJob(userID uint, jobID uint) (*usecase.SpotifyImportJobOutput, error) {
+	ret := _m.Called(userID, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetImportJob")
+	}
+
+	var r0 *usecase.SpotifyImportJobOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint) (*usecase.SpotifyImportJobOutput, error)); ok {
+		return rf(userID, jobID)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint) *usecase.SpotifyImportJobOutput); ok {
+		r0 = rf(userID, jobID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.SpotifyImportJobOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(userID, jobID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReconcileCollections provides a mock function with given fields: ctx
+func (_m *SpotifyUsecase) ReconcileCollections(ctx context.Context) (*usecase.ReconcileCollectionsOutput, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReconcileCollections")
+	}
+
+	var r0 *usecase.ReconcileCollectionsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*usecase.ReconcileCollectionsOutput, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *usecase.ReconcileCollectionsOutput); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ReconcileCollectionsOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StartImport provides a mock function with given fields: userID
+func (_m *SpotifyUsecase) StartImport(userID uint) (*usecase.SpotifyImportJobOutput, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartImport")
+	}
+
+	var r0 *usecase.SpotifyImportJobOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (*usecase.SpotifyImportJobOutput, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(uint) *usecase.SpotifyImportJobOutput); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.SpotifyImportJobOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StartLink provides a mock function with given fields: userID
+func (_m *SpotifyUsecase) StartLink(userID uint) (*usecase.StartSpotifyLinkOutput, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartLink")
+	}
+
+	var r0 *usecase.StartSpotifyLinkOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (*usecase.StartSpotifyLinkOutput, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(uint) *usecase.StartSpotifyLinkOutput); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.StartSpotifyLinkOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Unlink provides a mock function with given fields: userID
+func (_m *SpotifyUsecase) Unlink(userID uint) error {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unlink")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint) error); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewSpotifyUsecase creates a new instance of SpotifyUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSpotifyUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SpotifyUsecase {
+	mock := &SpotifyUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,225 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	usecase "github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TopsterUsecase is an autogenerated mock type for the TopsterUsecase type
+type TopsterUsecase struct {
+	mock.Mock
+}
+
+// CreateTopster provides a mock function with given fields: userID, input
+func (_m *TopsterUsecase) CreateTopster(userID uint, input *usecase.CreateTopsterInput) (*usecase.TopsterOutput, error) {
+	ret := _m.Called(userID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTopster")
+	}
+
+	var r0 *usecase.TopsterOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, *usecase.CreateTopsterInput) (*usecase.TopsterOutput, error)); ok {
+		return rf(userID, input)
+	}
+	if rf, ok := ret.Get(0).(func(uint, *usecase.CreateTopsterInput) *usecase.TopsterOutput); ok {
+		r0 = rf(userID, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.TopsterOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, *usecase.CreateTopsterInput) error); ok {
+		r1 = rf(userID, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteTopster provides a mock function with given fields: userID, topsterID
+func (_m *TopsterUsecase) DeleteTopster(userID uint, topsterID uint) error {
+	ret := _m.Called(userID, topsterID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTopster")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint) error); ok {
+		r0 = rf(userID, topsterID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetTopster provides a mock function with given fields: viewerID, topsterID
+func (_m *TopsterUsecase) GetTopster(viewerID uint, topsterID uint) (*usecase.TopsterOutput, error) {
+	ret := _m.Called(viewerID, topsterID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTopster")
+	}
+
+	var r0 *usecase.TopsterOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint) (*usecase.TopsterOutput, error)); ok {
+		return rf(viewerID, topsterID)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint) *usecase.TopsterOutput); ok {
+		r0 = rf(viewerID, topsterID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.TopsterOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(viewerID, topsterID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListUserTopsters provides a mock function with given fields: viewerID, ownerID, limit, offset
+func (_m *TopsterUsecase) ListUserTopsters(viewerID uint, ownerID uint, limit *int, offset *int) (*usecase.ListTopstersOutput, error) {
+	ret := _m.Called(viewerID, ownerID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserTopsters")
+	}
+
+	var r0 *usecase.ListTopstersOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, *int, *int) (*usecase.ListTopstersOutput, error)); ok {
+		return rf(viewerID, ownerID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, *int, *int) *usecase.ListTopstersOutput); ok {
+		r0 = rf(viewerID, ownerID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.ListTopstersOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, *int, *int) error); ok {
+		r1 = rf(viewerID, ownerID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PatchTopster provides a mock function with given fields: userID, topsterID, input
+func (_m *TopsterUsecase) PatchTopster(userID uint, topsterID uint, input *usecase.PatchTopsterInput) (*usecase.TopsterOutput, error) {
+	ret := _m.Called(userID, topsterID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PatchTopster")
+	}
+
+	var r0 *usecase.TopsterOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, *usecase.PatchTopsterInput) (*usecase.TopsterOutput, error)); ok {
+		return rf(userID, topsterID, input)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, *usecase.PatchTopsterInput) *usecase.TopsterOutput); ok {
+		r0 = rf(userID, topsterID, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.TopsterOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, *usecase.PatchTopsterInput) error); ok {
+		r1 = rf(userID, topsterID, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreviewAutofill provides a mock function with given fields: userID, topsterID, input
+func (_m *TopsterUsecase) PreviewAutofill(userID uint, topsterID uint, input *usecase.AutofillTopsterInput) (*usecase.TopsterOutput, error) {
+	ret := _m.Called(userID, topsterID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PreviewAutofill")
+	}
+
+	var r0 *usecase.TopsterOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, *usecase.AutofillTopsterInput) (*usecase.TopsterOutput, error)); ok {
+		return rf(userID, topsterID, input)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, *usecase.AutofillTopsterInput) *usecase.TopsterOutput); ok {
+		r0 = rf(userID, topsterID, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.TopsterOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, *usecase.AutofillTopsterInput) error); ok {
+		r1 = rf(userID, topsterID, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateLayout provides a mock function with given fields: userID, topsterID, albums
+func (_m *TopsterUsecase) UpdateLayout(userID uint, topsterID uint, albums []usecase.TopsterAlbumInput) (*usecase.TopsterOutput, error) {
+	ret := _m.Called(userID, topsterID, albums)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLayout")
+	}
+
+	var r0 *usecase.TopsterOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, []usecase.TopsterAlbumInput) (*usecase.TopsterOutput, error)); ok {
+		return rf(userID, topsterID, albums)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, []usecase.TopsterAlbumInput) *usecase.TopsterOutput); ok {
+		r0 = rf(userID, topsterID, albums)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*usecase.TopsterOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, []usecase.TopsterAlbumInput) error); ok {
+		r1 = rf(userID, topsterID, albums)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewTopsterUsecase creates a new instance of TopsterUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTopsterUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TopsterUsecase {
+	mock := &TopsterUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,187 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/auth"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+)
+
+type BlockController interface {
+	Block(c *gin.Context)
+	Unblock(c *gin.Context)
+	ListBlocked(c *gin.Context)
+	Mute(c *gin.Context)
+	Unmute(c *gin.Context)
+	ListMuted(c *gin.Context)
+}
+
+type blockController struct {
+	blockUsecase usecase.BlockUsecase
+	jwtAuth      *auth.JWTMiddleware
+}
+
+func NewBlockController(blockUsecase usecase.BlockUsecase, jwtAuth *auth.JWTMiddleware) BlockController {
+	return &blockController{
+		blockUsecase: blockUsecase,
+		jwtAuth:      jwtAuth,
+	}
+}
+
+// Block godoc
+// @Summary      Block user
+// @Description  유저 차단. 서로의 게시글, 댓글, 팔로워 목록, 검색 결과, 프로필이 보이지 않게 되고, 서로의 팔로우는 해제되며, 팔로우와 댓글 답글이 불가능해짐
+// @Tags         users, blocks
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "User ID"
+// @Security     BearerAuth
+// @Success      201  {object}  BlockResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      409  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/users/{id}/block [post]
+func (b *blockController) Block(c *gin.Context) {
+	if targetID, ok := b.apply(c, b.blockUsecase.Block); ok {
+		c.JSON(http.StatusCreated, BlockResponse{UserID: targetID, Blocked: true})
+	}
+}
+
+// Unblock godoc
+// @Summary      Unblock user
+// @Description  유저 차단 해제
+// @Tags         users, blocks
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "User ID"
+// @Security     BearerAuth
+// @Success      200  {object}  BlockResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/users/{id}/block [delete]
+func (b *blockController) Unblock(c *gin.Context) {
+	if targetID, ok := b.apply(c, b.blockUsecase.Unblock); ok {
+		c.JSON(http.StatusOK, BlockResponse{UserID: targetID, Blocked: false})
+	}
+}
+
+// ListBlocked godoc
+// @Summary      List blocked users
+// @Description  내가 차단한 유저 목록 조회 (최근 차단순)
+// @Tags         users, blocks
+// @Accept       json
+// @Produce      json
+// @Param request query ListRestrictedUsersRequest false "ListRestrictedUsers Request"
+// @Security     BearerAuth
+// @Success      200  {object}  ListRestrictedUsersResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/users/me/blocks [get]
+func (b *blockController) ListBlocked(c *gin.Context) {
+	b.list(c, b.blockUsecase.ListBlocked)
+}
+
+// Mute godoc
+// @Summary      Mute user
+// @Description  유저 뮤트. 뮤트한 유저의 게시글은 내 피드(전체 게시글 목록)에서만 숨겨짐
+// @Tags         users, blocks
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "User ID"
+// @Security     BearerAuth
+// @Success      201  {object}  MuteResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      409  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/users/{id}/mute [post]
+func (b *blockController) Mute(c *gin.Context) {
+	if targetID, ok := b.apply(c, b.blockUsecase.Mute); ok {
+		c.JSON(http.StatusCreated, MuteResponse{UserID: targetID, Muted: true})
+	}
+}
+
+// Unmute godoc
+// @Summary      Unmute user
+// @Description  유저 뮤트 해제
+// @Tags         users, blocks
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "User ID"
+// @Security     BearerAuth
+// @Success      200  {object}  MuteResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/users/{id}/mute [delete]
+func (b *blockController) Unmute(c *gin.Context) {
+	if targetID, ok := b.apply(c, b.blockUsecase.Unmute); ok {
+		c.JSON(http.StatusOK, MuteResponse{UserID: targetID, Muted: false})
+	}
+}
+
+// ListMuted godoc
+// @Summary      List muted users
+// @Description  내가 뮤트한 유저 목록 조회 (최근 뮤트순)
+// @Tags         users, blocks
+// @Accept       json
+// @Produce      json
+// @Param request query ListRestrictedUsersRequest false "ListRestrictedUsers Request"
+// @Security     BearerAuth
+// @Success      200  {object}  ListRestrictedUsersResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/users/me/mutes [get]
+func (b *blockController) ListMuted(c *gin.Context) {
+	b.list(c, b.blockUsecase.ListMuted)
+}
+
+// apply runs the block or mute change against the user in the path. It
+// returns false after writing the error response when the change failed.
+func (b *blockController) apply(c *gin.Context, change func(userID, targetID uint) error) (uint, bool) {
+	var uri UserURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return 0, false
+	}
+
+	payload := auth.GetUserPayload(c, b.jwtAuth.GinJWTMiddleware)
+	if err := change(payload.UserID, uri.ID); err != nil {
+		HandleError(c, err)
+		return 0, false
+	}
+	return uri.ID, true
+}
+
+func (b *blockController) list(c *gin.Context, list func(userID uint, limit, offset *int) (*usecase.ListRestrictedUsersOutput, error)) {
+	var req ListRestrictedUsersRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, b.jwtAuth.GinJWTMiddleware)
+	output, err := list(payload.UserID, req.Limit, req.Offset)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	users := make([]RestrictedUserResponse, len(output.Users))
+	for i, u := range output.Users {
+		users[i] = RestrictedUserResponse{
+			UserID:          u.UserID,
+			Nickname:        u.Nickname,
+			ProfileImageURL: u.ProfileImageURL,
+			Since:           u.Since,
+		}
+	}
+	c.JSON(http.StatusOK, ListRestrictedUsersResponse{Users: users, Total: output.Total})
+}
@@ -0,0 +1,103 @@
+package v1
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/auth"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockController_Block(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockBlockUsecase.Mock.ExpectedCalls = nil }()
+
+		mockBlockUsecase.On("Block", uint(1), uint(2)).Return(nil)
+
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/users/2/block", nil)
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		var res BlockResponse
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, BlockResponse{UserID: 2, Blocked: true}, res)
+		mockBlockUsecase.AssertExpectations(t)
+	})
+
+	t.Run("Self", func(t *testing.T) {
+		defer func() { mockBlockUsecase.Mock.ExpectedCalls = nil }()
+
+		mockBlockUsecase.On("Block", uint(1), uint(1)).Return(usecase.ErrCannotBlockSelf)
+
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/users/1/block", nil)
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestBlockController_Unmute(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("NotMuted", func(t *testing.T) {
+		defer func() { mockBlockUsecase.Mock.ExpectedCalls = nil }()
+
+		mockBlockUsecase.On("Unmute", uint(1), uint(2)).Return(usecase.ErrNotMuted)
+
+		req, _ := http.NewRequest(http.MethodDelete, "/api/v1/users/2/mute", nil)
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestBlockController_ListBlocked(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockBlockUsecase.Mock.ExpectedCalls = nil }()
+
+		limit := 10
+		mockBlockUsecase.On("ListBlocked", uint(1), &limit, (*int)(nil)).Return(&usecase.ListRestrictedUsersOutput{
+			Users: []usecase.RestrictedUserOutput{{UserID: 2, Nickname: "blocked"}},
+			Total: 1,
+		}, nil)
+
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/users/me/blocks?limit=10", nil)
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		var res ListRestrictedUsersResponse
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, 1, res.Total)
+		assert.Len(t, res.Users, 1)
+		assert.Equal(t, "blocked", res.Users[0].Nickname)
+		mockBlockUsecase.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,682 @@
+package v1
+
+import (
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/auth"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+	"github.com/myjinjin/sonic-odyssey-backend/pkg/utils"
+)
+
+type CollectionController interface {
+	CreateCollection(c *gin.Context)
+	GetCollection(c *gin.Context)
+	PatchCollection(c *gin.Context)
+	DeleteCollection(c *gin.Context)
+	AddTracks(c *gin.Context)
+	RemoveTrack(c *gin.Context)
+	MoveTrack(c *gin.Context)
+	ListUserCollections(c *gin.Context)
+	CreateInvite(c *gin.Context)
+	AcceptInvite(c *gin.Context)
+	ListMembers(c *gin.Context)
+	UpdateMember(c *gin.Context)
+	RemoveMember(c *gin.Context)
+	ExportCollection(c *gin.Context)
+	ImportCollection(c *gin.Context)
+}
+
+type collectionController struct {
+	collectionUsecase usecase.CollectionUsecase
+	jwtAuth           *auth.JWTMiddleware
+}
+
+func NewCollectionController(collectionUsecase usecase.CollectionUsecase, jwtAuth *auth.JWTMiddleware) CollectionController {
+	return &collectionController{
+		collectionUsecase: collectionUsecase,
+		jwtAuth:           jwtAuth,
+	}
+}
+
+// CreateCollection godoc
+// @Summary      Create collection
+// @Description  새 컬렉션 생성 (기본 비공개)
+// @Tags         collections
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param request body CreateCollectionRequest true "CreateCollection Request"
+// @Success      201  {object}  CollectionResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/collections [post]
+func (co *collectionController) CreateCollection(c *gin.Context) {
+	var req CreateCollectionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, co.jwtAuth.GinJWTMiddleware)
+	output, err := co.collectionUsecase.CreateCollection(payload.UserID, &usecase.CreateCollectionInput{
+		Name:        req.Name,
+		Description: req.Description,
+		IsPublic:    req.IsPublic,
+	})
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toCollectionResponse(*output))
+}
+
+// GetCollection godoc
+// @Summary      Get collection
+// @Description  컬렉션과 트랙 목록 조회 (순서대로, 트랙을 추가한 유저 포함). 비공개 컬렉션은 멤버만 조회 가능
+// @Tags         collections
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Collection ID"
+// @Security     BearerAuth
+// @Success      200  {object}  GetCollectionResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/collections/{id} [get]
+func (co *collectionController) GetCollection(c *gin.Context) {
+	var req CollectionURI
+	if err := c.ShouldBindUri(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, co.jwtAuth.GinJWTMiddleware)
+	output, err := co.collectionUsecase.GetCollection(payload.UserID, req.ID)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	tracks := make([]CollectionTrack, len(output.Tracks))
+	for i, t := range output.Tracks {
+		tracks[i] = CollectionTrack{Track: toCatalogTrack(t.Track), AddedBy: t.AddedBy}
+	}
+
+	res := GetCollectionResponse{Collection: toCollectionResponse(output.Collection), Role: output.Role, Tracks: tracks}
+	c.JSON(http.StatusOK, res)
+}
+
+// PatchCollection godoc
+// @Summary      Patch collection
+// @Description  컬렉션 이름, 설명, 공개 여부 수정 (소유자만 가능)
+// @Tags         collections
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Collection ID"
+// @Security     BearerAuth
+// @Param request body PatchCollectionRequest true "PatchCollection Request"
+// @Success      200  {object}  CollectionResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      403  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/collections/{id} [patch]
+func (co *collectionController) PatchCollection(c *gin.Context) {
+	var uri CollectionURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	var req PatchCollectionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	if err := utils.ValidateRequest(&req); err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, co.jwtAuth.GinJWTMiddleware)
+	output, err := co.collectionUsecase.PatchCollection(payload.UserID, uri.ID, &usecase.PatchCollectionInput{
+		Name:        req.Name,
+		Description: req.Description,
+		IsPublic:    req.IsPublic,
+	})
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toCollectionResponse(*output))
+}
+
+// DeleteCollection godoc
+// @Summary      Delete collection
+// @Description  컬렉션 삭제 (소유자만 가능, 연결된 Spotify 플레이리스트는 유지됨)
+// @Tags         collections
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Collection ID"
+// @Security     BearerAuth
+// @Success      200  {object}  DeleteCollectionResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      403  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/collections/{id} [delete]
+func (co *collectionController) DeleteCollection(c *gin.Context) {
+	var req CollectionURI
+	if err := c.ShouldBindUri(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, co.jwtAuth.GinJWTMiddleware)
+	if err := co.collectionUsecase.DeleteCollection(payload.UserID, req.ID); err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, DeleteCollectionResponse{})
+}
+
+// AddTracks godoc
+// @Summary      Add tracks to collection
+// @Description  컬렉션 끝에 트랙 추가 (최대 100개, 요청 순서대로, EDITOR 이상). 이미 있는 트랙은 건너뜀
+// @Tags         collections
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Collection ID"
+// @Security     BearerAuth
+// @Param request body AddCollectionTracksRequest true "AddCollectionTracks Request"
+// @Success      200  {object}  AddCollectionTracksResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      403  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/collections/{id}/tracks [post]
+func (co *collectionController) AddTracks(c *gin.Context) {
+	var uri CollectionURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	var req AddCollectionTracksRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, co.jwtAuth.GinJWTMiddleware)
+	output, err := co.collectionUsecase.AddTracks(payload.UserID, uri.ID, req.MusicIDs)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, AddCollectionTracksResponse{Added: output.Added})
+}
+
+// RemoveTrack godoc
+// @Summary      Remove track from collection
+// @Description  컬렉션에서 트랙 삭제 (EDITOR 이상)
+// @Tags         collections
+// @Accept       json
+// @Produce      json
+// @Param        id        path      int  true  "Collection ID"
+// @Param        music_id  path      int  true  "Music ID"
+// @Security     BearerAuth
+// @Success      200  {object}  RemoveCollectionTrackResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      403  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/collections/{id}/tracks/{music_id} [delete]
+func (co *collectionController) RemoveTrack(c *gin.Context) {
+	var req CollectionTrackURI
+	if err := c.ShouldBindUri(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, co.jwtAuth.GinJWTMiddleware)
+	if err := co.collectionUsecase.RemoveTrack(payload.UserID, req.ID, req.MusicID); err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, RemoveCollectionTrackResponse{})
+}
+
+// MoveTrack godoc
+// @Summary      Move track in collection
+// @Description  컬렉션 내 트랙을 지정한 위치(0부터 시작)로 이동 (EDITOR 이상). 나머지 트랙의 상대 순서는 유지됨
+// @Tags         collections
+// @Accept       json
+// @Produce      json
+// @Param        id        path      int  true  "Collection ID"
+// @Param        music_id  path      int  true  "Music ID"
+// @Security     BearerAuth
+// @Param request body MoveCollectionTrackRequest true "MoveCollectionTrack Request"
+// @Success      200  {object}  MoveCollectionTrackResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      403  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/collections/{id}/tracks/{music_id}/position [put]
+func (co *collectionController) MoveTrack(c *gin.Context) {
+	var uri CollectionTrackURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	var req MoveCollectionTrackRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, co.jwtAuth.GinJWTMiddleware)
+	if err := co.collectionUsecase.MoveTrack(payload.UserID, uri.ID, uri.MusicID, *req.Position); err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MoveCollectionTrackResponse{})
+}
+
+// ListUserCollections godoc
+// @Summary      List user collections
+// @Description  유저의 컬렉션 목록 조회 (최신순). 본인이 아니면 공개 컬렉션만 조회되고, 유저가 컬렉션을 숨기면 빈 목록이 반환됨. 해당 유저와 차단 관계이면 404
+// @Tags         users, collections
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "User ID"
+// @Param request query ListCollectionsRequest false "ListCollections Request"
+// @Security     BearerAuth
+// @Success      200  {object}  ListCollectionsResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/users/{id}/collections [get]
+func (co *collectionController) ListUserCollections(c *gin.Context) {
+	var uri UserURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	var req ListCollectionsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, co.jwtAuth.GinJWTMiddleware)
+	output, err := co.collectionUsecase.ListUserCollections(payload.UserID, uri.ID, req.Limit, req.Offset)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	collections := make([]CollectionResponse, len(output.Collections))
+	for i, col := range output.Collections {
+		collections[i] = toCollectionResponse(col)
+	}
+
+	res := ListCollectionsResponse{Collections: collections, Total: output.Total}
+	c.JSON(http.StatusOK, res)
+}
+
+// CreateInvite godoc
+// @Summary      Create collection invite
+// @Description  컬렉션 초대 링크 생성 (소유자만 가능). 만료 전까지 여러 유저가 사용할 수 있음 (기본 7일, 최대 30일)
+// @Tags         collections
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Collection ID"
+// @Security     BearerAuth
+// @Param request body CreateCollectionInviteRequest true "CreateCollectionInvite Request"
+// @Success      201  {object}  CollectionInviteResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      403  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/collections/{id}/invites [post]
+func (co *collectionController) CreateInvite(c *gin.Context) {
+	var uri CollectionURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	var req CreateCollectionInviteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, co.jwtAuth.GinJWTMiddleware)
+	output, err := co.collectionUsecase.CreateInvite(payload.UserID, uri.ID, &usecase.CreateCollectionInviteInput{
+		Role:      req.Role,
+		ExpiresIn: time.Duration(req.ExpiresInHours) * time.Hour,
+	})
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	res := CollectionInviteResponse{
+		CollectionID: output.CollectionID,
+		Token:        output.Token,
+		Role:         output.Role,
+		ExpiresAt:    output.ExpiresAt,
+	}
+	c.JSON(http.StatusCreated, res)
+}
+
+// AcceptInvite godoc
+// @Summary      Accept collection invite
+// @Description  초대 링크로 컬렉션 멤버로 참여. 이미 멤버인 경우 더 높은 역할일 때만 변경됨
+// @Tags         collections
+// @Accept       json
+// @Produce      json
+// @Param        token  path      string  true  "Invite Token"
+// @Security     BearerAuth
+// @Success      200  {object}  CollectionMember
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/collections/invites/{token}/accept [post]
+func (co *collectionController) AcceptInvite(c *gin.Context) {
+	var req CollectionInviteURI
+	if err := c.ShouldBindUri(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, co.jwtAuth.GinJWTMiddleware)
+	output, err := co.collectionUsecase.AcceptInvite(payload.UserID, req.Token)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toCollectionMember(*output))
+}
+
+// ListMembers godoc
+// @Summary      List collection members
+// @Description  컬렉션 멤버 목록 조회 (참여 순). 비공개 컬렉션은 멤버만 조회 가능
+// @Tags         collections
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Collection ID"
+// @Security     BearerAuth
+// @Success      200  {object}  ListCollectionMembersResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/collections/{id}/members [get]
+func (co *collectionController) ListMembers(c *gin.Context) {
+	var req CollectionURI
+	if err := c.ShouldBindUri(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, co.jwtAuth.GinJWTMiddleware)
+	output, err := co.collectionUsecase.ListMembers(payload.UserID, req.ID)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	members := make([]CollectionMember, len(output.Members))
+	for i, m := range output.Members {
+		members[i] = toCollectionMember(m)
+	}
+
+	c.JSON(http.StatusOK, ListCollectionMembersResponse{Members: members})
+}
+
+// UpdateMember godoc
+// @Summary      Update collection member role
+// @Description  컬렉션 멤버 역할 변경 (소유자만 가능, 소유자 역할은 변경 불가)
+// @Tags         collections
+// @Accept       json
+// @Produce      json
+// @Param        id       path      int  true  "Collection ID"
+// @Param        user_id  path      int  true  "Member User ID"
+// @Security     BearerAuth
+// @Param request body UpdateCollectionMemberRequest true "UpdateCollectionMember Request"
+// @Success      200  {object}  CollectionMember
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      403  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/collections/{id}/members/{user_id} [patch]
+func (co *collectionController) UpdateMember(c *gin.Context) {
+	var uri CollectionMemberURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	var req UpdateCollectionMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, co.jwtAuth.GinJWTMiddleware)
+	output, err := co.collectionUsecase.UpdateMemberRole(payload.UserID, uri.ID, uri.UserID, req.Role)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toCollectionMember(*output))
+}
+
+// RemoveMember godoc
+// @Summary      Remove collection member
+// @Description  컬렉션 멤버 내보내기 (소유자만 가능). 본인 ID를 지정하면 컬렉션에서 나가기 (소유자는 불가)
+// @Tags         collections
+// @Accept       json
+// @Produce      json
+// @Param        id       path      int  true  "Collection ID"
+// @Param        user_id  path      int  true  "Member User ID"
+// @Security     BearerAuth
+// @Success      200  {object}  RemoveCollectionMemberResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      403  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/collections/{id}/members/{user_id} [delete]
+func (co *collectionController) RemoveMember(c *gin.Context) {
+	var req CollectionMemberURI
+	if err := c.ShouldBindUri(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, co.jwtAuth.GinJWTMiddleware)
+	if err := co.collectionUsecase.RemoveMember(payload.UserID, req.ID, req.UserID); err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, RemoveCollectionMemberResponse{})
+}
+
+// ExportCollection godoc
+// @Summary      Export collection
+// @Description  컬렉션을 플레이리스트 파일(m3u8, xspf, jspf, csv)로 내보내기. 컬렉션을 조회할 수 있는 유저만 가능
+// @Tags         collections
+// @Produce      octet-stream
+// @Param        id      path      int     true  "Collection ID"
+// @Param        format  query     string  true  "Playlist format" Enums(m3u8, xspf, jspf, csv)
+// @Security     BearerAuth
+// @Success      200  {file}    file
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/collections/{id}/export [get]
+func (co *collectionController) ExportCollection(c *gin.Context) {
+	var uri CollectionURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	var req ExportCollectionRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, co.jwtAuth.GinJWTMiddleware)
+	output, err := co.collectionUsecase.ExportCollection(payload.UserID, uri.ID, req.Format)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": output.FileName}))
+	c.Data(http.StatusOK, output.ContentType, output.Data)
+}
+
+// maxPlaylistFileSize limits uploaded playlist files, which are read into
+// memory as a whole.
+const maxPlaylistFileSize = 5 << 20
+
+// ImportCollection godoc
+// @Summary      Import collection
+// @Description  플레이리스트 파일(m3u8, xspf, jspf, csv)을 가져와 카탈로그의 트랙과 매칭 (Spotify ID, ISRC, 제목+아티스트 순). collection_id를 지정하면 기존 컬렉션에 추가 (편집자 이상), 없으면 새 컬렉션 생성. 매칭되지 않은 항목은 unresolved로 반환
+// @Tags         collections
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        file           formData  file    true   "Playlist file (최대 5MB)"
+// @Param        format         formData  string  false  "Playlist format (생략 시 파일 확장자로 판단)" Enums(m3u8, m3u, xspf, jspf, csv)
+// @Param        collection_id  formData  int     false  "Collection ID"
+// @Param        name           formData  string  false  "새 컬렉션 이름 (생략 시 플레이리스트 제목)"
+// @Param        is_public      formData  bool    false  "새 컬렉션 공개 여부"
+// @Security     BearerAuth
+// @Success      200  {object}  ImportCollectionResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      403  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      413  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/collections/import [post]
+func (co *collectionController) ImportCollection(c *gin.Context) {
+	var req ImportCollectionRequest
+	if err := c.ShouldBind(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+	if file.Size > maxPlaylistFileSize {
+		HandleError(c, ErrPlaylistFileTooLarge)
+		return
+	}
+	f, err := file.Open()
+	if err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+	defer f.Close()
+	data, err := io.ReadAll(io.LimitReader(f, maxPlaylistFileSize))
+	if err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	format := req.Format
+	if format == "" {
+		format = filepath.Ext(file.Filename)
+	}
+
+	payload := auth.GetUserPayload(c, co.jwtAuth.GinJWTMiddleware)
+	output, err := co.collectionUsecase.ImportCollection(payload.UserID, &usecase.ImportCollectionInput{
+		Format:       format,
+		Data:         data,
+		CollectionID: req.CollectionID,
+		Name:         req.Name,
+		IsPublic:     req.IsPublic,
+	})
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	res := ImportCollectionResponse{
+		CollectionID: output.CollectionID,
+		Total:        output.Total,
+		Added:        output.Added,
+		Matched:      make([]MatchedPlaylistEntry, len(output.Matched)),
+		Unresolved:   make([]UnresolvedPlaylistEntry, len(output.Unresolved)),
+	}
+	for i, m := range output.Matched {
+		res.Matched[i] = MatchedPlaylistEntry{Line: m.Line, MusicID: m.MusicID, MatchedBy: m.MatchedBy}
+	}
+	for i, u := range output.Unresolved {
+		res.Unresolved[i] = UnresolvedPlaylistEntry{Line: u.Line, Title: u.Title, Artist: u.Artist}
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func toCollectionResponse(output usecase.CollectionOutput) CollectionResponse {
+	return CollectionResponse{
+		ID:                output.ID,
+		UserID:            output.UserID,
+		Name:              output.Name,
+		Description:       output.Description,
+		IsPublic:          output.IsPublic,
+		SpotifyPlaylistID: output.SpotifyPlaylistID,
+		CreatedAt:         output.CreatedAt,
+		UpdatedAt:         output.UpdatedAt,
+	}
+}
+
+func toCollectionMember(output usecase.CollectionMemberOutput) CollectionMember {
+	return CollectionMember{
+		CollectionID: output.CollectionID,
+		UserID:       output.UserID,
+		Nickname:     output.Nickname,
+		Role:         output.Role,
+		JoinedAt:     output.JoinedAt,
+	}
+}
@@ -0,0 +1,455 @@
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/auth"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollectionController_CreateCollection(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockCollectionUsecase.Mock.ExpectedCalls = nil }()
+
+		mockCollectionUsecase.On("CreateCollection", uint(1), &usecase.CreateCollectionInput{Name: "Road Trip", IsPublic: true}).
+			Return(&usecase.CollectionOutput{ID: 10, UserID: 1, Name: "Road Trip", IsPublic: true}, nil)
+
+		reqBody, _ := json.Marshal(CreateCollectionRequest{Name: "Road Trip", IsPublic: true})
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/collections", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		var res CollectionResponse
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, uint(10), res.ID)
+		assert.True(t, res.IsPublic)
+		mockCollectionUsecase.AssertExpectations(t)
+	})
+
+	t.Run("MissingName", func(t *testing.T) {
+		reqBody, _ := json.Marshal(CreateCollectionRequest{Description: "Songs for the drive"})
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/collections", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestCollectionController_GetCollection(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockCollectionUsecase.Mock.ExpectedCalls = nil }()
+
+		mockCollectionUsecase.On("GetCollection", uint(1), uint(10)).Return(&usecase.GetCollectionOutput{
+			Collection: usecase.CollectionOutput{ID: 10, UserID: 1, Name: "Road Trip"},
+			Role:       "OWNER",
+			Tracks: []usecase.CollectionTrackOutput{
+				{Track: usecase.CatalogTrack{ID: 3, Title: "One"}},
+				{Track: usecase.CatalogTrack{ID: 4, Title: "Two"}},
+			},
+		}, nil)
+
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/collections/10", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		var res GetCollectionResponse
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "Road Trip", res.Collection.Name)
+		assert.Len(t, res.Tracks, 2)
+		assert.Equal(t, "Two", res.Tracks[1].Track.Title)
+		assert.Equal(t, "OWNER", res.Role)
+		mockCollectionUsecase.AssertExpectations(t)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		defer func() { mockCollectionUsecase.Mock.ExpectedCalls = nil }()
+
+		mockCollectionUsecase.On("GetCollection", uint(1), uint(99)).Return(nil, usecase.ErrCollectionNotFound)
+
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/collections/99", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		mockCollectionUsecase.AssertExpectations(t)
+	})
+}
+
+func TestCollectionController_AddTracks(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockCollectionUsecase.Mock.ExpectedCalls = nil }()
+
+		mockCollectionUsecase.On("AddTracks", uint(1), uint(10), []uint{3, 4}).Return(&usecase.AddCollectionTracksOutput{Added: 2}, nil)
+
+		reqBody, _ := json.Marshal(AddCollectionTracksRequest{MusicIDs: []uint{3, 4}})
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/collections/10/tracks", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		var res AddCollectionTracksResponse
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, 2, res.Added)
+		mockCollectionUsecase.AssertExpectations(t)
+	})
+
+	t.Run("EmptyList", func(t *testing.T) {
+		reqBody, _ := json.Marshal(AddCollectionTracksRequest{MusicIDs: []uint{}})
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/collections/10/tracks", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestCollectionController_MoveTrack(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockCollectionUsecase.Mock.ExpectedCalls = nil }()
+
+		mockCollectionUsecase.On("MoveTrack", uint(1), uint(10), uint(4), 0).Return(nil)
+
+		reqBody, _ := json.Marshal(map[string]int{"position": 0})
+		req, _ := http.NewRequest(http.MethodPut, "/api/v1/collections/10/tracks/4/position", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockCollectionUsecase.AssertExpectations(t)
+	})
+
+	t.Run("MissingPosition", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPut, "/api/v1/collections/10/tracks/4/position", bytes.NewBufferString("{}"))
+		req.Header.Set("Content-Type", "application/json")
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestCollectionController_ListUserCollections(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockCollectionUsecase.Mock.ExpectedCalls = nil }()
+
+		mockCollectionUsecase.On("ListUserCollections", uint(1), uint(2), (*int)(nil), (*int)(nil)).Return(&usecase.ListCollectionsOutput{
+			Collections: []usecase.CollectionOutput{{ID: 10, UserID: 2, Name: "Road Trip", IsPublic: true}},
+			Total:       1,
+		}, nil)
+
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/users/2/collections", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		var res ListCollectionsResponse
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, 1, res.Total)
+		assert.Equal(t, "Road Trip", res.Collections[0].Name)
+		mockCollectionUsecase.AssertExpectations(t)
+	})
+}
+
+func TestCollectionController_CreateInvite(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockCollectionUsecase.Mock.ExpectedCalls = nil }()
+
+		mockCollectionUsecase.On("CreateInvite", uint(1), uint(10), &usecase.CreateCollectionInviteInput{Role: "EDITOR", ExpiresIn: 24 * time.Hour}).
+			Return(&usecase.CollectionInviteOutput{CollectionID: 10, Token: "token", Role: "EDITOR"}, nil)
+
+		reqBody, _ := json.Marshal(CreateCollectionInviteRequest{Role: "EDITOR", ExpiresInHours: 24})
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/collections/10/invites", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		var res CollectionInviteResponse
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, "token", res.Token)
+		mockCollectionUsecase.AssertExpectations(t)
+	})
+
+	t.Run("OwnerRoleRejected", func(t *testing.T) {
+		reqBody, _ := json.Marshal(CreateCollectionInviteRequest{Role: "OWNER"})
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/collections/10/invites", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("NotOwner", func(t *testing.T) {
+		defer func() { mockCollectionUsecase.Mock.ExpectedCalls = nil }()
+
+		mockCollectionUsecase.On("CreateInvite", uint(2), uint(10), &usecase.CreateCollectionInviteInput{Role: "VIEWER"}).
+			Return(nil, usecase.ErrCollectionPermissionDenied)
+
+		reqBody, _ := json.Marshal(CreateCollectionInviteRequest{Role: "VIEWER"})
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/collections/10/invites", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 2})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		mockCollectionUsecase.AssertExpectations(t)
+	})
+}
+
+func TestCollectionController_AcceptInvite(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockCollectionUsecase.Mock.ExpectedCalls = nil }()
+
+		mockCollectionUsecase.On("AcceptInvite", uint(2), "token").
+			Return(&usecase.CollectionMemberOutput{CollectionID: 10, UserID: 2, Role: "EDITOR"}, nil)
+
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/collections/invites/token/accept", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 2})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		var res CollectionMember
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, uint(10), res.CollectionID)
+		assert.Equal(t, "EDITOR", res.Role)
+		mockCollectionUsecase.AssertExpectations(t)
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		defer func() { mockCollectionUsecase.Mock.ExpectedCalls = nil }()
+
+		mockCollectionUsecase.On("AcceptInvite", uint(2), "token").Return(nil, usecase.ErrCollectionInviteExpired)
+
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/collections/invites/token/accept", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 2})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockCollectionUsecase.AssertExpectations(t)
+	})
+}
+
+func TestCollectionController_ExportCollection(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockCollectionUsecase.Mock.ExpectedCalls = nil }()
+
+		mockCollectionUsecase.On("ExportCollection", uint(1), uint(10), "csv").Return(&usecase.ExportCollectionOutput{
+			FileName:    "로드 트립.csv",
+			ContentType: "text/csv; charset=utf-8",
+			Data:        []byte("title,artist,album,isrc,spotify_id\n"),
+		}, nil)
+
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/collections/10/export?format=csv", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/csv; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Equal(t, "attachment; filename*=utf-8''%EB%A1%9C%EB%93%9C%20%ED%8A%B8%EB%A6%BD.csv", w.Header().Get("Content-Disposition"))
+		assert.Equal(t, "title,artist,album,isrc,spotify_id\n", w.Body.String())
+		mockCollectionUsecase.AssertExpectations(t)
+	})
+
+	t.Run("UnknownFormat", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/collections/10/export?format=pls", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestCollectionController_ImportCollection(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	newImportRequest := func(fileName, content string, fields map[string]string) *http.Request {
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		for k, v := range fields {
+			_ = writer.WriteField(k, v)
+		}
+		part, _ := writer.CreateFormFile("file", fileName)
+		_, _ = part.Write([]byte(content))
+		_ = writer.Close()
+
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/collections/import", body)
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+		return req
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockCollectionUsecase.Mock.ExpectedCalls = nil }()
+
+		collectionID := uint(10)
+		mockCollectionUsecase.On("ImportCollection", uint(1), &usecase.ImportCollectionInput{
+			Format:       ".m3u",
+			Data:         []byte("spotify:track:abc\nArtist - Missing.mp3\n"),
+			CollectionID: &collectionID,
+		}).Return(&usecase.ImportCollectionOutput{
+			CollectionID: 10,
+			Total:        2,
+			Added:        1,
+			Matched:      []usecase.MatchedPlaylistEntry{{Line: 1, MusicID: 3, MatchedBy: "spotify_id"}},
+			Unresolved:   []usecase.UnresolvedPlaylistEntry{{Line: 2, Title: "Missing", Artist: "Artist"}},
+		}, nil)
+
+		req := newImportRequest("mix.m3u", "spotify:track:abc\nArtist - Missing.mp3\n", map[string]string{"collection_id": "10"})
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		var res ImportCollectionResponse
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, 1, res.Added)
+		assert.Equal(t, []UnresolvedPlaylistEntry{{Line: 2, Title: "Missing", Artist: "Artist"}}, res.Unresolved)
+		mockCollectionUsecase.AssertExpectations(t)
+	})
+
+	t.Run("MissingFile", func(t *testing.T) {
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		_ = writer.WriteField("format", "csv")
+		_ = writer.Close()
+
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/collections/import", body)
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("UnsupportedFormat", func(t *testing.T) {
+		defer func() { mockCollectionUsecase.Mock.ExpectedCalls = nil }()
+
+		mockCollectionUsecase.On("ImportCollection", uint(1), &usecase.ImportCollectionInput{Format: ".pls", Data: []byte("[playlist]")}).
+			Return(nil, usecase.ErrUnsupportedPlaylistFormat)
+
+		req := newImportRequest("mix.pls", "[playlist]", nil)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockCollectionUsecase.AssertExpectations(t)
+	})
+}
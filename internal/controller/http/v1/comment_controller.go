@@ -0,0 +1,250 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/auth"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+	"github.com/myjinjin/sonic-odyssey-backend/pkg/utils"
+)
+
+type CommentController interface {
+	CreateComment(c *gin.Context)
+	ListComments(c *gin.Context)
+	GetComment(c *gin.Context)
+	PatchComment(c *gin.Context)
+	DeleteComment(c *gin.Context)
+}
+
+type commentController struct {
+	commentUsecase usecase.CommentUsecase
+	jwtAuth        *auth.JWTMiddleware
+}
+
+func NewCommentController(commentUsecase usecase.CommentUsecase, jwtAuth *auth.JWTMiddleware) CommentController {
+	return &commentController{
+		commentUsecase: commentUsecase,
+		jwtAuth:        jwtAuth,
+	}
+}
+
+// CreateComment godoc
+// @Summary      Create comment
+// @Description  게시글에 댓글 작성. parent_id를 지정하면 해당 댓글에 답글 작성 (최대 깊이 제한, 삭제된 댓글에는 답글 불가). music으로 트랙 첨부 가능 (최대 10개). 커뮤니티에서 차단된 사용자와, 게시글 또는 부모 댓글 작성자와 차단 관계인 사용자는 작성 불가. 욕설은 마스킹되고, 링크 스팸으로 의심되면 검토 전까지 숨겨지며, 같은 게시글에 최근 작성한 댓글과 중복되면 거부됨
+// @Tags         posts, comments
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Post ID"
+// @Security     BearerAuth
+// @Param request body CreateCommentRequest true "CreateComment Request"
+// @Success      201  {object}  CommentResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      403  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      409  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/posts/{id}/comments [post]
+func (co *commentController) CreateComment(c *gin.Context) {
+	var uri PostURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	var req CreateCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, co.jwtAuth.GinJWTMiddleware)
+	output, err := co.commentUsecase.CreateComment(c.Request.Context(), payload.UserID, uri.ID, &usecase.CreateCommentInput{
+		Content:  req.Content,
+		ParentID: req.ParentID,
+		Music:    req.Music,
+	})
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toCommentResponse(*output))
+}
+
+// ListComments godoc
+// @Summary      List comments
+// @Description  게시글의 최상위 댓글 목록 조회 (오래된 순). 각 댓글의 답글은 replies에 트리 형태로 포함되며, total은 최상위 댓글 수. 차단 관계인 유저의 댓글은 답글이 유지되도록 "[blocked]"로 표시
+// @Tags         posts, comments
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Post ID"
+// @Param request query ListCommentsRequest false "ListComments Request"
+// @Security     BearerAuth
+// @Success      200  {object}  ListCommentsResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/posts/{id}/comments [get]
+func (co *commentController) ListComments(c *gin.Context) {
+	var uri PostURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	var req ListCommentsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, co.jwtAuth.GinJWTMiddleware)
+	output, err := co.commentUsecase.ListComments(payload.UserID, uri.ID, req.Limit, req.Offset)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	comments := make([]CommentResponse, len(output.Comments))
+	for i, comment := range output.Comments {
+		comments[i] = toCommentResponse(comment)
+	}
+	c.JSON(http.StatusOK, ListCommentsResponse{Comments: comments, Total: output.Total})
+}
+
+// GetComment godoc
+// @Summary      Get comment thread
+// @Description  댓글과 그 아래의 모든 답글 조회. 차단 관계인 유저의 댓글은 "[blocked]"로 표시
+// @Tags         comments
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Comment ID"
+// @Security     BearerAuth
+// @Success      200  {object}  CommentResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/comments/{id} [get]
+func (co *commentController) GetComment(c *gin.Context) {
+	var req CommentURI
+	if err := c.ShouldBindUri(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, co.jwtAuth.GinJWTMiddleware)
+	output, err := co.commentUsecase.GetComment(payload.UserID, req.ID)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toCommentResponse(*output))
+}
+
+// PatchComment godoc
+// @Summary      Update comment
+// @Description  댓글 수정 (작성자만 가능). music을 지정하면 첨부된 트랙을 교체하고, 빈 배열이면 모두 제거. 욕설은 마스킹되고, 링크 스팸으로 의심되면 검토 전까지 숨겨지며, 같은 게시글에 최근 작성한 댓글과 중복되면 거부됨
+// @Tags         comments
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Comment ID"
+// @Security     BearerAuth
+// @Param request body PatchCommentRequest true "PatchComment Request"
+// @Success      200  {object}  CommentResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      403  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      409  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/comments/{id} [patch]
+func (co *commentController) PatchComment(c *gin.Context) {
+	var uri CommentURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	var req PatchCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	if err := utils.ValidateRequest(&req); err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, co.jwtAuth.GinJWTMiddleware)
+	output, err := co.commentUsecase.PatchComment(c.Request.Context(), payload.UserID, uri.ID, &usecase.PatchCommentInput{
+		Content: req.Content,
+		Music:   req.Music,
+	})
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toCommentResponse(*output))
+}
+
+// DeleteComment godoc
+// @Summary      Delete comment
+// @Description  댓글 삭제 (작성자만 가능). 답글이 있는 댓글은 "[deleted]"로 표시되어 남음
+// @Tags         comments
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Comment ID"
+// @Security     BearerAuth
+// @Success      200  {object}  DeleteCommentResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      403  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/comments/{id} [delete]
+func (co *commentController) DeleteComment(c *gin.Context) {
+	var req CommentURI
+	if err := c.ShouldBindUri(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, co.jwtAuth.GinJWTMiddleware)
+	if err := co.commentUsecase.DeleteComment(payload.UserID, req.ID); err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, DeleteCommentResponse{})
+}
+
+func toCommentResponse(output usecase.CommentOutput) CommentResponse {
+	replies := make([]CommentResponse, len(output.Replies))
+	for i, reply := range output.Replies {
+		replies[i] = toCommentResponse(reply)
+	}
+	return CommentResponse{
+		ID:         output.ID,
+		PostID:     output.PostID,
+		ParentID:   output.ParentID,
+		UserID:     output.UserID,
+		Nickname:   output.Nickname,
+		Content:    output.Content,
+		Depth:      output.Depth,
+		ReplyCount: output.ReplyCount,
+		Deleted:    output.Deleted,
+		Hidden:     output.Hidden,
+		Blocked:    output.Blocked,
+		Tracks:     toTrackCards(output.Tracks),
+		CreatedAt:  output.CreatedAt,
+		UpdatedAt:  output.UpdatedAt,
+		Replies:    replies,
+	}
+}
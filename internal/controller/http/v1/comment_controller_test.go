@@ -0,0 +1,118 @@
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/auth"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+	"github.com/myjinjin/sonic-odyssey-backend/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCommentController_CreateComment(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Reply", func(t *testing.T) {
+		defer func() { mockCommentUsecase.Mock.ExpectedCalls = nil }()
+
+		parentID := uint(7)
+		mockCommentUsecase.On("CreateComment", mock.Anything, uint(1), uint(5), &usecase.CreateCommentInput{Content: "Me too", ParentID: &parentID}).
+			Return(&usecase.CommentOutput{ID: 8, PostID: 5, ParentID: &parentID, UserID: 1, Content: "Me too", Depth: 1, Replies: []usecase.CommentOutput{}}, nil)
+
+		reqBody, _ := json.Marshal(CreateCommentRequest{Content: "Me too", ParentID: &parentID})
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/posts/5/comments", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		var res CommentResponse
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, uint(8), res.ID)
+		assert.Equal(t, 1, res.Depth)
+		mockCommentUsecase.AssertExpectations(t)
+	})
+
+	t.Run("TooDeep", func(t *testing.T) {
+		defer func() { mockCommentUsecase.Mock.ExpectedCalls = nil }()
+
+		parentID := uint(7)
+		mockCommentUsecase.On("CreateComment", mock.Anything, uint(1), uint(5), &usecase.CreateCommentInput{Content: "Me too", ParentID: &parentID}).
+			Return(nil, usecase.ErrCommentTooDeep)
+
+		reqBody, _ := json.Marshal(CreateCommentRequest{Content: "Me too", ParentID: &parentID})
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/posts/5/comments", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestCommentController_ListComments(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	defer func() { mockCommentUsecase.Mock.ExpectedCalls = nil }()
+
+	mockCommentUsecase.On("ListComments", uint(1), uint(5), utils.ToPtr(10), (*int)(nil)).Return(&usecase.ListCommentsOutput{
+		Comments: []usecase.CommentOutput{{
+			ID:      1,
+			Content: "[deleted]",
+			Deleted: true,
+			Replies: []usecase.CommentOutput{{ID: 2, ParentID: utils.ToPtr(uint(1)), Depth: 1, Replies: []usecase.CommentOutput{}}},
+		}},
+		Total: 1,
+	}, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/posts/5/comments?limit=10", nil)
+
+	token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	w := httptest.NewRecorder()
+	testRouter.ServeHTTP(w, req)
+
+	var res ListCommentsResponse
+	err := json.Unmarshal(w.Body.Bytes(), &res)
+	assert.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, 1, res.Total)
+	assert.True(t, res.Comments[0].Deleted)
+	assert.Equal(t, uint(2), res.Comments[0].Replies[0].ID)
+	mockCommentUsecase.AssertExpectations(t)
+}
+
+func TestCommentController_DeleteComment_NotAuthor(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	defer func() { mockCommentUsecase.Mock.ExpectedCalls = nil }()
+
+	mockCommentUsecase.On("DeleteComment", uint(2), uint(3)).Return(usecase.ErrCommentPermissionDenied)
+
+	req, _ := http.NewRequest(http.MethodDelete, "/api/v1/comments/3", nil)
+
+	token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 2})
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	w := httptest.NewRecorder()
+	testRouter.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	mockCommentUsecase.AssertExpectations(t)
+}
@@ -0,0 +1,467 @@
+package v1
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/auth"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+	"github.com/myjinjin/sonic-odyssey-backend/pkg/utils"
+)
+
+type CommunityController interface {
+	CreateCommunity(c *gin.Context)
+	ListCommunities(c *gin.Context)
+	GetCommunity(c *gin.Context)
+	PatchCommunity(c *gin.Context)
+	UpdateBanner(c *gin.Context)
+	UpdateIcon(c *gin.Context)
+	JoinCommunity(c *gin.Context)
+	LeaveCommunity(c *gin.Context)
+	ListModerators(c *gin.Context)
+	AssignModerator(c *gin.Context)
+	RemoveModerator(c *gin.Context)
+}
+
+type communityController struct {
+	communityUsecase usecase.CommunityUsecase
+	jwtAuth          *auth.JWTMiddleware
+}
+
+func NewCommunityController(communityUsecase usecase.CommunityUsecase, jwtAuth *auth.JWTMiddleware) CommunityController {
+	return &communityController{
+		communityUsecase: communityUsecase,
+		jwtAuth:          jwtAuth,
+	}
+}
+
+// CreateCommunity godoc
+// @Summary      Create community
+// @Description  장르 커뮤니티 생성 (관리자만 가능)
+// @Tags         communities
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param request body CreateCommunityRequest true "CreateCommunity Request"
+// @Success      201  {object}  CommunityResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      403  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/communities [post]
+func (co *communityController) CreateCommunity(c *gin.Context) {
+	var req CreateCommunityRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, co.jwtAuth.GinJWTMiddleware)
+	output, err := co.communityUsecase.CreateCommunity(payload.UserID, &usecase.CreateCommunityInput{
+		GenreID:     req.GenreID,
+		Name:        req.Name,
+		Description: req.Description,
+		Rules:       req.Rules,
+	})
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toCommunityResponse(*output))
+}
+
+// ListCommunities godoc
+// @Summary      List communities
+// @Description  커뮤니티 목록 조회 (멤버 수 순). genre_id를 지정하면 해당 장르의 커뮤니티만 조회
+// @Tags         communities
+// @Accept       json
+// @Produce      json
+// @Param request query ListCommunitiesRequest false "ListCommunities Request"
+// @Security     BearerAuth
+// @Success      200  {object}  ListCommunitiesResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/communities [get]
+func (co *communityController) ListCommunities(c *gin.Context) {
+	var req ListCommunitiesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	output, err := co.communityUsecase.ListCommunities(req.GenreID, req.Limit, req.Offset)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	communities := make([]CommunityResponse, len(output.Communities))
+	for i, community := range output.Communities {
+		communities[i] = toCommunityResponse(community)
+	}
+
+	c.JSON(http.StatusOK, ListCommunitiesResponse{Communities: communities, Total: output.Total})
+}
+
+// GetCommunity godoc
+// @Summary      Get community
+// @Description  커뮤니티 상세 조회 (규칙, 모더레이터 목록, 조회한 유저의 역할 포함)
+// @Tags         communities
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Community ID"
+// @Security     BearerAuth
+// @Success      200  {object}  GetCommunityResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/communities/{id} [get]
+func (co *communityController) GetCommunity(c *gin.Context) {
+	var req CommunityURI
+	if err := c.ShouldBindUri(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, co.jwtAuth.GinJWTMiddleware)
+	output, err := co.communityUsecase.GetCommunity(payload.UserID, req.ID)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	moderators := make([]CommunityMember, len(output.Moderators))
+	for i, m := range output.Moderators {
+		moderators[i] = toCommunityMember(m)
+	}
+
+	c.JSON(http.StatusOK, GetCommunityResponse{
+		Community:  toCommunityResponse(output.Community),
+		Role:       output.Role,
+		Moderators: moderators,
+	})
+}
+
+// PatchCommunity godoc
+// @Summary      Update community
+// @Description  커뮤니티 정보 수정 (관리자 또는 모더레이터만 가능, 장르 변경은 관리자만 가능)
+// @Tags         communities
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Community ID"
+// @Security     BearerAuth
+// @Param request body PatchCommunityRequest true "PatchCommunity Request"
+// @Success      200  {object}  CommunityResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      403  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/communities/{id} [patch]
+func (co *communityController) PatchCommunity(c *gin.Context) {
+	var uri CommunityURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	var req PatchCommunityRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	if err := utils.ValidateRequest(&req); err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, co.jwtAuth.GinJWTMiddleware)
+	output, err := co.communityUsecase.PatchCommunity(payload.UserID, uri.ID, &usecase.PatchCommunityInput{
+		GenreID:     req.GenreID,
+		Name:        req.Name,
+		Description: req.Description,
+		Rules:       req.Rules,
+	})
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toCommunityResponse(*output))
+}
+
+// maxCommunityImageFileSize limits uploaded banner and icon images.
+const maxCommunityImageFileSize = 2 << 20
+
+// UpdateBanner godoc
+// @Summary      Update community banner
+// @Description  커뮤니티 배너 이미지 업로드 (관리자 또는 모더레이터만 가능, PNG/JPEG, 최대 3000x1000)
+// @Tags         communities
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        id    path      int   true  "Community ID"
+// @Param        file  formData  file  true  "Image file (최대 2MB)"
+// @Security     BearerAuth
+// @Success      200  {object}  CommunityResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      403  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      413  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/communities/{id}/banner [put]
+func (co *communityController) UpdateBanner(c *gin.Context) {
+	co.updateImage(c, usecase.CommunityImageBanner)
+}
+
+// UpdateIcon godoc
+// @Summary      Update community icon
+// @Description  커뮤니티 아이콘 이미지 업로드 (관리자 또는 모더레이터만 가능, PNG/JPEG, 최대 1000x1000)
+// @Tags         communities
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        id    path      int   true  "Community ID"
+// @Param        file  formData  file  true  "Image file (최대 2MB)"
+// @Security     BearerAuth
+// @Success      200  {object}  CommunityResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      403  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      413  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/communities/{id}/icon [put]
+func (co *communityController) UpdateIcon(c *gin.Context) {
+	co.updateImage(c, usecase.CommunityImageIcon)
+}
+
+func (co *communityController) updateImage(c *gin.Context, kind string) {
+	var uri CommunityURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+	if file.Size > maxCommunityImageFileSize {
+		HandleError(c, ErrImageFileTooLarge)
+		return
+	}
+	f, err := file.Open()
+	if err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+	defer f.Close()
+	data, err := io.ReadAll(io.LimitReader(f, maxCommunityImageFileSize))
+	if err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, co.jwtAuth.GinJWTMiddleware)
+	output, err := co.communityUsecase.UpdateCommunityImage(payload.UserID, uri.ID, kind, data)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toCommunityResponse(*output))
+}
+
+// JoinCommunity godoc
+// @Summary      Join community
+// @Description  커뮤니티 가입. 이미 가입한 경우 기존 멤버십 유지. 커뮤니티에서 차단된 사용자는 가입 불가
+// @Tags         communities
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Community ID"
+// @Security     BearerAuth
+// @Success      200  {object}  CommunityMember
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      403  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/communities/{id}/membership [put]
+func (co *communityController) JoinCommunity(c *gin.Context) {
+	var req CommunityURI
+	if err := c.ShouldBindUri(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, co.jwtAuth.GinJWTMiddleware)
+	output, err := co.communityUsecase.JoinCommunity(payload.UserID, req.ID)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toCommunityMember(*output))
+}
+
+// LeaveCommunity godoc
+// @Summary      Leave community
+// @Description  커뮤니티 탈퇴. 모더레이터가 탈퇴하면 모더레이터 권한도 해제됨
+// @Tags         communities
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Community ID"
+// @Security     BearerAuth
+// @Success      200  {object}  LeaveCommunityResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/communities/{id}/membership [delete]
+func (co *communityController) LeaveCommunity(c *gin.Context) {
+	var req CommunityURI
+	if err := c.ShouldBindUri(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, co.jwtAuth.GinJWTMiddleware)
+	if err := co.communityUsecase.LeaveCommunity(payload.UserID, req.ID); err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, LeaveCommunityResponse{})
+}
+
+// ListModerators godoc
+// @Summary      List community moderators
+// @Description  커뮤니티 모더레이터 목록 조회 (임명 순)
+// @Tags         communities
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Community ID"
+// @Security     BearerAuth
+// @Success      200  {object}  ListCommunityMembersResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/communities/{id}/moderators [get]
+func (co *communityController) ListModerators(c *gin.Context) {
+	var req CommunityURI
+	if err := c.ShouldBindUri(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	output, err := co.communityUsecase.ListModerators(req.ID)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	members := make([]CommunityMember, len(output.Members))
+	for i, m := range output.Members {
+		members[i] = toCommunityMember(m)
+	}
+
+	c.JSON(http.StatusOK, ListCommunityMembersResponse{Members: members})
+}
+
+// AssignModerator godoc
+// @Summary      Assign community moderator
+// @Description  커뮤니티 모더레이터 임명 (관리자만 가능). 가입하지 않은 유저는 모더레이터로 가입됨
+// @Tags         communities
+// @Accept       json
+// @Produce      json
+// @Param        id       path      int  true  "Community ID"
+// @Param        user_id  path      int  true  "User ID"
+// @Security     BearerAuth
+// @Success      200  {object}  CommunityMember
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      403  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/communities/{id}/moderators/{user_id} [put]
+func (co *communityController) AssignModerator(c *gin.Context) {
+	var req CommunityMemberURI
+	if err := c.ShouldBindUri(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, co.jwtAuth.GinJWTMiddleware)
+	output, err := co.communityUsecase.AssignModerator(payload.UserID, req.ID, req.UserID)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toCommunityMember(*output))
+}
+
+// RemoveModerator godoc
+// @Summary      Remove community moderator
+// @Description  커뮤니티 모더레이터 해임 (관리자만 가능). 해임된 유저는 일반 멤버로 남음
+// @Tags         communities
+// @Accept       json
+// @Produce      json
+// @Param        id       path      int  true  "Community ID"
+// @Param        user_id  path      int  true  "User ID"
+// @Security     BearerAuth
+// @Success      200  {object}  RemoveCommunityModeratorResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      403  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/communities/{id}/moderators/{user_id} [delete]
+func (co *communityController) RemoveModerator(c *gin.Context) {
+	var req CommunityMemberURI
+	if err := c.ShouldBindUri(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, co.jwtAuth.GinJWTMiddleware)
+	if err := co.communityUsecase.RemoveModerator(payload.UserID, req.ID, req.UserID); err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, RemoveCommunityModeratorResponse{})
+}
+
+func toCommunityResponse(output usecase.CommunityOutput) CommunityResponse {
+	return CommunityResponse{
+		ID:          output.ID,
+		GenreID:     output.GenreID,
+		Name:        output.Name,
+		Description: output.Description,
+		Rules:       output.Rules,
+		BannerURL:   output.BannerURL,
+		IconURL:     output.IconURL,
+		MemberCount: output.MemberCount,
+		CreatedAt:   output.CreatedAt,
+		UpdatedAt:   output.UpdatedAt,
+	}
+}
+
+func toCommunityMember(output usecase.CommunityMemberOutput) CommunityMember {
+	return CommunityMember{
+		CommunityID: output.CommunityID,
+		UserID:      output.UserID,
+		Nickname:    output.Nickname,
+		Role:        output.Role,
+		JoinedAt:    output.JoinedAt,
+	}
+}
@@ -0,0 +1,314 @@
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/auth"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCommunityController_CreateCommunity(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockCommunityUsecase.Mock.ExpectedCalls = nil }()
+
+		mockCommunityUsecase.On("CreateCommunity", uint(1), &usecase.CreateCommunityInput{GenreID: 3, Name: "Indie Rock Lovers"}).
+			Return(&usecase.CommunityOutput{ID: 10, GenreID: 3, Name: "Indie Rock Lovers"}, nil)
+
+		reqBody, _ := json.Marshal(CreateCommunityRequest{GenreID: 3, Name: "Indie Rock Lovers"})
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/communities", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		var res CommunityResponse
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, uint(10), res.ID)
+		mockCommunityUsecase.AssertExpectations(t)
+	})
+
+	t.Run("NotAdmin", func(t *testing.T) {
+		defer func() { mockCommunityUsecase.Mock.ExpectedCalls = nil }()
+
+		mockCommunityUsecase.On("CreateCommunity", uint(2), mock.Anything).Return(nil, usecase.ErrCommunityPermissionDenied)
+
+		reqBody, _ := json.Marshal(CreateCommunityRequest{GenreID: 3, Name: "Indie Rock Lovers"})
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/communities", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 2})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("MissingGenre", func(t *testing.T) {
+		reqBody, _ := json.Marshal(CreateCommunityRequest{Name: "Indie Rock Lovers"})
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/communities", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestCommunityController_ListCommunities(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	defer func() { mockCommunityUsecase.Mock.ExpectedCalls = nil }()
+
+	genreID, limit := uint(3), 5
+	mockCommunityUsecase.On("ListCommunities", &genreID, &limit, (*int)(nil)).Return(&usecase.ListCommunitiesOutput{
+		Communities: []usecase.CommunityOutput{{ID: 10, GenreID: 3, MemberCount: 42}},
+		Total:       1,
+	}, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/communities?genre_id=3&limit=5", nil)
+
+	token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	w := httptest.NewRecorder()
+	testRouter.ServeHTTP(w, req)
+
+	var res ListCommunitiesResponse
+	err := json.Unmarshal(w.Body.Bytes(), &res)
+	assert.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, 1, res.Total)
+	assert.Equal(t, int64(42), res.Communities[0].MemberCount)
+	mockCommunityUsecase.AssertExpectations(t)
+}
+
+func TestCommunityController_GetCommunity(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockCommunityUsecase.Mock.ExpectedCalls = nil }()
+
+		mockCommunityUsecase.On("GetCommunity", uint(1), uint(10)).Return(&usecase.GetCommunityOutput{
+			Community:  usecase.CommunityOutput{ID: 10, Rules: "Be nice"},
+			Role:       "MEMBER",
+			Moderators: []usecase.CommunityMemberOutput{{CommunityID: 10, UserID: 2, Nickname: "mod", Role: "MODERATOR"}},
+		}, nil)
+
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/communities/10", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		var res GetCommunityResponse
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "Be nice", res.Community.Rules)
+		assert.Equal(t, "MEMBER", res.Role)
+		assert.Equal(t, "mod", res.Moderators[0].Nickname)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		defer func() { mockCommunityUsecase.Mock.ExpectedCalls = nil }()
+
+		mockCommunityUsecase.On("GetCommunity", uint(1), uint(99)).Return(nil, usecase.ErrCommunityNotFound)
+
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/communities/99", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestCommunityController_PatchCommunity(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockCommunityUsecase.Mock.ExpectedCalls = nil }()
+
+		rules := "No spam"
+		mockCommunityUsecase.On("PatchCommunity", uint(2), uint(10), &usecase.PatchCommunityInput{Rules: &rules}).
+			Return(&usecase.CommunityOutput{ID: 10, Rules: rules}, nil)
+
+		reqBody, _ := json.Marshal(map[string]string{"rules": rules})
+		req, _ := http.NewRequest(http.MethodPatch, "/api/v1/communities/10", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 2})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockCommunityUsecase.AssertExpectations(t)
+	})
+
+	t.Run("EmptyName", func(t *testing.T) {
+		reqBody, _ := json.Marshal(map[string]string{"name": ""})
+		req, _ := http.NewRequest(http.MethodPatch, "/api/v1/communities/10", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 2})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestCommunityController_UpdateBanner(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	newUploadRequest := func(data []byte) *http.Request {
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		part, _ := writer.CreateFormFile("file", "banner.png")
+		_, _ = part.Write(data)
+		_ = writer.Close()
+
+		req, _ := http.NewRequest(http.MethodPut, "/api/v1/communities/10/banner", body)
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 2})
+		req.Header.Set("Authorization", "Bearer "+token)
+		return req
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockCommunityUsecase.Mock.ExpectedCalls = nil }()
+
+		mockCommunityUsecase.On("UpdateCommunityImage", uint(2), uint(10), usecase.CommunityImageBanner, []byte("png")).
+			Return(&usecase.CommunityOutput{ID: 10, BannerURL: "/static/communities/10/banner.png"}, nil)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, newUploadRequest([]byte("png")))
+
+		var res CommunityResponse
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "/static/communities/10/banner.png", res.BannerURL)
+		mockCommunityUsecase.AssertExpectations(t)
+	})
+
+	t.Run("TooLarge", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, newUploadRequest(make([]byte, maxCommunityImageFileSize+1)))
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	})
+}
+
+func TestCommunityController_Membership(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Join", func(t *testing.T) {
+		defer func() { mockCommunityUsecase.Mock.ExpectedCalls = nil }()
+
+		mockCommunityUsecase.On("JoinCommunity", uint(1), uint(10)).
+			Return(&usecase.CommunityMemberOutput{CommunityID: 10, UserID: 1, Role: "MEMBER"}, nil)
+
+		req, _ := http.NewRequest(http.MethodPut, "/api/v1/communities/10/membership", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		var res CommunityMember
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "MEMBER", res.Role)
+	})
+
+	t.Run("LeaveNotMember", func(t *testing.T) {
+		defer func() { mockCommunityUsecase.Mock.ExpectedCalls = nil }()
+
+		mockCommunityUsecase.On("LeaveCommunity", uint(1), uint(10)).Return(usecase.ErrCommunityMemberNotFound)
+
+		req, _ := http.NewRequest(http.MethodDelete, "/api/v1/communities/10/membership", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestCommunityController_Moderators(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Assign", func(t *testing.T) {
+		defer func() { mockCommunityUsecase.Mock.ExpectedCalls = nil }()
+
+		mockCommunityUsecase.On("AssignModerator", uint(1), uint(10), uint(2)).
+			Return(&usecase.CommunityMemberOutput{CommunityID: 10, UserID: 2, Role: "MODERATOR"}, nil)
+
+		req, _ := http.NewRequest(http.MethodPut, "/api/v1/communities/10/moderators/2", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockCommunityUsecase.AssertExpectations(t)
+	})
+
+	t.Run("RemoveForbidden", func(t *testing.T) {
+		defer func() { mockCommunityUsecase.Mock.ExpectedCalls = nil }()
+
+		mockCommunityUsecase.On("RemoveModerator", uint(3), uint(10), uint(2)).Return(usecase.ErrCommunityPermissionDenied)
+
+		req, _ := http.NewRequest(http.MethodDelete, "/api/v1/communities/10/moderators/2", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 3})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
@@ -10,12 +10,15 @@ import (
 )
 
 var (
-	ErrInvalidRequestBody = errors.New("invalid request body")
+	ErrInvalidRequestBody   = errors.New("invalid request body")
+	ErrPlaylistFileTooLarge = errors.New("playlist file is too large")
+	ErrImageFileTooLarge    = errors.New("image file is too large")
 )
 
 var errorStatusMap = map[error]int{
 	usecase.ErrEmailAlreadyExists:        http.StatusBadRequest,
 	usecase.ErrNicknameAlreadyExists:     http.StatusBadRequest,
+	usecase.ErrNicknameReserved:          http.StatusBadRequest,
 	usecase.ErrInvalidPassword:           http.StatusBadRequest,
 	usecase.ErrUserNotFound:              http.StatusBadRequest,
 	usecase.ErrPasswordResetFlowNotFound: http.StatusBadRequest,
@@ -40,8 +43,108 @@ var errorStatusMap = map[error]int{
 	usecase.ErrDeletingRecord: http.StatusInternalServerError,
 
 	usecase.ErrSearchingSpotify: http.StatusInternalServerError,
+	usecase.ErrFetchingSpotify:  http.StatusInternalServerError,
+	usecase.ErrUpdatingSpotify:  http.StatusInternalServerError,
 
-	ErrInvalidRequestBody: http.StatusBadRequest,
+	usecase.ErrMusicNotFound:       http.StatusNotFound,
+	usecase.ErrAlbumNotFound:       http.StatusNotFound,
+	usecase.ErrArtistNotFound:      http.StatusNotFound,
+	usecase.ErrLastfmTrackNotFound: http.StatusNotFound,
+	usecase.ErrFetchingLastfm:      http.StatusInternalServerError,
+
+	usecase.ErrGenreNotFound: http.StatusNotFound,
+
+	usecase.ErrPostNotFound:    http.StatusNotFound,
+	usecase.ErrCommentNotFound: http.StatusNotFound,
+
+	usecase.ErrPostPermissionDenied:   http.StatusForbidden,
+	usecase.ErrInvalidPostSort:        http.StatusBadRequest,
+	usecase.ErrInvalidPostSearchField: http.StatusBadRequest,
+	usecase.ErrRenderingContent:       http.StatusInternalServerError,
+
+	usecase.ErrCommentPermissionDenied: http.StatusForbidden,
+	usecase.ErrInvalidCommentParent:    http.StatusBadRequest,
+	usecase.ErrCommentTooDeep:          http.StatusBadRequest,
+
+	usecase.ErrInvalidMusicReference:   http.StatusBadRequest,
+	usecase.ErrTooManyMusicAttachments: http.StatusBadRequest,
+
+	usecase.ErrSpotifyLinkFlowNotFound:     http.StatusBadRequest,
+	usecase.ErrSpotifyLinkFlowExpired:      http.StatusBadRequest,
+	usecase.ErrSpotifyAuthorizationFailed:  http.StatusBadRequest,
+	usecase.ErrSpotifyAccountAlreadyLinked: http.StatusConflict,
+	usecase.ErrSpotifyNotLinked:            http.StatusBadRequest,
+	usecase.ErrSpotifyImportInProgress:     http.StatusConflict,
+	usecase.ErrSpotifyImportJobNotFound:    http.StatusNotFound,
+	usecase.ErrEncryptingToken:             http.StatusInternalServerError,
+	usecase.ErrDecryptingToken:             http.StatusInternalServerError,
+
+	usecase.ErrCollectionNotFound:      http.StatusNotFound,
+	usecase.ErrCollectionNotExportable: http.StatusBadRequest,
+	usecase.ErrCollectionTrackNotFound: http.StatusNotFound,
+
+	usecase.ErrCollectionPermissionDenied: http.StatusForbidden,
+	usecase.ErrCollectionMemberNotFound:   http.StatusNotFound,
+	usecase.ErrCollectionOwnerImmutable:   http.StatusBadRequest,
+	usecase.ErrInvalidCollectionRole:      http.StatusBadRequest,
+	usecase.ErrCollectionInviteNotFound:   http.StatusNotFound,
+	usecase.ErrCollectionInviteExpired:    http.StatusBadRequest,
+
+	usecase.ErrUnsupportedPlaylistFormat: http.StatusBadRequest,
+	usecase.ErrInvalidPlaylistFile:       http.StatusBadRequest,
+	usecase.ErrPlaylistTooLarge:          http.StatusBadRequest,
+
+	usecase.ErrTopsterNotFound:            http.StatusNotFound,
+	usecase.ErrTopsterPermissionDenied:    http.StatusForbidden,
+	usecase.ErrInvalidTopsterGridSize:     http.StatusBadRequest,
+	usecase.ErrInvalidTopsterTemplate:     http.StatusBadRequest,
+	usecase.ErrInvalidAutofillSource:      http.StatusBadRequest,
+	usecase.ErrTopsterPositionOutOfBounds: http.StatusBadRequest,
+	usecase.ErrTopsterPositionConflict:    http.StatusBadRequest,
+	usecase.ErrDuplicateTopsterAlbum:      http.StatusBadRequest,
+
+	usecase.ErrCommunityNotFound:         http.StatusNotFound,
+	usecase.ErrCommunityPermissionDenied: http.StatusForbidden,
+	usecase.ErrCommunityMemberNotFound:   http.StatusNotFound,
+	usecase.ErrInvalidCommunityImage:     http.StatusBadRequest,
+
+	usecase.ErrCommunityMembershipRequired: http.StatusForbidden,
+	usecase.ErrCommunityBanned:             http.StatusForbidden,
+
+	usecase.ErrReportNotFound:          http.StatusNotFound,
+	usecase.ErrInvalidReportTarget:     http.StatusBadRequest,
+	usecase.ErrInvalidReportReason:     http.StatusBadRequest,
+	usecase.ErrInvalidReportStatus:     http.StatusBadRequest,
+	usecase.ErrReportAlreadyExists:     http.StatusConflict,
+	usecase.ErrReportAlreadyClosed:     http.StatusConflict,
+	usecase.ErrInvalidModerationAction: http.StatusBadRequest,
+
+	usecase.ErrCannotFollowSelf: http.StatusBadRequest,
+	usecase.ErrAlreadyFollowing: http.StatusConflict,
+	usecase.ErrNotFollowing:     http.StatusNotFound,
+
+	usecase.ErrCannotBlockSelf: http.StatusBadRequest,
+	usecase.ErrAlreadyBlocked:  http.StatusConflict,
+	usecase.ErrNotBlocked:      http.StatusNotFound,
+	usecase.ErrCannotMuteSelf:  http.StatusBadRequest,
+	usecase.ErrAlreadyMuted:    http.StatusConflict,
+	usecase.ErrNotMuted:        http.StatusNotFound,
+	usecase.ErrUserBlocked:     http.StatusForbidden,
+
+	usecase.ErrInvalidFeedCursor: http.StatusBadRequest,
+
+	usecase.ErrInvalidNotificationType: http.StatusBadRequest,
+	usecase.ErrNoNotificationsSelected: http.StatusBadRequest,
+
+	usecase.ErrContentRejected:  http.StatusBadRequest,
+	usecase.ErrDuplicateContent: http.StatusConflict,
+	usecase.ErrFilteringContent: http.StatusInternalServerError,
+
+	usecase.ErrStoringFile: http.StatusInternalServerError,
+
+	ErrInvalidRequestBody:   http.StatusBadRequest,
+	ErrPlaylistFileTooLarge: http.StatusRequestEntityTooLarge,
+	ErrImageFileTooLarge:    http.StatusRequestEntityTooLarge,
 }
 
 func HandleError(c *gin.Context, err error) {
@@ -0,0 +1,74 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/auth"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+)
+
+type FeedController interface {
+	GetFeed(c *gin.Context)
+}
+
+type feedController struct {
+	feedUsecase usecase.FeedUsecase
+	jwtAuth     *auth.JWTMiddleware
+}
+
+func NewFeedController(feedUsecase usecase.FeedUsecase, jwtAuth *auth.JWTMiddleware) FeedController {
+	return &feedController{
+		feedUsecase: feedUsecase,
+		jwtAuth:     jwtAuth,
+	}
+}
+
+// GetFeed godoc
+// @Summary      Get home feed
+// @Description  내가 팔로우하는 유저들의 활동(새 게시글, 공개 탑스터, 공개 컬렉션, 트랙 좋아요, 팔로우) 조회 (최신순). 같은 유저의 좋아요와 팔로우는 페이지 안에서 하나로 묶이며(예: "X님이 트랙 5곡을 좋아합니다"), 페이지 끝에서 이어지는 묶음은 limit을 넘더라도 해당 페이지에 포함됨. 뮤트하거나 차단 관계인 유저의 활동은 제외됨. 다음 페이지는 next_cursor를 cursor로 전달하여 조회
+// @Tags         feed
+// @Accept       json
+// @Produce      json
+// @Param request query GetFeedRequest false "GetFeed Request"
+// @Security     BearerAuth
+// @Success      200  {object}  GetFeedResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/feed [get]
+func (f *feedController) GetFeed(c *gin.Context) {
+	var req GetFeedRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, f.jwtAuth.GinJWTMiddleware)
+	output, err := f.feedUsecase.GetFeed(payload.UserID, req.Cursor, req.Limit)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	activities := make([]FeedActivityResponse, len(output.Activities))
+	for i, a := range output.Activities {
+		subjects := make([]FeedSubjectResponse, len(a.Subjects))
+		for j, s := range a.Subjects {
+			subjects[j] = FeedSubjectResponse{ID: s.ID, Title: s.Title, ImageURL: s.ImageURL}
+		}
+		activities[i] = FeedActivityResponse{
+			ID:   a.ID,
+			Type: a.Type,
+			Actor: FeedUserResponse{
+				UserID:          a.Actor.UserID,
+				Nickname:        a.Actor.Nickname,
+				ProfileImageURL: a.Actor.ProfileImageURL,
+			},
+			Subjects:  subjects,
+			Count:     len(subjects),
+			CreatedAt: a.CreatedAt,
+		}
+	}
+	c.JSON(http.StatusOK, GetFeedResponse{Activities: activities, NextCursor: output.NextCursor})
+}
@@ -0,0 +1,65 @@
+package v1
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/auth"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFeedController_GetFeed(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockFeedUsecase.Mock.ExpectedCalls = nil }()
+
+		limit := 10
+		mockFeedUsecase.On("GetFeed", uint(1), "120", &limit).Return(&usecase.FeedOutput{
+			Activities: []usecase.FeedActivityOutput{{
+				ID:       110,
+				Type:     "TRACK_LIKE",
+				Actor:    usecase.FeedUserOutput{UserID: 2, Nickname: "alice"},
+				Subjects: []usecase.FeedSubjectOutput{{ID: 5, Title: "Only Shallow"}, {ID: 6, Title: "Soon"}},
+			}},
+			NextCursor: "100",
+		}, nil)
+
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/feed?cursor=120&limit=10", nil)
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		var res GetFeedResponse
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "100", res.NextCursor)
+		assert.Len(t, res.Activities, 1)
+		assert.Equal(t, 2, res.Activities[0].Count)
+		assert.Equal(t, "alice", res.Activities[0].Actor.Nickname)
+		mockFeedUsecase.AssertExpectations(t)
+	})
+
+	t.Run("InvalidCursor", func(t *testing.T) {
+		defer func() { mockFeedUsecase.Mock.ExpectedCalls = nil }()
+
+		mockFeedUsecase.On("GetFeed", uint(1), "abc", (*int)(nil)).Return(nil, usecase.ErrInvalidFeedCursor)
+
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/feed?cursor=abc", nil)
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
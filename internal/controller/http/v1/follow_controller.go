@@ -0,0 +1,170 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/auth"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+)
+
+type FollowController interface {
+	Follow(c *gin.Context)
+	Unfollow(c *gin.Context)
+	ListFollowers(c *gin.Context)
+	ListFollowing(c *gin.Context)
+}
+
+type followController struct {
+	followUsecase usecase.FollowUsecase
+	jwtAuth       *auth.JWTMiddleware
+}
+
+func NewFollowController(followUsecase usecase.FollowUsecase, jwtAuth *auth.JWTMiddleware) FollowController {
+	return &followController{
+		followUsecase: followUsecase,
+		jwtAuth:       jwtAuth,
+	}
+}
+
+// Follow godoc
+// @Summary      Follow user
+// @Description  유저 팔로우. 자기 자신, 이미 팔로우 중인 유저, 차단 관계인 유저는 팔로우할 수 없음
+// @Tags         users, follows
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "User ID"
+// @Security     BearerAuth
+// @Success      201  {object}  FollowResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      403  {object}  ErrorResponse
+// @Failure      409  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/users/{id}/follow [post]
+func (f *followController) Follow(c *gin.Context) {
+	var uri UserURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, f.jwtAuth.GinJWTMiddleware)
+	output, err := f.followUsecase.Follow(payload.UserID, uri.ID)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, toFollowResponse(output))
+}
+
+// Unfollow godoc
+// @Summary      Unfollow user
+// @Description  유저 언팔로우
+// @Tags         users, follows
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "User ID"
+// @Security     BearerAuth
+// @Success      200  {object}  FollowResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/users/{id}/follow [delete]
+func (f *followController) Unfollow(c *gin.Context) {
+	var uri UserURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, f.jwtAuth.GinJWTMiddleware)
+	output, err := f.followUsecase.Unfollow(payload.UserID, uri.ID)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, toFollowResponse(output))
+}
+
+// ListFollowers godoc
+// @Summary      List followers
+// @Description  유저의 팔로워 목록 조회 (최근 팔로우순). 차단 관계인 유저는 제외되고, 해당 유저와 차단 관계이면 404. 유저가 팔로우 목록을 숨기면 본인 외에는 빈 목록이 반환됨. following은 내가 팔로우 중인지, follows_you는 나를 팔로우 중인지 표시
+// @Tags         users, follows
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "User ID"
+// @Param request query ListFollowsRequest false "ListFollows Request"
+// @Security     BearerAuth
+// @Success      200  {object}  ListFollowsResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/users/{id}/followers [get]
+func (f *followController) ListFollowers(c *gin.Context) {
+	f.list(c, f.followUsecase.ListFollowers)
+}
+
+// ListFollowing godoc
+// @Summary      List following
+// @Description  유저가 팔로우 중인 유저 목록 조회 (최근 팔로우순). 차단 관계인 유저는 제외되고, 해당 유저와 차단 관계이면 404. 유저가 팔로우 목록을 숨기면 본인 외에는 빈 목록이 반환됨. following은 내가 팔로우 중인지, follows_you는 나를 팔로우 중인지 표시
+// @Tags         users, follows
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "User ID"
+// @Param request query ListFollowsRequest false "ListFollows Request"
+// @Security     BearerAuth
+// @Success      200  {object}  ListFollowsResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/users/{id}/following [get]
+func (f *followController) ListFollowing(c *gin.Context) {
+	f.list(c, f.followUsecase.ListFollowing)
+}
+
+func (f *followController) list(c *gin.Context, list func(viewerID, userID uint, limit, offset *int) (*usecase.ListFollowsOutput, error)) {
+	var uri UserURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	var req ListFollowsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, f.jwtAuth.GinJWTMiddleware)
+	output, err := list(payload.UserID, uri.ID, req.Limit, req.Offset)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	users := make([]FollowUserResponse, len(output.Users))
+	for i, u := range output.Users {
+		users[i] = FollowUserResponse{
+			UserID:          u.UserID,
+			Nickname:        u.Nickname,
+			ProfileImageURL: u.ProfileImageURL,
+			Following:       u.Following,
+			FollowsYou:      u.FollowsYou,
+			FollowedAt:      u.FollowedAt,
+		}
+	}
+	c.JSON(http.StatusOK, ListFollowsResponse{Users: users, Total: output.Total})
+}
+
+func toFollowResponse(output *usecase.FollowOutput) FollowResponse {
+	return FollowResponse{
+		UserID:        output.UserID,
+		Following:     output.Following,
+		FollowsYou:    output.FollowsYou,
+		FollowerCount: output.FollowerCount,
+	}
+}
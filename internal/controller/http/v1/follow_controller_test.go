@@ -0,0 +1,102 @@
+package v1
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/auth"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFollowController_Follow(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockFollowUsecase.Mock.ExpectedCalls = nil }()
+
+		mockFollowUsecase.On("Follow", uint(1), uint(2)).
+			Return(&usecase.FollowOutput{UserID: 2, Following: true, FollowsYou: true, FollowerCount: 5}, nil)
+
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/users/2/follow", nil)
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		var res FollowResponse
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, FollowResponse{UserID: 2, Following: true, FollowsYou: true, FollowerCount: 5}, res)
+		mockFollowUsecase.AssertExpectations(t)
+	})
+
+	t.Run("AlreadyFollowing", func(t *testing.T) {
+		defer func() { mockFollowUsecase.Mock.ExpectedCalls = nil }()
+
+		mockFollowUsecase.On("Follow", uint(1), uint(2)).Return(nil, usecase.ErrAlreadyFollowing)
+
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/users/2/follow", nil)
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
+
+func TestFollowController_Unfollow(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("NotFollowing", func(t *testing.T) {
+		defer func() { mockFollowUsecase.Mock.ExpectedCalls = nil }()
+
+		mockFollowUsecase.On("Unfollow", uint(1), uint(2)).Return(nil, usecase.ErrNotFollowing)
+
+		req, _ := http.NewRequest(http.MethodDelete, "/api/v1/users/2/follow", nil)
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestFollowController_ListFollowers(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockFollowUsecase.Mock.ExpectedCalls = nil }()
+
+		limit := 10
+		mockFollowUsecase.On("ListFollowers", uint(1), uint(2), &limit, (*int)(nil)).
+			Return(&usecase.ListFollowsOutput{Users: []usecase.FollowUserOutput{{UserID: 3, Nickname: "mutual", Following: true, FollowsYou: true}}, Total: 1}, nil)
+
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/users/2/followers?limit=10", nil)
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		var res ListFollowsResponse
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, 1, res.Total)
+		assert.Equal(t, "mutual", res.Users[0].Nickname)
+		assert.True(t, res.Users[0].FollowsYou)
+		mockFollowUsecase.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,166 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/auth"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+)
+
+type GenreController interface {
+	ListGenres(c *gin.Context)
+	GetGenreTracks(c *gin.Context)
+	GetGenreCommunities(c *gin.Context)
+}
+
+type genreController struct {
+	genreUsecase usecase.GenreUsecase
+	jwtAuth      *auth.JWTMiddleware
+}
+
+func NewGenreController(genreUsecase usecase.GenreUsecase, jwtAuth *auth.JWTMiddleware) GenreController {
+	return &genreController{
+		genreUsecase: genreUsecase,
+		jwtAuth:      jwtAuth,
+	}
+}
+
+// ListGenres godoc
+// @Summary      List genres
+// @Description  장르 분류 트리 조회
+// @Tags         genres
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  ListGenresResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/genres [get]
+func (g *genreController) ListGenres(c *gin.Context) {
+	output, err := g.genreUsecase.ListGenres()
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	genres := make([]GenreNode, len(output.Genres))
+	for i, n := range output.Genres {
+		genres[i] = toGenreNode(n)
+	}
+
+	res := ListGenresResponse{Genres: genres}
+	c.JSON(http.StatusOK, res)
+}
+
+// GetGenreTracks godoc
+// @Summary      Get genre tracks
+// @Description  장르에 속한 트랙 목록 조회
+// @Tags         genres
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Genre ID"
+// @Param        request query GetGenreTracksRequest false "GetGenreTracks Request"
+// @Security     BearerAuth
+// @Success      200  {object}  GetGenreTracksResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/genres/{id}/tracks [get]
+func (g *genreController) GetGenreTracks(c *gin.Context) {
+	var uri GenreURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+	var req GetGenreTracksRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	output, err := g.genreUsecase.GetGenreTracks(uri.GenreID, req.Limit, req.Offset)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	tracks := make([]CatalogTrack, len(output.Tracks))
+	for i, t := range output.Tracks {
+		tracks[i] = toCatalogTrack(t)
+	}
+
+	res := GetGenreTracksResponse{Tracks: tracks, Total: output.Total}
+	c.JSON(http.StatusOK, res)
+}
+
+// GetGenreCommunities godoc
+// @Summary      Get genre communities
+// @Description  장르 커뮤니티 목록 조회
+// @Tags         genres
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Genre ID"
+// @Security     BearerAuth
+// @Success      200  {object}  GetGenreCommunitiesResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/genres/{id}/communities [get]
+func (g *genreController) GetGenreCommunities(c *gin.Context) {
+	var uri GenreURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	output, err := g.genreUsecase.GetGenreCommunities(uri.GenreID)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	communities := make([]GenreCommunity, len(output.Communities))
+	for i, gc := range output.Communities {
+		communities[i] = GenreCommunity{
+			ID:          gc.ID,
+			GenreID:     gc.GenreID,
+			Name:        gc.Name,
+			Description: gc.Description,
+		}
+	}
+
+	res := GetGenreCommunitiesResponse{Communities: communities}
+	c.JSON(http.StatusOK, res)
+}
+
+func toGenreNode(n usecase.GenreNode) GenreNode {
+	children := make([]GenreNode, len(n.Children))
+	for i, c := range n.Children {
+		children[i] = toGenreNode(c)
+	}
+	return GenreNode{
+		ID:          n.ID,
+		ParentID:    n.ParentID,
+		Name:        n.Name,
+		Description: n.Description,
+		Aliases:     n.Aliases,
+		Children:    children,
+	}
+}
+
+func toCatalogTrack(t usecase.CatalogTrack) CatalogTrack {
+	artists := make([]CatalogArtist, len(t.Artists))
+	for i, a := range t.Artists {
+		artists[i] = CatalogArtist{ID: a.ID, Name: a.Name}
+	}
+	return CatalogTrack{
+		ID:        t.ID,
+		Title:     t.Title,
+		AlbumID:   t.AlbumID,
+		SpotifyID: t.SpotifyID,
+		Artists:   artists,
+	}
+}
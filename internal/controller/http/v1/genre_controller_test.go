@@ -0,0 +1,152 @@
+package v1
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/auth"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenreController_ListGenres(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockGenreUsecase.Mock.ExpectedCalls = nil }()
+
+		mockOutput := &usecase.ListGenresOutput{
+			Genres: []usecase.GenreNode{
+				{ID: 1, Name: "Rock", Aliases: []string{"rock"}, Children: []usecase.GenreNode{{ID: 2, Name: "Indie Rock"}}},
+			},
+		}
+		mockGenreUsecase.On("ListGenres").Return(mockOutput, nil)
+
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/genres", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		var res ListGenresResponse
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "Rock", res.Genres[0].Name)
+		assert.Equal(t, "Indie Rock", res.Genres[0].Children[0].Name)
+		mockGenreUsecase.AssertExpectations(t)
+	})
+
+	t.Run("Unauthorized", func(t *testing.T) {
+		calls := len(mockGenreUsecase.Calls)
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/genres", nil)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Len(t, mockGenreUsecase.Calls, calls)
+	})
+}
+
+func TestGenreController_GetGenreTracks(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockGenreUsecase.Mock.ExpectedCalls = nil }()
+
+		limit := 10
+		offset := 0
+		mockOutput := &usecase.GetGenreTracksOutput{
+			Tracks: []usecase.CatalogTrack{
+				{ID: 1, Title: "One", Artists: []usecase.CatalogArtist{{ID: 1, Name: "Aimee Mann"}}},
+			},
+			Total: 1,
+		}
+		mockGenreUsecase.On("GetGenreTracks", uint(1), &limit, &offset).Return(mockOutput, nil)
+
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/genres/1/tracks?limit=10&offset=0", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		var res GetGenreTracksResponse
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, 1, res.Total)
+		assert.Equal(t, "One", res.Tracks[0].Title)
+		assert.Equal(t, "Aimee Mann", res.Tracks[0].Artists[0].Name)
+		mockGenreUsecase.AssertExpectations(t)
+	})
+
+	t.Run("GenreNotFound", func(t *testing.T) {
+		defer func() { mockGenreUsecase.Mock.ExpectedCalls = nil }()
+
+		var limit, offset *int
+		mockGenreUsecase.On("GetGenreTracks", uint(2), limit, offset).Return(nil, usecase.ErrGenreNotFound)
+
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/genres/2/tracks", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		mockGenreUsecase.AssertExpectations(t)
+	})
+}
+
+func TestGenreController_GetGenreCommunities(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockGenreUsecase.Mock.ExpectedCalls = nil }()
+
+		mockOutput := &usecase.GetGenreCommunitiesOutput{
+			Communities: []usecase.GenreCommunity{{ID: 1, GenreID: 1, Name: "Rock Lovers"}},
+		}
+		mockGenreUsecase.On("GetGenreCommunities", uint(1)).Return(mockOutput, nil)
+
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/genres/1/communities", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		var res GetGenreCommunitiesResponse
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "Rock Lovers", res.Communities[0].Name)
+		mockGenreUsecase.AssertExpectations(t)
+	})
+
+	t.Run("InvalidGenreID", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/genres/abc/communities", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockGenreUsecase.AssertNotCalled(t, "GetGenreCommunities")
+	})
+}
@@ -0,0 +1,269 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/auth"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+)
+
+type LikeController interface {
+	LikeMusic(c *gin.Context)
+	DislikeMusic(c *gin.Context)
+	ClearMusicReaction(c *gin.Context)
+	LikePost(c *gin.Context)
+	DislikePost(c *gin.Context)
+	ClearPostReaction(c *gin.Context)
+	LikeComment(c *gin.Context)
+	DislikeComment(c *gin.Context)
+	ClearCommentReaction(c *gin.Context)
+	GetMyLikedTracks(c *gin.Context)
+}
+
+type likeController struct {
+	likeUsecase usecase.LikeUsecase
+	jwtAuth     *auth.JWTMiddleware
+}
+
+func NewLikeController(likeUsecase usecase.LikeUsecase, jwtAuth *auth.JWTMiddleware) LikeController {
+	return &likeController{
+		likeUsecase: likeUsecase,
+		jwtAuth:     jwtAuth,
+	}
+}
+
+// LikeMusic godoc
+// @Summary      Like track
+// @Description  트랙 좋아요 (이미 좋아요한 경우 변경 없음)
+// @Tags         music, likes
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Music ID"
+// @Security     BearerAuth
+// @Success      200  {object}  ReactionResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/music/tracks/{id}/like [put]
+func (l *likeController) LikeMusic(c *gin.Context) {
+	l.react(c, func(userID, id uint) (*usecase.ReactionOutput, error) {
+		return l.likeUsecase.ReactToMusic(userID, id, true)
+	})
+}
+
+// DislikeMusic godoc
+// @Summary      Dislike track
+// @Description  트랙 싫어요 (이미 싫어요한 경우 변경 없음)
+// @Tags         music, likes
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Music ID"
+// @Security     BearerAuth
+// @Success      200  {object}  ReactionResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/music/tracks/{id}/dislike [put]
+func (l *likeController) DislikeMusic(c *gin.Context) {
+	l.react(c, func(userID, id uint) (*usecase.ReactionOutput, error) {
+		return l.likeUsecase.ReactToMusic(userID, id, false)
+	})
+}
+
+// ClearMusicReaction godoc
+// @Summary      Clear track reaction
+// @Description  트랙 좋아요/싫어요 취소
+// @Tags         music, likes
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Music ID"
+// @Security     BearerAuth
+// @Success      200  {object}  ReactionResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/music/tracks/{id}/like [delete]
+func (l *likeController) ClearMusicReaction(c *gin.Context) {
+	l.react(c, func(userID, id uint) (*usecase.ReactionOutput, error) {
+		return l.likeUsecase.ClearMusicReaction(userID, id)
+	})
+}
+
+// LikePost godoc
+// @Summary      Like post
+// @Description  게시글 좋아요 (이미 좋아요한 경우 변경 없음)
+// @Tags         posts, likes
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Post ID"
+// @Security     BearerAuth
+// @Success      200  {object}  ReactionResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/posts/{id}/like [put]
+func (l *likeController) LikePost(c *gin.Context) {
+	l.react(c, func(userID, id uint) (*usecase.ReactionOutput, error) {
+		return l.likeUsecase.ReactToPost(userID, id, true)
+	})
+}
+
+// DislikePost godoc
+// @Summary      Dislike post
+// @Description  게시글 싫어요 (이미 싫어요한 경우 변경 없음)
+// @Tags         posts, likes
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Post ID"
+// @Security     BearerAuth
+// @Success      200  {object}  ReactionResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/posts/{id}/dislike [put]
+func (l *likeController) DislikePost(c *gin.Context) {
+	l.react(c, func(userID, id uint) (*usecase.ReactionOutput, error) {
+		return l.likeUsecase.ReactToPost(userID, id, false)
+	})
+}
+
+// ClearPostReaction godoc
+// @Summary      Clear post reaction
+// @Description  게시글 좋아요/싫어요 취소
+// @Tags         posts, likes
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Post ID"
+// @Security     BearerAuth
+// @Success      200  {object}  ReactionResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/posts/{id}/like [delete]
+func (l *likeController) ClearPostReaction(c *gin.Context) {
+	l.react(c, func(userID, id uint) (*usecase.ReactionOutput, error) {
+		return l.likeUsecase.ClearPostReaction(userID, id)
+	})
+}
+
+// LikeComment godoc
+// @Summary      Like comment
+// @Description  댓글 좋아요 (이미 좋아요한 경우 변경 없음)
+// @Tags         comments, likes
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Comment ID"
+// @Security     BearerAuth
+// @Success      200  {object}  ReactionResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/comments/{id}/like [put]
+func (l *likeController) LikeComment(c *gin.Context) {
+	l.react(c, func(userID, id uint) (*usecase.ReactionOutput, error) {
+		return l.likeUsecase.ReactToComment(userID, id, true)
+	})
+}
+
+// DislikeComment godoc
+// @Summary      Dislike comment
+// @Description  댓글 싫어요 (이미 싫어요한 경우 변경 없음)
+// @Tags         comments, likes
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Comment ID"
+// @Security     BearerAuth
+// @Success      200  {object}  ReactionResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/comments/{id}/dislike [put]
+func (l *likeController) DislikeComment(c *gin.Context) {
+	l.react(c, func(userID, id uint) (*usecase.ReactionOutput, error) {
+		return l.likeUsecase.ReactToComment(userID, id, false)
+	})
+}
+
+// ClearCommentReaction godoc
+// @Summary      Clear comment reaction
+// @Description  댓글 좋아요/싫어요 취소
+// @Tags         comments, likes
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Comment ID"
+// @Security     BearerAuth
+// @Success      200  {object}  ReactionResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/comments/{id}/like [delete]
+func (l *likeController) ClearCommentReaction(c *gin.Context) {
+	l.react(c, func(userID, id uint) (*usecase.ReactionOutput, error) {
+		return l.likeUsecase.ClearCommentReaction(userID, id)
+	})
+}
+
+// GetMyLikedTracks godoc
+// @Summary      List my liked tracks
+// @Description  JWT 인증 토큰 기반 내가 좋아요한 트랙 목록 조회 (최근 좋아요 순)
+// @Tags         users, likes
+// @Accept       json
+// @Produce      json
+// @Param request query GetLikedTracksRequest false "GetLikedTracks Request"
+// @Security     BearerAuth
+// @Success      200  {object}  GetLikedTracksResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/users/me/likes/tracks [get]
+func (l *likeController) GetMyLikedTracks(c *gin.Context) {
+	var req GetLikedTracksRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, l.jwtAuth.GinJWTMiddleware)
+	output, err := l.likeUsecase.GetLikedTracks(payload.UserID, req.Limit, req.Offset)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	tracks := make([]CatalogTrack, len(output.Tracks))
+	for i, t := range output.Tracks {
+		tracks[i] = toCatalogTrack(t)
+	}
+
+	res := GetLikedTracksResponse{Tracks: tracks, Total: output.Total}
+	c.JSON(http.StatusOK, res)
+}
+
+// react binds the target ID from the path and runs fn on behalf of the caller.
+func (l *likeController) react(c *gin.Context, fn func(userID, id uint) (*usecase.ReactionOutput, error)) {
+	var req ReactionURI
+	if err := c.ShouldBindUri(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, l.jwtAuth.GinJWTMiddleware)
+	output, err := fn(payload.UserID, req.ID)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	res := ReactionResponse{Likes: output.Likes, Dislikes: output.Dislikes, Liked: output.Liked}
+	c.JSON(http.StatusOK, res)
+}
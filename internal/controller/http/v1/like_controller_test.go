@@ -0,0 +1,186 @@
+package v1
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/auth"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLikeController_LikeMusic(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockLikeUsecase.Mock.ExpectedCalls = nil }()
+
+		liked := true
+		mockLikeUsecase.On("ReactToMusic", uint(1), uint(2), true).Return(&usecase.ReactionOutput{Likes: 5, Dislikes: 1, Liked: &liked}, nil)
+
+		req, _ := http.NewRequest(http.MethodPut, "/api/v1/music/tracks/2/like", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		var res ReactionResponse
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, int64(5), res.Likes)
+		assert.Equal(t, int64(1), res.Dislikes)
+		assert.True(t, *res.Liked)
+		mockLikeUsecase.AssertExpectations(t)
+	})
+
+	t.Run("MusicNotFound", func(t *testing.T) {
+		defer func() { mockLikeUsecase.Mock.ExpectedCalls = nil }()
+
+		mockLikeUsecase.On("ReactToMusic", uint(1), uint(99), true).Return(nil, usecase.ErrMusicNotFound)
+
+		req, _ := http.NewRequest(http.MethodPut, "/api/v1/music/tracks/99/like", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		mockLikeUsecase.AssertExpectations(t)
+	})
+
+	t.Run("InvalidMusicID", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPut, "/api/v1/music/tracks/abc/like", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestLikeController_DislikePost(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockLikeUsecase.Mock.ExpectedCalls = nil }()
+
+		liked := false
+		mockLikeUsecase.On("ReactToPost", uint(1), uint(2), false).Return(&usecase.ReactionOutput{Likes: 0, Dislikes: 1, Liked: &liked}, nil)
+
+		req, _ := http.NewRequest(http.MethodPut, "/api/v1/posts/2/dislike", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		var res ReactionResponse
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.False(t, *res.Liked)
+		mockLikeUsecase.AssertExpectations(t)
+	})
+}
+
+func TestLikeController_ClearCommentReaction(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockLikeUsecase.Mock.ExpectedCalls = nil }()
+
+		mockLikeUsecase.On("ClearCommentReaction", uint(1), uint(2)).Return(&usecase.ReactionOutput{}, nil)
+
+		req, _ := http.NewRequest(http.MethodDelete, "/api/v1/comments/2/like", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		var res ReactionResponse
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Nil(t, res.Liked)
+		mockLikeUsecase.AssertExpectations(t)
+	})
+
+	t.Run("CommentNotFound", func(t *testing.T) {
+		defer func() { mockLikeUsecase.Mock.ExpectedCalls = nil }()
+
+		mockLikeUsecase.On("ClearCommentReaction", uint(1), uint(99)).Return(nil, usecase.ErrCommentNotFound)
+
+		req, _ := http.NewRequest(http.MethodDelete, "/api/v1/comments/99/like", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		mockLikeUsecase.AssertExpectations(t)
+	})
+}
+
+func TestLikeController_GetMyLikedTracks(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockLikeUsecase.Mock.ExpectedCalls = nil }()
+
+		limit := 10
+		offset := 0
+		mockOutput := &usecase.GetLikedTracksOutput{
+			Tracks: []usecase.CatalogTrack{{ID: 2, Title: "One"}},
+			Total:  1,
+		}
+		mockLikeUsecase.On("GetLikedTracks", uint(1), &limit, &offset).Return(mockOutput, nil)
+
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/users/me/likes/tracks?limit=10&offset=0", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		var res GetLikedTracksResponse
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, 1, res.Total)
+		assert.Equal(t, "One", res.Tracks[0].Title)
+		mockLikeUsecase.AssertExpectations(t)
+	})
+
+	t.Run("InvalidLimit", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/users/me/likes/tracks?limit=abc", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -13,12 +13,26 @@ import (
 )
 
 var (
-	mockUserRepo     *mocks2.UserRepository
-	mockUserUsecase  *mocks.UserUsecase
-	mockMusicUsecase *mocks.MusicUsecase
-	userJwt          auth.UserJWT
-	testUserJwtAuth  *auth.JWTMiddleware
-	testRouter       *gin.Engine
+	mockUserRepo            *mocks2.UserRepository
+	mockUserUsecase         *mocks.UserUsecase
+	mockMusicUsecase        *mocks.MusicUsecase
+	mockGenreUsecase        *mocks.GenreUsecase
+	mockLikeUsecase         *mocks.LikeUsecase
+	mockSpotifyUsecase      *mocks.SpotifyUsecase
+	mockCollectionUsecase   *mocks.CollectionUsecase
+	mockTopsterUsecase      *mocks.TopsterUsecase
+	mockCommunityUsecase    *mocks.CommunityUsecase
+	mockPostUsecase         *mocks.PostUsecase
+	mockCommentUsecase      *mocks.CommentUsecase
+	mockModerationUsecase   *mocks.ModerationUsecase
+	mockFollowUsecase       *mocks.FollowUsecase
+	mockProfileUsecase      *mocks.ProfileUsecase
+	mockBlockUsecase        *mocks.BlockUsecase
+	mockFeedUsecase         *mocks.FeedUsecase
+	mockNotificationUsecase *mocks.NotificationUsecase
+	userJwt                 auth.UserJWT
+	testUserJwtAuth         *auth.JWTMiddleware
+	testRouter              *gin.Engine
 )
 
 func TestMain(m *testing.M) {
@@ -28,6 +42,20 @@ func TestMain(m *testing.M) {
 	mockUserRepo = new(mocks2.UserRepository)
 	mockUserUsecase = new(mocks.UserUsecase)
 	mockMusicUsecase = new(mocks.MusicUsecase)
+	mockGenreUsecase = new(mocks.GenreUsecase)
+	mockLikeUsecase = new(mocks.LikeUsecase)
+	mockSpotifyUsecase = new(mocks.SpotifyUsecase)
+	mockCollectionUsecase = new(mocks.CollectionUsecase)
+	mockTopsterUsecase = new(mocks.TopsterUsecase)
+	mockCommunityUsecase = new(mocks.CommunityUsecase)
+	mockPostUsecase = new(mocks.PostUsecase)
+	mockCommentUsecase = new(mocks.CommentUsecase)
+	mockModerationUsecase = new(mocks.ModerationUsecase)
+	mockFollowUsecase = new(mocks.FollowUsecase)
+	mockProfileUsecase = new(mocks.ProfileUsecase)
+	mockBlockUsecase = new(mocks.BlockUsecase)
+	mockFeedUsecase = new(mocks.FeedUsecase)
+	mockNotificationUsecase = new(mocks.NotificationUsecase)
 	userJwt = auth.NewUserJWT(mockUserRepo)
 	testUserJwtAuth, err = auth.NewJWTMiddleware(
 		auth.WithKey([]byte(os.Getenv("JWT_SECRET_KEY"))),
@@ -41,6 +69,6 @@ func TestMain(m *testing.M) {
 	if err != nil {
 		logging.Log().Fatal("failed to create jwt auth middleware.", zap.Error(err))
 	}
-	testRouter = SetupRouter(mockUserUsecase, mockMusicUsecase, testUserJwtAuth)
+	testRouter = SetupRouter(mockUserUsecase, mockMusicUsecase, mockGenreUsecase, mockLikeUsecase, mockSpotifyUsecase, mockCollectionUsecase, mockTopsterUsecase, mockCommunityUsecase, mockPostUsecase, mockCommentUsecase, mockModerationUsecase, mockFollowUsecase, mockProfileUsecase, mockBlockUsecase, mockFeedUsecase, mockNotificationUsecase, testUserJwtAuth)
 	os.Exit(m.Run())
 }
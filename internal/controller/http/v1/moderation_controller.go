@@ -0,0 +1,299 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/auth"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+)
+
+type ModerationController interface {
+	CreateReport(c *gin.Context)
+	ListAdminReports(c *gin.Context)
+	TakeAction(c *gin.Context)
+	ListCommunityReports(c *gin.Context)
+	ListCommunityModerationLog(c *gin.Context)
+	ListMyModerationLog(c *gin.Context)
+}
+
+type moderationController struct {
+	moderationUsecase usecase.ModerationUsecase
+	jwtAuth           *auth.JWTMiddleware
+}
+
+func NewModerationController(moderationUsecase usecase.ModerationUsecase, jwtAuth *auth.JWTMiddleware) ModerationController {
+	return &moderationController{
+		moderationUsecase: moderationUsecase,
+		jwtAuth:           jwtAuth,
+	}
+}
+
+// CreateReport godoc
+// @Summary      Report content
+// @Description  게시글, 댓글 또는 프로필 신고. 게시글/댓글 신고는 해당 커뮤니티의 모더레이션 대기열로, 프로필 신고는 관리자에게 전달됨. 같은 대상에 처리 대기 중인 신고가 있으면 409
+// @Tags         reports
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param request body CreateReportRequest true "CreateReport Request"
+// @Success      201  {object}  ReportResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      409  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/reports [post]
+func (co *moderationController) CreateReport(c *gin.Context) {
+	var req CreateReportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, co.jwtAuth.GinJWTMiddleware)
+	output, err := co.moderationUsecase.ReportContent(payload.UserID, &usecase.CreateReportInput{
+		TargetType: req.TargetType,
+		TargetID:   req.TargetID,
+		Reason:     req.Reason,
+		Details:    req.Details,
+	})
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toReportResponse(*output))
+}
+
+// ListAdminReports godoc
+// @Summary      List reports for admins
+// @Description  관리자 대기열 조회 (관리자만 가능). 모더레이터가 관리자에게 넘긴 신고와 프로필 신고를 오래된 순으로 반환
+// @Tags         reports
+// @Accept       json
+// @Produce      json
+// @Param request query ListReportsRequest false "ListReports Request"
+// @Security     BearerAuth
+// @Success      200  {object}  ListReportsResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      403  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/reports [get]
+func (co *moderationController) ListAdminReports(c *gin.Context) {
+	var req ListReportsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, co.jwtAuth.GinJWTMiddleware)
+	output, err := co.moderationUsecase.ListAdminReports(payload.UserID, req.Limit, req.Offset)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toListReportsResponse(*output))
+}
+
+// TakeAction godoc
+// @Summary      Take moderation action
+// @Description  신고 처리. HIDE(숨김), REMOVE(삭제, 프로필은 소개/웹사이트/프로필 이미지를 지우고 닉네임을 user-{id}로 초기화), WARN(경고), BAN(커뮤니티 차단), ESCALATE(관리자에게 넘김), DISMISS(기각). 커뮤니티 모더레이터는 해당 커뮤니티의 대기 중인 신고를, 관리자는 모든 신고를 처리 가능. ESCALATE 외의 처리는 같은 대상의 대기 중인 신고를 모두 종료하며, 처리 내역은 모더레이션 로그에 기록됨
+// @Tags         reports
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Report ID"
+// @Security     BearerAuth
+// @Param request body ModerationActionRequest true "ModerationAction Request"
+// @Success      201  {object}  ModerationActionResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      403  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      409  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/reports/{id}/actions [post]
+func (co *moderationController) TakeAction(c *gin.Context) {
+	var uri ReportURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	var req ModerationActionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, co.jwtAuth.GinJWTMiddleware)
+	output, err := co.moderationUsecase.TakeAction(payload.UserID, uri.ID, &usecase.ModerationActionInput{
+		Action: req.Action,
+		Reason: req.Reason,
+	})
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toModerationActionResponse(*output))
+}
+
+// ListCommunityReports godoc
+// @Summary      List community reports
+// @Description  커뮤니티 모더레이션 대기열 조회 (모더레이터 또는 관리자만 가능). status로 필터링 (기본값 OPEN), 오래된 순
+// @Tags         communities, reports
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Community ID"
+// @Param request query ListCommunityReportsRequest false "ListCommunityReports Request"
+// @Security     BearerAuth
+// @Success      200  {object}  ListReportsResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      403  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/communities/{id}/reports [get]
+func (co *moderationController) ListCommunityReports(c *gin.Context) {
+	var uri CommunityURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	var req ListCommunityReportsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, co.jwtAuth.GinJWTMiddleware)
+	output, err := co.moderationUsecase.ListCommunityReports(payload.UserID, uri.ID, req.Status, req.Limit, req.Offset)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toListReportsResponse(*output))
+}
+
+// ListCommunityModerationLog godoc
+// @Summary      List community moderation log
+// @Description  커뮤니티 모더레이션 로그 조회 (모더레이터 또는 관리자만 가능). 최신순
+// @Tags         communities, reports
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Community ID"
+// @Param request query ListReportsRequest false "ListReports Request"
+// @Security     BearerAuth
+// @Success      200  {object}  ListModerationActionsResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      403  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/communities/{id}/moderation-log [get]
+func (co *moderationController) ListCommunityModerationLog(c *gin.Context) {
+	var uri CommunityURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	var req ListReportsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, co.jwtAuth.GinJWTMiddleware)
+	output, err := co.moderationUsecase.ListCommunityModerationLog(payload.UserID, uri.ID, req.Limit, req.Offset)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toListModerationActionsResponse(*output))
+}
+
+// ListMyModerationLog godoc
+// @Summary      List my moderation log
+// @Description  내 게시글, 댓글 및 계정에 대한 제재 기록 조회 (숨김, 삭제, 경고, 차단). 최신순이며 처리한 모더레이터는 공개되지 않음
+// @Tags         users, reports
+// @Accept       json
+// @Produce      json
+// @Param request query ListReportsRequest false "ListReports Request"
+// @Security     BearerAuth
+// @Success      200  {object}  ListModerationActionsResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/users/me/moderation-log [get]
+func (co *moderationController) ListMyModerationLog(c *gin.Context) {
+	var req ListReportsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, co.jwtAuth.GinJWTMiddleware)
+	output, err := co.moderationUsecase.ListMyModerationLog(payload.UserID, req.Limit, req.Offset)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toListModerationActionsResponse(*output))
+}
+
+func toReportResponse(output usecase.ReportOutput) ReportResponse {
+	return ReportResponse{
+		ID:           output.ID,
+		ReporterID:   output.ReporterID,
+		TargetType:   output.TargetType,
+		TargetID:     output.TargetID,
+		TargetUserID: output.TargetUserID,
+		CommunityID:  output.CommunityID,
+		Reason:       output.Reason,
+		Details:      output.Details,
+		Excerpt:      output.Excerpt,
+		Status:       output.Status,
+		ResolvedByID: output.ResolvedByID,
+		ResolvedAt:   output.ResolvedAt,
+		CreatedAt:    output.CreatedAt,
+	}
+}
+
+func toListReportsResponse(output usecase.ListReportsOutput) ListReportsResponse {
+	reports := make([]ReportResponse, len(output.Reports))
+	for i, r := range output.Reports {
+		reports[i] = toReportResponse(r)
+	}
+	return ListReportsResponse{Reports: reports, Total: output.Total}
+}
+
+func toModerationActionResponse(output usecase.ModerationActionOutput) ModerationActionResponse {
+	return ModerationActionResponse{
+		ID:           output.ID,
+		CommunityID:  output.CommunityID,
+		ReportID:     output.ReportID,
+		ModeratorID:  output.ModeratorID,
+		TargetUserID: output.TargetUserID,
+		Action:       output.Action,
+		TargetType:   output.TargetType,
+		TargetID:     output.TargetID,
+		Excerpt:      output.Excerpt,
+		Reason:       output.Reason,
+		CreatedAt:    output.CreatedAt,
+	}
+}
+
+func toListModerationActionsResponse(output usecase.ListModerationActionsOutput) ListModerationActionsResponse {
+	actions := make([]ModerationActionResponse, len(output.Actions))
+	for i, a := range output.Actions {
+		actions[i] = toModerationActionResponse(a)
+	}
+	return ListModerationActionsResponse{Actions: actions, Total: output.Total}
+}
@@ -0,0 +1,181 @@
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/auth"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModerationController_CreateReport(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockModerationUsecase.Mock.ExpectedCalls = nil }()
+
+		communityID, reporterID := uint(10), uint(1)
+		mockModerationUsecase.On("ReportContent", uint(1), &usecase.CreateReportInput{TargetType: "POST", TargetID: 5, Reason: "SPAM", Details: "link farm"}).
+			Return(&usecase.ReportOutput{ID: 2, ReporterID: &reporterID, TargetType: "POST", TargetID: 5, TargetUserID: 3, CommunityID: &communityID, Reason: "SPAM", Status: "OPEN"}, nil)
+
+		reqBody, _ := json.Marshal(CreateReportRequest{TargetType: "POST", TargetID: 5, Reason: "SPAM", Details: "link farm"})
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/reports", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		var res ReportResponse
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, uint(2), res.ID)
+		assert.Equal(t, "OPEN", res.Status)
+		assert.Equal(t, uint(10), *res.CommunityID)
+		mockModerationUsecase.AssertExpectations(t)
+	})
+
+	t.Run("InvalidReason", func(t *testing.T) {
+		reqBody, _ := json.Marshal(CreateReportRequest{TargetType: "POST", TargetID: 5, Reason: "BORING"})
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/reports", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("AlreadyPending", func(t *testing.T) {
+		defer func() { mockModerationUsecase.Mock.ExpectedCalls = nil }()
+
+		mockModerationUsecase.On("ReportContent", uint(1), &usecase.CreateReportInput{TargetType: "USER", TargetID: 3, Reason: "HARASSMENT"}).
+			Return(nil, usecase.ErrReportAlreadyExists)
+
+		reqBody, _ := json.Marshal(CreateReportRequest{TargetType: "USER", TargetID: 3, Reason: "HARASSMENT"})
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/reports", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
+
+func TestModerationController_TakeAction(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("NotModerator", func(t *testing.T) {
+		defer func() { mockModerationUsecase.Mock.ExpectedCalls = nil }()
+
+		mockModerationUsecase.On("TakeAction", uint(2), uint(7), &usecase.ModerationActionInput{Action: "BAN", Reason: "spam"}).
+			Return(nil, usecase.ErrCommunityPermissionDenied)
+
+		reqBody, _ := json.Marshal(ModerationActionRequest{Action: "BAN", Reason: "spam"})
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/reports/7/actions", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 2})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		mockModerationUsecase.AssertExpectations(t)
+	})
+
+	t.Run("InvalidAction", func(t *testing.T) {
+		reqBody, _ := json.Marshal(ModerationActionRequest{Action: "SHADOWBAN"})
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/reports/7/actions", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 2})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestModerationController_ListCommunityReports(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockModerationUsecase.Mock.ExpectedCalls = nil }()
+
+		mockModerationUsecase.On("ListCommunityReports", uint(4), uint(10), "ESCALATED", (*int)(nil), (*int)(nil)).
+			Return(&usecase.ListReportsOutput{Reports: []usecase.ReportOutput{{ID: 2, Status: "ESCALATED"}}, Total: 1}, nil)
+
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/communities/10/reports?status=ESCALATED", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 4})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		var res ListReportsResponse
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, 1, res.Total)
+		mockModerationUsecase.AssertExpectations(t)
+	})
+
+	t.Run("InvalidStatus", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/communities/10/reports?status=PENDING", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 4})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestModerationController_ListMyModerationLog(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	defer func() { mockModerationUsecase.Mock.ExpectedCalls = nil }()
+
+	mockModerationUsecase.On("ListMyModerationLog", uint(3), (*int)(nil), (*int)(nil)).
+		Return(&usecase.ListModerationActionsOutput{Actions: []usecase.ModerationActionOutput{{ID: 1, TargetUserID: 3, Action: "WARN", Reason: "be nice"}}, Total: 1}, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/users/me/moderation-log", nil)
+
+	token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 3})
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	w := httptest.NewRecorder()
+	testRouter.ServeHTTP(w, req)
+
+	var res ListModerationActionsResponse
+	err := json.Unmarshal(w.Body.Bytes(), &res)
+	assert.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "WARN", res.Actions[0].Action)
+	assert.NotContains(t, w.Body.String(), "moderator_id")
+	mockModerationUsecase.AssertExpectations(t)
+}
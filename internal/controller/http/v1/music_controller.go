@@ -10,6 +10,10 @@ import (
 
 type MusicController interface {
 	SearchTrack(c *gin.Context)
+	SyncLastfm(c *gin.Context)
+	GetTrack(c *gin.Context)
+	GetAlbum(c *gin.Context)
+	GetArtist(c *gin.Context)
 }
 
 type musicController struct {
@@ -64,3 +68,192 @@ func (m *musicController) SearchTrack(c *gin.Context) {
 	res := SearchTrackResponse{Tracks: tracks, Total: total}
 	c.JSON(http.StatusOK, res)
 }
+
+// SyncLastfm godoc
+// @Summary      Sync track with Last.fm
+// @Description  Last.fm 트랙 정보로 재생/청취자 통계를 갱신하고, Last.fm 태그와 아티스트의 Spotify 장르로 장르 매핑 갱신
+// @Tags         music, tracks
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Music ID"
+// @Security     BearerAuth
+// @Success      200  {object}  SyncLastfmResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/music/tracks/{id}/lastfm [post]
+func (m *musicController) SyncLastfm(c *gin.Context) {
+	var req SyncLastfmRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	output, err := m.musicUsecase.SyncLastfm(c, req.MusicID)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	genres := make([]Genre, len(output.Genres))
+	for i, g := range output.Genres {
+		genres[i] = Genre{ID: g.ID, Name: g.Name}
+	}
+
+	res := SyncLastfmResponse{
+		MusicID:   output.MusicID,
+		LastfmID:  output.LastfmID,
+		Listeners: output.Listeners,
+		Playcount: output.Playcount,
+		Genres:    genres,
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// GetTrack godoc
+// @Summary      Get track detail
+// @Description  로컬 ID 또는 Spotify ID로 트랙 상세 정보와 커뮤니티 통계(좋아요/싫어요, 컬렉션 수, 내 좋아요 여부) 조회
+// @Tags         music, tracks
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "Music ID or Spotify track ID"
+// @Security     BearerAuth
+// @Success      200  {object}  GetTrackResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/music/tracks/{id} [get]
+func (m *musicController) GetTrack(c *gin.Context) {
+	var req CatalogURI
+	if err := c.ShouldBindUri(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, m.jwtAuth.GinJWTMiddleware)
+	output, err := m.musicUsecase.GetTrack(c, payload.UserID, req.ID)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	genres := make([]Genre, len(output.Genres))
+	for i, g := range output.Genres {
+		genres[i] = Genre{ID: g.ID, Name: g.Name}
+	}
+
+	res := GetTrackResponse{
+		Track:           toCatalogTrack(output.Track),
+		Album:           toCatalogAlbum(output.Album),
+		Genres:          genres,
+		LastfmListeners: output.LastfmListeners,
+		LastfmPlaycount: output.LastfmPlaycount,
+		Likes:           output.Likes,
+		Dislikes:        output.Dislikes,
+		CollectionCount: output.CollectionCount,
+		Liked:           output.Liked,
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// GetAlbum godoc
+// @Summary      Get album detail
+// @Description  로컬 ID 또는 Spotify ID로 앨범 상세 정보, 수록곡, 좋아요/싫어요 합계 조회
+// @Tags         music, albums
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "Album ID or Spotify album ID"
+// @Security     BearerAuth
+// @Success      200  {object}  GetAlbumResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/music/albums/{id} [get]
+func (m *musicController) GetAlbum(c *gin.Context) {
+	var req CatalogURI
+	if err := c.ShouldBindUri(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	output, err := m.musicUsecase.GetAlbum(c, req.ID)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	tracks := make([]CatalogTrack, len(output.Tracks))
+	for i, t := range output.Tracks {
+		tracks[i] = toCatalogTrack(t)
+	}
+
+	res := GetAlbumResponse{
+		Album:    toCatalogAlbum(output.Album),
+		Tracks:   tracks,
+		Likes:    output.Likes,
+		Dislikes: output.Dislikes,
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// GetArtist godoc
+// @Summary      Get artist detail
+// @Description  로컬 ID 또는 Spotify ID로 아티스트 상세 정보, 앨범, 트랙, 좋아요/싫어요 합계 조회
+// @Tags         music, artists
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "Artist ID or Spotify artist ID"
+// @Security     BearerAuth
+// @Success      200  {object}  GetArtistResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/music/artists/{id} [get]
+func (m *musicController) GetArtist(c *gin.Context) {
+	var req CatalogURI
+	if err := c.ShouldBindUri(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	output, err := m.musicUsecase.GetArtist(c, req.ID)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	albums := make([]CatalogAlbum, len(output.Albums))
+	for i, a := range output.Albums {
+		albums[i] = toCatalogAlbum(a)
+	}
+	tracks := make([]CatalogTrack, len(output.Tracks))
+	for i, t := range output.Tracks {
+		tracks[i] = toCatalogTrack(t)
+	}
+
+	res := GetArtistResponse{
+		Artist:      CatalogArtist{ID: output.Artist.ID, Name: output.Artist.Name},
+		Description: output.Description,
+		SpotifyID:   output.SpotifyID,
+		Albums:      albums,
+		Tracks:      tracks,
+		Likes:       output.Likes,
+		Dislikes:    output.Dislikes,
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func toCatalogAlbum(a usecase.CatalogAlbum) CatalogAlbum {
+	return CatalogAlbum{
+		ID:          a.ID,
+		Name:        a.Name,
+		SpotifyID:   a.SpotifyID,
+		ImageURL:    a.ImageURL,
+		ReleaseDate: a.ReleaseDate,
+		Artist:      CatalogArtist{ID: a.Artist.ID, Name: a.Artist.Name},
+	}
+}
@@ -94,3 +94,231 @@ func TestMusicController_SearchTrack(t *testing.T) {
 		mockMusicUsecase.AssertExpectations(t)
 	})
 }
+
+func TestMusicController_SyncLastfm(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockMusicUsecase.Mock.ExpectedCalls = nil }()
+
+		mockOutput := &usecase.SyncLastfmOutput{
+			MusicID:   1,
+			LastfmID:  "32ca187e-ee25-4f18-b7d0-3b6713f24635",
+			Listeners: 1027457,
+			Playcount: 7863914,
+			Genres:    []usecase.Genre{{ID: 1, Name: "pop"}},
+		}
+		mockMusicUsecase.On("SyncLastfm", mock.Anything, uint(1)).Return(mockOutput, nil)
+
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/music/tracks/1/lastfm", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		var res SyncLastfmResponse
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, mockOutput.LastfmID, res.LastfmID)
+		assert.Equal(t, mockOutput.Listeners, res.Listeners)
+		assert.Equal(t, mockOutput.Playcount, res.Playcount)
+		assert.Equal(t, []Genre{{ID: 1, Name: "pop"}}, res.Genres)
+		mockMusicUsecase.AssertExpectations(t)
+	})
+
+	t.Run("InvalidMusicID", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/music/tracks/abc/lastfm", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockMusicUsecase.AssertNotCalled(t, "SyncLastfm")
+	})
+
+	t.Run("MusicNotFound", func(t *testing.T) {
+		defer func() { mockMusicUsecase.Mock.ExpectedCalls = nil }()
+		mockMusicUsecase.On("SyncLastfm", mock.Anything, uint(2)).Return(nil, usecase.ErrMusicNotFound)
+
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/music/tracks/2/lastfm", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		mockMusicUsecase.AssertExpectations(t)
+	})
+}
+
+func TestMusicController_GetTrack(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockMusicUsecase.Mock.ExpectedCalls = nil }()
+
+		liked := true
+		mockOutput := &usecase.GetTrackOutput{
+			Track:           usecase.CatalogTrack{ID: 1, Title: "One", AlbumID: 2, Artists: []usecase.CatalogArtist{{ID: 3, Name: "Aimee Mann"}}},
+			Album:           usecase.CatalogAlbum{ID: 2, Name: "Magnolia", Artist: usecase.CatalogArtist{ID: 3, Name: "Aimee Mann"}},
+			Genres:          []usecase.Genre{{ID: 4, Name: "Rock"}},
+			Likes:           12,
+			Dislikes:        1,
+			CollectionCount: 4,
+			Liked:           &liked,
+		}
+		mockMusicUsecase.On("GetTrack", mock.Anything, uint(1), "2up3OPMp9Tb4dAKM2erWXQ").Return(mockOutput, nil)
+
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/music/tracks/2up3OPMp9Tb4dAKM2erWXQ", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		var res GetTrackResponse
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "One", res.Track.Title)
+		assert.Equal(t, "Magnolia", res.Album.Name)
+		assert.Equal(t, []Genre{{ID: 4, Name: "Rock"}}, res.Genres)
+		assert.Equal(t, int64(12), res.Likes)
+		assert.Equal(t, int64(4), res.CollectionCount)
+		assert.True(t, *res.Liked)
+		mockMusicUsecase.AssertExpectations(t)
+	})
+
+	t.Run("MusicNotFound", func(t *testing.T) {
+		defer func() { mockMusicUsecase.Mock.ExpectedCalls = nil }()
+
+		mockMusicUsecase.On("GetTrack", mock.Anything, uint(1), "99").Return(nil, usecase.ErrMusicNotFound)
+
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/music/tracks/99", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		mockMusicUsecase.AssertExpectations(t)
+	})
+}
+
+func TestMusicController_GetAlbum(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockMusicUsecase.Mock.ExpectedCalls = nil }()
+
+		mockOutput := &usecase.GetAlbumOutput{
+			Album:    usecase.CatalogAlbum{ID: 2, Name: "Magnolia"},
+			Tracks:   []usecase.CatalogTrack{{ID: 1, Title: "One"}},
+			Likes:    30,
+			Dislikes: 2,
+		}
+		mockMusicUsecase.On("GetAlbum", mock.Anything, "2").Return(mockOutput, nil)
+
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/music/albums/2", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		var res GetAlbumResponse
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "Magnolia", res.Album.Name)
+		assert.Equal(t, "One", res.Tracks[0].Title)
+		assert.Equal(t, int64(30), res.Likes)
+		mockMusicUsecase.AssertExpectations(t)
+	})
+
+	t.Run("FetchingSpotifyError", func(t *testing.T) {
+		defer func() { mockMusicUsecase.Mock.ExpectedCalls = nil }()
+
+		mockMusicUsecase.On("GetAlbum", mock.Anything, "3M3cfh4JyWpuL0nVwEPSdo").Return(nil, usecase.ErrFetchingSpotify)
+
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/music/albums/3M3cfh4JyWpuL0nVwEPSdo", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		mockMusicUsecase.AssertExpectations(t)
+	})
+}
+
+func TestMusicController_GetArtist(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockMusicUsecase.Mock.ExpectedCalls = nil }()
+
+		mockOutput := &usecase.GetArtistOutput{
+			Artist:    usecase.CatalogArtist{ID: 3, Name: "Aimee Mann"},
+			SpotifyID: "6lGiIBsLhPUMFGRmh3Vqsd",
+			Albums:    []usecase.CatalogAlbum{{ID: 2, Name: "Magnolia"}},
+			Tracks:    []usecase.CatalogTrack{{ID: 1, Title: "One"}},
+			Likes:     120,
+			Dislikes:  5,
+		}
+		mockMusicUsecase.On("GetArtist", mock.Anything, "3").Return(mockOutput, nil)
+
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/music/artists/3", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		var res GetArtistResponse
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "Aimee Mann", res.Artist.Name)
+		assert.Equal(t, "Magnolia", res.Albums[0].Name)
+		assert.Equal(t, "One", res.Tracks[0].Title)
+		assert.Equal(t, int64(5), res.Dislikes)
+		mockMusicUsecase.AssertExpectations(t)
+	})
+
+	t.Run("ArtistNotFound", func(t *testing.T) {
+		defer func() { mockMusicUsecase.Mock.ExpectedCalls = nil }()
+
+		mockMusicUsecase.On("GetArtist", mock.Anything, "99").Return(nil, usecase.ErrArtistNotFound)
+
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/music/artists/99", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		mockMusicUsecase.AssertExpectations(t)
+	})
+}
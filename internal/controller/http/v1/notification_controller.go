@@ -0,0 +1,173 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/auth"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+)
+
+type NotificationController interface {
+	ListNotifications(c *gin.Context)
+	MarkRead(c *gin.Context)
+	GetPreferences(c *gin.Context)
+	UpdatePreferences(c *gin.Context)
+}
+
+type notificationController struct {
+	notificationUsecase usecase.NotificationUsecase
+	jwtAuth             *auth.JWTMiddleware
+}
+
+func NewNotificationController(notificationUsecase usecase.NotificationUsecase, jwtAuth *auth.JWTMiddleware) NotificationController {
+	return &notificationController{
+		notificationUsecase: notificationUsecase,
+		jwtAuth:             jwtAuth,
+	}
+}
+
+// ListNotifications godoc
+// @Summary      List notifications
+// @Description  내 알림 목록 조회 (최신순). 내 글에 달린 댓글과 답글, 내 게시글 좋아요, 새 팔로워, 멘션(@닉네임), 운영 조치 알림이 포함되며, 차단 관계인 유저의 알림은 제외됨. unread=true이면 읽지 않은 알림만 조회. 읽지 않은 알림 수(unread_count)를 함께 반환
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Param request query ListNotificationsRequest false "ListNotifications Request"
+// @Security     BearerAuth
+// @Success      200  {object}  ListNotificationsResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/notifications [get]
+func (n *notificationController) ListNotifications(c *gin.Context) {
+	var req ListNotificationsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, n.jwtAuth.GinJWTMiddleware)
+	output, err := n.notificationUsecase.ListNotifications(payload.UserID, req.Unread, req.Limit, req.Offset)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	notifications := make([]NotificationResponse, len(output.Notifications))
+	for i, o := range output.Notifications {
+		notifications[i] = NotificationResponse{
+			ID:                 o.ID,
+			Type:               o.Type,
+			Message:            o.Message,
+			PostID:             o.PostID,
+			CommentID:          o.CommentID,
+			ModerationActionID: o.ModerationActionID,
+			Read:               o.Read,
+			CreatedAt:          o.CreatedAt,
+		}
+		if o.Actor != nil {
+			notifications[i].Actor = &NotificationActorResponse{
+				UserID:          o.Actor.UserID,
+				Nickname:        o.Actor.Nickname,
+				ProfileImageURL: o.Actor.ProfileImageURL,
+			}
+		}
+	}
+	c.JSON(http.StatusOK, ListNotificationsResponse{
+		Notifications: notifications,
+		Total:         output.Total,
+		UnreadCount:   output.UnreadCount,
+	})
+}
+
+// MarkRead godoc
+// @Summary      Mark notifications as read
+// @Description  알림 읽음 처리. ids에 포함된 내 알림을 읽음 처리하거나, all=true이면 모든 알림을 읽음 처리. 남은 읽지 않은 알림 수를 반환
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Param request body MarkNotificationsReadRequest true "MarkNotificationsRead Request"
+// @Security     BearerAuth
+// @Success      200  {object}  MarkNotificationsReadResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/notifications [patch]
+func (n *notificationController) MarkRead(c *gin.Context) {
+	var req MarkNotificationsReadRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, n.jwtAuth.GinJWTMiddleware)
+	output, err := n.notificationUsecase.MarkRead(payload.UserID, &usecase.MarkNotificationsReadInput{IDs: req.IDs, All: req.All})
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, MarkNotificationsReadResponse{UnreadCount: output.UnreadCount})
+}
+
+// GetPreferences godoc
+// @Summary      Get notification preferences
+// @Description  알림 종류(REPLY, POST_LIKE, FOLLOW, MENTION, MODERATION)별 수신 설정 조회. 앱 내 알림은 기본적으로 모두 켜져 있고, 이메일 알림은 운영 조치만 기본으로 켜져 있음
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  NotificationPreferencesResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/notifications/preferences [get]
+func (n *notificationController) GetPreferences(c *gin.Context) {
+	payload := auth.GetUserPayload(c, n.jwtAuth.GinJWTMiddleware)
+	output, err := n.notificationUsecase.GetPreferences(payload.UserID)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, toNotificationPreferencesResponse(output))
+}
+
+// UpdatePreferences godoc
+// @Summary      Update notification preferences
+// @Description  알림 종류별 앱 내 알림(in_app)과 이메일 알림(email) 수신 여부 변경. 전달하지 않은 항목은 변경되지 않음
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Param request body UpdateNotificationPreferencesRequest true "UpdateNotificationPreferences Request"
+// @Security     BearerAuth
+// @Success      200  {object}  NotificationPreferencesResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/notifications/preferences [patch]
+func (n *notificationController) UpdatePreferences(c *gin.Context) {
+	var req UpdateNotificationPreferencesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	input := make([]usecase.UpdateNotificationPreferenceInput, len(req.Preferences))
+	for i, p := range req.Preferences {
+		input[i] = usecase.UpdateNotificationPreferenceInput{Type: p.Type, InApp: p.InApp, Email: p.Email}
+	}
+	payload := auth.GetUserPayload(c, n.jwtAuth.GinJWTMiddleware)
+	output, err := n.notificationUsecase.UpdatePreferences(payload.UserID, input)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, toNotificationPreferencesResponse(output))
+}
+
+func toNotificationPreferencesResponse(output *usecase.NotificationPreferencesOutput) NotificationPreferencesResponse {
+	preferences := make([]NotificationPreferenceResponse, len(output.Preferences))
+	for i, p := range output.Preferences {
+		preferences[i] = NotificationPreferenceResponse{Type: p.Type, InApp: p.InApp, Email: p.Email}
+	}
+	return NotificationPreferencesResponse{Preferences: preferences}
+}
@@ -0,0 +1,116 @@
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/auth"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationController_ListNotifications(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	defer func() { mockNotificationUsecase.Mock.ExpectedCalls = nil }()
+
+	mockNotificationUsecase.On("ListNotifications", uint(1), true, (*int)(nil), (*int)(nil)).Return(&usecase.ListNotificationsOutput{
+		Notifications: []usecase.NotificationOutput{
+			{ID: 3, Type: "FOLLOW", Actor: &usecase.NotificationActorOutput{UserID: 2, Nickname: "alice"}, Message: "alice님이 회원님을 팔로우하기 시작했습니다."},
+			{ID: 2, Type: "MODERATION", Message: "회원님의 계정 또는 콘텐츠에 운영 조치가 취해졌습니다."},
+		},
+		Total:       2,
+		UnreadCount: 2,
+	}, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/notifications?unread=true", nil)
+	token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	w := httptest.NewRecorder()
+	testRouter.ServeHTTP(w, req)
+
+	var res ListNotificationsResponse
+	err := json.Unmarshal(w.Body.Bytes(), &res)
+	assert.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, 2, res.UnreadCount)
+	assert.Len(t, res.Notifications, 2)
+	assert.Equal(t, "alice", res.Notifications[0].Actor.Nickname)
+	assert.Nil(t, res.Notifications[1].Actor)
+	mockNotificationUsecase.AssertExpectations(t)
+}
+
+func TestNotificationController_MarkRead(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockNotificationUsecase.Mock.ExpectedCalls = nil }()
+
+		mockNotificationUsecase.On("MarkRead", uint(1), &usecase.MarkNotificationsReadInput{IDs: []uint{3, 4}}).
+			Return(&usecase.MarkNotificationsReadOutput{UnreadCount: 1}, nil)
+
+		body, _ := json.Marshal(MarkNotificationsReadRequest{IDs: []uint{3, 4}})
+		req, _ := http.NewRequest(http.MethodPatch, "/api/v1/notifications", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		var res MarkNotificationsReadResponse
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, 1, res.UnreadCount)
+		mockNotificationUsecase.AssertExpectations(t)
+	})
+
+	t.Run("NothingSelected", func(t *testing.T) {
+		defer func() { mockNotificationUsecase.Mock.ExpectedCalls = nil }()
+
+		mockNotificationUsecase.On("MarkRead", uint(1), &usecase.MarkNotificationsReadInput{}).Return(nil, usecase.ErrNoNotificationsSelected)
+
+		req, _ := http.NewRequest(http.MethodPatch, "/api/v1/notifications", bytes.NewBufferString("{}"))
+		req.Header.Set("Content-Type", "application/json")
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestNotificationController_UpdatePreferences(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	defer func() { mockNotificationUsecase.Mock.ExpectedCalls = nil }()
+
+	email := true
+	mockNotificationUsecase.On("UpdatePreferences", uint(1), []usecase.UpdateNotificationPreferenceInput{{Type: "FOLLOW", Email: &email}}).
+		Return(&usecase.NotificationPreferencesOutput{Preferences: []usecase.NotificationPreferenceOutput{{Type: "FOLLOW", InApp: true, Email: true}}}, nil)
+
+	body, _ := json.Marshal(UpdateNotificationPreferencesRequest{Preferences: []NotificationPreferenceRequest{{Type: "FOLLOW", Email: &email}}})
+	req, _ := http.NewRequest(http.MethodPatch, "/api/v1/notifications/preferences", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	w := httptest.NewRecorder()
+	testRouter.ServeHTTP(w, req)
+
+	var res NotificationPreferencesResponse
+	err := json.Unmarshal(w.Body.Bytes(), &res)
+	assert.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, []NotificationPreferenceResponse{{Type: "FOLLOW", InApp: true, Email: true}}, res.Preferences)
+	mockNotificationUsecase.AssertExpectations(t)
+}
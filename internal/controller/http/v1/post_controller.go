@@ -0,0 +1,351 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/auth"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+	"github.com/myjinjin/sonic-odyssey-backend/pkg/utils"
+)
+
+type PostController interface {
+	CreatePost(c *gin.Context)
+	GetPost(c *gin.Context)
+	PatchPost(c *gin.Context)
+	DeletePost(c *gin.Context)
+	ListCommunityPosts(c *gin.Context)
+	ListPosts(c *gin.Context)
+	SearchPosts(c *gin.Context)
+}
+
+type postController struct {
+	postUsecase usecase.PostUsecase
+	jwtAuth     *auth.JWTMiddleware
+}
+
+func NewPostController(postUsecase usecase.PostUsecase, jwtAuth *auth.JWTMiddleware) PostController {
+	return &postController{
+		postUsecase: postUsecase,
+		jwtAuth:     jwtAuth,
+	}
+}
+
+// CreatePost godoc
+// @Summary      Create post
+// @Description  커뮤니티에 게시글 작성 (커뮤니티 멤버만 가능). content는 Markdown(CommonMark)으로 작성하며, 정제된 HTML이 content_html로 함께 저장됨. 허용된 사이트 링크는 미리보기가 생성됨. music에 음악 ID, Spotify 트랙 URL 또는 spotify:track: URI를 지정하면 트랙 카드로 첨부됨 (최대 10개). 욕설은 마스킹되고, 링크 스팸으로 의심되면 검토 전까지 숨겨지며, 최근 작성한 내용과 중복되면 거부됨
+// @Tags         communities, posts
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Community ID"
+// @Security     BearerAuth
+// @Param request body CreatePostRequest true "CreatePost Request"
+// @Success      201  {object}  PostResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      403  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      409  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/communities/{id}/posts [post]
+func (co *postController) CreatePost(c *gin.Context) {
+	var uri CommunityURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	var req CreatePostRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, co.jwtAuth.GinJWTMiddleware)
+	output, err := co.postUsecase.CreatePost(c.Request.Context(), payload.UserID, uri.ID, &usecase.CreatePostInput{
+		Title:   req.Title,
+		Content: req.Content,
+		Music:   req.Music,
+	})
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toPostResponse(*output))
+}
+
+// GetPost godoc
+// @Summary      Get post
+// @Description  게시글 조회 (좋아요/싫어요 수, 첨부된 트랙 카드 포함)
+// @Tags         posts
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Post ID"
+// @Security     BearerAuth
+// @Success      200  {object}  PostResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/posts/{id} [get]
+func (co *postController) GetPost(c *gin.Context) {
+	var req PostURI
+	if err := c.ShouldBindUri(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	output, err := co.postUsecase.GetPost(req.ID)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toPostResponse(*output))
+}
+
+// PatchPost godoc
+// @Summary      Update post
+// @Description  게시글 수정 (작성자만 가능). music을 지정하면 첨부된 트랙을 교체하고, 빈 배열이면 모두 제거. 욕설은 마스킹되고, 링크 스팸으로 의심되면 검토 전까지 숨겨지며, 최근 작성한 내용과 중복되면 거부됨
+// @Tags         posts
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Post ID"
+// @Security     BearerAuth
+// @Param request body PatchPostRequest true "PatchPost Request"
+// @Success      200  {object}  PostResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      403  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      409  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/posts/{id} [patch]
+func (co *postController) PatchPost(c *gin.Context) {
+	var uri PostURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	var req PatchPostRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	if err := utils.ValidateRequest(&req); err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, co.jwtAuth.GinJWTMiddleware)
+	output, err := co.postUsecase.PatchPost(c.Request.Context(), payload.UserID, uri.ID, &usecase.PatchPostInput{
+		Title:   req.Title,
+		Content: req.Content,
+		Music:   req.Music,
+	})
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toPostResponse(*output))
+}
+
+// DeletePost godoc
+// @Summary      Delete post
+// @Description  게시글 삭제 (작성자만 가능). 댓글도 함께 삭제됨
+// @Tags         posts
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Post ID"
+// @Security     BearerAuth
+// @Success      200  {object}  DeletePostResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      403  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/posts/{id} [delete]
+func (co *postController) DeletePost(c *gin.Context) {
+	var req PostURI
+	if err := c.ShouldBindUri(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, co.jwtAuth.GinJWTMiddleware)
+	if err := co.postUsecase.DeletePost(payload.UserID, req.ID); err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, DeletePostResponse{})
+}
+
+// ListCommunityPosts godoc
+// @Summary      List community posts
+// @Description  커뮤니티 게시글 목록 조회. new(최신순, 기본값), top(좋아요-싫어요 순), hot(시간에 따라 감소하는 점수 순). 차단 관계인 유저의 게시글은 제외
+// @Tags         communities, posts
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Community ID"
+// @Param request query ListPostsRequest false "ListPosts Request"
+// @Security     BearerAuth
+// @Success      200  {object}  ListPostsResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/communities/{id}/posts [get]
+func (co *postController) ListCommunityPosts(c *gin.Context) {
+	var uri CommunityURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	var req ListPostsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, co.jwtAuth.GinJWTMiddleware)
+	output, err := co.postUsecase.ListCommunityPosts(payload.UserID, uri.ID, req.Sort, req.Limit, req.Offset)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toListPostsResponse(*output))
+}
+
+// ListPosts godoc
+// @Summary      List posts
+// @Description  전체 커뮤니티의 게시글 목록 조회 (피드). new(최신순, 기본값), top(좋아요-싫어요 순), hot(시간에 따라 감소하는 점수 순). 차단 관계이거나 뮤트한 유저의 게시글은 제외
+// @Tags         posts
+// @Accept       json
+// @Produce      json
+// @Param request query ListPostsRequest false "ListPosts Request"
+// @Security     BearerAuth
+// @Success      200  {object}  ListPostsResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/posts [get]
+func (co *postController) ListPosts(c *gin.Context) {
+	var req ListPostsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, co.jwtAuth.GinJWTMiddleware)
+	output, err := co.postUsecase.ListPosts(payload.UserID, req.Sort, req.Limit, req.Offset)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toListPostsResponse(*output))
+}
+
+// SearchPosts godoc
+// @Summary      Search posts
+// @Description  제목 또는 본문으로 게시글 검색 (최신순). community_id를 지정하면 해당 커뮤니티에서만 검색. 차단 관계인 유저의 게시글은 제외
+// @Tags         posts
+// @Accept       json
+// @Produce      json
+// @Param request query SearchPostsRequest true "SearchPosts Request"
+// @Security     BearerAuth
+// @Success      200  {object}  ListPostsResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/posts/search [get]
+func (co *postController) SearchPosts(c *gin.Context) {
+	var req SearchPostsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, co.jwtAuth.GinJWTMiddleware)
+	output, err := co.postUsecase.SearchPosts(payload.UserID, &usecase.SearchPostsInput{
+		Query:       req.Query,
+		Field:       req.Field,
+		CommunityID: req.CommunityID,
+	}, req.Limit, req.Offset)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toListPostsResponse(*output))
+}
+
+func toPostResponse(output usecase.PostOutput) PostResponse {
+	return PostResponse{
+		ID:               output.ID,
+		UserID:           output.UserID,
+		Nickname:         output.Nickname,
+		GenreCommunityID: output.GenreCommunityID,
+		Title:            output.Title,
+		Content:          output.Content,
+		ContentHTML:      output.ContentHTML,
+		Likes:            output.Likes,
+		Dislikes:         output.Dislikes,
+		Tracks:           toTrackCards(output.Tracks),
+		LinkPreviews:     toLinkPreviews(output.LinkPreviews),
+		Hidden:           output.Hidden,
+		CreatedAt:        output.CreatedAt,
+		UpdatedAt:        output.UpdatedAt,
+	}
+}
+
+func toListPostsResponse(output usecase.ListPostsOutput) ListPostsResponse {
+	posts := make([]PostResponse, len(output.Posts))
+	for i, p := range output.Posts {
+		posts[i] = toPostResponse(p)
+	}
+	return ListPostsResponse{Posts: posts, Total: output.Total}
+}
+
+func toTrackCards(cards []usecase.TrackCard) []TrackCard {
+	tracks := make([]TrackCard, len(cards))
+	for i, card := range cards {
+		artists := make([]CatalogArtist, len(card.Artists))
+		for j, a := range card.Artists {
+			artists[j] = CatalogArtist{ID: a.ID, Name: a.Name}
+		}
+		tracks[i] = TrackCard{
+			ID:        card.ID,
+			Title:     card.Title,
+			SpotifyID: card.SpotifyID,
+			Artists:   artists,
+			AlbumID:   card.AlbumID,
+			AlbumName: card.AlbumName,
+			ImageURL:  card.ImageURL,
+			Likes:     card.Likes,
+			Dislikes:  card.Dislikes,
+		}
+	}
+	return tracks
+}
+
+func toLinkPreviews(previews []usecase.LinkPreview) []LinkPreview {
+	res := make([]LinkPreview, len(previews))
+	for i, p := range previews {
+		res[i] = LinkPreview{
+			URL:         p.URL,
+			Title:       p.Title,
+			Description: p.Description,
+			ImageURL:    p.ImageURL,
+			SiteName:    p.SiteName,
+		}
+	}
+	return res
+}
@@ -0,0 +1,207 @@
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/auth"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPostController_CreatePost(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockPostUsecase.Mock.ExpectedCalls = nil }()
+
+		music := []string{"spotify:track:4uLU6hMCjMI75M1A2tKUQC"}
+		mockPostUsecase.On("CreatePost", mock.Anything, uint(1), uint(10), &usecase.CreatePostInput{Title: "Hello", Content: "World", Music: music}).
+			Return(&usecase.PostOutput{
+				ID:               5,
+				UserID:           1,
+				Nickname:         "author",
+				GenreCommunityID: 10,
+				Title:            "Hello",
+				Content:          "World",
+				Tracks:           []usecase.TrackCard{{ID: 42, Title: "Wise Up", Artists: []usecase.CatalogArtist{{ID: 3, Name: "Aimee Mann"}}}},
+			}, nil)
+
+		reqBody, _ := json.Marshal(CreatePostRequest{Title: "Hello", Content: "World", Music: music})
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/communities/10/posts", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		var res PostResponse
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, uint(5), res.ID)
+		assert.Equal(t, "author", res.Nickname)
+		assert.Equal(t, "Wise Up", res.Tracks[0].Title)
+		assert.Equal(t, "Aimee Mann", res.Tracks[0].Artists[0].Name)
+		mockPostUsecase.AssertExpectations(t)
+	})
+
+	t.Run("NotMember", func(t *testing.T) {
+		defer func() { mockPostUsecase.Mock.ExpectedCalls = nil }()
+
+		mockPostUsecase.On("CreatePost", mock.Anything, uint(2), uint(10), &usecase.CreatePostInput{Title: "Hello", Content: "World"}).
+			Return(nil, usecase.ErrCommunityMembershipRequired)
+
+		reqBody, _ := json.Marshal(CreatePostRequest{Title: "Hello", Content: "World"})
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/communities/10/posts", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 2})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("TooManyTracks", func(t *testing.T) {
+		music := make([]string, 11)
+		for i := range music {
+			music[i] = fmt.Sprint(i + 1)
+		}
+		reqBody, _ := json.Marshal(CreatePostRequest{Title: "Hello", Content: "World", Music: music})
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/communities/10/posts", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("MissingTitle", func(t *testing.T) {
+		reqBody, _ := json.Marshal(CreatePostRequest{Content: "World"})
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/communities/10/posts", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestPostController_PatchPost_NotAuthor(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	defer func() { mockPostUsecase.Mock.ExpectedCalls = nil }()
+
+	title := "New"
+	mockPostUsecase.On("PatchPost", mock.Anything, uint(2), uint(5), &usecase.PatchPostInput{Title: &title}).Return(nil, usecase.ErrPostPermissionDenied)
+
+	reqBody, _ := json.Marshal(map[string]string{"title": title})
+	req, _ := http.NewRequest(http.MethodPatch, "/api/v1/posts/5", bytes.NewBuffer(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 2})
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	w := httptest.NewRecorder()
+	testRouter.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	mockPostUsecase.AssertExpectations(t)
+}
+
+func TestPostController_ListCommunityPosts(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockPostUsecase.Mock.ExpectedCalls = nil }()
+
+		limit := 2
+		mockPostUsecase.On("ListCommunityPosts", uint(1), uint(10), "top", &limit, (*int)(nil)).Return(&usecase.ListPostsOutput{
+			Posts: []usecase.PostOutput{{ID: 5, Likes: 10}, {ID: 6, Likes: 3}},
+			Total: 7,
+		}, nil)
+
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/communities/10/posts?sort=top&limit=2", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		var res ListPostsResponse
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, 7, res.Total)
+		assert.Len(t, res.Posts, 2)
+		mockPostUsecase.AssertExpectations(t)
+	})
+
+	t.Run("InvalidSort", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/communities/10/posts?sort=random", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestPostController_SearchPosts(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockPostUsecase.Mock.ExpectedCalls = nil }()
+
+		communityID := uint(10)
+		mockPostUsecase.On("SearchPosts", uint(1), &usecase.SearchPostsInput{Query: "jazz", Field: "content", CommunityID: &communityID}, (*int)(nil), (*int)(nil)).
+			Return(&usecase.ListPostsOutput{Posts: []usecase.PostOutput{{ID: 5}}, Total: 1}, nil)
+
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/posts/search?q=jazz&field=content&community_id=10", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockPostUsecase.AssertExpectations(t)
+	})
+
+	t.Run("MissingQuery", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/posts/search", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
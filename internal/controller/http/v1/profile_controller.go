@@ -0,0 +1,91 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/auth"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+)
+
+type ProfileController interface {
+	GetPublicProfile(c *gin.Context)
+}
+
+type profileController struct {
+	profileUsecase usecase.ProfileUsecase
+	jwtAuth        *auth.JWTMiddleware
+}
+
+func NewProfileController(profileUsecase usecase.ProfileUsecase, jwtAuth *auth.JWTMiddleware) ProfileController {
+	return &profileController{
+		profileUsecase: profileUsecase,
+		jwtAuth:        jwtAuth,
+	}
+}
+
+// GetPublicProfile godoc
+// @Summary      Get public user profile
+// @Description  닉네임으로 유저의 공개 프로필 조회. 프로필 주인이 숨긴 섹션은 hidden_sections에 표시되고 주인 외에는 비어 있음. 이메일, 이름 등 비공개 정보는 포함되지 않으며, 차단 관계인 유저의 프로필은 조회 불가
+// @Tags         users
+// @Accept       json
+// @Produce      json
+// @Param        nickname   path      string  true  "User nickname"
+// @Security     BearerAuth
+// @Success      200  {object}  PublicProfileResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/users/{nickname} [get]
+func (p *profileController) GetPublicProfile(c *gin.Context) {
+	var uri NicknameURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, p.jwtAuth.GinJWTMiddleware)
+	output, err := p.profileUsecase.GetPublicProfile(payload.UserID, uri.Nickname)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	res := PublicProfileResponse{
+		UserID:          output.ID,
+		Nickname:        output.Nickname,
+		ProfileImageURL: output.ProfileImageURL,
+		Bio:             output.Bio,
+		Website:         output.Website,
+		FollowerCount:   output.FollowerCount,
+		FollowingCount:  output.FollowingCount,
+		Following:       output.Following,
+		FollowsYou:      output.FollowsYou,
+		IsOwner:         output.IsOwner,
+		HiddenSections:  output.HiddenSections,
+	}
+	if output.Topsters != nil {
+		res.Topsters = make([]TopsterResponse, len(output.Topsters))
+		for i, t := range output.Topsters {
+			res.Topsters[i] = toTopsterResponse(t)
+		}
+	}
+	if output.Collections != nil {
+		res.Collections = make([]CollectionResponse, len(output.Collections))
+		for i, col := range output.Collections {
+			res.Collections[i] = toCollectionResponse(col)
+		}
+	}
+	if output.RecentPosts != nil {
+		res.RecentPosts = make([]ProfilePostResponse, len(output.RecentPosts))
+		for i, post := range output.RecentPosts {
+			res.RecentPosts[i] = ProfilePostResponse{
+				ID:               post.ID,
+				GenreCommunityID: post.GenreCommunityID,
+				Title:            post.Title,
+				CreatedAt:        post.CreatedAt,
+			}
+		}
+	}
+	c.JSON(http.StatusOK, res)
+}
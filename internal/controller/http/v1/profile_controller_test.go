@@ -0,0 +1,72 @@
+package v1
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/auth"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProfileController_GetPublicProfile(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockProfileUsecase.Mock.ExpectedCalls = nil }()
+
+		followers := int64(12)
+		mockProfileUsecase.On("GetPublicProfile", uint(1), "nickname").Return(&usecase.PublicProfileOutput{
+			ID:             2,
+			Nickname:       "nickname",
+			Bio:            "bio",
+			FollowerCount:  &followers,
+			FollowingCount: &followers,
+			Following:      true,
+			Collections:    []usecase.CollectionOutput{{ID: 8, UserID: 2, Name: "Road Trip", IsPublic: true}},
+			HiddenSections: []string{usecase.ProfileSectionWebsite, usecase.ProfileSectionTopsters, usecase.ProfileSectionPosts},
+		}, nil)
+
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/users/nickname", nil)
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		var res map[string]any
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "bio", res["bio"])
+		assert.Equal(t, float64(12), res["follower_count"])
+		assert.Equal(t, true, res["following"])
+		assert.Len(t, res["collections"], 1)
+		assert.Equal(t, []any{"website", "topsters", "posts"}, res["hidden_sections"])
+		assert.NotContains(t, res, "website")
+		assert.NotContains(t, res, "topsters")
+		assert.NotContains(t, res, "recent_posts")
+		assert.NotContains(t, res, "email")
+		assert.NotContains(t, res, "name")
+		mockProfileUsecase.AssertExpectations(t)
+	})
+
+	t.Run("UserNotFound", func(t *testing.T) {
+		defer func() { mockProfileUsecase.Mock.ExpectedCalls = nil }()
+
+		mockProfileUsecase.On("GetPublicProfile", uint(1), "unknown").Return(nil, usecase.ErrUserNotFound)
+
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/users/unknown", nil)
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
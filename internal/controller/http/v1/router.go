@@ -14,7 +14,7 @@ import (
 	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
 )
 
-func SetupRouter(userUsecase usecase.UserUsecase, musicUsecase usecase.MusicUsecase, jwtAuth *auth.JWTMiddleware) *gin.Engine {
+func SetupRouter(userUsecase usecase.UserUsecase, musicUsecase usecase.MusicUsecase, genreUsecase usecase.GenreUsecase, likeUsecase usecase.LikeUsecase, spotifyUsecase usecase.SpotifyUsecase, collectionUsecase usecase.CollectionUsecase, topsterUsecase usecase.TopsterUsecase, communityUsecase usecase.CommunityUsecase, postUsecase usecase.PostUsecase, commentUsecase usecase.CommentUsecase, moderationUsecase usecase.ModerationUsecase, followUsecase usecase.FollowUsecase, profileUsecase usecase.ProfileUsecase, blockUsecase usecase.BlockUsecase, feedUsecase usecase.FeedUsecase, notificationUsecase usecase.NotificationUsecase, jwtAuth *auth.JWTMiddleware) *gin.Engine {
 	r := gin.Default()
 
 	r.Use(func(c *gin.Context) {
@@ -40,6 +40,20 @@ func SetupRouter(userUsecase usecase.UserUsecase, musicUsecase usecase.MusicUsec
 
 	userController := NewUserController(userUsecase, jwtAuth)
 	musicController := NewMusicController(musicUsecase, jwtAuth)
+	genreController := NewGenreController(genreUsecase, jwtAuth)
+	likeController := NewLikeController(likeUsecase, jwtAuth)
+	spotifyController := NewSpotifyController(spotifyUsecase, jwtAuth)
+	collectionController := NewCollectionController(collectionUsecase, jwtAuth)
+	topsterController := NewTopsterController(topsterUsecase, jwtAuth)
+	communityController := NewCommunityController(communityUsecase, jwtAuth)
+	postController := NewPostController(postUsecase, jwtAuth)
+	commentController := NewCommentController(commentUsecase, jwtAuth)
+	moderationController := NewModerationController(moderationUsecase, jwtAuth)
+	followController := NewFollowController(followUsecase, jwtAuth)
+	profileController := NewProfileController(profileUsecase, jwtAuth)
+	blockController := NewBlockController(blockUsecase, jwtAuth)
+	feedController := NewFeedController(feedUsecase, jwtAuth)
+	notificationController := NewNotificationController(notificationUsecase, jwtAuth)
 
 	apiV1 := r.Group("/api/v1")
 	{
@@ -51,6 +65,26 @@ func SetupRouter(userUsecase usecase.UserUsecase, musicUsecase usecase.MusicUsec
 			userGroup.GET("/me", jwtAuth.MiddlewareFunc(), userController.GetMyUserInfo)
 			userGroup.PATCH("/me", jwtAuth.MiddlewareFunc(), userController.PatchMyUser)
 			userGroup.PUT("/me/password", jwtAuth.MiddlewareFunc(), userController.UpdatePassword)
+			userGroup.GET("/me/likes/tracks", jwtAuth.MiddlewareFunc(), likeController.GetMyLikedTracks)
+			userGroup.POST("/me/spotify/link", jwtAuth.MiddlewareFunc(), spotifyController.StartLink)
+			userGroup.POST("/me/spotify/link/callback", jwtAuth.MiddlewareFunc(), spotifyController.CompleteLink)
+			userGroup.DELETE("/me/spotify/link", jwtAuth.MiddlewareFunc(), spotifyController.Unlink)
+			userGroup.POST("/me/spotify/imports", jwtAuth.MiddlewareFunc(), spotifyController.StartImport)
+			userGroup.GET("/me/spotify/imports/:id", jwtAuth.MiddlewareFunc(), spotifyController.GetImportJob)
+			userGroup.GET("/me/moderation-log", jwtAuth.MiddlewareFunc(), moderationController.ListMyModerationLog)
+			userGroup.GET("/me/blocks", jwtAuth.MiddlewareFunc(), blockController.ListBlocked)
+			userGroup.GET("/me/mutes", jwtAuth.MiddlewareFunc(), blockController.ListMuted)
+			userGroup.GET("/:id", jwtAuth.MiddlewareFunc(), profileController.GetPublicProfile)
+			userGroup.GET("/:id/collections", jwtAuth.MiddlewareFunc(), collectionController.ListUserCollections)
+			userGroup.GET("/:id/topsters", jwtAuth.MiddlewareFunc(), topsterController.ListUserTopsters)
+			userGroup.POST("/:id/follow", jwtAuth.MiddlewareFunc(), followController.Follow)
+			userGroup.DELETE("/:id/follow", jwtAuth.MiddlewareFunc(), followController.Unfollow)
+			userGroup.GET("/:id/followers", jwtAuth.MiddlewareFunc(), followController.ListFollowers)
+			userGroup.GET("/:id/following", jwtAuth.MiddlewareFunc(), followController.ListFollowing)
+			userGroup.POST("/:id/block", jwtAuth.MiddlewareFunc(), blockController.Block)
+			userGroup.DELETE("/:id/block", jwtAuth.MiddlewareFunc(), blockController.Unblock)
+			userGroup.POST("/:id/mute", jwtAuth.MiddlewareFunc(), blockController.Mute)
+			userGroup.DELETE("/:id/mute", jwtAuth.MiddlewareFunc(), blockController.Unmute)
 		}
 
 		authGroup := apiV1.Group("/auth")
@@ -61,6 +95,109 @@ func SetupRouter(userUsecase usecase.UserUsecase, musicUsecase usecase.MusicUsec
 		musicGroup := apiV1.Group("/music")
 		{
 			musicGroup.GET("/tracks", jwtAuth.MiddlewareFunc(), musicController.SearchTrack)
+			musicGroup.GET("/tracks/:id", jwtAuth.MiddlewareFunc(), musicController.GetTrack)
+			musicGroup.POST("/tracks/:id/lastfm", jwtAuth.MiddlewareFunc(), musicController.SyncLastfm)
+			musicGroup.PUT("/tracks/:id/like", jwtAuth.MiddlewareFunc(), likeController.LikeMusic)
+			musicGroup.PUT("/tracks/:id/dislike", jwtAuth.MiddlewareFunc(), likeController.DislikeMusic)
+			musicGroup.DELETE("/tracks/:id/like", jwtAuth.MiddlewareFunc(), likeController.ClearMusicReaction)
+			musicGroup.GET("/albums/:id", jwtAuth.MiddlewareFunc(), musicController.GetAlbum)
+			musicGroup.GET("/artists/:id", jwtAuth.MiddlewareFunc(), musicController.GetArtist)
+		}
+
+		collectionGroup := apiV1.Group("/collections")
+		{
+			collectionGroup.POST("", jwtAuth.MiddlewareFunc(), collectionController.CreateCollection)
+			collectionGroup.POST("/import", jwtAuth.MiddlewareFunc(), collectionController.ImportCollection)
+			collectionGroup.GET("/:id", jwtAuth.MiddlewareFunc(), collectionController.GetCollection)
+			collectionGroup.PATCH("/:id", jwtAuth.MiddlewareFunc(), collectionController.PatchCollection)
+			collectionGroup.DELETE("/:id", jwtAuth.MiddlewareFunc(), collectionController.DeleteCollection)
+			collectionGroup.GET("/:id/export", jwtAuth.MiddlewareFunc(), collectionController.ExportCollection)
+			collectionGroup.POST("/:id/tracks", jwtAuth.MiddlewareFunc(), collectionController.AddTracks)
+			collectionGroup.DELETE("/:id/tracks/:music_id", jwtAuth.MiddlewareFunc(), collectionController.RemoveTrack)
+			collectionGroup.PUT("/:id/tracks/:music_id/position", jwtAuth.MiddlewareFunc(), collectionController.MoveTrack)
+			collectionGroup.POST("/:id/invites", jwtAuth.MiddlewareFunc(), collectionController.CreateInvite)
+			collectionGroup.POST("/invites/:token/accept", jwtAuth.MiddlewareFunc(), collectionController.AcceptInvite)
+			collectionGroup.GET("/:id/members", jwtAuth.MiddlewareFunc(), collectionController.ListMembers)
+			collectionGroup.PATCH("/:id/members/:user_id", jwtAuth.MiddlewareFunc(), collectionController.UpdateMember)
+			collectionGroup.DELETE("/:id/members/:user_id", jwtAuth.MiddlewareFunc(), collectionController.RemoveMember)
+			collectionGroup.POST("/:id/spotify", jwtAuth.MiddlewareFunc(), spotifyController.ExportCollection)
+		}
+
+		topsterGroup := apiV1.Group("/topsters")
+		{
+			topsterGroup.POST("", jwtAuth.MiddlewareFunc(), topsterController.CreateTopster)
+			topsterGroup.GET("/:id", jwtAuth.MiddlewareFunc(), topsterController.GetTopster)
+			topsterGroup.PATCH("/:id", jwtAuth.MiddlewareFunc(), topsterController.PatchTopster)
+			topsterGroup.DELETE("/:id", jwtAuth.MiddlewareFunc(), topsterController.DeleteTopster)
+			topsterGroup.PUT("/:id/albums", jwtAuth.MiddlewareFunc(), topsterController.UpdateLayout)
+			topsterGroup.POST("/:id/autofill", jwtAuth.MiddlewareFunc(), topsterController.PreviewAutofill)
+		}
+
+		genreGroup := apiV1.Group("/genres")
+		{
+			genreGroup.GET("", jwtAuth.MiddlewareFunc(), genreController.ListGenres)
+			genreGroup.GET("/:id/tracks", jwtAuth.MiddlewareFunc(), genreController.GetGenreTracks)
+			genreGroup.GET("/:id/communities", jwtAuth.MiddlewareFunc(), genreController.GetGenreCommunities)
+		}
+
+		communityGroup := apiV1.Group("/communities")
+		{
+			communityGroup.POST("", jwtAuth.MiddlewareFunc(), communityController.CreateCommunity)
+			communityGroup.GET("", jwtAuth.MiddlewareFunc(), communityController.ListCommunities)
+			communityGroup.GET("/:id", jwtAuth.MiddlewareFunc(), communityController.GetCommunity)
+			communityGroup.PATCH("/:id", jwtAuth.MiddlewareFunc(), communityController.PatchCommunity)
+			communityGroup.PUT("/:id/banner", jwtAuth.MiddlewareFunc(), communityController.UpdateBanner)
+			communityGroup.PUT("/:id/icon", jwtAuth.MiddlewareFunc(), communityController.UpdateIcon)
+			communityGroup.PUT("/:id/membership", jwtAuth.MiddlewareFunc(), communityController.JoinCommunity)
+			communityGroup.DELETE("/:id/membership", jwtAuth.MiddlewareFunc(), communityController.LeaveCommunity)
+			communityGroup.GET("/:id/moderators", jwtAuth.MiddlewareFunc(), communityController.ListModerators)
+			communityGroup.PUT("/:id/moderators/:user_id", jwtAuth.MiddlewareFunc(), communityController.AssignModerator)
+			communityGroup.DELETE("/:id/moderators/:user_id", jwtAuth.MiddlewareFunc(), communityController.RemoveModerator)
+			communityGroup.POST("/:id/posts", jwtAuth.MiddlewareFunc(), postController.CreatePost)
+			communityGroup.GET("/:id/posts", jwtAuth.MiddlewareFunc(), postController.ListCommunityPosts)
+			communityGroup.GET("/:id/reports", jwtAuth.MiddlewareFunc(), moderationController.ListCommunityReports)
+			communityGroup.GET("/:id/moderation-log", jwtAuth.MiddlewareFunc(), moderationController.ListCommunityModerationLog)
+		}
+
+		postGroup := apiV1.Group("/posts")
+		{
+			postGroup.GET("", jwtAuth.MiddlewareFunc(), postController.ListPosts)
+			postGroup.GET("/search", jwtAuth.MiddlewareFunc(), postController.SearchPosts)
+			postGroup.GET("/:id", jwtAuth.MiddlewareFunc(), postController.GetPost)
+			postGroup.PATCH("/:id", jwtAuth.MiddlewareFunc(), postController.PatchPost)
+			postGroup.DELETE("/:id", jwtAuth.MiddlewareFunc(), postController.DeletePost)
+			postGroup.PUT("/:id/like", jwtAuth.MiddlewareFunc(), likeController.LikePost)
+			postGroup.PUT("/:id/dislike", jwtAuth.MiddlewareFunc(), likeController.DislikePost)
+			postGroup.DELETE("/:id/like", jwtAuth.MiddlewareFunc(), likeController.ClearPostReaction)
+			postGroup.POST("/:id/comments", jwtAuth.MiddlewareFunc(), commentController.CreateComment)
+			postGroup.GET("/:id/comments", jwtAuth.MiddlewareFunc(), commentController.ListComments)
+		}
+
+		commentGroup := apiV1.Group("/comments")
+		{
+			commentGroup.GET("/:id", jwtAuth.MiddlewareFunc(), commentController.GetComment)
+			commentGroup.PATCH("/:id", jwtAuth.MiddlewareFunc(), commentController.PatchComment)
+			commentGroup.DELETE("/:id", jwtAuth.MiddlewareFunc(), commentController.DeleteComment)
+			commentGroup.PUT("/:id/like", jwtAuth.MiddlewareFunc(), likeController.LikeComment)
+			commentGroup.PUT("/:id/dislike", jwtAuth.MiddlewareFunc(), likeController.DislikeComment)
+			commentGroup.DELETE("/:id/like", jwtAuth.MiddlewareFunc(), likeController.ClearCommentReaction)
+		}
+
+		reportGroup := apiV1.Group("/reports")
+		{
+			reportGroup.POST("", jwtAuth.MiddlewareFunc(), moderationController.CreateReport)
+			reportGroup.GET("", jwtAuth.MiddlewareFunc(), moderationController.ListAdminReports)
+			reportGroup.POST("/:id/actions", jwtAuth.MiddlewareFunc(), moderationController.TakeAction)
+		}
+
+		apiV1.GET("/feed", jwtAuth.MiddlewareFunc(), feedController.GetFeed)
+
+		notificationGroup := apiV1.Group("/notifications")
+		{
+			notificationGroup.GET("", jwtAuth.MiddlewareFunc(), notificationController.ListNotifications)
+			notificationGroup.PATCH("", jwtAuth.MiddlewareFunc(), notificationController.MarkRead)
+			notificationGroup.GET("/preferences", jwtAuth.MiddlewareFunc(), notificationController.GetPreferences)
+			notificationGroup.PATCH("/preferences", jwtAuth.MiddlewareFunc(), notificationController.UpdatePreferences)
 		}
 	}
 
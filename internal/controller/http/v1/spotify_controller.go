@@ -0,0 +1,219 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/auth"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+)
+
+type SpotifyController interface {
+	StartLink(c *gin.Context)
+	CompleteLink(c *gin.Context)
+	Unlink(c *gin.Context)
+	StartImport(c *gin.Context)
+	GetImportJob(c *gin.Context)
+	ExportCollection(c *gin.Context)
+}
+
+type spotifyController struct {
+	spotifyUsecase usecase.SpotifyUsecase
+	jwtAuth        *auth.JWTMiddleware
+}
+
+func NewSpotifyController(spotifyUsecase usecase.SpotifyUsecase, jwtAuth *auth.JWTMiddleware) SpotifyController {
+	return &spotifyController{
+		spotifyUsecase: spotifyUsecase,
+		jwtAuth:        jwtAuth,
+	}
+}
+
+// StartLink godoc
+// @Summary      Start Spotify account link
+// @Description  Spotify 계정 연동 시작. 반환된 URL로 이동해 권한을 승인하면 code와 state가 리다이렉트 URL로 전달됨
+// @Tags         users, spotify
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  StartSpotifyLinkResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/users/me/spotify/link [post]
+func (s *spotifyController) StartLink(c *gin.Context) {
+	payload := auth.GetUserPayload(c, s.jwtAuth.GinJWTMiddleware)
+	output, err := s.spotifyUsecase.StartLink(payload.UserID)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	res := StartSpotifyLinkResponse{AuthURL: output.AuthURL}
+	c.JSON(http.StatusOK, res)
+}
+
+// CompleteLink godoc
+// @Summary      Complete Spotify account link
+// @Description  Spotify 리다이렉트로 받은 code와 state로 계정 연동 완료
+// @Tags         users, spotify
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param request body CompleteSpotifyLinkRequest true "CompleteSpotifyLink Request"
+// @Success      200  {object}  CompleteSpotifyLinkResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      409  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/users/me/spotify/link/callback [post]
+func (s *spotifyController) CompleteLink(c *gin.Context) {
+	var req CompleteSpotifyLinkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, s.jwtAuth.GinJWTMiddleware)
+	output, err := s.spotifyUsecase.CompleteLink(c.Request.Context(), payload.UserID, req.State, req.Code)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	res := CompleteSpotifyLinkResponse{
+		SpotifyUserID: output.SpotifyUserID,
+		DisplayName:   output.DisplayName,
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// Unlink godoc
+// @Summary      Unlink Spotify account
+// @Description  Spotify 계정 연동 해제 (가져온 컬렉션은 유지됨)
+// @Tags         users, spotify
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  UnlinkSpotifyResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/users/me/spotify/link [delete]
+func (s *spotifyController) Unlink(c *gin.Context) {
+	payload := auth.GetUserPayload(c, s.jwtAuth.GinJWTMiddleware)
+	if err := s.spotifyUsecase.Unlink(payload.UserID); err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, UnlinkSpotifyResponse{})
+}
+
+// StartImport godoc
+// @Summary      Import Spotify library
+// @Description  연동된 Spotify 계정의 플레이리스트와 저장한 트랙을 컬렉션으로 가져오기 (백그라운드 작업)
+// @Tags         users, spotify
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Success      202  {object}  SpotifyImportJobResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      409  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/users/me/spotify/imports [post]
+func (s *spotifyController) StartImport(c *gin.Context) {
+	payload := auth.GetUserPayload(c, s.jwtAuth.GinJWTMiddleware)
+	output, err := s.spotifyUsecase.StartImport(payload.UserID)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, toSpotifyImportJobResponse(output))
+}
+
+// GetImportJob godoc
+// @Summary      Get Spotify import job
+// @Description  Spotify 가져오기 작업 상태 조회
+// @Tags         users, spotify
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Import Job ID"
+// @Security     BearerAuth
+// @Success      200  {object}  SpotifyImportJobResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/users/me/spotify/imports/{id} [get]
+func (s *spotifyController) GetImportJob(c *gin.Context) {
+	var req SpotifyImportJobURI
+	if err := c.ShouldBindUri(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, s.jwtAuth.GinJWTMiddleware)
+	output, err := s.spotifyUsecase.GetImportJob(payload.UserID, req.ID)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toSpotifyImportJobResponse(output))
+}
+
+// ExportCollection godoc
+// @Summary      Export collection to Spotify
+// @Description  컬렉션을 Spotify 플레이리스트로 내보내기. 이미 연결된 플레이리스트가 있으면 마지막 동기화 이후 양쪽의 추가/삭제를 반영
+// @Tags         collections, spotify
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Collection ID"
+// @Security     BearerAuth
+// @Success      200  {object}  CollectionSyncResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/collections/{id}/spotify [post]
+func (s *spotifyController) ExportCollection(c *gin.Context) {
+	var req CollectionURI
+	if err := c.ShouldBindUri(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, s.jwtAuth.GinJWTMiddleware)
+	output, err := s.spotifyUsecase.ExportCollection(c.Request.Context(), payload.UserID, req.ID)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	res := CollectionSyncResponse{
+		CollectionID:          output.CollectionID,
+		SpotifyPlaylistID:     output.SpotifyPlaylistID,
+		Status:                output.Status,
+		AddedToSpotify:        output.AddedToSpotify,
+		RemovedFromSpotify:    output.RemovedFromSpotify,
+		AddedToCollection:     output.AddedToCollection,
+		RemovedFromCollection: output.RemovedFromCollection,
+		SyncedAt:              output.SyncedAt,
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func toSpotifyImportJobResponse(output *usecase.SpotifyImportJobOutput) SpotifyImportJobResponse {
+	return SpotifyImportJobResponse{
+		ID:                  output.ID,
+		Status:              output.Status,
+		CollectionsImported: output.CollectionsImported,
+		TracksImported:      output.TracksImported,
+		Error:               output.Error,
+		StartedAt:           output.StartedAt,
+		FinishedAt:          output.FinishedAt,
+		CreatedAt:           output.CreatedAt,
+	}
+}
@@ -0,0 +1,250 @@
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/auth"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSpotifyController_StartLink(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockSpotifyUsecase.Mock.ExpectedCalls = nil }()
+
+		mockSpotifyUsecase.On("StartLink", uint(1)).Return(&usecase.StartSpotifyLinkOutput{AuthURL: "https://accounts.spotify.com/authorize"}, nil)
+
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/users/me/spotify/link", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		var res StartSpotifyLinkResponse
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "https://accounts.spotify.com/authorize", res.AuthURL)
+		mockSpotifyUsecase.AssertExpectations(t)
+	})
+
+	t.Run("Unauthorized", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/users/me/spotify/link", nil)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestSpotifyController_CompleteLink(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockSpotifyUsecase.Mock.ExpectedCalls = nil }()
+
+		mockSpotifyUsecase.On("CompleteLink", mock.Anything, uint(1), "state", "code").Return(&usecase.CompleteSpotifyLinkOutput{SpotifyUserID: "wizzler", DisplayName: "JM Wizzler"}, nil)
+
+		reqBody, _ := json.Marshal(CompleteSpotifyLinkRequest{State: "state", Code: "code"})
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/users/me/spotify/link/callback", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		var res CompleteSpotifyLinkResponse
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "wizzler", res.SpotifyUserID)
+		assert.Equal(t, "JM Wizzler", res.DisplayName)
+		mockSpotifyUsecase.AssertExpectations(t)
+	})
+
+	t.Run("AlreadyLinked", func(t *testing.T) {
+		defer func() { mockSpotifyUsecase.Mock.ExpectedCalls = nil }()
+
+		mockSpotifyUsecase.On("CompleteLink", mock.Anything, uint(1), "state", "code").Return(nil, usecase.ErrSpotifyAccountAlreadyLinked)
+
+		reqBody, _ := json.Marshal(CompleteSpotifyLinkRequest{State: "state", Code: "code"})
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/users/me/spotify/link/callback", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+		mockSpotifyUsecase.AssertExpectations(t)
+	})
+
+	t.Run("MissingCode", func(t *testing.T) {
+		reqBody, _ := json.Marshal(CompleteSpotifyLinkRequest{State: "state"})
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/users/me/spotify/link/callback", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestSpotifyController_StartImport(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockSpotifyUsecase.Mock.ExpectedCalls = nil }()
+
+		mockSpotifyUsecase.On("StartImport", uint(1)).Return(&usecase.SpotifyImportJobOutput{ID: 7, Status: "PENDING"}, nil)
+
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/users/me/spotify/imports", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		var res SpotifyImportJobResponse
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusAccepted, w.Code)
+		assert.Equal(t, uint(7), res.ID)
+		assert.Equal(t, "PENDING", res.Status)
+		mockSpotifyUsecase.AssertExpectations(t)
+	})
+
+	t.Run("NotLinked", func(t *testing.T) {
+		defer func() { mockSpotifyUsecase.Mock.ExpectedCalls = nil }()
+
+		mockSpotifyUsecase.On("StartImport", uint(1)).Return(nil, usecase.ErrSpotifyNotLinked)
+
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/users/me/spotify/imports", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockSpotifyUsecase.AssertExpectations(t)
+	})
+}
+
+func TestSpotifyController_GetImportJob(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockSpotifyUsecase.Mock.ExpectedCalls = nil }()
+
+		mockSpotifyUsecase.On("GetImportJob", uint(1), uint(7)).Return(&usecase.SpotifyImportJobOutput{ID: 7, Status: "COMPLETED", CollectionsImported: 3, TracksImported: 120}, nil)
+
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/users/me/spotify/imports/7", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		var res SpotifyImportJobResponse
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "COMPLETED", res.Status)
+		assert.Equal(t, 3, res.CollectionsImported)
+		assert.Equal(t, 120, res.TracksImported)
+		mockSpotifyUsecase.AssertExpectations(t)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		defer func() { mockSpotifyUsecase.Mock.ExpectedCalls = nil }()
+
+		mockSpotifyUsecase.On("GetImportJob", uint(1), uint(99)).Return(nil, usecase.ErrSpotifyImportJobNotFound)
+
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/users/me/spotify/imports/99", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		mockSpotifyUsecase.AssertExpectations(t)
+	})
+}
+
+func TestSpotifyController_ExportCollection(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockSpotifyUsecase.Mock.ExpectedCalls = nil }()
+
+		mockSpotifyUsecase.On("ExportCollection", mock.Anything, uint(1), uint(10)).Return(&usecase.CollectionSyncOutput{
+			CollectionID:      10,
+			SpotifyPlaylistID: "playlist1",
+			Status:            "SYNCED",
+			AddedToSpotify:    2,
+		}, nil)
+
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/collections/10/spotify", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		var res CollectionSyncResponse
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "playlist1", res.SpotifyPlaylistID)
+		assert.Equal(t, 2, res.AddedToSpotify)
+		mockSpotifyUsecase.AssertExpectations(t)
+	})
+
+	t.Run("NotExportable", func(t *testing.T) {
+		defer func() { mockSpotifyUsecase.Mock.ExpectedCalls = nil }()
+
+		mockSpotifyUsecase.On("ExportCollection", mock.Anything, uint(1), uint(11)).Return(nil, usecase.ErrCollectionNotExportable)
+
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/collections/11/spotify", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockSpotifyUsecase.AssertExpectations(t)
+	})
+}
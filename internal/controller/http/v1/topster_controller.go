@@ -0,0 +1,355 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/auth"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+	"github.com/myjinjin/sonic-odyssey-backend/pkg/utils"
+)
+
+type TopsterController interface {
+	CreateTopster(c *gin.Context)
+	GetTopster(c *gin.Context)
+	PatchTopster(c *gin.Context)
+	DeleteTopster(c *gin.Context)
+	UpdateLayout(c *gin.Context)
+	PreviewAutofill(c *gin.Context)
+	ListUserTopsters(c *gin.Context)
+}
+
+type topsterController struct {
+	topsterUsecase usecase.TopsterUsecase
+	jwtAuth        *auth.JWTMiddleware
+}
+
+func NewTopsterController(topsterUsecase usecase.TopsterUsecase, jwtAuth *auth.JWTMiddleware) TopsterController {
+	return &topsterController{
+		topsterUsecase: topsterUsecase,
+		jwtAuth:        jwtAuth,
+	}
+}
+
+// CreateTopster godoc
+// @Summary      Create topster
+// @Description  탑스터 생성. template이 grid(기본값)이면 rows x cols 격자이며, grid_size는 정사각형 격자의 축약형. top42는 5, 5, 6, 6, 10, 10칸 행으로 이루어진 계단형 템플릿. 앨범은 {row, col} 위치(0부터 시작)로 배치하며, 칸이 겹치거나 같은 앨범이 중복될 수 없음
+// @Tags         topsters
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param request body CreateTopsterRequest true "CreateTopster Request"
+// @Success      201  {object}  TopsterResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/topsters [post]
+func (tc *topsterController) CreateTopster(c *gin.Context) {
+	var req CreateTopsterRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, tc.jwtAuth.GinJWTMiddleware)
+	rows, cols := req.Rows, req.Cols
+	if rows == 0 && cols == 0 {
+		rows, cols = req.GridSize, req.GridSize
+	}
+	output, err := tc.topsterUsecase.CreateTopster(payload.UserID, &usecase.CreateTopsterInput{
+		Title:       req.Title,
+		Description: req.Description,
+		Template:    req.Template,
+		Rows:        rows,
+		Cols:        cols,
+		IsPublic:    req.IsPublic,
+		ShowTitles:  req.ShowTitles,
+		Albums:      toTopsterAlbumInputs(req.Albums),
+	})
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toTopsterResponse(*output))
+}
+
+// GetTopster godoc
+// @Summary      Get topster
+// @Description  탑스터와 배치된 앨범 조회 (행, 열 순). 비공개 탑스터는 본인만 조회 가능
+// @Tags         topsters
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Topster ID"
+// @Security     BearerAuth
+// @Success      200  {object}  TopsterResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/topsters/{id} [get]
+func (tc *topsterController) GetTopster(c *gin.Context) {
+	var uri TopsterURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, tc.jwtAuth.GinJWTMiddleware)
+	output, err := tc.topsterUsecase.GetTopster(payload.UserID, uri.ID)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toTopsterResponse(*output))
+}
+
+// PatchTopster godoc
+// @Summary      Patch topster
+// @Description  탑스터 제목, 설명, 템플릿, 격자 크기, 공개 여부 수정 (본인만 가능). grid_size를 보내면 정사각형 grid 템플릿으로 바뀜. 격자 밖으로 밀려나는 앨범이 있으면 템플릿이나 격자를 바꿀 수 없음
+// @Tags         topsters
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Topster ID"
+// @Security     BearerAuth
+// @Param request body PatchTopsterRequest true "PatchTopster Request"
+// @Success      200  {object}  TopsterResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      403  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/topsters/{id} [patch]
+func (tc *topsterController) PatchTopster(c *gin.Context) {
+	var uri TopsterURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	var req PatchTopsterRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	if err := utils.ValidateRequest(&req); err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, tc.jwtAuth.GinJWTMiddleware)
+	input := &usecase.PatchTopsterInput{
+		Title:       req.Title,
+		Description: req.Description,
+		Template:    req.Template,
+		Rows:        req.Rows,
+		Cols:        req.Cols,
+		IsPublic:    req.IsPublic,
+		ShowTitles:  req.ShowTitles,
+	}
+	if req.GridSize != nil {
+		if input.Rows == nil {
+			input.Rows = req.GridSize
+		}
+		if input.Cols == nil {
+			input.Cols = req.GridSize
+		}
+	}
+	output, err := tc.topsterUsecase.PatchTopster(payload.UserID, uri.ID, input)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toTopsterResponse(*output))
+}
+
+// DeleteTopster godoc
+// @Summary      Delete topster
+// @Description  탑스터 삭제 (본인만 가능)
+// @Tags         topsters
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Topster ID"
+// @Security     BearerAuth
+// @Success      200  {object}  DeleteTopsterResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      403  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/topsters/{id} [delete]
+func (tc *topsterController) DeleteTopster(c *gin.Context) {
+	var uri TopsterURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, tc.jwtAuth.GinJWTMiddleware)
+	if err := tc.topsterUsecase.DeleteTopster(payload.UserID, uri.ID); err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, DeleteTopsterResponse{})
+}
+
+// UpdateLayout godoc
+// @Summary      Update topster layout
+// @Description  탑스터의 앨범 배치를 한 번에 교체 (본인만 가능). 요청에 없는 앨범은 탑스터에서 제거됨
+// @Tags         topsters
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Topster ID"
+// @Security     BearerAuth
+// @Param request body UpdateTopsterLayoutRequest true "UpdateTopsterLayout Request"
+// @Success      200  {object}  TopsterResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      403  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/topsters/{id}/albums [put]
+func (tc *topsterController) UpdateLayout(c *gin.Context) {
+	var uri TopsterURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	var req UpdateTopsterLayoutRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, tc.jwtAuth.GinJWTMiddleware)
+	output, err := tc.topsterUsecase.UpdateLayout(payload.UserID, uri.ID, toTopsterAlbumInputs(req.Albums))
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toTopsterResponse(*output))
+}
+
+// PreviewAutofill godoc
+// @Summary      Preview topster auto-fill
+// @Description  좋아요를 가장 많이 누른 앨범(likes), 컬렉션(collection) 또는 장르(genre)의 앨범으로 탑스터의 빈 칸을 행, 열 순서로 채운 미리보기 반환 (본인만 가능). overwrite가 true이면 기존 배치를 비우고 채움. 저장되지 않으며, 반환된 albums를 PUT /topsters/{id}/albums로 보내면 적용됨
+// @Tags         topsters
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Topster ID"
+// @Security     BearerAuth
+// @Param request body AutofillTopsterRequest true "AutofillTopster Request"
+// @Success      200  {object}  TopsterResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      403  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/topsters/{id}/autofill [post]
+func (tc *topsterController) PreviewAutofill(c *gin.Context) {
+	var uri TopsterURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	var req AutofillTopsterRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, tc.jwtAuth.GinJWTMiddleware)
+	output, err := tc.topsterUsecase.PreviewAutofill(payload.UserID, uri.ID, &usecase.AutofillTopsterInput{
+		Source:       req.Source,
+		CollectionID: req.CollectionID,
+		GenreID:      req.GenreID,
+		Overwrite:    req.Overwrite,
+	})
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toTopsterResponse(*output))
+}
+
+// ListUserTopsters godoc
+// @Summary      List user topsters
+// @Description  유저의 탑스터 목록 조회 (최신순, 앨범 제외). 본인이 아니면 공개 탑스터만 조회되고, 유저가 탑스터를 숨기면 빈 목록이 반환됨. 해당 유저와 차단 관계이면 404
+// @Tags         users, topsters
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "User ID"
+// @Param request query ListTopstersRequest false "ListTopsters Request"
+// @Security     BearerAuth
+// @Success      200  {object}  ListTopstersResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/v1/users/{id}/topsters [get]
+func (tc *topsterController) ListUserTopsters(c *gin.Context) {
+	var uri UserURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	var req ListTopstersRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		HandleError(c, ErrInvalidRequestBody)
+		return
+	}
+
+	payload := auth.GetUserPayload(c, tc.jwtAuth.GinJWTMiddleware)
+	output, err := tc.topsterUsecase.ListUserTopsters(payload.UserID, uri.ID, req.Limit, req.Offset)
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+
+	topsters := make([]TopsterResponse, len(output.Topsters))
+	for i, t := range output.Topsters {
+		topsters[i] = toTopsterResponse(t)
+	}
+	c.JSON(http.StatusOK, ListTopstersResponse{Topsters: topsters, Total: output.Total})
+}
+
+func toTopsterAlbumInputs(albums []TopsterAlbumPosition) []usecase.TopsterAlbumInput {
+	inputs := make([]usecase.TopsterAlbumInput, len(albums))
+	for i, a := range albums {
+		inputs[i] = usecase.TopsterAlbumInput{AlbumID: a.AlbumID, Row: *a.Row, Col: *a.Col}
+	}
+	return inputs
+}
+
+func toTopsterResponse(output usecase.TopsterOutput) TopsterResponse {
+	albums := make([]TopsterAlbum, len(output.Albums))
+	for i, a := range output.Albums {
+		albums[i] = TopsterAlbum{Album: toCatalogAlbum(a.Album), Row: a.Row, Col: a.Col}
+	}
+	return TopsterResponse{
+		ID:          output.ID,
+		UserID:      output.UserID,
+		Title:       output.Title,
+		Description: output.Description,
+		Template:    output.Template,
+		Rows:        output.Rows,
+		Cols:        output.Cols,
+		RowWidths:   output.RowWidths,
+		ImageURL:    output.ImageURL,
+		IsPublic:    output.IsPublic,
+		ShowTitles:  output.ShowTitles,
+		Albums:      albums,
+		CreatedAt:   output.CreatedAt,
+		UpdatedAt:   output.UpdatedAt,
+	}
+}
@@ -0,0 +1,330 @@
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/auth"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase"
+	"github.com/myjinjin/sonic-odyssey-backend/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTopsterController_CreateTopster(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockTopsterUsecase.Mock.ExpectedCalls = nil }()
+
+		mockTopsterUsecase.On("CreateTopster", uint(1), &usecase.CreateTopsterInput{
+			Title:    "Daft Punk",
+			Rows:     3,
+			Cols:     3,
+			IsPublic: true,
+			Albums:   []usecase.TopsterAlbumInput{{AlbumID: 5, Row: 0, Col: 2}},
+		}).Return(&usecase.TopsterOutput{
+			ID:        10,
+			UserID:    1,
+			Title:     "Daft Punk",
+			Template:  "grid",
+			Rows:      3,
+			Cols:      3,
+			RowWidths: []int{3, 3, 3},
+			IsPublic:  true,
+			Albums:    []usecase.TopsterAlbumOutput{{Album: usecase.CatalogAlbum{ID: 5, Name: "Discovery"}, Row: 0, Col: 2}},
+		}, nil)
+
+		reqBody, _ := json.Marshal(CreateTopsterRequest{
+			Title:    "Daft Punk",
+			GridSize: 3,
+			IsPublic: true,
+			Albums:   []TopsterAlbumPosition{{AlbumID: 5, Row: utils.ToPtr(0), Col: utils.ToPtr(2)}},
+		})
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/topsters", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		var res TopsterResponse
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, uint(10), res.ID)
+		assert.Equal(t, "Discovery", res.Albums[0].Album.Name)
+		assert.Equal(t, 2, res.Albums[0].Col)
+		assert.Equal(t, []int{3, 3, 3}, res.RowWidths)
+		mockTopsterUsecase.AssertExpectations(t)
+	})
+
+	t.Run("TieredTemplate", func(t *testing.T) {
+		defer func() { mockTopsterUsecase.Mock.ExpectedCalls = nil }()
+
+		mockTopsterUsecase.On("CreateTopster", uint(1), &usecase.CreateTopsterInput{
+			Title:    "Top 42",
+			Template: "top42",
+			Albums:   []usecase.TopsterAlbumInput{},
+		}).Return(&usecase.TopsterOutput{ID: 11, Template: "top42", Rows: 6, Cols: 10, RowWidths: []int{5, 5, 6, 6, 10, 10}}, nil)
+
+		reqBody := []byte(`{"title":"Top 42","template":"top42","albums":[]}`)
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/topsters", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		var res TopsterResponse
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, "top42", res.Template)
+		assert.Equal(t, []int{5, 5, 6, 6, 10, 10}, res.RowWidths)
+		mockTopsterUsecase.AssertExpectations(t)
+	})
+
+	t.Run("UnknownTemplate", func(t *testing.T) {
+		reqBody := []byte(`{"title":"Top 7","template":"top7"}`)
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/topsters", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("MissingPosition", func(t *testing.T) {
+		reqBody := []byte(`{"title":"Daft Punk","grid_size":3,"albums":[{"album_id":5,"row":0}]}`)
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/topsters", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Overlap", func(t *testing.T) {
+		defer func() { mockTopsterUsecase.Mock.ExpectedCalls = nil }()
+
+		mockTopsterUsecase.On("CreateTopster", uint(1), &usecase.CreateTopsterInput{
+			Title:  "Daft Punk",
+			Rows:   3,
+			Cols:   3,
+			Albums: []usecase.TopsterAlbumInput{{AlbumID: 5, Row: 1, Col: 1}, {AlbumID: 6, Row: 1, Col: 1}},
+		}).Return(nil, usecase.ErrTopsterPositionConflict)
+
+		reqBody := []byte(`{"title":"Daft Punk","grid_size":3,"albums":[{"album_id":5,"row":1,"col":1},{"album_id":6,"row":1,"col":1}]}`)
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/topsters", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockTopsterUsecase.AssertExpectations(t)
+	})
+}
+
+func TestTopsterController_GetTopster(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("NotFound", func(t *testing.T) {
+		defer func() { mockTopsterUsecase.Mock.ExpectedCalls = nil }()
+
+		mockTopsterUsecase.On("GetTopster", uint(2), uint(10)).Return(nil, usecase.ErrTopsterNotFound)
+
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/topsters/10", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 2})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		mockTopsterUsecase.AssertExpectations(t)
+	})
+}
+
+func TestTopsterController_PatchTopster(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockTopsterUsecase.Mock.ExpectedCalls = nil }()
+
+		mockTopsterUsecase.On("PatchTopster", uint(1), uint(10), &usecase.PatchTopsterInput{Rows: utils.ToPtr(4), Cols: utils.ToPtr(4)}).
+			Return(&usecase.TopsterOutput{ID: 10, UserID: 1, Template: "grid", Rows: 4, Cols: 4}, nil)
+
+		reqBody := []byte(`{"grid_size":4}`)
+		req, _ := http.NewRequest(http.MethodPatch, "/api/v1/topsters/10", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		var res TopsterResponse
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, 4, res.Cols)
+		mockTopsterUsecase.AssertExpectations(t)
+	})
+
+	t.Run("GridSizeTooLarge", func(t *testing.T) {
+		reqBody := []byte(`{"grid_size":11}`)
+		req, _ := http.NewRequest(http.MethodPatch, "/api/v1/topsters/10", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestTopsterController_UpdateLayout(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockTopsterUsecase.Mock.ExpectedCalls = nil }()
+
+		mockTopsterUsecase.On("UpdateLayout", uint(1), uint(10), []usecase.TopsterAlbumInput{{AlbumID: 6, Row: 0, Col: 0}}).
+			Return(&usecase.TopsterOutput{ID: 10, Albums: []usecase.TopsterAlbumOutput{{Album: usecase.CatalogAlbum{ID: 6}}}}, nil)
+
+		reqBody := []byte(`{"albums":[{"album_id":6,"row":0,"col":0}]}`)
+		req, _ := http.NewRequest(http.MethodPut, "/api/v1/topsters/10/albums", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockTopsterUsecase.AssertExpectations(t)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		defer func() { mockTopsterUsecase.Mock.ExpectedCalls = nil }()
+
+		mockTopsterUsecase.On("UpdateLayout", uint(2), uint(10), []usecase.TopsterAlbumInput{}).
+			Return(nil, usecase.ErrTopsterPermissionDenied)
+
+		reqBody := []byte(`{"albums":[]}`)
+		req, _ := http.NewRequest(http.MethodPut, "/api/v1/topsters/10/albums", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 2})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		mockTopsterUsecase.AssertExpectations(t)
+	})
+}
+
+func TestTopsterController_PreviewAutofill(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockTopsterUsecase.Mock.ExpectedCalls = nil }()
+
+		mockTopsterUsecase.On("PreviewAutofill", uint(1), uint(10), &usecase.AutofillTopsterInput{Source: "collection", CollectionID: utils.ToPtr(uint(4))}).
+			Return(&usecase.TopsterOutput{ID: 10, Albums: []usecase.TopsterAlbumOutput{{Album: usecase.CatalogAlbum{ID: 7}, Row: 0, Col: 0}}}, nil)
+
+		reqBody := []byte(`{"source":"collection","collection_id":4}`)
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/topsters/10/autofill", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		var res TopsterResponse
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, uint(7), res.Albums[0].Album.ID)
+		mockTopsterUsecase.AssertExpectations(t)
+	})
+
+	t.Run("UnknownSource", func(t *testing.T) {
+		reqBody := []byte(`{"source":"friends"}`)
+		req, _ := http.NewRequest(http.MethodPost, "/api/v1/topsters/10/autofill", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 1})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestTopsterController_ListUserTopsters(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		defer func() { mockTopsterUsecase.Mock.ExpectedCalls = nil }()
+
+		limit := 5
+		mockTopsterUsecase.On("ListUserTopsters", uint(2), uint(1), &limit, (*int)(nil)).Return(&usecase.ListTopstersOutput{
+			Topsters: []usecase.TopsterOutput{{ID: 10, UserID: 1, IsPublic: true}},
+			Total:    1,
+		}, nil)
+
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/users/1/topsters?limit=5", nil)
+
+		token, _, _ := testUserJwtAuth.TokenGenerator(&auth.UserPayload{UserID: 2})
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		testRouter.ServeHTTP(w, req)
+
+		var res ListTopstersResponse
+		err := json.Unmarshal(w.Body.Bytes(), &res)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, 1, res.Total)
+		assert.Equal(t, uint(10), res.Topsters[0].ID)
+		mockTopsterUsecase.AssertExpectations(t)
+	})
+}
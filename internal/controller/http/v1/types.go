@@ -1,5 +1,7 @@
 package v1
 
+import "time"
+
 type ErrorResponse struct {
 	Error string `json:"error,omitempty"`
 }
@@ -35,20 +37,46 @@ type ResetPasswordRequest struct {
 type ResetPasswordResponse struct{}
 
 type GetMyUserInfoResponse struct {
-	UserID          uint   `json:"user_id" example:"1"`
-	Email           string `json:"email" example:"user@example.com"`
-	Name            string `json:"name" example:"name"`
-	Nickname        string `json:"nickname" example:"nickname"`
-	ProfileImageURL string `json:"profile_image_url" example:"https://example.com/profile.png"`
-	Bio             string `json:"bio" example:"bio..."`
-	Website         string `json:"website" example:"https://example.com"`
+	UserID          uint                   `json:"user_id" example:"1"`
+	Email           string                 `json:"email" example:"user@example.com"`
+	Name            string                 `json:"name" example:"name"`
+	Nickname        string                 `json:"nickname" example:"nickname"`
+	ProfileImageURL string                 `json:"profile_image_url" example:"https://example.com/profile.png"`
+	Bio             string                 `json:"bio" example:"bio..."`
+	Website         string                 `json:"website" example:"https://example.com"`
+	FollowerCount   int64                  `json:"follower_count" example:"12"`
+	FollowingCount  int64                  `json:"following_count" example:"3"`
+	Privacy         ProfilePrivacyResponse `json:"privacy"`
+}
+
+// ProfilePrivacyResponse lists the sections of the public profile hidden from
+// other users.
+type ProfilePrivacyResponse struct {
+	HideBio         bool `json:"hide_bio" example:"false"`
+	HideWebsite     bool `json:"hide_website" example:"false"`
+	HideFollows     bool `json:"hide_follows" example:"false"`
+	HideTopsters    bool `json:"hide_topsters" example:"false"`
+	HideCollections bool `json:"hide_collections" example:"false"`
+	HidePosts       bool `json:"hide_posts" example:"true"`
 }
 
 type PatchMyUserRequest struct {
-	Name     *string `json:"name" example:"newname" validate:"omitempty,min=2"`
-	Nickname *string `json:"nickname" example:"newnickname" validate:"omitempty,min=5"`
-	Bio      *string `json:"bio" example:"newbio" validate:"omitempty"`
-	Website  *string `json:"website" example:"https://example.com/new" validate:"omitempty,url"`
+	Name     *string                     `json:"name" example:"newname" validate:"omitempty,min=2"`
+	Nickname *string                     `json:"nickname" example:"newnickname" validate:"omitempty,min=5"`
+	Bio      *string                     `json:"bio" example:"newbio" validate:"omitempty"`
+	Website  *string                     `json:"website" example:"https://example.com/new" validate:"omitempty,url"`
+	Privacy  *PatchProfilePrivacyRequest `json:"privacy"`
+}
+
+// PatchProfilePrivacyRequest hides or shows sections of the public profile.
+// Omitted fields keep their current setting.
+type PatchProfilePrivacyRequest struct {
+	HideBio         *bool `json:"hide_bio" example:"false"`
+	HideWebsite     *bool `json:"hide_website" example:"false"`
+	HideFollows     *bool `json:"hide_follows" example:"false"`
+	HideTopsters    *bool `json:"hide_topsters" example:"false"`
+	HideCollections *bool `json:"hide_collections" example:"false"`
+	HidePosts       *bool `json:"hide_posts" example:"true"`
 }
 
 type PatchMyUserResponse struct{}
@@ -81,3 +109,830 @@ type Artist struct {
 	ID   string `json:"id" example:"2up3OPMp9Tb4dAKM2erWXQ"`
 	Name string `json:"name" example:"Aimee mann"`
 }
+
+type SyncLastfmRequest struct {
+	MusicID uint `uri:"id" binding:"required" example:"1"`
+}
+
+type SyncLastfmResponse struct {
+	MusicID   uint    `json:"music_id" example:"1"`
+	LastfmID  string  `json:"lastfm_id" example:"32ca187e-ee25-4f18-b7d0-3b6713f24635"`
+	Listeners int64   `json:"listeners" example:"1027457"`
+	Playcount int64   `json:"playcount" example:"7863914"`
+	Genres    []Genre `json:"genres"`
+}
+
+type Genre struct {
+	ID   uint   `json:"id" example:"1"`
+	Name string `json:"name" example:"pop"`
+}
+
+type GenreURI struct {
+	GenreID uint `uri:"id" binding:"required" example:"1"`
+}
+
+type ListGenresResponse struct {
+	Genres []GenreNode `json:"genres"`
+}
+
+type GenreNode struct {
+	ID          uint        `json:"id" example:"1"`
+	ParentID    *uint       `json:"parent_id" example:"1"`
+	Name        string      `json:"name" example:"Indie Rock"`
+	Description string      `json:"description" example:"Guitar-driven popular music rooted in rock and roll"`
+	Aliases     []string    `json:"aliases" example:"indie rock,k indie"`
+	Children    []GenreNode `json:"children"`
+}
+
+type GetGenreTracksRequest struct {
+	Limit  *int `form:"limit" example:"20"`
+	Offset *int `form:"offset" example:"0"`
+}
+
+type GetGenreTracksResponse struct {
+	Tracks []CatalogTrack `json:"tracks"`
+	Total  int            `json:"total" example:"12"`
+}
+
+type CatalogTrack struct {
+	ID        uint            `json:"id" example:"1"`
+	Title     string          `json:"title" example:"One"`
+	AlbumID   uint            `json:"album_id" example:"1"`
+	SpotifyID string          `json:"spotify_id" example:"2up3OPMp9Tb4dAKM2erWXQ"`
+	Artists   []CatalogArtist `json:"artists"`
+}
+
+type CatalogArtist struct {
+	ID   uint   `json:"id" example:"1"`
+	Name string `json:"name" example:"Aimee Mann"`
+}
+
+type CatalogURI struct {
+	ID string `uri:"id" binding:"required" example:"2up3OPMp9Tb4dAKM2erWXQ"`
+}
+
+type CatalogAlbum struct {
+	ID          uint          `json:"id" example:"1"`
+	Name        string        `json:"name" example:"Magnolia"`
+	SpotifyID   string        `json:"spotify_id" example:"3M3cfh4JyWpuL0nVwEPSdo"`
+	ImageURL    string        `json:"image_url" example:"https://i.scdn.co/image/ab67616d0000b273"`
+	ReleaseDate time.Time     `json:"release_date" example:"1999-12-07T00:00:00Z"`
+	Artist      CatalogArtist `json:"artist"`
+}
+
+type GetTrackResponse struct {
+	Track           CatalogTrack `json:"track"`
+	Album           CatalogAlbum `json:"album"`
+	Genres          []Genre      `json:"genres"`
+	LastfmListeners int64        `json:"lastfm_listeners" example:"1027457"`
+	LastfmPlaycount int64        `json:"lastfm_playcount" example:"7863914"`
+	Likes           int64        `json:"likes" example:"12"`
+	Dislikes        int64        `json:"dislikes" example:"1"`
+	CollectionCount int64        `json:"collection_count" example:"4"`
+	Liked           *bool        `json:"liked" example:"true"`
+}
+
+type GetAlbumResponse struct {
+	Album    CatalogAlbum   `json:"album"`
+	Tracks   []CatalogTrack `json:"tracks"`
+	Likes    int64          `json:"likes" example:"30"`
+	Dislikes int64          `json:"dislikes" example:"2"`
+}
+
+type GetArtistResponse struct {
+	Artist      CatalogArtist  `json:"artist"`
+	Description string         `json:"description" example:"American singer-songwriter"`
+	SpotifyID   string         `json:"spotify_id" example:"6lGiIBsLhPUMFGRmh3Vqsd"`
+	Albums      []CatalogAlbum `json:"albums"`
+	Tracks      []CatalogTrack `json:"tracks"`
+	Likes       int64          `json:"likes" example:"120"`
+	Dislikes    int64          `json:"dislikes" example:"5"`
+}
+
+type GetGenreCommunitiesResponse struct {
+	Communities []GenreCommunity `json:"communities"`
+}
+
+type GenreCommunity struct {
+	ID          uint   `json:"id" example:"1"`
+	GenreID     uint   `json:"genre_id" example:"1"`
+	Name        string `json:"name" example:"Indie Rock Lovers"`
+	Description string `json:"description" example:"인디 록 팬 커뮤니티"`
+}
+
+type ReactionURI struct {
+	ID uint `uri:"id" binding:"required" example:"1"`
+}
+
+type ReactionResponse struct {
+	Likes    int64 `json:"likes" example:"12"`
+	Dislikes int64 `json:"dislikes" example:"1"`
+	Liked    *bool `json:"liked" example:"true"`
+}
+
+type GetLikedTracksRequest struct {
+	Limit  *int `form:"limit" example:"20"`
+	Offset *int `form:"offset" example:"0"`
+}
+
+type GetLikedTracksResponse struct {
+	Tracks []CatalogTrack `json:"tracks"`
+	Total  int            `json:"total" example:"42"`
+}
+
+type StartSpotifyLinkResponse struct {
+	AuthURL string `json:"auth_url" example:"https://accounts.spotify.com/authorize?client_id=...&state=..."`
+}
+
+type CompleteSpotifyLinkRequest struct {
+	State string `json:"state" binding:"required" example:"2f1c5a4e-7a0b-4d4b-9f43-0d6d1f0c6a8e:1717171717"`
+	Code  string `json:"code" binding:"required" example:"AQD...authorization-code"`
+}
+
+type CompleteSpotifyLinkResponse struct {
+	SpotifyUserID string `json:"spotify_user_id" example:"wizzler"`
+	DisplayName   string `json:"display_name" example:"JM Wizzler"`
+}
+
+type UnlinkSpotifyResponse struct{}
+
+type SpotifyImportJobURI struct {
+	ID uint `uri:"id" binding:"required" example:"1"`
+}
+
+type SpotifyImportJobResponse struct {
+	ID                  uint       `json:"id" example:"1"`
+	Status              string     `json:"status" example:"RUNNING"`
+	CollectionsImported int        `json:"collections_imported" example:"3"`
+	TracksImported      int        `json:"tracks_imported" example:"120"`
+	Error               string     `json:"error,omitempty" example:""`
+	StartedAt           *time.Time `json:"started_at,omitempty" example:"2024-05-01T12:00:00Z"`
+	FinishedAt          *time.Time `json:"finished_at,omitempty" example:"2024-05-01T12:03:00Z"`
+	CreatedAt           time.Time  `json:"created_at" example:"2024-05-01T12:00:00Z"`
+}
+
+type CollectionURI struct {
+	ID uint `uri:"id" binding:"required" example:"1"`
+}
+
+type CollectionSyncResponse struct {
+	CollectionID          uint       `json:"collection_id" example:"1"`
+	SpotifyPlaylistID     string     `json:"spotify_playlist_id" example:"3cEYpjA9oz9GiPac4AsH4n"`
+	Status                string     `json:"status" example:"SYNCED"`
+	AddedToSpotify        int        `json:"added_to_spotify" example:"2"`
+	RemovedFromSpotify    int        `json:"removed_from_spotify" example:"0"`
+	AddedToCollection     int        `json:"added_to_collection" example:"1"`
+	RemovedFromCollection int        `json:"removed_from_collection" example:"0"`
+	SyncedAt              *time.Time `json:"synced_at,omitempty" example:"2024-05-01T12:00:00Z"`
+}
+
+type CreateCollectionRequest struct {
+	Name        string `json:"name" binding:"required,max=255" example:"Road Trip"`
+	Description string `json:"description" example:"Songs for the drive"`
+	IsPublic    bool   `json:"is_public" example:"true"`
+}
+
+type PatchCollectionRequest struct {
+	Name        *string `json:"name" example:"Road Trip" validate:"omitempty,min=1,max=255"`
+	Description *string `json:"description" example:"Songs for the drive" validate:"omitempty"`
+	IsPublic    *bool   `json:"is_public" example:"false" validate:"omitempty"`
+}
+
+type CollectionResponse struct {
+	ID                uint      `json:"id" example:"1"`
+	UserID            uint      `json:"user_id" example:"1"`
+	Name              string    `json:"name" example:"Road Trip"`
+	Description       string    `json:"description" example:"Songs for the drive"`
+	IsPublic          bool      `json:"is_public" example:"true"`
+	SpotifyPlaylistID string    `json:"spotify_playlist_id,omitempty" example:"3cEYpjA9oz9GiPac4AsH4n"`
+	CreatedAt         time.Time `json:"created_at" example:"2024-05-01T12:00:00Z"`
+	UpdatedAt         time.Time `json:"updated_at" example:"2024-05-01T12:00:00Z"`
+}
+
+type GetCollectionResponse struct {
+	Collection CollectionResponse `json:"collection"`
+	Role       string             `json:"role,omitempty" example:"EDITOR"`
+	Tracks     []CollectionTrack  `json:"tracks"`
+}
+
+type CollectionTrack struct {
+	Track   CatalogTrack `json:"track"`
+	AddedBy *uint        `json:"added_by" example:"1"`
+}
+
+type DeleteCollectionResponse struct{}
+
+type AddCollectionTracksRequest struct {
+	MusicIDs []uint `json:"music_ids" binding:"required,min=1,max=100" example:"1,2,3"`
+}
+
+type AddCollectionTracksResponse struct {
+	Added int `json:"added" example:"3"`
+}
+
+type CollectionTrackURI struct {
+	ID      uint `uri:"id" binding:"required" example:"1"`
+	MusicID uint `uri:"music_id" binding:"required" example:"1"`
+}
+
+type RemoveCollectionTrackResponse struct{}
+
+type MoveCollectionTrackRequest struct {
+	Position *int `json:"position" binding:"required,min=0" example:"0"`
+}
+
+type MoveCollectionTrackResponse struct{}
+
+type UserURI struct {
+	ID uint `uri:"id" binding:"required" example:"1"`
+}
+
+type ListCollectionsRequest struct {
+	Limit  *int `form:"limit" example:"20"`
+	Offset *int `form:"offset" example:"0"`
+}
+
+type ListCollectionsResponse struct {
+	Collections []CollectionResponse `json:"collections"`
+	Total       int                  `json:"total" example:"3"`
+}
+
+type CreateCollectionInviteRequest struct {
+	Role           string `json:"role" binding:"required,oneof=EDITOR VIEWER" example:"EDITOR"`
+	ExpiresInHours int    `json:"expires_in_hours" binding:"omitempty,min=1,max=720" example:"168"`
+}
+
+type CollectionInviteResponse struct {
+	CollectionID uint      `json:"collection_id" example:"1"`
+	Token        string    `json:"token" example:"0b8f5c1e-3d2a-4f6b-9c7d-1e2f3a4b5c6d:1714564800"`
+	Role         string    `json:"role" example:"EDITOR"`
+	ExpiresAt    time.Time `json:"expires_at" example:"2024-05-08T12:00:00Z"`
+}
+
+type CollectionInviteURI struct {
+	Token string `uri:"token" binding:"required" example:"0b8f5c1e-3d2a-4f6b-9c7d-1e2f3a4b5c6d:1714564800"`
+}
+
+type CollectionMember struct {
+	CollectionID uint      `json:"collection_id" example:"1"`
+	UserID       uint      `json:"user_id" example:"2"`
+	Nickname     string    `json:"nickname,omitempty" example:"nickname"`
+	Role         string    `json:"role" example:"EDITOR"`
+	JoinedAt     time.Time `json:"joined_at" example:"2024-05-01T12:00:00Z"`
+}
+
+type ListCollectionMembersResponse struct {
+	Members []CollectionMember `json:"members"`
+}
+
+type CollectionMemberURI struct {
+	ID     uint `uri:"id" binding:"required" example:"1"`
+	UserID uint `uri:"user_id" binding:"required" example:"2"`
+}
+
+type UpdateCollectionMemberRequest struct {
+	Role string `json:"role" binding:"required,oneof=EDITOR VIEWER" example:"VIEWER"`
+}
+
+type RemoveCollectionMemberResponse struct{}
+
+type ExportCollectionRequest struct {
+	Format string `form:"format" binding:"required,oneof=m3u8 m3u xspf jspf csv" example:"m3u8"`
+}
+
+type ImportCollectionRequest struct {
+	// Format defaults to the uploaded file's extension.
+	Format       string `form:"format" binding:"omitempty,oneof=m3u8 m3u xspf jspf csv" example:"xspf"`
+	CollectionID *uint  `form:"collection_id" example:"1"`
+	Name         string `form:"name" binding:"max=255" example:"Imported playlist"`
+	IsPublic     bool   `form:"is_public" example:"false"`
+}
+
+type ImportCollectionResponse struct {
+	CollectionID uint                      `json:"collection_id" example:"1"`
+	Total        int                       `json:"total" example:"3"`
+	Added        int                       `json:"added" example:"2"`
+	Matched      []MatchedPlaylistEntry    `json:"matched"`
+	Unresolved   []UnresolvedPlaylistEntry `json:"unresolved"`
+}
+
+type MatchedPlaylistEntry struct {
+	Line      int    `json:"line" example:"2"`
+	MusicID   uint   `json:"music_id" example:"1"`
+	MatchedBy string `json:"matched_by" example:"isrc"`
+}
+
+type UnresolvedPlaylistEntry struct {
+	Line   int    `json:"line" example:"5"`
+	Title  string `json:"title,omitempty" example:"One"`
+	Artist string `json:"artist,omitempty" example:"Metallica"`
+}
+
+type TopsterAlbumPosition struct {
+	AlbumID uint `json:"album_id" binding:"required" example:"1"`
+	Row     *int `json:"row" binding:"required,min=0" example:"0"`
+	Col     *int `json:"col" binding:"required,min=0" example:"2"`
+}
+
+type CreateTopsterRequest struct {
+	Title       string                 `json:"title" binding:"required,max=255" example:"2024 Favorites"`
+	Description string                 `json:"description" binding:"max=500" example:"올해 가장 많이 들은 앨범"`
+	Template    string                 `json:"template" binding:"omitempty,oneof=grid top42" example:"grid"`
+	Rows        int                    `json:"rows" binding:"omitempty,min=1,max=10" example:"3"`
+	Cols        int                    `json:"cols" binding:"omitempty,min=1,max=10" example:"4"`
+	GridSize    int                    `json:"grid_size" binding:"omitempty,min=1,max=10" example:"3"`
+	IsPublic    bool                   `json:"is_public" example:"true"`
+	ShowTitles  bool                   `json:"show_titles" example:"true"`
+	Albums      []TopsterAlbumPosition `json:"albums" binding:"max=100,dive"`
+}
+
+type PatchTopsterRequest struct {
+	Title       *string `json:"title" example:"2024 Favorites" validate:"omitempty,min=1,max=255"`
+	Description *string `json:"description" example:"올해 가장 많이 들은 앨범" validate:"omitempty,max=500"`
+	Template    *string `json:"template" example:"top42" validate:"omitempty,oneof=grid top42"`
+	Rows        *int    `json:"rows" example:"4" validate:"omitempty,min=1,max=10"`
+	Cols        *int    `json:"cols" example:"5" validate:"omitempty,min=1,max=10"`
+	GridSize    *int    `json:"grid_size" example:"4" validate:"omitempty,min=1,max=10"`
+	IsPublic    *bool   `json:"is_public" example:"false" validate:"omitempty"`
+	ShowTitles  *bool   `json:"show_titles" example:"true" validate:"omitempty"`
+}
+
+type UpdateTopsterLayoutRequest struct {
+	Albums []TopsterAlbumPosition `json:"albums" binding:"max=100,dive"`
+}
+
+type AutofillTopsterRequest struct {
+	Source       string `json:"source" binding:"required,oneof=likes collection genre" example:"likes"`
+	CollectionID *uint  `json:"collection_id" example:"1"`
+	GenreID      *uint  `json:"genre_id" example:"1"`
+	Overwrite    bool   `json:"overwrite" example:"false"`
+}
+
+type TopsterURI struct {
+	ID uint `uri:"id" binding:"required" example:"1"`
+}
+
+type TopsterResponse struct {
+	ID          uint           `json:"id" example:"1"`
+	UserID      uint           `json:"user_id" example:"1"`
+	Title       string         `json:"title" example:"2024 Favorites"`
+	Description string         `json:"description" example:"올해 가장 많이 들은 앨범"`
+	Template    string         `json:"template" example:"grid"`
+	Rows        int            `json:"rows" example:"3"`
+	Cols        int            `json:"cols" example:"4"`
+	RowWidths   []int          `json:"row_widths" example:"4,4,4"`
+	ImageURL    string         `json:"image_url,omitempty" example:"https://example.com/topsters/1.png"`
+	IsPublic    bool           `json:"is_public" example:"true"`
+	ShowTitles  bool           `json:"show_titles" example:"true"`
+	Albums      []TopsterAlbum `json:"albums,omitempty"`
+	CreatedAt   time.Time      `json:"created_at" example:"2024-05-01T12:00:00Z"`
+	UpdatedAt   time.Time      `json:"updated_at" example:"2024-05-01T12:00:00Z"`
+}
+
+type TopsterAlbum struct {
+	Album CatalogAlbum `json:"album"`
+	Row   int          `json:"row" example:"0"`
+	Col   int          `json:"col" example:"2"`
+}
+
+type DeleteTopsterResponse struct{}
+
+type ListTopstersRequest struct {
+	Limit  *int `form:"limit" example:"20"`
+	Offset *int `form:"offset" example:"0"`
+}
+
+type ListTopstersResponse struct {
+	Topsters []TopsterResponse `json:"topsters"`
+	Total    int               `json:"total" example:"3"`
+}
+
+type CreateCommunityRequest struct {
+	GenreID     uint   `json:"genre_id" binding:"required" example:"1"`
+	Name        string `json:"name" binding:"required,max=100" example:"Indie Rock Lovers"`
+	Description string `json:"description" binding:"max=255" example:"인디 록 팬 커뮤니티"`
+	Rules       string `json:"rules" example:"1. 서로 존중하기"`
+}
+
+type PatchCommunityRequest struct {
+	GenreID     *uint   `json:"genre_id" example:"1" validate:"omitempty,min=1"`
+	Name        *string `json:"name" example:"Indie Rock Lovers" validate:"omitempty,min=1,max=100"`
+	Description *string `json:"description" example:"인디 록 팬 커뮤니티" validate:"omitempty,max=255"`
+	Rules       *string `json:"rules" example:"1. 서로 존중하기" validate:"omitempty"`
+}
+
+type ListCommunitiesRequest struct {
+	GenreID *uint `form:"genre_id" example:"1"`
+	Limit   *int  `form:"limit" example:"20"`
+	Offset  *int  `form:"offset" example:"0"`
+}
+
+type CommunityURI struct {
+	ID uint `uri:"id" binding:"required" example:"1"`
+}
+
+type CommunityMemberURI struct {
+	ID     uint `uri:"id" binding:"required" example:"1"`
+	UserID uint `uri:"user_id" binding:"required" example:"2"`
+}
+
+type CommunityResponse struct {
+	ID          uint      `json:"id" example:"1"`
+	GenreID     uint      `json:"genre_id" example:"1"`
+	Name        string    `json:"name" example:"Indie Rock Lovers"`
+	Description string    `json:"description" example:"인디 록 팬 커뮤니티"`
+	Rules       string    `json:"rules" example:"1. 서로 존중하기"`
+	BannerURL   string    `json:"banner_url,omitempty" example:"/static/communities/1/banner-3f2a9c.png"`
+	IconURL     string    `json:"icon_url,omitempty" example:"/static/communities/1/icon-8b1d0e.png"`
+	MemberCount int64     `json:"member_count" example:"128"`
+	CreatedAt   time.Time `json:"created_at" example:"2024-05-01T12:00:00Z"`
+	UpdatedAt   time.Time `json:"updated_at" example:"2024-05-01T12:00:00Z"`
+}
+
+type ListCommunitiesResponse struct {
+	Communities []CommunityResponse `json:"communities"`
+	Total       int                 `json:"total" example:"3"`
+}
+
+type GetCommunityResponse struct {
+	Community  CommunityResponse `json:"community"`
+	Role       string            `json:"role,omitempty" example:"MEMBER"`
+	Moderators []CommunityMember `json:"moderators"`
+}
+
+type CommunityMember struct {
+	CommunityID uint      `json:"community_id" example:"1"`
+	UserID      uint      `json:"user_id" example:"2"`
+	Nickname    string    `json:"nickname,omitempty" example:"nickname"`
+	Role        string    `json:"role" example:"MODERATOR"`
+	JoinedAt    time.Time `json:"joined_at" example:"2024-05-01T12:00:00Z"`
+}
+
+type ListCommunityMembersResponse struct {
+	Members []CommunityMember `json:"members"`
+}
+
+type LeaveCommunityResponse struct{}
+
+type RemoveCommunityModeratorResponse struct{}
+
+type CreatePostRequest struct {
+	Title   string   `json:"title" binding:"required,max=100" example:"이번 주 최애 앨범"`
+	Content string   `json:"content" binding:"required" example:"다들 이번 주에 뭐 들으셨나요?"`
+	Music   []string `json:"music" binding:"max=10" example:"spotify:track:4uLU6hMCjMI75M1A2tKUQC"`
+}
+
+type PatchPostRequest struct {
+	Title   *string  `json:"title" example:"이번 주 최애 앨범" validate:"omitempty,min=1,max=100"`
+	Content *string  `json:"content" example:"다들 이번 주에 뭐 들으셨나요?" validate:"omitempty,min=1"`
+	Music   []string `json:"music" example:"https://open.spotify.com/track/4uLU6hMCjMI75M1A2tKUQC" validate:"max=10"`
+}
+
+type PostURI struct {
+	ID uint `uri:"id" binding:"required" example:"1"`
+}
+
+type ListPostsRequest struct {
+	Sort   string `form:"sort" binding:"omitempty,oneof=new top hot" example:"hot"`
+	Limit  *int   `form:"limit" example:"20"`
+	Offset *int   `form:"offset" example:"0"`
+}
+
+type SearchPostsRequest struct {
+	Query       string `form:"q" binding:"required,max=100" example:"앨범"`
+	Field       string `form:"field" binding:"omitempty,oneof=title content" example:"title"`
+	CommunityID *uint  `form:"community_id" example:"1"`
+	Limit       *int   `form:"limit" example:"20"`
+	Offset      *int   `form:"offset" example:"0"`
+}
+
+type PostResponse struct {
+	ID               uint          `json:"id" example:"1"`
+	UserID           uint          `json:"user_id" example:"1"`
+	Nickname         string        `json:"nickname" example:"nickname"`
+	GenreCommunityID uint          `json:"genre_community_id" example:"1"`
+	Title            string        `json:"title" example:"이번 주 최애 앨범"`
+	Content          string        `json:"content" example:"다들 이번 주에 뭐 들으셨나요?"`
+	ContentHTML      string        `json:"content_html" example:"<p>다들 이번 주에 뭐 들으셨나요?</p>"`
+	Likes            int64         `json:"likes" example:"12"`
+	Dislikes         int64         `json:"dislikes" example:"1"`
+	Tracks           []TrackCard   `json:"tracks"`
+	LinkPreviews     []LinkPreview `json:"link_previews"`
+	Hidden           bool          `json:"hidden" example:"false"`
+	CreatedAt        time.Time     `json:"created_at" example:"2024-05-01T12:00:00Z"`
+	UpdatedAt        time.Time     `json:"updated_at" example:"2024-05-01T12:00:00Z"`
+}
+
+type LinkPreview struct {
+	URL         string `json:"url" example:"https://www.youtube.com/watch?v=dQw4w9WgXcQ"`
+	Title       string `json:"title" example:"Aimee Mann - Wise Up"`
+	Description string `json:"description" example:"Live at the Troubadour"`
+	ImageURL    string `json:"image_url" example:"https://i.ytimg.com/vi/dQw4w9WgXcQ/hqdefault.jpg"`
+	SiteName    string `json:"site_name" example:"YouTube"`
+}
+
+type TrackCard struct {
+	ID        uint            `json:"id" example:"1"`
+	Title     string          `json:"title" example:"Wise Up"`
+	SpotifyID string          `json:"spotify_id" example:"4uLU6hMCjMI75M1A2tKUQC"`
+	Artists   []CatalogArtist `json:"artists"`
+	AlbumID   uint            `json:"album_id" example:"1"`
+	AlbumName string          `json:"album_name" example:"Magnolia"`
+	ImageURL  string          `json:"image_url" example:"https://i.scdn.co/image/ab67616d0000b273"`
+	Likes     int64           `json:"likes" example:"12"`
+	Dislikes  int64           `json:"dislikes" example:"1"`
+}
+
+type ListPostsResponse struct {
+	Posts []PostResponse `json:"posts"`
+	Total int            `json:"total" example:"42"`
+}
+
+type DeletePostResponse struct{}
+
+type CreateCommentRequest struct {
+	Content  string   `json:"content" binding:"required,max=2000" example:"저도 이 앨범 좋아해요"`
+	ParentID *uint    `json:"parent_id" example:"1"`
+	Music    []string `json:"music" binding:"max=10" example:"spotify:track:4uLU6hMCjMI75M1A2tKUQC"`
+}
+
+type PatchCommentRequest struct {
+	Content *string  `json:"content" example:"저도 이 앨범 좋아해요" validate:"omitempty,min=1,max=2000"`
+	Music   []string `json:"music" example:"spotify:track:4uLU6hMCjMI75M1A2tKUQC" validate:"max=10"`
+}
+
+type CommentURI struct {
+	ID uint `uri:"id" binding:"required" example:"1"`
+}
+
+type ListCommentsRequest struct {
+	Limit  *int `form:"limit" example:"20"`
+	Offset *int `form:"offset" example:"0"`
+}
+
+type CommentResponse struct {
+	ID         uint              `json:"id" example:"1"`
+	PostID     uint              `json:"post_id" example:"1"`
+	ParentID   *uint             `json:"parent_id,omitempty" example:"1"`
+	UserID     uint              `json:"user_id,omitempty" example:"1"`
+	Nickname   string            `json:"nickname,omitempty" example:"nickname"`
+	Content    string            `json:"content" example:"저도 이 앨범 좋아해요"`
+	Depth      int               `json:"depth" example:"1"`
+	ReplyCount int64             `json:"reply_count" example:"3"`
+	Deleted    bool              `json:"deleted" example:"false"`
+	Hidden     bool              `json:"hidden" example:"false"`
+	Blocked    bool              `json:"blocked" example:"false"`
+	Tracks     []TrackCard       `json:"tracks"`
+	CreatedAt  time.Time         `json:"created_at" example:"2024-05-01T12:00:00Z"`
+	UpdatedAt  time.Time         `json:"updated_at" example:"2024-05-01T12:00:00Z"`
+	Replies    []CommentResponse `json:"replies"`
+}
+
+type ListCommentsResponse struct {
+	Comments []CommentResponse `json:"comments"`
+	Total    int               `json:"total" example:"42"`
+}
+
+type DeleteCommentResponse struct{}
+
+type CreateReportRequest struct {
+	TargetType string `json:"target_type" binding:"required,oneof=POST COMMENT USER" example:"POST"`
+	TargetID   uint   `json:"target_id" binding:"required" example:"1"`
+	Reason     string `json:"reason" binding:"required,oneof=SPAM HARASSMENT HATE_SPEECH SEXUAL_CONTENT VIOLENCE MISINFORMATION OTHER" example:"SPAM"`
+	Details    string `json:"details" binding:"max=1000" example:"같은 링크를 여러 커뮤니티에 반복 게시"`
+}
+
+type ReportURI struct {
+	ID uint `uri:"id" binding:"required" example:"1"`
+}
+
+type ListCommunityReportsRequest struct {
+	Status string `form:"status" binding:"omitempty,oneof=OPEN ESCALATED RESOLVED DISMISSED" example:"OPEN"`
+	Limit  *int   `form:"limit" example:"20"`
+	Offset *int   `form:"offset" example:"0"`
+}
+
+type ListReportsRequest struct {
+	Limit  *int `form:"limit" example:"20"`
+	Offset *int `form:"offset" example:"0"`
+}
+
+type ReportResponse struct {
+	ID           uint       `json:"id" example:"1"`
+	ReporterID   *uint      `json:"reporter_id,omitempty" example:"2"`
+	TargetType   string     `json:"target_type" example:"POST"`
+	TargetID     uint       `json:"target_id" example:"1"`
+	TargetUserID uint       `json:"target_user_id" example:"3"`
+	CommunityID  *uint      `json:"community_id,omitempty" example:"1"`
+	Reason       string     `json:"reason" example:"SPAM"`
+	Details      string     `json:"details" example:"같은 링크를 여러 커뮤니티에 반복 게시"`
+	Excerpt      string     `json:"excerpt" example:"무료 음원 다운로드"`
+	Status       string     `json:"status" example:"OPEN"`
+	ResolvedByID *uint      `json:"resolved_by_id,omitempty" example:"4"`
+	ResolvedAt   *time.Time `json:"resolved_at,omitempty" example:"2024-05-02T12:00:00Z"`
+	CreatedAt    time.Time  `json:"created_at" example:"2024-05-01T12:00:00Z"`
+}
+
+type ListReportsResponse struct {
+	Reports []ReportResponse `json:"reports"`
+	Total   int              `json:"total" example:"42"`
+}
+
+type ModerationActionRequest struct {
+	Action string `json:"action" binding:"required,oneof=HIDE REMOVE WARN BAN ESCALATE DISMISS" example:"HIDE"`
+	Reason string `json:"reason" binding:"max=1000" example:"커뮤니티 규칙 3조 위반"`
+}
+
+type ModerationActionResponse struct {
+	ID           uint      `json:"id" example:"1"`
+	CommunityID  *uint     `json:"community_id,omitempty" example:"1"`
+	ReportID     *uint     `json:"report_id,omitempty" example:"1"`
+	ModeratorID  uint      `json:"moderator_id,omitempty" example:"4"`
+	TargetUserID uint      `json:"target_user_id" example:"3"`
+	Action       string    `json:"action" example:"HIDE"`
+	TargetType   string    `json:"target_type" example:"POST"`
+	TargetID     uint      `json:"target_id" example:"1"`
+	Excerpt      string    `json:"excerpt" example:"무료 음원 다운로드"`
+	Reason       string    `json:"reason" example:"커뮤니티 규칙 3조 위반"`
+	CreatedAt    time.Time `json:"created_at" example:"2024-05-02T12:00:00Z"`
+}
+
+type ListModerationActionsResponse struct {
+	Actions []ModerationActionResponse `json:"actions"`
+	Total   int                        `json:"total" example:"42"`
+}
+
+type FollowResponse struct {
+	UserID        uint  `json:"user_id" example:"2"`
+	Following     bool  `json:"following" example:"true"`
+	FollowsYou    bool  `json:"follows_you" example:"false"`
+	FollowerCount int64 `json:"follower_count" example:"12"`
+}
+
+type ListFollowsRequest struct {
+	Limit  *int `form:"limit" example:"20"`
+	Offset *int `form:"offset" example:"0"`
+}
+
+type FollowUserResponse struct {
+	UserID          uint      `json:"user_id" example:"2"`
+	Nickname        string    `json:"nickname" example:"nickname"`
+	ProfileImageURL string    `json:"profile_image_url" example:"https://example.com/profile.png"`
+	Following       bool      `json:"following" example:"true"`
+	FollowsYou      bool      `json:"follows_you" example:"true"`
+	FollowedAt      time.Time `json:"followed_at" example:"2024-05-01T12:00:00Z"`
+}
+
+type ListFollowsResponse struct {
+	Users []FollowUserResponse `json:"users"`
+	Total int                  `json:"total" example:"42"`
+}
+
+// NicknameURI binds the nickname of /users/:id routes. The wildcard is named
+// id because gin requires sibling wildcards to share a name.
+type NicknameURI struct {
+	Nickname string `uri:"id" binding:"required" example:"nickname"`
+}
+
+// PublicProfileResponse is a user's profile as another user sees it. Sections
+// listed in hidden_sections are omitted unless the viewer owns the profile.
+type PublicProfileResponse struct {
+	UserID          uint                  `json:"user_id" example:"1"`
+	Nickname        string                `json:"nickname" example:"nickname"`
+	ProfileImageURL string                `json:"profile_image_url" example:"https://example.com/profile.png"`
+	Bio             string                `json:"bio,omitempty" example:"bio..."`
+	Website         string                `json:"website,omitempty" example:"https://example.com"`
+	FollowerCount   *int64                `json:"follower_count,omitempty" example:"12"`
+	FollowingCount  *int64                `json:"following_count,omitempty" example:"3"`
+	Following       bool                  `json:"following" example:"false"`
+	FollowsYou      bool                  `json:"follows_you" example:"false"`
+	IsOwner         bool                  `json:"is_owner" example:"false"`
+	Topsters        []TopsterResponse     `json:"topsters,omitempty"`
+	Collections     []CollectionResponse  `json:"collections,omitempty"`
+	RecentPosts     []ProfilePostResponse `json:"recent_posts,omitempty"`
+	HiddenSections  []string              `json:"hidden_sections" example:"posts"`
+}
+
+type ProfilePostResponse struct {
+	ID               uint      `json:"id" example:"1"`
+	GenreCommunityID uint      `json:"genre_community_id" example:"1"`
+	Title            string    `json:"title" example:"Favorite shoegaze records"`
+	CreatedAt        time.Time `json:"created_at" example:"2024-05-01T12:00:00Z"`
+}
+
+type BlockResponse struct {
+	UserID  uint `json:"user_id" example:"2"`
+	Blocked bool `json:"blocked" example:"true"`
+}
+
+type MuteResponse struct {
+	UserID uint `json:"user_id" example:"2"`
+	Muted  bool `json:"muted" example:"true"`
+}
+
+type ListRestrictedUsersRequest struct {
+	Limit  *int `form:"limit" example:"20"`
+	Offset *int `form:"offset" example:"0"`
+}
+
+type RestrictedUserResponse struct {
+	UserID          uint      `json:"user_id" example:"2"`
+	Nickname        string    `json:"nickname" example:"nickname"`
+	ProfileImageURL string    `json:"profile_image_url" example:"https://example.com/profile.png"`
+	Since           time.Time `json:"since" example:"2024-05-01T12:00:00Z"`
+}
+
+type ListRestrictedUsersResponse struct {
+	Users []RestrictedUserResponse `json:"users"`
+	Total int                      `json:"total" example:"1"`
+}
+
+type GetFeedRequest struct {
+	Cursor string `form:"cursor" example:"120"`
+	Limit  *int   `form:"limit" example:"20"`
+}
+
+type FeedUserResponse struct {
+	UserID          uint   `json:"user_id" example:"2"`
+	Nickname        string `json:"nickname" example:"nickname"`
+	ProfileImageURL string `json:"profile_image_url" example:"https://example.com/profile.png"`
+}
+
+type FeedSubjectResponse struct {
+	ID       uint   `json:"id" example:"10"`
+	Title    string `json:"title" example:"Loveless"`
+	ImageURL string `json:"image_url" example:"https://example.com/cover.png"`
+}
+
+type FeedActivityResponse struct {
+	ID        uint                  `json:"id" example:"130"`
+	Type      string                `json:"type" example:"TRACK_LIKE"`
+	Actor     FeedUserResponse      `json:"actor"`
+	Subjects  []FeedSubjectResponse `json:"subjects"`
+	Count     int                   `json:"count" example:"5"`
+	CreatedAt time.Time             `json:"created_at" example:"2024-05-01T12:00:00Z"`
+}
+
+type GetFeedResponse struct {
+	Activities []FeedActivityResponse `json:"activities"`
+	NextCursor string                 `json:"next_cursor,omitempty" example:"120"`
+}
+
+type ListNotificationsRequest struct {
+	Unread bool `form:"unread" example:"false"`
+	Limit  *int `form:"limit" example:"20"`
+	Offset *int `form:"offset" example:"0"`
+}
+
+type NotificationActorResponse struct {
+	UserID          uint   `json:"user_id" example:"2"`
+	Nickname        string `json:"nickname" example:"nickname"`
+	ProfileImageURL string `json:"profile_image_url" example:"https://example.com/profile.png"`
+}
+
+type NotificationResponse struct {
+	ID                 uint                       `json:"id" example:"30"`
+	Type               string                     `json:"type" example:"REPLY"`
+	Actor              *NotificationActorResponse `json:"actor,omitempty"`
+	Message            string                     `json:"message" example:"nickname님이 회원님의 글에 댓글을 남겼습니다."`
+	PostID             *uint                      `json:"post_id,omitempty" example:"10"`
+	CommentID          *uint                      `json:"comment_id,omitempty" example:"25"`
+	ModerationActionID *uint                      `json:"moderation_action_id,omitempty" example:"3"`
+	Read               bool                       `json:"read" example:"false"`
+	CreatedAt          time.Time                  `json:"created_at" example:"2024-05-01T12:00:00Z"`
+}
+
+type ListNotificationsResponse struct {
+	Notifications []NotificationResponse `json:"notifications"`
+	Total         int                    `json:"total" example:"1"`
+	UnreadCount   int                    `json:"unread_count" example:"1"`
+}
+
+type MarkNotificationsReadRequest struct {
+	IDs []uint `json:"ids" example:"30,31"`
+	All bool   `json:"all" example:"false"`
+}
+
+type MarkNotificationsReadResponse struct {
+	UnreadCount int `json:"unread_count" example:"0"`
+}
+
+type NotificationPreferenceRequest struct {
+	Type  string `json:"type" binding:"required" example:"POST_LIKE"`
+	InApp *bool  `json:"in_app" example:"true"`
+	Email *bool  `json:"email" example:"false"`
+}
+
+type UpdateNotificationPreferencesRequest struct {
+	Preferences []NotificationPreferenceRequest `json:"preferences" binding:"required,dive"`
+}
+
+type NotificationPreferenceResponse struct {
+	Type  string `json:"type" example:"POST_LIKE"`
+	InApp bool   `json:"in_app" example:"true"`
+	Email bool   `json:"email" example:"false"`
+}
+
+type NotificationPreferencesResponse struct {
+	Preferences []NotificationPreferenceResponse `json:"preferences"`
+}
@@ -152,20 +152,32 @@ func (u *userController) GetMyUserInfo(c *gin.Context) {
 		ProfileImageURL: user.ProfileImageURL,
 		Bio:             user.Bio,
 		Website:         user.Website,
+		FollowerCount:   user.FollowerCount,
+		FollowingCount:  user.FollowingCount,
+		Privacy: ProfilePrivacyResponse{
+			HideBio:         user.Privacy.HideBio,
+			HideWebsite:     user.Privacy.HideWebsite,
+			HideFollows:     user.Privacy.HideFollows,
+			HideTopsters:    user.Privacy.HideTopsters,
+			HideCollections: user.Privacy.HideCollections,
+			HidePosts:       user.Privacy.HidePosts,
+		},
 	}
 	c.JSON(http.StatusOK, res)
 }
 
 // PatchMyUser godoc
 // @Summary      Patch my user info
-// @Description  JWT 인증 토큰 기반 내 유저 정보 업데이트
+// @Description  JWT 인증 토큰 기반 내 유저 정보 업데이트. 부적절하거나 경로로 예약된(me, password) 닉네임, user-로 시작하는 닉네임과 링크 스팸으로 의심되는 소개는 거부되고, 소개의 욕설은 마스킹됨. privacy로 공개 프로필의 섹션별 공개 여부 설정
 // @Tags         users
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
 // @Param request body PatchMyUserRequest true "PatchMyUser Request"
 // @Success      200  {object}  PatchMyUserResponse
+// @Failure      400  {object}  ErrorResponse
 // @Failure      401  {object}  ErrorResponse
+// @Failure      409  {object}  ErrorResponse
 // @Failure      500  {object}  ErrorResponse
 // @Router       /api/v1/users/me [patch]
 func (u *userController) PatchMyUser(c *gin.Context) {
@@ -189,14 +201,25 @@ func (u *userController) PatchMyUser(c *gin.Context) {
 		return
 	}
 
-	err = u.userUsecase.PatchUser(userID, &usecase.PatchUserInput{
+	input := &usecase.PatchUserInput{
 		Name:     req.Name,
 		Nickname: req.Nickname,
 		Bio:      req.Bio,
 		Website:  req.Website,
-	})
+	}
+	if req.Privacy != nil {
+		input.Privacy = &usecase.PatchProfilePrivacyInput{
+			HideBio:         req.Privacy.HideBio,
+			HideWebsite:     req.Privacy.HideWebsite,
+			HideFollows:     req.Privacy.HideFollows,
+			HideTopsters:    req.Privacy.HideTopsters,
+			HideCollections: req.Privacy.HideCollections,
+			HidePosts:       req.Privacy.HidePosts,
+		}
+	}
+	err = u.userUsecase.PatchUser(userID, input)
 	if err != nil {
-		HandleError(c, usecase.ErrUpdatingRecord)
+		HandleError(c, err)
 		return
 	}
 
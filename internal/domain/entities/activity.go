@@ -0,0 +1,42 @@
+package entities
+
+import "time"
+
+const (
+	ActivityTypePost       = "POST"       // new post
+	ActivityTypeTopster    = "TOPSTER"    // topster made public
+	ActivityTypeCollection = "COLLECTION" // collection made public
+	ActivityTypeTrackLike  = "TRACK_LIKE" // track liked
+	ActivityTypeFollow     = "FOLLOW"     // user followed
+)
+
+// Activity is something a user did that shows up in their followers' feeds.
+// Exactly one of the subject IDs is set, depending on Type.
+type Activity struct {
+	ID           uint   `gorm:"primaryKey;autoIncrement"`
+	ActorID      uint   `gorm:"not null"`
+	Actor        User   `gorm:"foreignKey:ActorID"`
+	Type         string `gorm:"type:varchar(20);not null"`
+	PostID       *uint
+	Post         *Post `gorm:"foreignKey:PostID"`
+	TopsterID    *uint
+	Topster      *UserTopster `gorm:"foreignKey:TopsterID"`
+	CollectionID *uint
+	Collection   *MusicCollection `gorm:"foreignKey:CollectionID"`
+	MusicID      *uint
+	Music        *Music `gorm:"foreignKey:MusicID"`
+	TargetUserID *uint
+	TargetUser   *User `gorm:"foreignKey:TargetUserID"`
+	FannedOut    bool  `gorm:"not null"` // copied into the followers' feed items when created
+
+	CreatedAt time.Time
+}
+
+// FeedItem puts a fanned out activity in a follower's feed.
+type FeedItem struct {
+	ID         uint `gorm:"primaryKey;autoIncrement"`
+	UserID     uint `gorm:"not null"`
+	ActivityID uint `gorm:"not null"`
+
+	CreatedAt time.Time
+}
@@ -8,9 +8,12 @@ type Album struct {
 	ArtistID    uint      `gorm:"index"`
 	ReleaseDate time.Time `gorm:"type:date"`
 	ImageURL    string    `gorm:"type:varchar(255)"`
+	SpotifyID   string    `gorm:"type:varchar(50)"`
+	UPC         string    `gorm:"column:upc;type:varchar(20);index"`
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
 
-	Music []Music `gorm:"foreignKey:AlbumID"`
+	Artist Artist  `gorm:"foreignKey:ArtistID"`
+	Music  []Music `gorm:"foreignKey:AlbumID"`
 }
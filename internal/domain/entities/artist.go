@@ -6,6 +6,7 @@ type Artist struct {
 	ID          uint   `gorm:"primaryKey;autoIncrement"`
 	Name        string `gorm:"type:varchar(100)"`
 	Description string `gorm:"type:varchar(255)"`
+	SpotifyID   string `gorm:"type:varchar(50)"`
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
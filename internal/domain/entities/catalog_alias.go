@@ -0,0 +1,22 @@
+package entities
+
+import "time"
+
+// MusicAlias keeps the ID of a music row that was merged into another one, so
+// that references to the old ID still resolve.
+type MusicAlias struct {
+	ID         uint `gorm:"primaryKey;autoIncrement"`
+	MusicID    uint `gorm:"index"`
+	OldMusicID uint `gorm:"unique"`
+
+	CreatedAt time.Time
+}
+
+// AlbumAlias is the album counterpart of MusicAlias.
+type AlbumAlias struct {
+	ID         uint `gorm:"primaryKey;autoIncrement"`
+	AlbumID    uint `gorm:"index"`
+	OldAlbumID uint `gorm:"unique"`
+
+	CreatedAt time.Time
+}
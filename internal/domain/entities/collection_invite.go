@@ -0,0 +1,14 @@
+package entities
+
+import "time"
+
+type CollectionInvite struct {
+	ID           uint       `gorm:"primaryKey;autoIncrement"`
+	CollectionID uint       `gorm:"not null"`
+	Token        string     `gorm:"type:varchar(255);unique;not null"` // 초대 링크 식별자
+	Role         string     `gorm:"type:varchar(10);not null"`         // 초대받은 유저에게 부여할 역할
+	CreatedBy    uint       `gorm:"not null"`
+	ExpiresAt    *time.Time `gorm:"not null"` // 초대 링크 만료 시간
+
+	CreatedAt time.Time
+}
@@ -0,0 +1,20 @@
+package entities
+
+import "time"
+
+const (
+	CollectionRoleOwner  = "OWNER"
+	CollectionRoleEditor = "EDITOR"
+	CollectionRoleViewer = "VIEWER"
+)
+
+type CollectionMember struct {
+	ID           uint   `gorm:"primaryKey;autoIncrement"`
+	CollectionID uint   `gorm:"not null"`
+	UserID       uint   `gorm:"not null"`
+	User         User   `gorm:"foreignKey:UserID"`
+	Role         string `gorm:"type:varchar(10);not null"` // OWNER, EDITOR, VIEWER
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -3,9 +3,15 @@ package entities
 import "time"
 
 type CollectionMusicMapping struct {
-	ID           uint `gorm:"primaryKey;autoIncrement"`
-	CollectionID uint `gorm:"index"`
-	MusicID      uint `gorm:"index"`
+	ID           uint  `gorm:"primaryKey;autoIncrement"`
+	CollectionID uint  `gorm:"index"`
+	MusicID      uint  `gorm:"index"`
+	Position     int   // 컬렉션 내 0부터 시작하는 순서
+	AddedBy      *uint // 트랙을 추가한 유저
 
 	CreatedAt time.Time
 }
+
+func (CollectionMusicMapping) TableName() string {
+	return "collection_music_mapping"
+}
@@ -0,0 +1,32 @@
+package entities
+
+import "time"
+
+const (
+	SyncStatusSynced        = "SYNCED"
+	SyncStatusFailed        = "FAILED"
+	SyncStatusRemoteDeleted = "REMOTE_DELETED"
+)
+
+// CollectionSpotifySync records the state of a collection exported to a
+// Spotify playlist. Tracks holds the playlist contents as of the last
+// successful sync and serves as the common base when reconciling changes.
+type CollectionSpotifySync struct {
+	ID           uint   `gorm:"primaryKey;autoIncrement"`
+	CollectionID uint   `gorm:"uniqueIndex;not null"`
+	Status       string `gorm:"type:varchar(20);not null"`
+	SnapshotID   string `gorm:"type:varchar(100)"` // 마지막 동기화 시점의 Spotify 플레이리스트 스냅샷
+	Error        string `gorm:"type:text"`
+	SyncedAt     *time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	Tracks []CollectionSpotifySyncTrack `gorm:"foreignKey:SyncID"`
+}
+
+type CollectionSpotifySyncTrack struct {
+	ID             uint   `gorm:"primaryKey;autoIncrement"`
+	SyncID         uint   `gorm:"index;not null"`
+	SpotifyTrackID string `gorm:"type:varchar(50);not null"`
+}
@@ -3,10 +3,25 @@ package entities
 import "time"
 
 type Comment struct {
-	ID      uint   `gorm:"primaryKey;autoIncrement"`
-	UserID  uint   `gorm:"index"`
-	PostID  uint   `gorm:"index"`
-	Content string `gorm:"type:text"`
+	ID       uint   `gorm:"primaryKey;autoIncrement"`
+	UserID   uint   `gorm:"index"`
+	User     User   `gorm:"foreignKey:UserID"`
+	PostID   uint   `gorm:"index"`
+	ParentID *uint  `gorm:"index"`
+	Content  string `gorm:"type:text"`
+
+	// Path holds the zero-padded IDs of the comment's ancestors and the
+	// comment itself, each followed by a slash, e.g. "0000000012/0000000034/".
+	// Ordering by path lists a thread depth-first in creation order.
+	Path       string `gorm:"type:text;not null"`
+	Depth      int    `gorm:"not null;default:0"`
+	ReplyCount int64  `gorm:"not null;default:0"` // direct replies, maintained by CommentRepository
+	IsDeleted  bool   `gorm:"not null;default:false"`
+	IsHidden   bool   `gorm:"not null;default:false"` // hidden by a moderator
+
+	// Blocked is set by CommentRepository when the viewer a thread is read
+	// for blocked the author or was blocked by them. It is not stored.
+	Blocked bool `gorm:"->;-:migration"`
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
@@ -0,0 +1,13 @@
+package entities
+
+import "time"
+
+type CommunityBan struct {
+	ID          uint   `gorm:"primaryKey;autoIncrement"`
+	CommunityID uint   `gorm:"not null"`
+	UserID      uint   `gorm:"not null"`
+	ModeratorID uint   `gorm:"not null"`
+	Reason      string `gorm:"type:text"`
+
+	CreatedAt time.Time
+}
@@ -0,0 +1,19 @@
+package entities
+
+import "time"
+
+const (
+	CommunityRoleMember    = "MEMBER"
+	CommunityRoleModerator = "MODERATOR"
+)
+
+type CommunityMember struct {
+	ID          uint   `gorm:"primaryKey;autoIncrement"`
+	CommunityID uint   `gorm:"not null"`
+	UserID      uint   `gorm:"not null"`
+	User        User   `gorm:"foreignKey:UserID"`
+	Role        string `gorm:"type:varchar(10);not null"` // MEMBER, MODERATOR
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -4,9 +4,12 @@ import "time"
 
 type Genre struct {
 	ID          uint   `gorm:"primaryKey;autoIncrement"`
+	ParentID    *uint  `gorm:"index"`
 	Name        string `gorm:"type:varchar(50);unique"`
 	Description string `gorm:"type:varchar(255)"`
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
+
+	Aliases []GenreAlias `gorm:"foreignKey:GenreID"`
 }
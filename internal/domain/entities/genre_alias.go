@@ -0,0 +1,11 @@
+package entities
+
+import "time"
+
+type GenreAlias struct {
+	ID      uint   `gorm:"primaryKey;autoIncrement"`
+	GenreID uint   `gorm:"index"`
+	Alias   string `gorm:"type:varchar(100);unique"` // normalized, see taxonomy.Normalize
+
+	CreatedAt time.Time
+}
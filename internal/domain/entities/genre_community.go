@@ -7,6 +7,10 @@ type GenreCommunity struct {
 	GenreID     uint   `gorm:"index"`
 	Name        string `gorm:"type:varchar(100)"`
 	Description string `gorm:"type:varchar(255)"`
+	Rules       string `gorm:"type:text"`
+	BannerURL   string `gorm:"type:varchar(255)"`
+	IconURL     string `gorm:"type:varchar(255)"`
+	MemberCount int64  `gorm:"not null;default:0"` // maintained by CommunityMemberRepository
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
@@ -0,0 +1,16 @@
+package entities
+
+import "time"
+
+type LinkPreview struct {
+	ID          uint   `gorm:"primaryKey;autoIncrement"`
+	PostID      uint   `gorm:"not null;index"`
+	URL         string `gorm:"type:varchar(2048);not null"`
+	Title       string `gorm:"type:varchar(255)"`
+	Description string `gorm:"type:text"`
+	ImageURL    string `gorm:"type:varchar(2048)"`
+	SiteName    string `gorm:"type:varchar(100)"`
+	Position    int    `gorm:"not null;default:0"`
+
+	CreatedAt time.Time
+}
@@ -0,0 +1,28 @@
+package entities
+
+import "time"
+
+const (
+	ModerationActionHide     = "HIDE"
+	ModerationActionRemove   = "REMOVE"
+	ModerationActionWarn     = "WARN"
+	ModerationActionBan      = "BAN"
+	ModerationActionEscalate = "ESCALATE"
+	ModerationActionDismiss  = "DISMISS"
+)
+
+// ModerationAction is an entry of the moderation log.
+type ModerationAction struct {
+	ID           uint  `gorm:"primaryKey;autoIncrement"`
+	CommunityID  *uint `gorm:"index"`
+	ReportID     *uint
+	ModeratorID  uint   `gorm:"not null"`
+	TargetUserID uint   `gorm:"not null;index"`
+	Action       string `gorm:"type:varchar(10);not null"`
+	TargetType   string `gorm:"type:varchar(10);not null"`
+	TargetID     uint   `gorm:"not null"`
+	Excerpt      string `gorm:"type:text"`
+	Reason       string `gorm:"type:text"`
+
+	CreatedAt time.Time
+}
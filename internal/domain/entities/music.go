@@ -3,17 +3,26 @@ package entities
 import "time"
 
 type Music struct {
-	ID        uint   `gorm:"primaryKey;autoIncrement"`
-	Title     string `gorm:"type:varchar(255)"`
-	AlbumID   uint   `gorm:"index"`
-	SpotifyID string `gorm:"type:varchar(50)"`
-	LastfmID  string `gorm:"type:varchar(50)"`
+	ID              uint   `gorm:"primaryKey;autoIncrement"`
+	Title           string `gorm:"type:varchar(255)"`
+	AlbumID         uint   `gorm:"index"`
+	SpotifyID       string `gorm:"type:varchar(50)"`
+	ISRC            string `gorm:"column:isrc;type:varchar(12);index"`
+	LastfmID        string `gorm:"type:varchar(50)"`
+	LastfmListeners int64
+	LastfmPlaycount int64
+	LastfmSyncedAt  *time.Time
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
 
+	Album                  *Album                   `gorm:"foreignKey:AlbumID"`
 	MusicGenreMapping      []MusicGenreMapping      `gorm:"foreignKey:MusicID"`
 	MusicArtistMapping     []MusicArtistMapping     `gorm:"foreignKey:MusicID"`
 	UserLikes              []UserLike               `gorm:"foreignKey:MusicID"`
 	CollectionMusicMapping []CollectionMusicMapping `gorm:"foreignKey:MusicID"`
 }
+
+func (Music) TableName() string {
+	return "music"
+}
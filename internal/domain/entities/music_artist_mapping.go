@@ -3,9 +3,14 @@ package entities
 import "time"
 
 type MusicArtistMapping struct {
-	ID       uint `gorm:"primaryKey;autoIncrement"`
-	MusicID  uint `gorm:"index"`
-	ArtistID uint `gorm:"index"`
+	ID       uint   `gorm:"primaryKey;autoIncrement"`
+	MusicID  uint   `gorm:"index"`
+	ArtistID uint   `gorm:"index"`
+	Artist   Artist `gorm:"foreignKey:ArtistID"`
 
 	CreatedAt time.Time
 }
+
+func (MusicArtistMapping) TableName() string {
+	return "music_artist_mapping"
+}
@@ -0,0 +1,15 @@
+package entities
+
+import "time"
+
+// MusicAttachment embeds a track in either a post or a comment.
+type MusicAttachment struct {
+	ID        uint  `gorm:"primaryKey;autoIncrement"`
+	MusicID   uint  `gorm:"not null;index"`
+	Music     Music `gorm:"foreignKey:MusicID"`
+	PostID    *uint `gorm:"index"`
+	CommentID *uint `gorm:"index"`
+	Position  int   `gorm:"not null;default:0"`
+
+	CreatedAt time.Time
+}
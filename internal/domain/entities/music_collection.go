@@ -7,6 +7,9 @@ type MusicCollection struct {
 	UserID      uint   `gorm:"index"`
 	Name        string `gorm:"type:varchar(255)"`
 	Description string
+	IsPublic    bool
+
+	SpotifyPlaylistID string `gorm:"type:varchar(50)"` // 가져온 Spotify 플레이리스트 ID
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
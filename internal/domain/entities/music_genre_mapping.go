@@ -3,9 +3,14 @@ package entities
 import "time"
 
 type MusicGenreMapping struct {
-	ID      uint `gorm:"primaryKey;autoIncrement"`
-	MusicID uint `gorm:"index"`
-	GenreID uint `gorm:"index"`
+	ID      uint  `gorm:"primaryKey;autoIncrement"`
+	MusicID uint  `gorm:"index"`
+	GenreID uint  `gorm:"index"`
+	Genre   Genre `gorm:"foreignKey:GenreID"`
 
 	CreatedAt time.Time
 }
+
+func (MusicGenreMapping) TableName() string {
+	return "music_genre_mapping"
+}
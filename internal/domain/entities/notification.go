@@ -0,0 +1,49 @@
+package entities
+
+import "time"
+
+const (
+	NotificationTypeReply      = "REPLY"      // comment on my post, or reply to my comment
+	NotificationTypePostLike   = "POST_LIKE"  // like on my post
+	NotificationTypeFollow     = "FOLLOW"     // new follower
+	NotificationTypeMention    = "MENTION"    // mention in a post or comment
+	NotificationTypeModeration = "MODERATION" // moderation action on me or my content
+)
+
+// NotificationTypes lists every notification type, in the order preferences
+// are shown.
+var NotificationTypes = []string{
+	NotificationTypeReply,
+	NotificationTypePostLike,
+	NotificationTypeFollow,
+	NotificationTypeMention,
+	NotificationTypeModeration,
+}
+
+// Notification tells a user about something that concerns them. ActorID is
+// nil for moderation actions, which do not reveal the moderator.
+type Notification struct {
+	ID                 uint   `gorm:"primaryKey;autoIncrement"`
+	UserID             uint   `gorm:"not null"`
+	ActorID            *uint  `gorm:"index"`
+	Actor              *User  `gorm:"foreignKey:ActorID"`
+	Type               string `gorm:"type:varchar(20);not null"`
+	PostID             *uint
+	Post               *Post `gorm:"foreignKey:PostID"`
+	CommentID          *uint
+	Comment            *Comment `gorm:"foreignKey:CommentID"`
+	ModerationActionID *uint
+	ModerationAction   *ModerationAction `gorm:"foreignKey:ModerationActionID"`
+	ReadAt             *time.Time
+
+	CreatedAt time.Time
+}
+
+// NotificationPreference is how a user wants to receive one type of
+// notification. Types without a preference use the defaults.
+type NotificationPreference struct {
+	UserID uint   `gorm:"primaryKey"`
+	Type   string `gorm:"primaryKey;type:varchar(20)"`
+	InApp  bool   `gorm:"not null"`
+	Email  bool   `gorm:"not null"`
+}
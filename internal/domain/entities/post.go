@@ -5,9 +5,12 @@ import "time"
 type Post struct {
 	ID               uint   `gorm:"primaryKey;autoIncrement"`
 	UserID           uint   `gorm:"index"`
+	User             User   `gorm:"foreignKey:UserID"`
 	GenreCommunityID uint   `gorm:"index"`
 	Title            string `gorm:"type:varchar(100)"`
-	Content          string
+	Content          string // Markdown source
+	ContentHTML      string `gorm:"type:text"`              // sanitized rendering of Content
+	IsHidden         bool   `gorm:"not null;default:false"` // hidden by a moderator
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
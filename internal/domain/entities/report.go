@@ -0,0 +1,47 @@
+package entities
+
+import "time"
+
+const (
+	ReportTargetPost    = "POST"
+	ReportTargetComment = "COMMENT"
+	ReportTargetUser    = "USER"
+)
+
+const (
+	ReportReasonSpam           = "SPAM"
+	ReportReasonHarassment     = "HARASSMENT"
+	ReportReasonHateSpeech     = "HATE_SPEECH"
+	ReportReasonSexualContent  = "SEXUAL_CONTENT"
+	ReportReasonViolence       = "VIOLENCE"
+	ReportReasonMisinformation = "MISINFORMATION"
+	ReportReasonOther          = "OTHER"
+	// ReportReasonAutomated marks reports filed by the content filter for held
+	// content. Users cannot report with it.
+	ReportReasonAutomated = "AUTOMATED"
+)
+
+const (
+	ReportStatusOpen      = "OPEN"
+	ReportStatusEscalated = "ESCALATED"
+	ReportStatusResolved  = "RESOLVED"
+	ReportStatusDismissed = "DISMISSED"
+)
+
+type Report struct {
+	ID           uint   `gorm:"primaryKey;autoIncrement"`
+	ReporterID   *uint  // nil for content held by the content filter
+	TargetType   string `gorm:"type:varchar(10);not null"` // POST, COMMENT, USER
+	TargetID     uint   `gorm:"not null"`
+	TargetUserID uint   `gorm:"not null"` // author of the reported content, or the reported user
+	CommunityID  *uint  // nil for reported profiles, which only admins review
+	Reason       string `gorm:"type:varchar(20);not null"`
+	Details      string `gorm:"type:text"`
+	Excerpt      string `gorm:"type:text"`                 // the reported content at the time of the report
+	Status       string `gorm:"type:varchar(10);not null"` // OPEN, ESCALATED, RESOLVED, DISMISSED
+	ResolvedByID *uint
+	ResolvedAt   *time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
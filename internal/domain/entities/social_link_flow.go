@@ -0,0 +1,13 @@
+package entities
+
+import "time"
+
+type SocialLinkFlow struct {
+	ID        uint       `gorm:"primaryKey;autoIncrement"`
+	UserID    uint       `gorm:"not null"`
+	Provider  string     `gorm:"type:social_provider;not null"`
+	State     string     `gorm:"type:varchar(255);unique;not null"` // OAuth state 파라미터
+	ExpiresAt *time.Time `gorm:"not null"`                          // 연동 요청 만료 시간
+
+	CreatedAt time.Time
+}
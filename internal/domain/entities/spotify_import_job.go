@@ -0,0 +1,24 @@
+package entities
+
+import "time"
+
+const (
+	ImportJobStatusPending   = "PENDING"
+	ImportJobStatusRunning   = "RUNNING"
+	ImportJobStatusCompleted = "COMPLETED"
+	ImportJobStatusFailed    = "FAILED"
+)
+
+type SpotifyImportJob struct {
+	ID                  uint   `gorm:"primaryKey;autoIncrement"`
+	UserID              uint   `gorm:"index;not null"`
+	Status              string `gorm:"type:varchar(20);not null;default:PENDING"`
+	CollectionsImported int    `gorm:"not null;default:0"`
+	TracksImported      int    `gorm:"not null;default:0"`
+	Error               string `gorm:"type:text"`
+	StartedAt           *time.Time
+	FinishedAt          *time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
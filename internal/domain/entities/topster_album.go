@@ -1,8 +1,40 @@
 package entities
 
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+)
+
 type TopsterAlbum struct {
-	ID        uint   `gorm:"primaryKey;autoIncrement"`
-	TopsterID uint   `gorm:"index"`
-	AlbumID   uint   `gorm:"index"`
-	Position  string `gorm:"type:json"`
+	ID        uint            `gorm:"primaryKey;autoIncrement"`
+	TopsterID uint            `gorm:"index"`
+	AlbumID   uint            `gorm:"index"`
+	Album     Album           `gorm:"foreignKey:AlbumID"`
+	Position  TopsterPosition `gorm:"type:jsonb"`
+}
+
+// TopsterPosition is the zero-based cell of an album in the topster grid,
+// stored as {"row": 0, "col": 0}.
+type TopsterPosition struct {
+	Row int `json:"row"`
+	Col int `json:"col"`
+}
+
+func (p TopsterPosition) Value() (driver.Value, error) {
+	b, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+func (p *TopsterPosition) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, p)
+	case string:
+		return json.Unmarshal([]byte(v), p)
+	}
+	return errors.New("unsupported topster position type")
 }
@@ -13,6 +13,7 @@ type User struct {
 	PasswordHash string `gorm:"type:varchar(255)"`
 	Name         string `gorm:"type:varchar(50)"`
 	Nickname     string `gorm:"type:varchar(50);unique"`
+	IsAdmin      bool   `gorm:"not null;default:false"`
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
@@ -0,0 +1,12 @@
+package entities
+
+import "time"
+
+type UserBlock struct {
+	ID        uint `gorm:"primaryKey;autoIncrement"`
+	BlockerID uint `gorm:"not null"`
+	BlockedID uint `gorm:"not null"`
+	Blocked   User `gorm:"foreignKey:BlockedID"`
+
+	CreatedAt time.Time
+}
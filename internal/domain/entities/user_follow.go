@@ -5,7 +5,9 @@ import "time"
 type UserFollow struct {
 	ID          uint `gorm:"primaryKey;autoIncrement"`
 	FollowerID  uint `gorm:"index"`
+	Follower    User `gorm:"foreignKey:FollowerID"`
 	FollowingID uint `gorm:"index"`
+	Following   User `gorm:"foreignKey:FollowingID"`
 
 	CreatedAt time.Time
 }
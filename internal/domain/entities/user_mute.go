@@ -0,0 +1,12 @@
+package entities
+
+import "time"
+
+type UserMute struct {
+	ID      uint `gorm:"primaryKey;autoIncrement"`
+	MuterID uint `gorm:"not null"`
+	MutedID uint `gorm:"not null"`
+	Muted   User `gorm:"foreignKey:MutedID"`
+
+	CreatedAt time.Time
+}
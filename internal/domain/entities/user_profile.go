@@ -13,6 +13,14 @@ type UserProfile struct {
 	Bio             string `gorm:"type:varchar(500);default:''"`
 	Website         string `gorm:"type:varchar(255);default:''"`
 
+	// Sections of the public profile hidden from other users.
+	HideBio         bool `gorm:"not null;default:false"`
+	HideWebsite     bool `gorm:"not null;default:false"`
+	HideFollows     bool `gorm:"not null;default:false"`
+	HideTopsters    bool `gorm:"not null;default:false"`
+	HideCollections bool `gorm:"not null;default:false"`
+	HidePosts       bool `gorm:"not null;default:false"`
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	DeletedAt gorm.DeletedAt `gorm:"index"`
@@ -6,11 +6,18 @@ import (
 	"gorm.io/gorm"
 )
 
+const (
+	SocialProviderSpotify = "SPOTIFY"
+)
+
 type UserSocialAccount struct {
 	ID             uint   `gorm:"primaryKey;autoIncrement"`
 	UserID         uint   `gorm:"index"`
 	Provider       string `gorm:"type:enum('GOOGLE','KAKAO','NAVER', 'SPOTIFY');not null"`
 	ProviderUserID string `gorm:"type:varchar(100)"`
+	AccessToken    string `gorm:"type:text"` // 암호화된 액세스 토큰
+	RefreshToken   string `gorm:"type:text"` // 암호화된 리프레시 토큰
+	TokenExpiresAt *time.Time
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
@@ -2,14 +2,24 @@ package entities
 
 import "time"
 
+// Topster templates. Grid topsters have rows x cols cells, while tiered
+// templates have fewer, larger cells in their top rows.
+const (
+	TopsterTemplateGrid  = "grid"
+	TopsterTemplateTop42 = "top42"
+)
+
 type UserTopster struct {
 	ID          uint   `gorm:"primaryKey;autoIncrement"`
 	UserID      uint   `gorm:"index"`
 	Title       string `gorm:"type:varchar(255)"`
 	Description string `gorm:"type:varchar(500)"`
-	GridSize    int
+	Template    string `gorm:"type:varchar(20);not null"` // grid, top42
+	Rows        int
+	Cols        int
 	ImageURL    string `gorm:"type:varchar(255)"`
 	IsPublic    bool
+	ShowTitles  bool
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
@@ -0,0 +1,16 @@
+package repositories
+
+import "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+
+type ActivityRepository interface {
+	// Create stores the activity, replacing an earlier one of the actor about
+	// the same subject. A fanned out activity is also added to the feeds of
+	// the actor's current followers.
+	Create(activity *entities.Activity) error
+	// FindFeed returns the activities of the users viewer.ID follows, newest
+	// first: the ones fanned out to the viewer and the ones of accounts that
+	// are read on demand. Activities whose subject is gone, hidden or private,
+	// and likes and follows that were undone, are left out. beforeID of 0
+	// starts from the newest activity.
+	FindFeed(viewer Viewer, beforeID uint, limit int) ([]*entities.Activity, error)
+}
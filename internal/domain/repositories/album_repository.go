@@ -5,8 +5,15 @@ import "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
 type AlbumRepository interface {
 	Create(album *entities.Album) error
 	FindByID(id uint) (*entities.Album, error)
+	FindBySpotifyID(spotifyID string) (*entities.Album, error)
+	FindByUPC(upc string) ([]*entities.Album, error)
+	FindDuplicateUPCs() ([]string, error)
 	FindByArtistID(artistID uint, offset, limit int) ([]*entities.Album, error)
 	SearchByName(name string, offset, limit int) ([]*entities.Album, error)
+	FindMostLikedByUserID(userID uint, limit int) ([]*entities.Album, error)
+	FindMostLikedByGenreID(genreID uint, limit int) ([]*entities.Album, error)
+	FindByCollectionID(collectionID uint, limit int) ([]*entities.Album, error)
 	Update(album *entities.Album) error
 	Delete(id uint) error
+	Merge(canonicalID uint, duplicateIDs []uint) error
 }
@@ -6,6 +6,7 @@ type ArtistRepository interface {
 	Create(artist *entities.Artist) error
 	FindByID(id uint) (*entities.Artist, error)
 	FindByName(name string) ([]*entities.Artist, error)
+	FindBySpotifyID(spotifyID string) (*entities.Artist, error)
 	Update(artist *entities.Artist) error
 	Delete(id uint) error
 }
@@ -0,0 +1,8 @@
+package repositories
+
+import "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+
+type CollectionInviteRepository interface {
+	Create(invite *entities.CollectionInvite) error
+	FindByToken(token string) (*entities.CollectionInvite, error)
+}
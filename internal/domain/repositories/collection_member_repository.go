@@ -0,0 +1,11 @@
+package repositories
+
+import "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+
+type CollectionMemberRepository interface {
+	Create(member *entities.CollectionMember) error
+	FindByCollectionIDAndUserID(collectionID, userID uint) (*entities.CollectionMember, error)
+	FindByCollectionID(collectionID uint) ([]*entities.CollectionMember, error)
+	Update(member *entities.CollectionMember) error
+	DeleteByCollectionIDAndUserID(collectionID, userID uint) error
+}
@@ -4,8 +4,12 @@ import "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
 
 type CollectionMusicMappingRepository interface {
 	Create(collectionMusicMapping *entities.CollectionMusicMapping) error
+	CreateBatch(collectionMusicMappings []*entities.CollectionMusicMapping) error
 	FindByID(id uint) (*entities.CollectionMusicMapping, error)
 	FindByCollectionID(collectionID uint) ([]*entities.CollectionMusicMapping, error)
 	FindByMusicID(musicID uint) ([]*entities.CollectionMusicMapping, error)
+	CountCollectionsByMusicID(musicID uint) (int64, error)
+	Move(collectionID, musicID uint, position int) error
 	Delete(id uint) error
+	DeleteByCollectionIDAndMusicID(collectionID, musicID uint) error
 }
@@ -0,0 +1,10 @@
+package repositories
+
+import "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+
+type CollectionSpotifySyncRepository interface {
+	FindByCollectionID(collectionID uint) (*entities.CollectionSpotifySync, error)
+	FindReconcilable(afterID uint, limit int) ([]*entities.CollectionSpotifySync, error)
+	// Save creates or updates the sync and replaces its tracks.
+	Save(sync *entities.CollectionSpotifySync) error
+}
@@ -3,10 +3,22 @@ package repositories
 import "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
 
 type CommentRepository interface {
+	// Create sets the comment's path and depth from its parent and keeps the
+	// parent's reply count in sync.
 	Create(comment *entities.Comment) error
 	FindByID(id uint) (*entities.Comment, error)
 	FindByUserID(userID uint, offset, limit int) ([]*entities.Comment, error)
 	FindByPostID(postID uint, offset, limit int) ([]*entities.Comment, error)
+	// FindRootsByPostID marks the comments of users the viewer blocked or who
+	// blocked the viewer as Blocked rather than leaving them out, so that the
+	// threads below them stay in place.
+	FindRootsByPostID(viewer Viewer, postID uint, offset, limit int) ([]*entities.Comment, error)
+	CountRootsByPostID(postID uint) (int64, error)
+	// FindDescendants returns the replies below the given comments, ordered
+	// by path, and marks them as Blocked like FindRootsByPostID.
+	FindDescendants(viewer Viewer, postID uint, paths []string) ([]*entities.Comment, error)
 	Update(comment *entities.Comment) error
+	// Delete removes the comment and the reactions to it, and decrements the
+	// parent's reply count.
 	Delete(id uint) error
 }
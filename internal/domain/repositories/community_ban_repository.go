@@ -0,0 +1,10 @@
+package repositories
+
+import "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+
+type CommunityBanRepository interface {
+	// Create bans the user from the community and removes their membership.
+	// Banning a user who is already banned keeps the existing ban.
+	Create(ban *entities.CommunityBan) error
+	FindByCommunityIDAndUserID(communityID, userID uint) (*entities.CommunityBan, error)
+}
@@ -0,0 +1,13 @@
+package repositories
+
+import "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+
+// CommunityMemberRepository keeps GenreCommunity.MemberCount in sync as
+// members are created and deleted.
+type CommunityMemberRepository interface {
+	Create(member *entities.CommunityMember) error
+	FindByCommunityIDAndUserID(communityID, userID uint) (*entities.CommunityMember, error)
+	FindByCommunityIDAndRole(communityID uint, role string) ([]*entities.CommunityMember, error)
+	Update(member *entities.CommunityMember) error
+	DeleteByCommunityIDAndUserID(communityID, userID uint) error
+}
@@ -0,0 +1,10 @@
+package repositories
+
+import "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+
+type GenreAliasRepository interface {
+	Create(genreAlias *entities.GenreAlias) error
+	FindByAlias(alias string) (*entities.GenreAlias, error)
+	FindByGenreID(genreID uint) ([]*entities.GenreAlias, error)
+	Delete(id uint) error
+}
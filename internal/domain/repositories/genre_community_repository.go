@@ -6,6 +6,8 @@ type GenresCommunityRepository interface {
 	Create(genreCommunity *entities.GenreCommunity) error
 	FindByID(id uint) (*entities.GenreCommunity, error)
 	FindByGenreID(genreID uint) ([]*entities.GenreCommunity, error)
+	FindAll(genreID *uint, offset, limit int) ([]*entities.GenreCommunity, error)
+	Count(genreID *uint) (int64, error)
 	Update(genresCommunity *entities.GenreCommunity) error
 	Delete(id uint) error
 }
@@ -6,6 +6,7 @@ type GenreRepository interface {
 	Create(genre *entities.Genre) error
 	FindByID(id uint) (*entities.Genre, error)
 	FindByName(name string) (*entities.Genre, error)
+	FindAll() ([]*entities.Genre, error)
 	Update(genre *entities.Genre) error
 	Delete(id uint) error
 }
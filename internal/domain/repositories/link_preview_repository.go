@@ -0,0 +1,9 @@
+package repositories
+
+import "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+
+type LinkPreviewRepository interface {
+	// ReplaceByPostID replaces the post's previews, keeping their order.
+	ReplaceByPostID(postID uint, previews []*entities.LinkPreview) error
+	FindByPostIDs(postIDs []uint) ([]*entities.LinkPreview, error)
+}
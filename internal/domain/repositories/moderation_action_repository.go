@@ -0,0 +1,11 @@
+package repositories
+
+import "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+
+type ModerationActionRepository interface {
+	Create(action *entities.ModerationAction) error
+	FindByCommunityID(communityID uint, offset, limit int) ([]*entities.ModerationAction, error)
+	CountByCommunityID(communityID uint) (int64, error)
+	FindByTargetUserID(userID uint, actions []string, offset, limit int) ([]*entities.ModerationAction, error)
+	CountByTargetUserID(userID uint, actions []string) (int64, error)
+}
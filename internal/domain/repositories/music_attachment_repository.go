@@ -0,0 +1,15 @@
+package repositories
+
+import "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+
+type MusicAttachmentRepository interface {
+	// ReplaceByPostID replaces the post's attachments with musicIDs, in order.
+	ReplaceByPostID(postID uint, musicIDs []uint) error
+	// ReplaceByCommentID replaces the comment's attachments with musicIDs, in
+	// order.
+	ReplaceByCommentID(commentID uint, musicIDs []uint) error
+	// FindByPostIDs returns the attachments of the posts with their music,
+	// album and artists, ordered by position.
+	FindByPostIDs(postIDs []uint) ([]*entities.MusicAttachment, error)
+	FindByCommentIDs(commentIDs []uint) ([]*entities.MusicAttachment, error)
+}
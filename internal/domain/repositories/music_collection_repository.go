@@ -5,7 +5,9 @@ import "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
 type MusicCollectionRepository interface {
 	Create(musicCollection *entities.MusicCollection) error
 	FindByID(id uint) (*entities.MusicCollection, error)
-	FindByUserID(userID uint, offset, limit int) ([]*entities.MusicCollection, error)
+	FindByUserID(userID uint, includePrivate bool, offset, limit int) ([]*entities.MusicCollection, error)
+	CountByUserID(userID uint, includePrivate bool) (int64, error)
+	FindByUserIDAndSpotifyPlaylistID(userID uint, spotifyPlaylistID string) (*entities.MusicCollection, error)
 	Update(musicCollection *entities.MusicCollection) error
 	Delete(id uint) error
 }
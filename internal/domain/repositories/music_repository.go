@@ -8,13 +8,20 @@ type MusicRepository interface {
 	FindByTitle(title string) ([]*entities.Music, error)
 	FindByAlbumID(albumID uint) ([]*entities.Music, error)
 	FindByGenreID(genreID uint, offset, limit int) ([]*entities.Music, error)
+	CountByGenreID(genreID uint) (int64, error)
 	FindByArtistID(artistID uint, offset, limit int) ([]*entities.Music, error)
+	FindLikedByUserID(userID uint, offset, limit int) ([]*entities.Music, error)
+	CountLikedByUserID(userID uint) (int64, error)
+	FindByCollectionID(collectionID uint) ([]*entities.Music, error)
 	FindBySpotifyID(spotifyID string) (*entities.Music, error)
 	FindByLastfmID(lastfmID string) (*entities.Music, error)
+	FindByISRC(isrc string) ([]*entities.Music, error)
+	FindDuplicateISRCs() ([]string, error)
 	SearchByTitle(title string, offset, limit int) ([]*entities.Music, error)
 	SearchByArtist(artistName string, offset, limit int) ([]*entities.Music, error)
 	SearchByAlbum(albumName string, offset, limit int) ([]*entities.Music, error)
 	Update(music *entities.Music) error
 	Delete(id uint) error
 	CountLikesAndDislikesByID(id uint) (likes, dislikes int64, err error)
+	Merge(canonicalID uint, duplicateIDs []uint) error
 }
@@ -0,0 +1,10 @@
+package repositories
+
+import "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+
+type NotificationPreferenceRepository interface {
+	FindByUserID(userID uint) ([]*entities.NotificationPreference, error)
+	FindByUserIDAndType(userID uint, notificationType string) (*entities.NotificationPreference, error)
+	// Save creates the preference or replaces the stored one.
+	Save(preference *entities.NotificationPreference) error
+}
@@ -0,0 +1,20 @@
+package repositories
+
+import "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+
+type NotificationRepository interface {
+	Create(notification *entities.Notification) error
+	// FindByUserID returns the user's notifications with their actors, newest
+	// first. unreadOnly leaves out the ones already read.
+	// Notifications from users the user blocked or who blocked them are left
+	// out here and in CountByUserID.
+	FindByUserID(userID uint, unreadOnly bool, offset, limit int) ([]*entities.Notification, error)
+	CountByUserID(userID uint, unreadOnly bool) (int64, error)
+	// MarkRead marks the user's notifications with the given IDs as read.
+	// IDs of other users' notifications are ignored.
+	MarkRead(userID uint, ids []uint) error
+	MarkAllRead(userID uint) error
+	// ExistsUnread reports whether the recipient has an unread notification
+	// of the same type from the same actor about the same post and comment.
+	ExistsUnread(notification *entities.Notification) (bool, error)
+}
@@ -2,16 +2,30 @@ package repositories
 
 import "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
 
+// Post list orders. Top orders by likes minus dislikes, and hot divides that
+// score by a power of the post's age, so that new posts with a few likes rank
+// above old posts with many.
+const (
+	PostSortNew = "new"
+	PostSortTop = "top"
+	PostSortHot = "hot"
+)
+
+// PostRepository leaves posts hidden by moderators out of lists, searches and
+// counts, and filters them for the viewer. FindByID and FindByUserID still
+// return them.
 type PostRepository interface {
 	Create(post *entities.Post) error
 	FindByID(id uint) (*entities.Post, error)
 	FindByUserID(userID uint, offset, limit int) ([]*entities.Post, error)
-	FindByGenreCommunityID(genreCommunityID uint, offset, limit int) ([]*entities.Post, error)
-	CountByGenreCommunityID(genreCommunityID uint) (int64, error)
-	FindAll(offset, limit int) ([]*entities.Post, error)
-	CountAll() (int64, error)
-	SearchByTitle(title string, offset, limit int) ([]*entities.Post, error)
-	SearchByContent(content string, offset, limit int) ([]*entities.Post, error)
+	FindByGenreCommunityID(viewer Viewer, genreCommunityID uint, sort string, offset, limit int) ([]*entities.Post, error)
+	CountByGenreCommunityID(viewer Viewer, genreCommunityID uint) (int64, error)
+	FindAll(viewer Viewer, sort string, offset, limit int) ([]*entities.Post, error)
+	CountAll(viewer Viewer) (int64, error)
+	SearchByTitle(viewer Viewer, title string, genreCommunityID *uint, offset, limit int) ([]*entities.Post, error)
+	CountByTitle(viewer Viewer, title string, genreCommunityID *uint) (int64, error)
+	SearchByContent(viewer Viewer, content string, genreCommunityID *uint, offset, limit int) ([]*entities.Post, error)
+	CountByContent(viewer Viewer, content string, genreCommunityID *uint) (int64, error)
 	Update(post *entities.Post) error
 	Delete(id uint) error
 	CountLikesAndDislikesByID(id uint) (likes, dislikes int64, err error)
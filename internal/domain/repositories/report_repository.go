@@ -0,0 +1,21 @@
+package repositories
+
+import "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+
+type ReportRepository interface {
+	Create(report *entities.Report) error
+	FindByID(id uint) (*entities.Report, error)
+	// FindPendingByReporterAndTarget returns the reporter's open or escalated
+	// report on the target.
+	FindPendingByReporterAndTarget(reporterID uint, targetType string, targetID uint) (*entities.Report, error)
+	FindByCommunityID(communityID uint, status string, offset, limit int) ([]*entities.Report, error)
+	CountByCommunityID(communityID uint, status string) (int64, error)
+	// FindAdminQueue returns the reports waiting for an admin: escalated
+	// reports and open reports on profiles, oldest first.
+	FindAdminQueue(offset, limit int) ([]*entities.Report, error)
+	CountAdminQueue() (int64, error)
+	// ClosePendingByTarget closes every open or escalated report on the
+	// target with the given status.
+	ClosePendingByTarget(targetType string, targetID uint, status string, resolvedByID uint) error
+	Update(report *entities.Report) error
+}
@@ -0,0 +1,9 @@
+package repositories
+
+import "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+
+type SocialLinkFlowRepository interface {
+	Create(flow *entities.SocialLinkFlow) error
+	FindByState(state string) (*entities.SocialLinkFlow, error)
+	DeleteByState(state string) error
+}
@@ -0,0 +1,10 @@
+package repositories
+
+import "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+
+type SpotifyImportJobRepository interface {
+	Create(job *entities.SpotifyImportJob) error
+	FindByID(id uint) (*entities.SpotifyImportJob, error)
+	FindActiveByUserID(userID uint) (*entities.SpotifyImportJob, error)
+	Update(job *entities.SpotifyImportJob) error
+}
@@ -6,6 +6,7 @@ type TopsterAlbumRepository interface {
 	Create(topsterAlbum *entities.TopsterAlbum) error
 	FindByID(id uint) (*entities.TopsterAlbum, error)
 	FindByTopsterID(topsterID uint) ([]*entities.TopsterAlbum, error)
+	ReplaceByTopsterID(topsterID uint, topsterAlbums []*entities.TopsterAlbum) error
 	Update(topsterAlbum *entities.TopsterAlbum) error
 	Delete(id uint) error
 }
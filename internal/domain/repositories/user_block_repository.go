@@ -0,0 +1,17 @@
+package repositories
+
+import "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+
+type UserBlockRepository interface {
+	// Create also removes the follows between the two users in either
+	// direction.
+	Create(block *entities.UserBlock) error
+	FindByBlockerIDAndBlockedID(blockerID, blockedID uint) (*entities.UserBlock, error)
+	// ExistsBetween reports whether either user blocked the other.
+	ExistsBetween(userID, otherID uint) (bool, error)
+	// FindByBlockerID returns the user's blocks with the blocked users,
+	// newest first.
+	FindByBlockerID(blockerID uint, offset, limit int) ([]*entities.UserBlock, error)
+	CountByBlockerID(blockerID uint) (int64, error)
+	DeleteByBlockerIDAndBlockedID(blockerID, blockedID uint) error
+}
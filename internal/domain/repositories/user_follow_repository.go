@@ -6,10 +6,18 @@ type UserFollowRepository interface {
 	Create(userFollow *entities.UserFollow) error
 	FindByID(id uint) (*entities.UserFollow, error)
 	FindByFollowerIDAndFollowingID(followerID, followingID uint) (*entities.UserFollow, error)
-	FindFollowersByUserID(userID uint) ([]*entities.UserFollow, error)
-	FindFollowingsByUserID(userID uint) ([]*entities.UserFollow, error)
-	CountFollowersByUserID(userID uint) (int64, error)
-	CountFollowingsByUserID(userID uint) (int64, error)
+	// FindFollowersByUserID returns the follows of the user with their
+	// followers, newest first.
+	FindFollowersByUserID(viewer Viewer, userID uint, offset, limit int) ([]*entities.UserFollow, error)
+	// FindFollowingsByUserID returns the follows by the user with the users
+	// followed, newest first.
+	FindFollowingsByUserID(viewer Viewer, userID uint, offset, limit int) ([]*entities.UserFollow, error)
+	CountFollowersByUserID(viewer Viewer, userID uint) (int64, error)
+	CountFollowingsByUserID(viewer Viewer, userID uint) (int64, error)
+	// FindFollowingIDs returns which of the users followerID follows.
+	FindFollowingIDs(followerID uint, userIDs []uint) ([]uint, error)
+	// FindFollowerIDs returns which of the users follow followingID.
+	FindFollowerIDs(followingID uint, userIDs []uint) ([]uint, error)
 	Delete(id uint) error
 	DeleteByFollowerIDAndFollowingID(followerID, followingID uint) error
 }
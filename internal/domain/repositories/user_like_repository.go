@@ -9,6 +9,8 @@ type UserLikeRepository interface {
 	FindByUserIDAndPostID(userID, postID uint) (*entities.UserLike, error)
 	FindByUserIDAndCommentID(userID, commentID uint) (*entities.UserLike, error)
 	CountLikesAndDislikesByMusicID(musicID uint) (likes int64, dislikes int64, err error)
+	CountLikesAndDislikesByAlbumID(albumID uint) (likes int64, dislikes int64, err error)
+	CountLikesAndDislikesByArtistID(artistID uint) (likes int64, dislikes int64, err error)
 	CountLikesAndDislikesByPostID(postID uint) (likes int64, dislikes int64, err error)
 	CountLikesAndDislikesByCommentID(commentID uint) (likes int64, dislikes int64, err error)
 	Update(userLike *entities.UserLike) error
@@ -0,0 +1,13 @@
+package repositories
+
+import "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+
+type UserMuteRepository interface {
+	Create(mute *entities.UserMute) error
+	FindByMuterIDAndMutedID(muterID, mutedID uint) (*entities.UserMute, error)
+	// FindByMuterID returns the user's mutes with the muted users, newest
+	// first.
+	FindByMuterID(muterID uint, offset, limit int) ([]*entities.UserMute, error)
+	CountByMuterID(muterID uint) (int64, error)
+	DeleteByMuterIDAndMutedID(muterID, mutedID uint) error
+}
@@ -6,6 +6,7 @@ type UserSocialAccountRepository interface {
 	Create(userSocialAccount *entities.UserSocialAccount) error
 	FindByID(id uint) (*entities.UserSocialAccount, error)
 	FindByUserIDAndProvider(userID uint, provider string) (*entities.UserSocialAccount, error)
+	FindByProviderAndProviderUserID(provider, providerUserID string) (*entities.UserSocialAccount, error)
 	Update(userSocialAccount *entities.UserSocialAccount) error
 	Delete(id uint) error
 }
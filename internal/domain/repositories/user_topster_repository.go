@@ -5,7 +5,8 @@ import "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
 type UserTopsterRepository interface {
 	Create(userTopster *entities.UserTopster) error
 	FindByID(id uint) (*entities.UserTopster, error)
-	FindByUserID(userID uint) ([]*entities.UserTopster, error)
+	FindByUserID(userID uint, includePrivate bool, offset, limit int) ([]*entities.UserTopster, error)
+	CountByUserID(userID uint, includePrivate bool) (int64, error)
 	Update(userTopster *entities.UserTopster) error
 	Delete(id uint) error
 }
@@ -0,0 +1,9 @@
+package repositories
+
+// Viewer is the user a list is loaded for. Lists taking a Viewer leave out
+// rows by users the viewer blocked or who blocked the viewer, and, when
+// HideMuted is set, by users the viewer muted. The zero Viewer sees every row.
+type Viewer struct {
+	ID        uint
+	HideMuted bool
+}
@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/logging"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"go.uber.org/zap"
+)
+
+// DefaultFeedFanOutLimit is the follower count from which an account's
+// activities are no longer copied into each follower's feed, but read from
+// the account when the feed is loaded.
+const DefaultFeedFanOutLimit = 1000
+
+// ActivityRecorder records what users do for their followers' feeds. It is
+// shared by the post, topster, collection, like and follow usecases. A nil
+// recorder records nothing.
+type ActivityRecorder struct {
+	activityRepo repositories.ActivityRepository
+	followRepo   repositories.UserFollowRepository
+	fanOutLimit  int64
+}
+
+// NewActivityRecorder creates a recorder that fans out the activities of
+// accounts with fewer than fanOutLimit followers. A non-positive fanOutLimit
+// falls back to DefaultFeedFanOutLimit.
+func NewActivityRecorder(activityRepo repositories.ActivityRepository, followRepo repositories.UserFollowRepository, fanOutLimit int) *ActivityRecorder {
+	if fanOutLimit <= 0 {
+		fanOutLimit = DefaultFeedFanOutLimit
+	}
+	return &ActivityRecorder{
+		activityRepo: activityRepo,
+		followRepo:   followRepo,
+		fanOutLimit:  int64(fanOutLimit),
+	}
+}
+
+// record stores the activity. The feed is secondary to what the user did, so
+// failures are logged rather than returned.
+func (r *ActivityRecorder) record(activity *entities.Activity) {
+	if r == nil {
+		return
+	}
+	followers, err := r.followRepo.CountFollowersByUserID(repositories.Viewer{}, activity.ActorID)
+	if err != nil {
+		logging.Log().Error("failed to count followers for activity", zap.Error(err), zap.Uint("actor_id", activity.ActorID))
+		return
+	}
+	activity.FannedOut = followers < r.fanOutLimit
+	if err := r.activityRepo.Create(activity); err != nil {
+		logging.Log().Error("failed to record activity", zap.Error(err), zap.String("type", activity.Type), zap.Uint("actor_id", activity.ActorID))
+	}
+}
@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase/mocks"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestActivityRecorder_Record(t *testing.T) {
+	testCases := []struct {
+		name      string
+		followers int64
+		fannedOut bool
+	}{
+		{name: "FanOutOnWrite", followers: 99, fannedOut: true},
+		{name: "FanOutOnRead", followers: 100, fannedOut: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup
+			activityRepo := &mocks.ActivityRepository{}
+			followRepo := &mocks.UserFollowRepository{}
+
+			recorder := NewActivityRecorder(activityRepo, followRepo, 100)
+
+			// Expectations
+			followRepo.On("CountFollowersByUserID", repositories.Viewer{}, uint(1)).Return(tc.followers, nil)
+			activityRepo.On("Create", mock.MatchedBy(func(a *entities.Activity) bool {
+				return a.ActorID == 1 && a.Type == entities.ActivityTypeFollow && a.FannedOut == tc.fannedOut
+			})).Return(nil)
+
+			// Execute
+			recorder.record(&entities.Activity{ActorID: 1, Type: entities.ActivityTypeFollow, TargetUserID: new(uint)})
+
+			// Verify
+			followRepo.AssertExpectations(t)
+			activityRepo.AssertExpectations(t)
+		})
+	}
+}
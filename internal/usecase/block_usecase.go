@@ -0,0 +1,192 @@
+package usecase
+
+import (
+	"errors"
+	"time"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+)
+
+// BlockUsecase manages blocks and mutes. A block hides the two users from each
+// other everywhere, ends their follows and keeps them from following or
+// replying to each other. A mute only hides the muted user from the muter's
+// feed.
+type BlockUsecase interface {
+	Block(userID, targetID uint) error
+	Unblock(userID, targetID uint) error
+	ListBlocked(userID uint, limit, offset *int) (*ListRestrictedUsersOutput, error)
+	Mute(userID, targetID uint) error
+	Unmute(userID, targetID uint) error
+	ListMuted(userID uint, limit, offset *int) (*ListRestrictedUsersOutput, error)
+}
+
+type blockUsecase struct {
+	blockRepo repositories.UserBlockRepository
+	muteRepo  repositories.UserMuteRepository
+	userRepo  repositories.UserRepository
+}
+
+func NewBlockUsecase(blockRepo repositories.UserBlockRepository, muteRepo repositories.UserMuteRepository, userRepo repositories.UserRepository) BlockUsecase {
+	return &blockUsecase{
+		blockRepo: blockRepo,
+		muteRepo:  muteRepo,
+		userRepo:  userRepo,
+	}
+}
+
+func (u *blockUsecase) Block(userID, targetID uint) error {
+	if userID == targetID {
+		return ErrCannotBlockSelf
+	}
+	if err := u.findUser(targetID); err != nil {
+		return err
+	}
+
+	_, err := u.blockRepo.FindByBlockerIDAndBlockedID(userID, targetID)
+	switch {
+	case err == nil:
+		return ErrAlreadyBlocked
+	case !errors.Is(err, repositories.ErrNotFound):
+		return ErrFindingRecord
+	}
+	if err := u.blockRepo.Create(&entities.UserBlock{BlockerID: userID, BlockedID: targetID}); err != nil {
+		return ErrCreatingRecord
+	}
+	return nil
+}
+
+func (u *blockUsecase) Unblock(userID, targetID uint) error {
+	if err := u.blockRepo.DeleteByBlockerIDAndBlockedID(userID, targetID); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrNotBlocked
+		}
+		return ErrDeletingRecord
+	}
+	return nil
+}
+
+// ListBlocked pages through the users the user blocked, most recent first.
+func (u *blockUsecase) ListBlocked(userID uint, limit, offset *int) (*ListRestrictedUsersOutput, error) {
+	l, o := pagination(limit, offset)
+	blocks, err := u.blockRepo.FindByBlockerID(userID, o, l)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	total, err := u.blockRepo.CountByBlockerID(userID)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+
+	output := &ListRestrictedUsersOutput{Users: make([]RestrictedUserOutput, len(blocks)), Total: int(total)}
+	for i, b := range blocks {
+		output.Users[i] = toRestrictedUserOutput(&b.Blocked, b.CreatedAt)
+	}
+	return output, nil
+}
+
+func (u *blockUsecase) Mute(userID, targetID uint) error {
+	if userID == targetID {
+		return ErrCannotMuteSelf
+	}
+	if err := u.findUser(targetID); err != nil {
+		return err
+	}
+
+	_, err := u.muteRepo.FindByMuterIDAndMutedID(userID, targetID)
+	switch {
+	case err == nil:
+		return ErrAlreadyMuted
+	case !errors.Is(err, repositories.ErrNotFound):
+		return ErrFindingRecord
+	}
+	if err := u.muteRepo.Create(&entities.UserMute{MuterID: userID, MutedID: targetID}); err != nil {
+		return ErrCreatingRecord
+	}
+	return nil
+}
+
+func (u *blockUsecase) Unmute(userID, targetID uint) error {
+	if err := u.muteRepo.DeleteByMuterIDAndMutedID(userID, targetID); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrNotMuted
+		}
+		return ErrDeletingRecord
+	}
+	return nil
+}
+
+// ListMuted pages through the users the user muted, most recent first.
+func (u *blockUsecase) ListMuted(userID uint, limit, offset *int) (*ListRestrictedUsersOutput, error) {
+	l, o := pagination(limit, offset)
+	mutes, err := u.muteRepo.FindByMuterID(userID, o, l)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	total, err := u.muteRepo.CountByMuterID(userID)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+
+	output := &ListRestrictedUsersOutput{Users: make([]RestrictedUserOutput, len(mutes)), Total: int(total)}
+	for i, m := range mutes {
+		output.Users[i] = toRestrictedUserOutput(&m.Muted, m.CreatedAt)
+	}
+	return output, nil
+}
+
+func (u *blockUsecase) findUser(userID uint) error {
+	if _, err := u.userRepo.FindByID(userID); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrUserNotFound
+		}
+		return ErrFindingRecord
+	}
+	return nil
+}
+
+func toRestrictedUserOutput(user *entities.User, since time.Time) RestrictedUserOutput {
+	output := RestrictedUserOutput{
+		UserID:   user.ID,
+		Nickname: user.Nickname,
+		Since:    since,
+	}
+	if user.UserProfile != nil {
+		output.ProfileImageURL = user.UserProfile.ProfileImageURL
+	}
+	return output
+}
+
+// checkNotBlocked returns ErrUserBlocked when either user blocked the other.
+func checkNotBlocked(blockRepo repositories.UserBlockRepository, userID, otherID uint) error {
+	blocked, err := blockRepo.ExistsBetween(userID, otherID)
+	if err != nil {
+		return ErrFindingRecord
+	}
+	if blocked {
+		return ErrUserBlocked
+	}
+	return nil
+}
+
+// findVisibleUser finds the user for viewerID. Users who blocked each other
+// are invisible to each other, so a block is reported as ErrUserNotFound.
+func findVisibleUser(userRepo repositories.UserRepository, blockRepo repositories.UserBlockRepository, viewerID, userID uint) (*entities.User, error) {
+	user, err := userRepo.FindByID(userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, ErrFindingRecord
+	}
+	if viewerID == userID {
+		return user, nil
+	}
+	if err := checkNotBlocked(blockRepo, viewerID, userID); err != nil {
+		if errors.Is(err, ErrUserBlocked) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	return user, nil
+}
@@ -0,0 +1,162 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestBlockUsecase_Block(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// Setup
+		blockRepo := &mocks.UserBlockRepository{}
+		userRepo := &mocks.UserRepository{}
+
+		blockUsecase := NewBlockUsecase(blockRepo, nil, userRepo)
+
+		// Expectations
+		userRepo.On("FindByID", uint(2)).Return(&entities.User{ID: 2}, nil)
+		blockRepo.On("FindByBlockerIDAndBlockedID", uint(1), uint(2)).Return(nil, repositories.ErrNotFound)
+		blockRepo.On("Create", mock.MatchedBy(func(b *entities.UserBlock) bool {
+			return b.BlockerID == 1 && b.BlockedID == 2
+		})).Return(nil)
+
+		// Execute
+		err := blockUsecase.Block(1, 2)
+
+		// Assert
+		assert.NoError(t, err)
+
+		// Verify
+		userRepo.AssertExpectations(t)
+		blockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Self", func(t *testing.T) {
+		// Setup
+		blockRepo := &mocks.UserBlockRepository{}
+
+		blockUsecase := NewBlockUsecase(blockRepo, nil, nil)
+
+		// Execute
+		err := blockUsecase.Block(1, 1)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrCannotBlockSelf)
+
+		// Verify
+		blockRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+
+	t.Run("AlreadyBlocked", func(t *testing.T) {
+		// Setup
+		blockRepo := &mocks.UserBlockRepository{}
+		userRepo := &mocks.UserRepository{}
+
+		blockUsecase := NewBlockUsecase(blockRepo, nil, userRepo)
+
+		// Expectations
+		userRepo.On("FindByID", uint(2)).Return(&entities.User{ID: 2}, nil)
+		blockRepo.On("FindByBlockerIDAndBlockedID", uint(1), uint(2)).Return(&entities.UserBlock{ID: 3}, nil)
+
+		// Execute
+		err := blockUsecase.Block(1, 2)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrAlreadyBlocked)
+
+		// Verify
+		blockRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+
+	t.Run("UserNotFound", func(t *testing.T) {
+		// Setup
+		blockRepo := &mocks.UserBlockRepository{}
+		userRepo := &mocks.UserRepository{}
+
+		blockUsecase := NewBlockUsecase(blockRepo, nil, userRepo)
+
+		// Expectations
+		userRepo.On("FindByID", uint(2)).Return(nil, repositories.ErrNotFound)
+
+		// Execute
+		err := blockUsecase.Block(1, 2)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrUserNotFound)
+
+		// Verify
+		blockRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+}
+
+func TestBlockUsecase_Unblock_NotBlocked(t *testing.T) {
+	// Setup
+	blockRepo := &mocks.UserBlockRepository{}
+
+	blockUsecase := NewBlockUsecase(blockRepo, nil, nil)
+
+	// Expectations
+	blockRepo.On("DeleteByBlockerIDAndBlockedID", uint(1), uint(2)).Return(repositories.ErrNotFound)
+
+	// Execute
+	err := blockUsecase.Unblock(1, 2)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrNotBlocked)
+
+	// Verify
+	blockRepo.AssertExpectations(t)
+}
+
+func TestBlockUsecase_Mute_Self(t *testing.T) {
+	// Setup
+	muteRepo := &mocks.UserMuteRepository{}
+
+	blockUsecase := NewBlockUsecase(nil, muteRepo, nil)
+
+	// Execute
+	err := blockUsecase.Mute(1, 1)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrCannotMuteSelf)
+
+	// Verify
+	muteRepo.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func TestBlockUsecase_ListMuted(t *testing.T) {
+	// Setup
+	muteRepo := &mocks.UserMuteRepository{}
+
+	blockUsecase := NewBlockUsecase(nil, muteRepo, nil)
+
+	since := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	mutes := []*entities.UserMute{
+		{ID: 1, MuterID: 1, MutedID: 2, Muted: entities.User{ID: 2, Nickname: "loud", UserProfile: &entities.UserProfile{ProfileImageURL: "https://example.com/loud.png"}}, CreatedAt: since},
+		{ID: 2, MuterID: 1, MutedID: 3, Muted: entities.User{ID: 3, Nickname: "noisy"}, CreatedAt: since},
+	}
+
+	// Expectations
+	muteRepo.On("FindByMuterID", uint(1), 0, 20).Return(mutes, nil)
+	muteRepo.On("CountByMuterID", uint(1)).Return(int64(2), nil)
+
+	// Execute
+	output, err := blockUsecase.ListMuted(1, nil, nil)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 2, output.Total)
+	assert.Equal(t, []RestrictedUserOutput{
+		{UserID: 2, Nickname: "loud", ProfileImageURL: "https://example.com/loud.png", Since: since},
+		{UserID: 3, Nickname: "noisy", Since: since},
+	}, output.Users)
+
+	// Verify
+	muteRepo.AssertExpectations(t)
+}
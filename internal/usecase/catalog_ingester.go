@@ -0,0 +1,275 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/spotifyclient"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"github.com/zmb3/spotify/v2"
+)
+
+// catalogIngester copies Spotify catalog entries into the local catalog the
+// first time they are requested, so that community data can reference them.
+type catalogIngester struct {
+	spotifyClient spotifyclient.SpotifyClient
+
+	musicRepo       repositories.MusicRepository
+	albumRepo       repositories.AlbumRepository
+	artistRepo      repositories.ArtistRepository
+	musicArtistRepo repositories.MusicArtistMappingRepository
+	musicGenreRepo  repositories.MusicGenreMappingRepository
+
+	genreResolver *genreResolver
+}
+
+// spotifyArtists caches the artists fetched during a single ingestion, since
+// the tracks of an album usually share them.
+type spotifyArtists map[spotify.ID]*ingestedArtist
+
+type ingestedArtist struct {
+	artist *entities.Artist
+	genres []string
+}
+
+func (i *catalogIngester) ingestTrack(ctx context.Context, spotifyID string) (*entities.Music, error) {
+	music, err := i.musicRepo.FindBySpotifyID(spotifyID)
+	if err == nil {
+		return music, nil
+	}
+	if !errors.Is(err, repositories.ErrNotFound) {
+		return nil, ErrFindingRecord
+	}
+
+	track, err := i.spotifyClient.GetTrack(ctx, spotify.ID(spotifyID))
+	if err != nil {
+		return nil, spotifyLookupError(err, ErrMusicNotFound)
+	}
+	return i.createFullTrack(ctx, track)
+}
+
+// ingestFullTrack is ingestTrack for callers that already hold the Spotify
+// track, such as playlist imports.
+func (i *catalogIngester) ingestFullTrack(ctx context.Context, track *spotify.FullTrack) (*entities.Music, error) {
+	music, err := i.musicRepo.FindBySpotifyID(string(track.ID))
+	if err == nil {
+		return music, nil
+	}
+	if !errors.Is(err, repositories.ErrNotFound) {
+		return nil, ErrFindingRecord
+	}
+	return i.createFullTrack(ctx, track)
+}
+
+func (i *catalogIngester) createFullTrack(ctx context.Context, track *spotify.FullTrack) (*entities.Music, error) {
+	// Full tracks carry their external IDs in a map of their own.
+	if isrc := track.ExternalIDs["isrc"]; isrc != "" {
+		track.SimpleTrack.ExternalIDs.ISRC = isrc
+	}
+
+	artists := spotifyArtists{}
+	album, err := i.findOrCreateAlbum(ctx, &track.Album, "", artists)
+	if err != nil {
+		return nil, err
+	}
+	return i.createMusic(ctx, album.ID, &track.SimpleTrack, artists)
+}
+
+func (i *catalogIngester) ingestAlbum(ctx context.Context, spotifyID string) (*entities.Album, error) {
+	album, err := i.albumRepo.FindBySpotifyID(spotifyID)
+	if err == nil {
+		return album, nil
+	}
+	if !errors.Is(err, repositories.ErrNotFound) {
+		return nil, ErrFindingRecord
+	}
+
+	fullAlbum, err := i.spotifyClient.GetAlbum(ctx, spotify.ID(spotifyID))
+	if err != nil {
+		return nil, spotifyLookupError(err, ErrAlbumNotFound)
+	}
+
+	artists := spotifyArtists{}
+	album, err = i.findOrCreateAlbum(ctx, &fullAlbum.SimpleAlbum, fullAlbum.ExternalIDs["upc"], artists)
+	if err != nil {
+		return nil, err
+	}
+	for _, track := range fullAlbum.Tracks.Tracks {
+		_, err := i.musicRepo.FindBySpotifyID(string(track.ID))
+		if err == nil {
+			continue
+		}
+		if !errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrFindingRecord
+		}
+		if _, err := i.createMusic(ctx, album.ID, &track, artists); err != nil {
+			return nil, err
+		}
+	}
+	return album, nil
+}
+
+func (i *catalogIngester) ingestArtist(ctx context.Context, spotifyID string) (*entities.Artist, error) {
+	ingested, err := i.findOrCreateArtist(ctx, spotify.ID(spotifyID), spotifyArtists{})
+	if err != nil {
+		return nil, err
+	}
+	return ingested.artist, nil
+}
+
+// findOrCreateAlbum takes the UPC separately because only full albums carry it.
+func (i *catalogIngester) findOrCreateAlbum(ctx context.Context, simple *spotify.SimpleAlbum, upc string, artists spotifyArtists) (*entities.Album, error) {
+	album, err := i.albumRepo.FindBySpotifyID(string(simple.ID))
+	if err == nil {
+		return album, nil
+	}
+	if !errors.Is(err, repositories.ErrNotFound) {
+		return nil, ErrFindingRecord
+	}
+	if len(simple.Artists) == 0 {
+		return nil, ErrAlbumNotFound
+	}
+
+	artist, err := i.findOrCreateArtist(ctx, simple.Artists[0].ID, artists)
+	if err != nil {
+		return nil, err
+	}
+
+	album = &entities.Album{
+		Name:        simple.Name,
+		ArtistID:    artist.artist.ID,
+		ReleaseDate: simple.ReleaseDateTime(),
+		SpotifyID:   string(simple.ID),
+		UPC:         upc,
+	}
+	if len(simple.Images) > 0 {
+		album.ImageURL = simple.Images[0].URL
+	}
+	if err := i.albumRepo.Create(album); err != nil {
+		return nil, ErrCreatingRecord
+	}
+	album.Artist = *artist.artist
+	return album, nil
+}
+
+// findOrCreateArtist always fetches the Spotify artist, even when it is already
+// stored locally, because its genres are not persisted.
+func (i *catalogIngester) findOrCreateArtist(ctx context.Context, id spotify.ID, artists spotifyArtists) (*ingestedArtist, error) {
+	if ingested, ok := artists[id]; ok {
+		return ingested, nil
+	}
+
+	fullArtist, err := i.spotifyClient.GetArtist(ctx, id)
+	if err != nil {
+		return nil, spotifyLookupError(err, ErrArtistNotFound)
+	}
+
+	artist, err := i.artistRepo.FindBySpotifyID(string(id))
+	if err != nil {
+		if !errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrFindingRecord
+		}
+		artist = &entities.Artist{Name: fullArtist.Name, SpotifyID: string(id)}
+		if err := i.artistRepo.Create(artist); err != nil {
+			return nil, ErrCreatingRecord
+		}
+	}
+
+	ingested := &ingestedArtist{artist: artist, genres: fullArtist.Genres}
+	artists[id] = ingested
+	return ingested, nil
+}
+
+func (i *catalogIngester) createMusic(ctx context.Context, albumID uint, track *spotify.SimpleTrack, artists spotifyArtists) (*entities.Music, error) {
+	music := &entities.Music{
+		Title:     track.Name,
+		AlbumID:   albumID,
+		SpotifyID: string(track.ID),
+		ISRC:      track.ExternalIDs.ISRC,
+	}
+	if err := i.musicRepo.Create(music); err != nil {
+		return nil, ErrCreatingRecord
+	}
+
+	genreNames := []string{}
+	for _, a := range track.Artists {
+		ingested, err := i.findOrCreateArtist(ctx, a.ID, artists)
+		if err != nil {
+			return nil, err
+		}
+		mapping := &entities.MusicArtistMapping{MusicID: music.ID, ArtistID: ingested.artist.ID}
+		if err := i.musicArtistRepo.Create(mapping); err != nil {
+			return nil, ErrCreatingRecord
+		}
+		music.MusicArtistMapping = append(music.MusicArtistMapping, entities.MusicArtistMapping{
+			ID:       mapping.ID,
+			MusicID:  music.ID,
+			ArtistID: ingested.artist.ID,
+			Artist:   *ingested.artist,
+		})
+		genreNames = append(genreNames, ingested.genres...)
+	}
+
+	if _, err := i.mapGenres(music, genreNames); err != nil {
+		return nil, err
+	}
+	return music, nil
+}
+
+// mapGenres links music to the genres resolved from the given provider genre
+// names. Names that do not correspond to a genre (e.g. "seen live") are ignored.
+func (i *catalogIngester) mapGenres(music *entities.Music, names []string) ([]Genre, error) {
+	mapped := make(map[uint]bool)
+	for _, m := range music.MusicGenreMapping {
+		mapped[m.GenreID] = true
+	}
+
+	genres := []Genre{}
+	seen := make(map[uint]bool)
+	for _, name := range names {
+		genre, err := i.genreResolver.resolve(name)
+		if err != nil {
+			if errors.Is(err, repositories.ErrNotFound) {
+				continue
+			}
+			return nil, ErrFindingRecord
+		}
+		if seen[genre.ID] {
+			continue
+		}
+		seen[genre.ID] = true
+
+		if !mapped[genre.ID] {
+			mapping := &entities.MusicGenreMapping{MusicID: music.ID, GenreID: genre.ID}
+			if err := i.musicGenreRepo.Create(mapping); err != nil {
+				return nil, ErrCreatingRecord
+			}
+			mapped[genre.ID] = true
+			music.MusicGenreMapping = append(music.MusicGenreMapping, entities.MusicGenreMapping{
+				ID:      mapping.ID,
+				MusicID: music.ID,
+				GenreID: genre.ID,
+				Genre:   *genre,
+			})
+		}
+		genres = append(genres, Genre{ID: genre.ID, Name: genre.Name})
+	}
+	return genres, nil
+}
+
+// spotifyLookupError maps Spotify's "not found" and "invalid id" responses to
+// notFound and every other failure to ErrFetchingSpotify.
+func spotifyLookupError(err error, notFound error) error {
+	if isSpotifyNotFound(err) {
+		return notFound
+	}
+	return ErrFetchingSpotify
+}
+
+func isSpotifyNotFound(err error) bool {
+	var spotifyErr spotify.Error
+	return errors.As(err, &spotifyErr) &&
+		(spotifyErr.Status == http.StatusNotFound || spotifyErr.Status == http.StatusBadRequest)
+}
@@ -0,0 +1,132 @@
+package usecase
+
+import (
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+)
+
+type CatalogUsecase interface {
+	MergeDuplicates(dryRun bool) (*MergeDuplicatesOutput, error)
+}
+
+type catalogUsecase struct {
+	musicRepo repositories.MusicRepository
+	albumRepo repositories.AlbumRepository
+}
+
+func NewCatalogUsecase(musicRepo repositories.MusicRepository, albumRepo repositories.AlbumRepository) CatalogUsecase {
+	return &catalogUsecase{
+		musicRepo: musicRepo,
+		albumRepo: albumRepo,
+	}
+}
+
+// MergeDuplicates merges albums sharing a UPC and tracks sharing an ISRC into
+// a single canonical row each. With dryRun set, it only reports what would be
+// merged.
+func (u *catalogUsecase) MergeDuplicates(dryRun bool) (*MergeDuplicatesOutput, error) {
+	output := &MergeDuplicatesOutput{DryRun: dryRun, Albums: []DuplicateGroup{}, Tracks: []DuplicateGroup{}}
+
+	upcs, err := u.albumRepo.FindDuplicateUPCs()
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	for _, upc := range upcs {
+		albums, err := u.albumRepo.FindByUPC(upc)
+		if err != nil {
+			return nil, ErrFindingRecord
+		}
+		if len(albums) < 2 {
+			continue
+		}
+		group := albumGroup(upc, albums)
+		if !dryRun {
+			if err := u.albumRepo.Merge(group.CanonicalID, group.DuplicateIDs); err != nil {
+				return nil, ErrUpdatingRecord
+			}
+		}
+		output.Albums = append(output.Albums, group)
+	}
+
+	isrcs, err := u.musicRepo.FindDuplicateISRCs()
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	for _, isrc := range isrcs {
+		music, err := u.musicRepo.FindByISRC(isrc)
+		if err != nil {
+			return nil, ErrFindingRecord
+		}
+		if len(music) < 2 {
+			continue
+		}
+		canonical, group := musicGroup(isrc, music)
+		if !dryRun {
+			if fillLastfm(canonical, music) {
+				if err := u.musicRepo.Update(canonical); err != nil {
+					return nil, ErrUpdatingRecord
+				}
+			}
+			if err := u.musicRepo.Merge(group.CanonicalID, group.DuplicateIDs); err != nil {
+				return nil, ErrUpdatingRecord
+			}
+		}
+		output.Tracks = append(output.Tracks, group)
+	}
+
+	return output, nil
+}
+
+// albumGroup picks the oldest album as canonical, preferring one that is
+// linked to Spotify so that its Spotify ID survives the merge.
+func albumGroup(upc string, albums []*entities.Album) DuplicateGroup {
+	canonical := albums[0]
+	for _, a := range albums[1:] {
+		if canonical.SpotifyID == "" && a.SpotifyID != "" {
+			canonical = a
+		}
+	}
+	group := DuplicateGroup{Key: upc, CanonicalID: canonical.ID}
+	for _, a := range albums {
+		if a.ID != canonical.ID {
+			group.DuplicateIDs = append(group.DuplicateIDs, a.ID)
+		}
+	}
+	return group
+}
+
+// musicGroup applies the same rule as albumGroup. Rows are ordered by ID.
+func musicGroup(isrc string, music []*entities.Music) (*entities.Music, DuplicateGroup) {
+	canonical := music[0]
+	for _, m := range music[1:] {
+		if canonical.SpotifyID == "" && m.SpotifyID != "" {
+			canonical = m
+		}
+	}
+	group := DuplicateGroup{Key: isrc, CanonicalID: canonical.ID}
+	for _, m := range music {
+		if m.ID != canonical.ID {
+			group.DuplicateIDs = append(group.DuplicateIDs, m.ID)
+		}
+	}
+	return canonical, group
+}
+
+// fillLastfm copies Last.fm data from a duplicate when the canonical row has
+// never been synced, and reports whether canonical changed.
+func fillLastfm(canonical *entities.Music, music []*entities.Music) bool {
+	if canonical.LastfmSyncedAt != nil {
+		return false
+	}
+	for _, m := range music {
+		if m.ID == canonical.ID || m.LastfmSyncedAt == nil {
+			continue
+		}
+		canonical.LastfmID = m.LastfmID
+		canonical.LastfmListeners = m.LastfmListeners
+		canonical.LastfmPlaycount = m.LastfmPlaycount
+		canonical.LastfmSyncedAt = m.LastfmSyncedAt
+		return true
+	}
+	return false
+}
@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCatalogUsecase_MergeDuplicates_DryRun(t *testing.T) {
+	// Setup
+	musicRepo := &mocks.MusicRepository{}
+	albumRepo := &mocks.AlbumRepository{}
+
+	catalogUsecase := NewCatalogUsecase(musicRepo, albumRepo)
+
+	// Expectations
+	albumRepo.On("FindDuplicateUPCs").Return([]string{"00602537518357"}, nil)
+	albumRepo.On("FindByUPC", "00602537518357").Return([]*entities.Album{
+		{ID: 1},
+		{ID: 2, SpotifyID: "3M3cfh4JyWpuL0nVwEPSdo"},
+		{ID: 3},
+	}, nil)
+	musicRepo.On("FindDuplicateISRCs").Return([]string{"USUM71703861"}, nil)
+	musicRepo.On("FindByISRC", "USUM71703861").Return([]*entities.Music{
+		{ID: 4, SpotifyID: "2up3OPMp9Tb4dAKM2erWXQ"},
+		{ID: 5, SpotifyID: "0ofbQMrRDsUaVKq2mGLEAb"},
+	}, nil)
+
+	// Execute
+	output, err := catalogUsecase.MergeDuplicates(true)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, output.DryRun)
+	assert.Equal(t, []DuplicateGroup{{Key: "00602537518357", CanonicalID: 2, DuplicateIDs: []uint{1, 3}}}, output.Albums)
+	assert.Equal(t, []DuplicateGroup{{Key: "USUM71703861", CanonicalID: 4, DuplicateIDs: []uint{5}}}, output.Tracks)
+
+	// Verify
+	albumRepo.AssertExpectations(t)
+	musicRepo.AssertExpectations(t)
+	albumRepo.AssertNotCalled(t, "Merge", mock.Anything, mock.Anything)
+	musicRepo.AssertNotCalled(t, "Merge", mock.Anything, mock.Anything)
+}
+
+func TestCatalogUsecase_MergeDuplicates_Success(t *testing.T) {
+	// Setup
+	musicRepo := &mocks.MusicRepository{}
+	albumRepo := &mocks.AlbumRepository{}
+
+	catalogUsecase := NewCatalogUsecase(musicRepo, albumRepo)
+
+	syncedAt := time.Now()
+
+	// Expectations
+	albumRepo.On("FindDuplicateUPCs").Return([]string{}, nil)
+	musicRepo.On("FindDuplicateISRCs").Return([]string{"USUM71703861"}, nil)
+	musicRepo.On("FindByISRC", "USUM71703861").Return([]*entities.Music{
+		{ID: 4, SpotifyID: "2up3OPMp9Tb4dAKM2erWXQ"},
+		{ID: 5, LastfmID: "32ca187e-ee25-4f18-b7d0-3b6713f24635", LastfmListeners: 10, LastfmSyncedAt: &syncedAt},
+	}, nil)
+	musicRepo.On("Update", mock.MatchedBy(func(m *entities.Music) bool {
+		return m.ID == 4 && m.LastfmID == "32ca187e-ee25-4f18-b7d0-3b6713f24635" && m.LastfmListeners == 10
+	})).Return(nil)
+	musicRepo.On("Merge", uint(4), []uint{5}).Return(nil)
+
+	// Execute
+	output, err := catalogUsecase.MergeDuplicates(false)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.False(t, output.DryRun)
+	assert.Empty(t, output.Albums)
+	assert.Len(t, output.Tracks, 1)
+
+	// Verify
+	albumRepo.AssertExpectations(t)
+	musicRepo.AssertExpectations(t)
+}
+
+func TestCatalogUsecase_MergeDuplicates_MergeError(t *testing.T) {
+	// Setup
+	musicRepo := &mocks.MusicRepository{}
+	albumRepo := &mocks.AlbumRepository{}
+
+	catalogUsecase := NewCatalogUsecase(musicRepo, albumRepo)
+
+	// Expectations
+	albumRepo.On("FindDuplicateUPCs").Return([]string{"00602537518357"}, nil)
+	albumRepo.On("FindByUPC", "00602537518357").Return([]*entities.Album{{ID: 1}, {ID: 2}}, nil)
+	albumRepo.On("Merge", uint(1), []uint{2}).Return(repositories.ErrUpdate)
+
+	// Execute
+	output, err := catalogUsecase.MergeDuplicates(false)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrUpdatingRecord)
+	assert.Nil(t, output)
+
+	// Verify
+	albumRepo.AssertExpectations(t)
+	musicRepo.AssertNotCalled(t, "FindDuplicateISRCs")
+}
@@ -0,0 +1,189 @@
+package usecase
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"unicode"
+
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/playlistformat"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+)
+
+const (
+	maxImportedPlaylistTracks = 1000
+	defaultImportedName       = "Imported playlist"
+)
+
+// ExportCollection renders the collection with its tracks in the given
+// playlist format. Anyone who can read the collection can export it.
+func (u *collectionUsecase) ExportCollection(viewerID, collectionID uint, format string) (*ExportCollectionOutput, error) {
+	f, err := playlistformat.ParseFormat(format)
+	if err != nil {
+		return nil, ErrUnsupportedPlaylistFormat
+	}
+	collection, _, err := u.readableCollection(viewerID, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	music, err := u.musicRepo.FindByCollectionID(collection.ID)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+
+	albums := map[uint]string{}
+	playlist := &playlistformat.Playlist{
+		Title:       collection.Name,
+		Description: collection.Description,
+		Tracks:      make([]playlistformat.Track, len(music)),
+	}
+	for i, m := range music {
+		album, ok := albums[m.AlbumID]
+		if !ok {
+			a, err := u.albumRepo.FindByID(m.AlbumID)
+			if err != nil && !errors.Is(err, repositories.ErrNotFound) {
+				return nil, ErrFindingRecord
+			}
+			if a != nil {
+				album = a.Name
+			}
+			albums[m.AlbumID] = album
+		}
+		artists := make([]string, len(m.MusicArtistMapping))
+		for j, a := range m.MusicArtistMapping {
+			artists[j] = a.Artist.Name
+		}
+		playlist.Tracks[i] = playlistformat.Track{
+			Title:     m.Title,
+			Artist:    strings.Join(artists, ", "),
+			Album:     album,
+			ISRC:      m.ISRC,
+			SpotifyID: m.SpotifyID,
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := playlistformat.Encode(&buf, f, playlist); err != nil {
+		return nil, err
+	}
+	return &ExportCollectionOutput{
+		FileName:    exportFileName(collection.Name) + f.Extension(),
+		ContentType: f.ContentType(),
+		Data:        buf.Bytes(),
+	}, nil
+}
+
+// ImportCollection parses a playlist file and adds the tracks found in the
+// catalog to a collection. Entries are matched by Spotify ID, then ISRC, then
+// by title and artist; entries that cannot be matched are reported back
+// instead of failing the import.
+func (u *collectionUsecase) ImportCollection(userID uint, input *ImportCollectionInput) (*ImportCollectionOutput, error) {
+	f, err := playlistformat.ParseFormat(input.Format)
+	if err != nil {
+		return nil, ErrUnsupportedPlaylistFormat
+	}
+	playlist, err := playlistformat.Decode(bytes.NewReader(input.Data), f)
+	if err != nil {
+		return nil, ErrInvalidPlaylistFile
+	}
+	if len(playlist.Tracks) > maxImportedPlaylistTracks {
+		return nil, ErrPlaylistTooLarge
+	}
+
+	var collection *entities.MusicCollection
+	inCollection := map[uint]bool{}
+	if input.CollectionID != nil {
+		if collection, err = u.authorize(userID, *input.CollectionID, entities.CollectionRoleEditor); err != nil {
+			return nil, err
+		}
+		existing, err := u.collectionMusicRepo.FindByCollectionID(collection.ID)
+		if err != nil {
+			return nil, ErrFindingRecord
+		}
+		for _, m := range existing {
+			inCollection[m.MusicID] = true
+		}
+	}
+
+	output := &ImportCollectionOutput{
+		Total:      len(playlist.Tracks),
+		Matched:    []MatchedPlaylistEntry{},
+		Unresolved: []UnresolvedPlaylistEntry{},
+	}
+	mappings := []*entities.CollectionMusicMapping{}
+	for _, t := range playlist.Tracks {
+		music, matchedBy, err := u.matchPlaylistTrack(t)
+		if err != nil {
+			return nil, err
+		}
+		if music == nil {
+			output.Unresolved = append(output.Unresolved, UnresolvedPlaylistEntry{Line: t.Line, Title: t.Title, Artist: t.Artist})
+			continue
+		}
+		output.Matched = append(output.Matched, MatchedPlaylistEntry{Line: t.Line, MusicID: music.ID, MatchedBy: matchedBy})
+		if inCollection[music.ID] {
+			continue
+		}
+		inCollection[music.ID] = true
+		mappings = append(mappings, &entities.CollectionMusicMapping{MusicID: music.ID, AddedBy: &userID})
+	}
+
+	// New collections are only created once matching succeeded, so a failed
+	// import does not leave an empty collection behind.
+	if collection == nil {
+		collection = &entities.MusicCollection{
+			UserID:      userID,
+			Name:        importedCollectionName(input.Name, playlist.Title),
+			Description: playlist.Description,
+			IsPublic:    input.IsPublic,
+		}
+		if err := u.collectionRepo.Create(collection); err != nil {
+			return nil, ErrCreatingRecord
+		}
+	}
+	for _, m := range mappings {
+		m.CollectionID = collection.ID
+	}
+	if err := u.collectionMusicRepo.CreateBatch(mappings); err != nil {
+		return nil, ErrCreatingRecord
+	}
+	if input.CollectionID == nil && collection.IsPublic {
+		u.activities.record(&entities.Activity{ActorID: userID, Type: entities.ActivityTypeCollection, CollectionID: &collection.ID})
+	}
+
+	output.CollectionID = collection.ID
+	output.Added = len(mappings)
+	return output, nil
+}
+
+func importedCollectionName(names ...string) string {
+	for _, name := range names {
+		if name = strings.TrimSpace(name); name != "" {
+			return truncateRunes(name, 255)
+		}
+	}
+	return defaultImportedName
+}
+
+// exportFileName turns the collection name into a file name that is safe on
+// common file systems.
+func exportFileName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' || r == '_' || r == ' ' {
+			return r
+		}
+		return '_'
+	}, strings.TrimSpace(name))
+	if name == "" {
+		return "collection"
+	}
+	return truncateRunes(name, 100)
+}
+
+func truncateRunes(s string, n int) string {
+	if r := []rune(s); len(r) > n {
+		return string(r[:n])
+	}
+	return s
+}
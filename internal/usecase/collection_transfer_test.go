@@ -0,0 +1,255 @@
+package usecase
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/playlistformat"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCollectionUsecase_ExportCollection_M3U8(t *testing.T) {
+	// Setup
+	collectionRepo := &mocks.MusicCollectionRepository{}
+	musicRepo := &mocks.MusicRepository{}
+	albumRepo := &mocks.AlbumRepository{}
+
+	collectionUsecase := NewCollectionUsecase(collectionRepo, nil, nil, nil, musicRepo, albumRepo, nil, nil, nil)
+
+	artist := entities.MusicArtistMapping{Artist: entities.Artist{Name: "Metallica"}}
+
+	// Expectations
+	collectionRepo.On("FindByID", uint(10)).Return(&entities.MusicCollection{ID: 10, UserID: 1, Name: "Thrash / Metal", IsPublic: true}, nil)
+	musicRepo.On("FindByCollectionID", uint(10)).Return([]*entities.Music{
+		{ID: 1, Title: "One", AlbumID: 5, SpotifyID: "abc", MusicArtistMapping: []entities.MusicArtistMapping{artist}},
+		{ID: 2, Title: "Blackened", AlbumID: 5, ISRC: "USEE10001993", MusicArtistMapping: []entities.MusicArtistMapping{artist}},
+	}, nil)
+	albumRepo.On("FindByID", uint(5)).Return(&entities.Album{ID: 5, Name: "...And Justice for All"}, nil).Once()
+
+	// Execute
+	output, err := collectionUsecase.ExportCollection(1, 10, "m3u8")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "Thrash _ Metal.m3u8", output.FileName)
+	assert.Equal(t, "#EXTM3U\n"+
+		"#PLAYLIST:Thrash / Metal\n"+
+		"#EXTINF:-1,Metallica - One\n"+
+		"#EXTALB:...And Justice for All\n"+
+		"https://open.spotify.com/track/abc\n"+
+		"#EXTINF:-1,Metallica - Blackened\n"+
+		"#EXTALB:...And Justice for All\n"+
+		"isrc:USEE10001993\n", string(output.Data))
+
+	// Verify
+	collectionRepo.AssertExpectations(t)
+	musicRepo.AssertExpectations(t)
+	albumRepo.AssertExpectations(t)
+}
+
+func TestCollectionUsecase_ExportCollection_UnsupportedFormat(t *testing.T) {
+	// Setup
+	collectionUsecase := NewCollectionUsecase(nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	// Execute
+	output, err := collectionUsecase.ExportCollection(1, 10, "pls")
+
+	// Assert
+	assert.ErrorIs(t, err, ErrUnsupportedPlaylistFormat)
+	assert.Nil(t, output)
+}
+
+func TestCollectionUsecase_ImportCollection_NewCollection(t *testing.T) {
+	// Setup
+	collectionRepo := &mocks.MusicCollectionRepository{}
+	collectionMusicRepo := &mocks.CollectionMusicMappingRepository{}
+	musicRepo := &mocks.MusicRepository{}
+
+	collectionUsecase := NewCollectionUsecase(collectionRepo, collectionMusicRepo, nil, nil, musicRepo, nil, nil, nil, nil)
+
+	data := "title,artist,album,isrc,spotify_id\n" +
+		"One,Metallica,,,abc\n" +
+		"Blackened,Metallica,,USEE10001993,\n" +
+		"Fade to Black (Remastered),Metallica,,,\n" +
+		"Unknown Song,Nobody,,,\n" +
+		"One,Metallica,,,abc\n"
+
+	// Expectations
+	musicRepo.On("FindBySpotifyID", "abc").Return(&entities.Music{ID: 1}, nil)
+	musicRepo.On("FindByISRC", "USEE10001993").Return([]*entities.Music{{ID: 2}}, nil)
+	musicRepo.On("SearchByTitle", "Fade to Black", 0, fuzzyMatchCandidates).Return([]*entities.Music{
+		{ID: 3, Title: "Fade To Black", MusicArtistMapping: []entities.MusicArtistMapping{{Artist: entities.Artist{Name: "Metallica"}}}},
+	}, nil)
+	musicRepo.On("SearchByTitle", "Unknown Song", 0, fuzzyMatchCandidates).Return([]*entities.Music{}, nil)
+	collectionRepo.On("Create", mock.MatchedBy(func(c *entities.MusicCollection) bool {
+		return c.UserID == 7 && c.Name == defaultImportedName && !c.IsPublic
+	})).Run(func(args mock.Arguments) {
+		args.Get(0).(*entities.MusicCollection).ID = 10
+	}).Return(nil)
+	collectionMusicRepo.On("CreateBatch", mock.MatchedBy(func(m []*entities.CollectionMusicMapping) bool {
+		return len(m) == 3 && m[0].MusicID == 1 && m[1].MusicID == 2 && m[2].MusicID == 3 &&
+			m[0].CollectionID == 10 && *m[0].AddedBy == 7
+	})).Return(nil)
+
+	// Execute
+	output, err := collectionUsecase.ImportCollection(7, &ImportCollectionInput{Format: "csv", Data: []byte(data)})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, uint(10), output.CollectionID)
+	assert.Equal(t, 5, output.Total)
+	assert.Equal(t, 3, output.Added)
+	assert.Equal(t, []MatchedPlaylistEntry{
+		{Line: 2, MusicID: 1, MatchedBy: matchedBySpotifyID},
+		{Line: 3, MusicID: 2, MatchedBy: matchedByISRC},
+		{Line: 4, MusicID: 3, MatchedBy: matchedByFuzzy},
+		{Line: 6, MusicID: 1, MatchedBy: matchedBySpotifyID},
+	}, output.Matched)
+	assert.Equal(t, []UnresolvedPlaylistEntry{{Line: 5, Title: "Unknown Song", Artist: "Nobody"}}, output.Unresolved)
+
+	// Verify
+	collectionRepo.AssertExpectations(t)
+	collectionMusicRepo.AssertExpectations(t)
+	musicRepo.AssertExpectations(t)
+}
+
+func TestCollectionUsecase_ImportCollection_RecordsActivity(t *testing.T) {
+	// Setup
+	collectionRepo := &mocks.MusicCollectionRepository{}
+	collectionMusicRepo := &mocks.CollectionMusicMappingRepository{}
+	musicRepo := &mocks.MusicRepository{}
+	activityRepo := &mocks.ActivityRepository{}
+	followRepo := &mocks.UserFollowRepository{}
+
+	collectionUsecase := NewCollectionUsecase(collectionRepo, collectionMusicRepo, nil, nil, musicRepo, nil, nil, nil, NewActivityRecorder(activityRepo, followRepo, 0))
+
+	// Expectations
+	musicRepo.On("FindBySpotifyID", "abc").Return(&entities.Music{ID: 1}, nil)
+	collectionRepo.On("Create", mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(0).(*entities.MusicCollection).ID = 10
+	}).Return(nil)
+	collectionMusicRepo.On("CreateBatch", mock.Anything).Return(nil)
+	followRepo.On("CountFollowersByUserID", repositories.Viewer{}, uint(7)).Return(int64(2), nil)
+	activityRepo.On("Create", mock.MatchedBy(func(a *entities.Activity) bool {
+		return a.ActorID == 7 && a.Type == entities.ActivityTypeCollection && *a.CollectionID == 10
+	})).Return(nil)
+
+	// Execute
+	_, err := collectionUsecase.ImportCollection(7, &ImportCollectionInput{
+		Format:   "csv",
+		Data:     []byte("title,artist,album,isrc,spotify_id\nOne,Metallica,,,abc\n"),
+		IsPublic: true,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+
+	// Verify
+	activityRepo.AssertExpectations(t)
+}
+
+func TestCollectionUsecase_ImportCollection_ExistingCollection(t *testing.T) {
+	t.Run("SkipsTracksAlreadyInCollection", func(t *testing.T) {
+		// Setup
+		collectionRepo := &mocks.MusicCollectionRepository{}
+		collectionMusicRepo := &mocks.CollectionMusicMappingRepository{}
+		musicRepo := &mocks.MusicRepository{}
+
+		collectionUsecase := NewCollectionUsecase(collectionRepo, collectionMusicRepo, nil, nil, musicRepo, nil, nil, nil, nil)
+
+		collectionID := uint(10)
+		data := `{"playlist":{"title":"Mix","track":[{"location":["spotify:track:abc"]},{"identifier":["isrc:USEE10001993"]}]}}`
+
+		// Expectations
+		collectionRepo.On("FindByID", collectionID).Return(&entities.MusicCollection{ID: 10, UserID: 1}, nil)
+		collectionMusicRepo.On("FindByCollectionID", collectionID).Return([]*entities.CollectionMusicMapping{{CollectionID: 10, MusicID: 1}}, nil)
+		musicRepo.On("FindBySpotifyID", "abc").Return(&entities.Music{ID: 1}, nil)
+		musicRepo.On("FindByISRC", "USEE10001993").Return([]*entities.Music{{ID: 2}}, nil)
+		collectionMusicRepo.On("CreateBatch", mock.MatchedBy(func(m []*entities.CollectionMusicMapping) bool {
+			return len(m) == 1 && m[0].MusicID == 2 && m[0].CollectionID == 10
+		})).Return(nil)
+
+		// Execute
+		output, err := collectionUsecase.ImportCollection(1, &ImportCollectionInput{Format: "jspf", Data: []byte(data), CollectionID: &collectionID})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 1, output.Added)
+		assert.Len(t, output.Matched, 2)
+
+		// Verify
+		collectionMusicRepo.AssertExpectations(t)
+		collectionRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+
+	t.Run("ViewerCannotImport", func(t *testing.T) {
+		// Setup
+		collectionRepo := &mocks.MusicCollectionRepository{}
+		memberRepo := &mocks.CollectionMemberRepository{}
+
+		collectionUsecase := NewCollectionUsecase(collectionRepo, nil, memberRepo, nil, nil, nil, nil, nil, nil)
+
+		collectionID := uint(10)
+
+		// Expectations
+		collectionRepo.On("FindByID", collectionID).Return(&entities.MusicCollection{ID: 10, UserID: 1}, nil)
+		memberRepo.On("FindByCollectionIDAndUserID", collectionID, uint(2)).Return(&entities.CollectionMember{Role: entities.CollectionRoleViewer}, nil)
+
+		// Execute
+		output, err := collectionUsecase.ImportCollection(2, &ImportCollectionInput{Format: "m3u8", Data: []byte("#EXTM3U\n"), CollectionID: &collectionID})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrCollectionPermissionDenied)
+		assert.Nil(t, output)
+	})
+}
+
+func TestCollectionUsecase_ImportCollection_InvalidFile(t *testing.T) {
+	// Setup
+	collectionUsecase := NewCollectionUsecase(nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	// Execute
+	_, invalidErr := collectionUsecase.ImportCollection(1, &ImportCollectionInput{Format: "xspf", Data: []byte("<playlist>")})
+	_, tooLargeErr := collectionUsecase.ImportCollection(1, &ImportCollectionInput{
+		Format: "m3u8",
+		Data:   []byte(strings.Repeat("spotify:track:abc\n", maxImportedPlaylistTracks+1)),
+	})
+
+	// Assert
+	assert.ErrorIs(t, invalidErr, ErrInvalidPlaylistFile)
+	assert.ErrorIs(t, tooLargeErr, ErrPlaylistTooLarge)
+}
+
+func TestBestFuzzyMatch(t *testing.T) {
+	withArtist := func(id uint, title, artist string) *entities.Music {
+		return &entities.Music{ID: id, Title: title, MusicArtistMapping: []entities.MusicArtistMapping{{Artist: entities.Artist{Name: artist}}}}
+	}
+
+	tests := []struct {
+		name       string
+		track      playlistformat.Track
+		candidates []*entities.Music
+		expected   uint
+	}{
+		{"VersionNotesIgnored", playlistformat.Track{Title: "Midnight City - Remastered 2011", Artist: "M83"}, []*entities.Music{withArtist(1, "Midnight City (Live)", "M83")}, 1},
+		{"FeaturedArtist", playlistformat.Track{Title: "Get Lucky (feat. Pharrell Williams)", Artist: "Daft Punk, Pharrell Williams"}, []*entities.Music{withArtist(1, "Get Lucky", "Pharrell Williams")}, 1},
+		{"ArtistWithAmpersand", playlistformat.Track{Title: "The Boxer", Artist: "Simon & Garfunkel"}, []*entities.Music{withArtist(1, "The Boxer", "Simon & Garfunkel")}, 1},
+		{"PrefersCloserTitle", playlistformat.Track{Title: "Dont Stop Me Now", Artist: "Queen"}, []*entities.Music{withArtist(1, "Don't Stop Me Now Now", "Queen"), withArtist(2, "Don't Stop Me Now", "Queen")}, 2},
+		{"DifferentArtist", playlistformat.Track{Title: "Hurt", Artist: "Nine Inch Nails"}, []*entities.Music{withArtist(1, "Hurt", "Johnny Cash")}, 0},
+		{"TitleOnlyRequiresExactTitle", playlistformat.Track{Title: "Hurts"}, []*entities.Music{withArtist(1, "Hurt", "Johnny Cash")}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match := bestFuzzyMatch(tt.track, tt.candidates)
+			if tt.expected == 0 {
+				assert.Nil(t, match)
+				return
+			}
+			assert.Equal(t, tt.expected, match.ID)
+		})
+	}
+}
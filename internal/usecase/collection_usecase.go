@@ -0,0 +1,479 @@
+package usecase
+
+import (
+	"errors"
+	"time"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+)
+
+type CollectionUsecase interface {
+	CreateCollection(userID uint, input *CreateCollectionInput) (*CollectionOutput, error)
+	GetCollection(viewerID, collectionID uint) (*GetCollectionOutput, error)
+	PatchCollection(userID, collectionID uint, input *PatchCollectionInput) (*CollectionOutput, error)
+	DeleteCollection(userID, collectionID uint) error
+	AddTracks(userID, collectionID uint, musicIDs []uint) (*AddCollectionTracksOutput, error)
+	RemoveTrack(userID, collectionID, musicID uint) error
+	MoveTrack(userID, collectionID, musicID uint, position int) error
+	ListUserCollections(viewerID, ownerID uint, limit, offset *int) (*ListCollectionsOutput, error)
+	CreateInvite(userID, collectionID uint, input *CreateCollectionInviteInput) (*CollectionInviteOutput, error)
+	AcceptInvite(userID uint, token string) (*CollectionMemberOutput, error)
+	ListMembers(viewerID, collectionID uint) (*ListCollectionMembersOutput, error)
+	UpdateMemberRole(userID, collectionID, memberID uint, role string) (*CollectionMemberOutput, error)
+	RemoveMember(userID, collectionID, memberID uint) error
+	ExportCollection(viewerID, collectionID uint, format string) (*ExportCollectionOutput, error)
+	ImportCollection(userID uint, input *ImportCollectionInput) (*ImportCollectionOutput, error)
+}
+
+const (
+	defaultCollectionInviteTTL = 7 * 24 * time.Hour
+	maxCollectionInviteTTL     = 30 * 24 * time.Hour
+)
+
+// collectionRoleRank orders roles so that each role includes the permissions
+// of the roles below it.
+var collectionRoleRank = map[string]int{
+	entities.CollectionRoleViewer: 1,
+	entities.CollectionRoleEditor: 2,
+	entities.CollectionRoleOwner:  3,
+}
+
+type collectionUsecase struct {
+	collectionRepo      repositories.MusicCollectionRepository
+	collectionMusicRepo repositories.CollectionMusicMappingRepository
+	memberRepo          repositories.CollectionMemberRepository
+	inviteRepo          repositories.CollectionInviteRepository
+	musicRepo           repositories.MusicRepository
+	albumRepo           repositories.AlbumRepository
+	userRepo            repositories.UserRepository
+	blockRepo           repositories.UserBlockRepository
+	activities          *ActivityRecorder
+}
+
+func NewCollectionUsecase(collectionRepo repositories.MusicCollectionRepository, collectionMusicRepo repositories.CollectionMusicMappingRepository, memberRepo repositories.CollectionMemberRepository, inviteRepo repositories.CollectionInviteRepository, musicRepo repositories.MusicRepository, albumRepo repositories.AlbumRepository, userRepo repositories.UserRepository, blockRepo repositories.UserBlockRepository, activities *ActivityRecorder) CollectionUsecase {
+	return &collectionUsecase{
+		collectionRepo:      collectionRepo,
+		collectionMusicRepo: collectionMusicRepo,
+		memberRepo:          memberRepo,
+		inviteRepo:          inviteRepo,
+		musicRepo:           musicRepo,
+		albumRepo:           albumRepo,
+		userRepo:            userRepo,
+		blockRepo:           blockRepo,
+		activities:          activities,
+	}
+}
+
+func (u *collectionUsecase) CreateCollection(userID uint, input *CreateCollectionInput) (*CollectionOutput, error) {
+	collection := &entities.MusicCollection{
+		UserID:      userID,
+		Name:        input.Name,
+		Description: input.Description,
+		IsPublic:    input.IsPublic,
+	}
+	if err := u.collectionRepo.Create(collection); err != nil {
+		return nil, ErrCreatingRecord
+	}
+	if collection.IsPublic {
+		u.activities.record(&entities.Activity{ActorID: userID, Type: entities.ActivityTypeCollection, CollectionID: &collection.ID})
+	}
+	output := toCollectionOutput(collection)
+	return &output, nil
+}
+
+// GetCollection returns the collection with its tracks in order. Private
+// collections are only visible to their members.
+func (u *collectionUsecase) GetCollection(viewerID, collectionID uint) (*GetCollectionOutput, error) {
+	collection, role, err := u.readableCollection(viewerID, collectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	music, err := u.musicRepo.FindByCollectionID(collection.ID)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	mappings, err := u.collectionMusicRepo.FindByCollectionID(collection.ID)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	addedBy := make(map[uint]*uint, len(mappings))
+	for _, m := range mappings {
+		addedBy[m.MusicID] = m.AddedBy
+	}
+
+	tracks := make([]CollectionTrackOutput, len(music))
+	for i, m := range music {
+		tracks[i] = CollectionTrackOutput{Track: toCatalogTrack(m), AddedBy: addedBy[m.ID]}
+	}
+	return &GetCollectionOutput{Collection: toCollectionOutput(collection), Role: role, Tracks: tracks}, nil
+}
+
+func (u *collectionUsecase) PatchCollection(userID, collectionID uint, input *PatchCollectionInput) (*CollectionOutput, error) {
+	collection, err := u.authorize(userID, collectionID, entities.CollectionRoleOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Name != nil {
+		collection.Name = *input.Name
+	}
+	if input.Description != nil {
+		collection.Description = *input.Description
+	}
+	published := input.IsPublic != nil && *input.IsPublic && !collection.IsPublic
+	if input.IsPublic != nil {
+		collection.IsPublic = *input.IsPublic
+	}
+	if err := u.collectionRepo.Update(collection); err != nil {
+		return nil, ErrUpdatingRecord
+	}
+	if published {
+		u.activities.record(&entities.Activity{ActorID: userID, Type: entities.ActivityTypeCollection, CollectionID: &collection.ID})
+	}
+	output := toCollectionOutput(collection)
+	return &output, nil
+}
+
+func (u *collectionUsecase) DeleteCollection(userID, collectionID uint) error {
+	collection, err := u.authorize(userID, collectionID, entities.CollectionRoleOwner)
+	if err != nil {
+		return err
+	}
+	if err := u.collectionRepo.Delete(collection.ID); err != nil {
+		return ErrDeletingRecord
+	}
+	return nil
+}
+
+// AddTracks appends the given tracks to the end of the collection in order.
+// Tracks already in the collection are skipped, and merged duplicates are
+// stored as their canonical track.
+func (u *collectionUsecase) AddTracks(userID, collectionID uint, musicIDs []uint) (*AddCollectionTracksOutput, error) {
+	collection, err := u.authorize(userID, collectionID, entities.CollectionRoleEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := u.collectionMusicRepo.FindByCollectionID(collection.ID)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	inCollection := make(map[uint]bool, len(existing)+len(musicIDs))
+	for _, m := range existing {
+		inCollection[m.MusicID] = true
+	}
+
+	mappings := []*entities.CollectionMusicMapping{}
+	for _, musicID := range musicIDs {
+		music, err := u.musicRepo.FindByID(musicID)
+		if err != nil {
+			if errors.Is(err, repositories.ErrNotFound) {
+				return nil, ErrMusicNotFound
+			}
+			return nil, ErrFindingRecord
+		}
+		if inCollection[music.ID] {
+			continue
+		}
+		inCollection[music.ID] = true
+		mappings = append(mappings, &entities.CollectionMusicMapping{CollectionID: collection.ID, MusicID: music.ID, AddedBy: &userID})
+	}
+
+	if err := u.collectionMusicRepo.CreateBatch(mappings); err != nil {
+		return nil, ErrCreatingRecord
+	}
+	return &AddCollectionTracksOutput{Added: len(mappings)}, nil
+}
+
+func (u *collectionUsecase) RemoveTrack(userID, collectionID, musicID uint) error {
+	collection, err := u.authorize(userID, collectionID, entities.CollectionRoleEditor)
+	if err != nil {
+		return err
+	}
+	if err := u.collectionMusicRepo.DeleteByCollectionIDAndMusicID(collection.ID, musicID); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrCollectionTrackNotFound
+		}
+		return ErrDeletingRecord
+	}
+	return nil
+}
+
+// MoveTrack places the track at the given zero-based position. Other tracks
+// keep their relative order.
+func (u *collectionUsecase) MoveTrack(userID, collectionID, musicID uint, position int) error {
+	collection, err := u.authorize(userID, collectionID, entities.CollectionRoleEditor)
+	if err != nil {
+		return err
+	}
+	if err := u.collectionMusicRepo.Move(collection.ID, musicID, position); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrCollectionTrackNotFound
+		}
+		return ErrUpdatingRecord
+	}
+	return nil
+}
+
+// ListUserCollections lists the owner's collections, newest first. Other
+// users only see public collections, and none if either blocked the other or
+// the owner hid their collections.
+func (u *collectionUsecase) ListUserCollections(viewerID, ownerID uint, limit, offset *int) (*ListCollectionsOutput, error) {
+	owner, err := findVisibleUser(u.userRepo, u.blockRepo, viewerID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	if sectionHidden(viewerID, owner, ProfileSectionCollections) {
+		return &ListCollectionsOutput{Collections: []CollectionOutput{}}, nil
+	}
+
+	l, o := pagination(limit, offset)
+	includePrivate := viewerID == ownerID
+	collections, err := u.collectionRepo.FindByUserID(ownerID, includePrivate, o, l)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	total, err := u.collectionRepo.CountByUserID(ownerID, includePrivate)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+
+	outputs := make([]CollectionOutput, len(collections))
+	for i, c := range collections {
+		outputs[i] = toCollectionOutput(c)
+	}
+	return &ListCollectionsOutput{Collections: outputs, Total: int(total)}, nil
+}
+
+func (u *collectionUsecase) findCollection(collectionID uint) (*entities.MusicCollection, error) {
+	collection, err := u.collectionRepo.FindByID(collectionID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrCollectionNotFound
+		}
+		return nil, ErrFindingRecord
+	}
+	return collection, nil
+}
+
+// CreateInvite creates an invite link that grants the given role to anyone
+// who accepts it before it expires.
+func (u *collectionUsecase) CreateInvite(userID, collectionID uint, input *CreateCollectionInviteInput) (*CollectionInviteOutput, error) {
+	collection, err := u.authorize(userID, collectionID, entities.CollectionRoleOwner)
+	if err != nil {
+		return nil, err
+	}
+	if input.Role != entities.CollectionRoleEditor && input.Role != entities.CollectionRoleViewer {
+		return nil, ErrInvalidCollectionRole
+	}
+
+	ttl := defaultCollectionInviteTTL
+	if input.ExpiresIn > 0 {
+		ttl = min(input.ExpiresIn, maxCollectionInviteTTL)
+	}
+	expiresAt := time.Now().Add(ttl)
+	invite := &entities.CollectionInvite{
+		CollectionID: collection.ID,
+		Token:        generateFlowID(),
+		Role:         input.Role,
+		CreatedBy:    userID,
+		ExpiresAt:    &expiresAt,
+	}
+	if err := u.inviteRepo.Create(invite); err != nil {
+		return nil, ErrCreatingRecord
+	}
+	return &CollectionInviteOutput{
+		CollectionID: collection.ID,
+		Token:        invite.Token,
+		Role:         invite.Role,
+		ExpiresAt:    expiresAt,
+	}, nil
+}
+
+// AcceptInvite adds the user to the invite's collection. Invite links can be
+// used by several users, and accepting never lowers an existing role.
+func (u *collectionUsecase) AcceptInvite(userID uint, token string) (*CollectionMemberOutput, error) {
+	invite, err := u.inviteRepo.FindByToken(token)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrCollectionInviteNotFound
+		}
+		return nil, ErrFindingRecord
+	}
+	if invite.ExpiresAt.Before(time.Now()) {
+		return nil, ErrCollectionInviteExpired
+	}
+
+	member, err := u.memberRepo.FindByCollectionIDAndUserID(invite.CollectionID, userID)
+	switch {
+	case err == nil:
+		if collectionRoleRank[member.Role] < collectionRoleRank[invite.Role] {
+			member.Role = invite.Role
+			if err := u.memberRepo.Update(member); err != nil {
+				return nil, ErrUpdatingRecord
+			}
+		}
+	case errors.Is(err, repositories.ErrNotFound):
+		member = &entities.CollectionMember{CollectionID: invite.CollectionID, UserID: userID, Role: invite.Role}
+		if err := u.memberRepo.Create(member); err != nil {
+			return nil, ErrCreatingRecord
+		}
+	default:
+		return nil, ErrFindingRecord
+	}
+	return &CollectionMemberOutput{
+		CollectionID: member.CollectionID,
+		UserID:       member.UserID,
+		Role:         member.Role,
+		JoinedAt:     member.CreatedAt,
+	}, nil
+}
+
+func (u *collectionUsecase) ListMembers(viewerID, collectionID uint) (*ListCollectionMembersOutput, error) {
+	collection, _, err := u.readableCollection(viewerID, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	members, err := u.memberRepo.FindByCollectionID(collection.ID)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+
+	outputs := make([]CollectionMemberOutput, len(members))
+	for i, m := range members {
+		outputs[i] = CollectionMemberOutput{
+			CollectionID: m.CollectionID,
+			UserID:       m.UserID,
+			Nickname:     m.User.Nickname,
+			Role:         m.Role,
+			JoinedAt:     m.CreatedAt,
+		}
+	}
+	return &ListCollectionMembersOutput{Members: outputs}, nil
+}
+
+// UpdateMemberRole changes a member's role. The owner's role cannot be
+// changed, and ownership cannot be granted.
+func (u *collectionUsecase) UpdateMemberRole(userID, collectionID, memberID uint, role string) (*CollectionMemberOutput, error) {
+	collection, err := u.authorize(userID, collectionID, entities.CollectionRoleOwner)
+	if err != nil {
+		return nil, err
+	}
+	if role != entities.CollectionRoleEditor && role != entities.CollectionRoleViewer {
+		return nil, ErrInvalidCollectionRole
+	}
+	if memberID == collection.UserID {
+		return nil, ErrCollectionOwnerImmutable
+	}
+
+	member, err := u.memberRepo.FindByCollectionIDAndUserID(collection.ID, memberID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrCollectionMemberNotFound
+		}
+		return nil, ErrFindingRecord
+	}
+	member.Role = role
+	if err := u.memberRepo.Update(member); err != nil {
+		return nil, ErrUpdatingRecord
+	}
+	return &CollectionMemberOutput{
+		CollectionID: member.CollectionID,
+		UserID:       member.UserID,
+		Role:         member.Role,
+		JoinedAt:     member.CreatedAt,
+	}, nil
+}
+
+// RemoveMember removes a member from the collection. The owner can remove
+// anyone but themselves, and other members can only remove themselves.
+func (u *collectionUsecase) RemoveMember(userID, collectionID, memberID uint) error {
+	required := entities.CollectionRoleOwner
+	if memberID == userID {
+		required = entities.CollectionRoleViewer
+	}
+	collection, err := u.authorize(userID, collectionID, required)
+	if err != nil {
+		return err
+	}
+	if memberID == collection.UserID {
+		return ErrCollectionOwnerImmutable
+	}
+
+	if err := u.memberRepo.DeleteByCollectionIDAndUserID(collection.ID, memberID); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrCollectionMemberNotFound
+		}
+		return ErrDeletingRecord
+	}
+	return nil
+}
+
+// memberRole returns the user's role in the collection, or an empty string
+// for non-members.
+func (u *collectionUsecase) memberRole(collection *entities.MusicCollection, userID uint) (string, error) {
+	if collection.UserID == userID {
+		return entities.CollectionRoleOwner, nil
+	}
+	member, err := u.memberRepo.FindByCollectionIDAndUserID(collection.ID, userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return "", nil
+		}
+		return "", ErrFindingRecord
+	}
+	return member.Role, nil
+}
+
+// authorize returns the collection if the user has at least the required
+// role. Private collections are hidden from non-members instead of rejecting
+// the request, so they cannot be probed for.
+func (u *collectionUsecase) authorize(userID, collectionID uint, required string) (*entities.MusicCollection, error) {
+	collection, err := u.findCollection(collectionID)
+	if err != nil {
+		return nil, err
+	}
+	role, err := u.memberRole(collection, userID)
+	if err != nil {
+		return nil, err
+	}
+	if collectionRoleRank[role] >= collectionRoleRank[required] {
+		return collection, nil
+	}
+	if role == "" && !collection.IsPublic {
+		return nil, ErrCollectionNotFound
+	}
+	return nil, ErrCollectionPermissionDenied
+}
+
+// readableCollection returns the collection and the viewer's role if the
+// collection is public or the viewer is a member.
+func (u *collectionUsecase) readableCollection(viewerID, collectionID uint) (*entities.MusicCollection, string, error) {
+	collection, err := u.findCollection(collectionID)
+	if err != nil {
+		return nil, "", err
+	}
+	role, err := u.memberRole(collection, viewerID)
+	if err != nil {
+		return nil, "", err
+	}
+	if role == "" && !collection.IsPublic {
+		return nil, "", ErrCollectionNotFound
+	}
+	return collection, role, nil
+}
+
+func toCollectionOutput(c *entities.MusicCollection) CollectionOutput {
+	return CollectionOutput{
+		ID:                c.ID,
+		UserID:            c.UserID,
+		Name:              c.Name,
+		Description:       c.Description,
+		IsPublic:          c.IsPublic,
+		SpotifyPlaylistID: c.SpotifyPlaylistID,
+		CreatedAt:         c.CreatedAt,
+		UpdatedAt:         c.UpdatedAt,
+	}
+}
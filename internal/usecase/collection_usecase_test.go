@@ -0,0 +1,412 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase/mocks"
+	"github.com/myjinjin/sonic-odyssey-backend/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCollectionUsecase_GetCollection_PrivateHiddenFromOthers(t *testing.T) {
+	// Setup
+	collectionRepo := &mocks.MusicCollectionRepository{}
+	collectionMusicRepo := &mocks.CollectionMusicMappingRepository{}
+	memberRepo := &mocks.CollectionMemberRepository{}
+	musicRepo := &mocks.MusicRepository{}
+
+	collectionUsecase := NewCollectionUsecase(collectionRepo, collectionMusicRepo, memberRepo, nil, musicRepo, nil, nil, nil, nil)
+
+	addedBy := uint(1)
+
+	// Expectations
+	collectionRepo.On("FindByID", uint(10)).Return(&entities.MusicCollection{ID: 10, UserID: 1, IsPublic: false}, nil)
+	memberRepo.On("FindByCollectionIDAndUserID", uint(10), uint(2)).Return(nil, repositories.ErrNotFound)
+	musicRepo.On("FindByCollectionID", uint(10)).Return([]*entities.Music{{ID: 3, Title: "One"}}, nil)
+	collectionMusicRepo.On("FindByCollectionID", uint(10)).Return([]*entities.CollectionMusicMapping{{CollectionID: 10, MusicID: 3, AddedBy: &addedBy}}, nil)
+
+	// Execute
+	output, err := collectionUsecase.GetCollection(1, 10)
+	_, otherErr := collectionUsecase.GetCollection(2, 10)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, entities.CollectionRoleOwner, output.Role)
+	assert.Len(t, output.Tracks, 1)
+	assert.Equal(t, "One", output.Tracks[0].Track.Title)
+	assert.Equal(t, uint(1), *output.Tracks[0].AddedBy)
+	assert.ErrorIs(t, otherErr, ErrCollectionNotFound)
+
+	// Verify
+	collectionRepo.AssertExpectations(t)
+	musicRepo.AssertNumberOfCalls(t, "FindByCollectionID", 1)
+}
+
+func TestCollectionUsecase_PatchCollection(t *testing.T) {
+	// Setup
+	collectionRepo := &mocks.MusicCollectionRepository{}
+
+	collectionUsecase := NewCollectionUsecase(collectionRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	// Expectations
+	collectionRepo.On("FindByID", uint(10)).Return(&entities.MusicCollection{ID: 10, UserID: 1, Name: "Old", Description: "Kept"}, nil)
+	collectionRepo.On("Update", mock.MatchedBy(func(c *entities.MusicCollection) bool {
+		return c.Name == "Road Trip" && c.Description == "Kept" && c.IsPublic
+	})).Return(nil)
+
+	// Execute
+	output, err := collectionUsecase.PatchCollection(1, 10, &PatchCollectionInput{
+		Name:     utils.ToPtr("Road Trip"),
+		IsPublic: utils.ToPtr(true),
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "Road Trip", output.Name)
+	assert.True(t, output.IsPublic)
+
+	// Verify
+	collectionRepo.AssertExpectations(t)
+}
+
+func TestCollectionUsecase_DeleteCollection_OtherUsersCollection(t *testing.T) {
+	// Setup
+	collectionRepo := &mocks.MusicCollectionRepository{}
+	memberRepo := &mocks.CollectionMemberRepository{}
+
+	collectionUsecase := NewCollectionUsecase(collectionRepo, nil, memberRepo, nil, nil, nil, nil, nil, nil)
+
+	// Expectations
+	collectionRepo.On("FindByID", uint(10)).Return(&entities.MusicCollection{ID: 10, UserID: 2}, nil)
+	memberRepo.On("FindByCollectionIDAndUserID", uint(10), uint(1)).Return(nil, repositories.ErrNotFound)
+
+	// Execute
+	err := collectionUsecase.DeleteCollection(1, 10)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrCollectionNotFound)
+
+	// Verify
+	collectionRepo.AssertNotCalled(t, "Delete", mock.Anything)
+}
+
+func TestCollectionUsecase_AddTracks(t *testing.T) {
+	// Setup
+	collectionRepo := &mocks.MusicCollectionRepository{}
+	collectionMusicRepo := &mocks.CollectionMusicMappingRepository{}
+	musicRepo := &mocks.MusicRepository{}
+
+	collectionUsecase := NewCollectionUsecase(collectionRepo, collectionMusicRepo, nil, nil, musicRepo, nil, nil, nil, nil)
+
+	// Expectations
+	collectionRepo.On("FindByID", uint(10)).Return(&entities.MusicCollection{ID: 10, UserID: 1}, nil)
+	collectionMusicRepo.On("FindByCollectionID", uint(10)).Return([]*entities.CollectionMusicMapping{{CollectionID: 10, MusicID: 3}}, nil)
+	musicRepo.On("FindByID", uint(3)).Return(&entities.Music{ID: 3}, nil)
+	musicRepo.On("FindByID", uint(4)).Return(&entities.Music{ID: 4}, nil)
+	// 6 is a merged duplicate of 5, so only one of them is added.
+	musicRepo.On("FindByID", uint(5)).Return(&entities.Music{ID: 5}, nil)
+	musicRepo.On("FindByID", uint(6)).Return(&entities.Music{ID: 5}, nil)
+	collectionMusicRepo.On("CreateBatch", mock.MatchedBy(func(m []*entities.CollectionMusicMapping) bool {
+		return len(m) == 2 && m[0].MusicID == 4 && m[1].MusicID == 5 && m[0].CollectionID == 10 && *m[0].AddedBy == 1
+	})).Return(nil)
+
+	// Execute
+	output, err := collectionUsecase.AddTracks(1, 10, []uint{3, 4, 5, 6})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 2, output.Added)
+
+	// Verify
+	collectionMusicRepo.AssertExpectations(t)
+	musicRepo.AssertExpectations(t)
+}
+
+func TestCollectionUsecase_AddTracks_MusicNotFound(t *testing.T) {
+	// Setup
+	collectionRepo := &mocks.MusicCollectionRepository{}
+	collectionMusicRepo := &mocks.CollectionMusicMappingRepository{}
+	musicRepo := &mocks.MusicRepository{}
+
+	collectionUsecase := NewCollectionUsecase(collectionRepo, collectionMusicRepo, nil, nil, musicRepo, nil, nil, nil, nil)
+
+	// Expectations
+	collectionRepo.On("FindByID", uint(10)).Return(&entities.MusicCollection{ID: 10, UserID: 1}, nil)
+	collectionMusicRepo.On("FindByCollectionID", uint(10)).Return([]*entities.CollectionMusicMapping{}, nil)
+	musicRepo.On("FindByID", uint(3)).Return(&entities.Music{ID: 3}, nil)
+	musicRepo.On("FindByID", uint(99)).Return(nil, repositories.ErrNotFound)
+
+	// Execute
+	_, err := collectionUsecase.AddTracks(1, 10, []uint{3, 99})
+
+	// Assert
+	assert.ErrorIs(t, err, ErrMusicNotFound)
+
+	// Verify
+	collectionMusicRepo.AssertNotCalled(t, "CreateBatch", mock.Anything)
+}
+
+func TestCollectionUsecase_MoveTrack_NotInCollection(t *testing.T) {
+	// Setup
+	collectionRepo := &mocks.MusicCollectionRepository{}
+	collectionMusicRepo := &mocks.CollectionMusicMappingRepository{}
+
+	collectionUsecase := NewCollectionUsecase(collectionRepo, collectionMusicRepo, nil, nil, nil, nil, nil, nil, nil)
+
+	// Expectations
+	collectionRepo.On("FindByID", uint(10)).Return(&entities.MusicCollection{ID: 10, UserID: 1}, nil)
+	collectionMusicRepo.On("Move", uint(10), uint(3), 0).Return(repositories.ErrNotFound)
+
+	// Execute
+	err := collectionUsecase.MoveTrack(1, 10, 3, 0)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrCollectionTrackNotFound)
+
+	// Verify
+	collectionMusicRepo.AssertExpectations(t)
+}
+
+func TestCollectionUsecase_ListUserCollections(t *testing.T) {
+	testCases := []struct {
+		name           string
+		viewerID       uint
+		includePrivate bool
+	}{
+		{name: "Owner", viewerID: 1, includePrivate: true},
+		{name: "OtherUser", viewerID: 2, includePrivate: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup
+			collectionRepo := &mocks.MusicCollectionRepository{}
+			userRepo := &mocks.UserRepository{}
+			blockRepo := &mocks.UserBlockRepository{}
+
+			collectionUsecase := NewCollectionUsecase(collectionRepo, nil, nil, nil, nil, nil, userRepo, blockRepo, nil)
+
+			// Expectations
+			userRepo.On("FindByID", uint(1)).Return(&entities.User{ID: 1}, nil)
+			blockRepo.On("ExistsBetween", tc.viewerID, uint(1)).Return(false, nil)
+			collectionRepo.On("FindByUserID", uint(1), tc.includePrivate, 0, defaultPageLimit).
+				Return([]*entities.MusicCollection{{ID: 10, UserID: 1, Name: "Road Trip", IsPublic: true}}, nil)
+			collectionRepo.On("CountByUserID", uint(1), tc.includePrivate).Return(int64(1), nil)
+
+			// Execute
+			output, err := collectionUsecase.ListUserCollections(tc.viewerID, 1, nil, nil)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, 1, output.Total)
+			assert.Equal(t, "Road Trip", output.Collections[0].Name)
+
+			// Verify
+			collectionRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCollectionUsecase_ListUserCollections_Blocked(t *testing.T) {
+	// Setup
+	collectionRepo := &mocks.MusicCollectionRepository{}
+	userRepo := &mocks.UserRepository{}
+	blockRepo := &mocks.UserBlockRepository{}
+
+	collectionUsecase := NewCollectionUsecase(collectionRepo, nil, nil, nil, nil, nil, userRepo, blockRepo, nil)
+
+	// Expectations
+	userRepo.On("FindByID", uint(1)).Return(&entities.User{ID: 1}, nil)
+	blockRepo.On("ExistsBetween", uint(2), uint(1)).Return(true, nil)
+
+	// Execute
+	output, err := collectionUsecase.ListUserCollections(2, 1, nil, nil)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrUserNotFound)
+	assert.Nil(t, output)
+
+	// Verify
+	collectionRepo.AssertNotCalled(t, "FindByUserID", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCollectionUsecase_ListUserCollections_Hidden(t *testing.T) {
+	// Setup
+	collectionRepo := &mocks.MusicCollectionRepository{}
+	userRepo := &mocks.UserRepository{}
+	blockRepo := &mocks.UserBlockRepository{}
+
+	collectionUsecase := NewCollectionUsecase(collectionRepo, nil, nil, nil, nil, nil, userRepo, blockRepo, nil)
+
+	// Expectations
+	userRepo.On("FindByID", uint(1)).Return(&entities.User{ID: 1, UserProfile: &entities.UserProfile{HideCollections: true}}, nil)
+	blockRepo.On("ExistsBetween", uint(2), uint(1)).Return(false, nil)
+
+	// Execute
+	output, err := collectionUsecase.ListUserCollections(2, 1, nil, nil)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, &ListCollectionsOutput{Collections: []CollectionOutput{}}, output)
+
+	// Verify
+	collectionRepo.AssertNotCalled(t, "FindByUserID", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCollectionUsecase_AddTracks_Roles(t *testing.T) {
+	testCases := []struct {
+		name        string
+		role        string
+		isPublic    bool
+		expectedErr error
+	}{
+		{name: "Editor", role: entities.CollectionRoleEditor, expectedErr: nil},
+		{name: "Viewer", role: entities.CollectionRoleViewer, expectedErr: ErrCollectionPermissionDenied},
+		{name: "NonMemberOfPublicCollection", isPublic: true, expectedErr: ErrCollectionPermissionDenied},
+		{name: "NonMemberOfPrivateCollection", expectedErr: ErrCollectionNotFound},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup
+			collectionRepo := &mocks.MusicCollectionRepository{}
+			collectionMusicRepo := &mocks.CollectionMusicMappingRepository{}
+			memberRepo := &mocks.CollectionMemberRepository{}
+			musicRepo := &mocks.MusicRepository{}
+
+			collectionUsecase := NewCollectionUsecase(collectionRepo, collectionMusicRepo, memberRepo, nil, musicRepo, nil, nil, nil, nil)
+
+			// Expectations
+			collectionRepo.On("FindByID", uint(10)).Return(&entities.MusicCollection{ID: 10, UserID: 1, IsPublic: tc.isPublic}, nil)
+			if tc.role != "" {
+				memberRepo.On("FindByCollectionIDAndUserID", uint(10), uint(2)).Return(&entities.CollectionMember{CollectionID: 10, UserID: 2, Role: tc.role}, nil)
+			} else {
+				memberRepo.On("FindByCollectionIDAndUserID", uint(10), uint(2)).Return(nil, repositories.ErrNotFound)
+			}
+			collectionMusicRepo.On("FindByCollectionID", uint(10)).Return([]*entities.CollectionMusicMapping{}, nil)
+			musicRepo.On("FindByID", uint(3)).Return(&entities.Music{ID: 3}, nil)
+			collectionMusicRepo.On("CreateBatch", mock.MatchedBy(func(m []*entities.CollectionMusicMapping) bool {
+				return len(m) == 1 && *m[0].AddedBy == 2
+			})).Return(nil)
+
+			// Execute
+			_, err := collectionUsecase.AddTracks(2, 10, []uint{3})
+
+			// Assert
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestCollectionUsecase_AcceptInvite(t *testing.T) {
+	future := time.Now().Add(time.Hour)
+	past := time.Now().Add(-time.Hour)
+
+	t.Run("JoinsCollection", func(t *testing.T) {
+		// Setup
+		memberRepo := &mocks.CollectionMemberRepository{}
+		inviteRepo := &mocks.CollectionInviteRepository{}
+
+		collectionUsecase := NewCollectionUsecase(nil, nil, memberRepo, inviteRepo, nil, nil, nil, nil, nil)
+
+		// Expectations
+		inviteRepo.On("FindByToken", "token").Return(&entities.CollectionInvite{CollectionID: 10, Role: entities.CollectionRoleEditor, ExpiresAt: &future}, nil)
+		memberRepo.On("FindByCollectionIDAndUserID", uint(10), uint(2)).Return(nil, repositories.ErrNotFound)
+		memberRepo.On("Create", mock.MatchedBy(func(m *entities.CollectionMember) bool {
+			return m.CollectionID == 10 && m.UserID == 2 && m.Role == entities.CollectionRoleEditor
+		})).Return(nil)
+
+		// Execute
+		output, err := collectionUsecase.AcceptInvite(2, "token")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, entities.CollectionRoleEditor, output.Role)
+
+		// Verify
+		memberRepo.AssertExpectations(t)
+	})
+
+	t.Run("KeepsHigherRole", func(t *testing.T) {
+		// Setup
+		memberRepo := &mocks.CollectionMemberRepository{}
+		inviteRepo := &mocks.CollectionInviteRepository{}
+
+		collectionUsecase := NewCollectionUsecase(nil, nil, memberRepo, inviteRepo, nil, nil, nil, nil, nil)
+
+		// Expectations
+		inviteRepo.On("FindByToken", "token").Return(&entities.CollectionInvite{CollectionID: 10, Role: entities.CollectionRoleViewer, ExpiresAt: &future}, nil)
+		memberRepo.On("FindByCollectionIDAndUserID", uint(10), uint(1)).Return(&entities.CollectionMember{CollectionID: 10, UserID: 1, Role: entities.CollectionRoleOwner}, nil)
+
+		// Execute
+		output, err := collectionUsecase.AcceptInvite(1, "token")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, entities.CollectionRoleOwner, output.Role)
+
+		// Verify
+		memberRepo.AssertNotCalled(t, "Update", mock.Anything)
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		// Setup
+		inviteRepo := &mocks.CollectionInviteRepository{}
+
+		collectionUsecase := NewCollectionUsecase(nil, nil, nil, inviteRepo, nil, nil, nil, nil, nil)
+
+		// Expectations
+		inviteRepo.On("FindByToken", "token").Return(&entities.CollectionInvite{CollectionID: 10, Role: entities.CollectionRoleEditor, ExpiresAt: &past}, nil)
+
+		// Execute
+		_, err := collectionUsecase.AcceptInvite(2, "token")
+
+		// Assert
+		assert.ErrorIs(t, err, ErrCollectionInviteExpired)
+	})
+}
+
+func TestCollectionUsecase_RemoveMember(t *testing.T) {
+	testCases := []struct {
+		name        string
+		userID      uint
+		memberID    uint
+		role        string
+		expectedErr error
+	}{
+		{name: "OwnerRemovesEditor", userID: 1, memberID: 2, expectedErr: nil},
+		{name: "MemberLeaves", userID: 2, memberID: 2, role: entities.CollectionRoleViewer, expectedErr: nil},
+		{name: "EditorRemovesOther", userID: 2, memberID: 3, role: entities.CollectionRoleEditor, expectedErr: ErrCollectionPermissionDenied},
+		{name: "OwnerLeaves", userID: 1, memberID: 1, expectedErr: ErrCollectionOwnerImmutable},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup
+			collectionRepo := &mocks.MusicCollectionRepository{}
+			memberRepo := &mocks.CollectionMemberRepository{}
+
+			collectionUsecase := NewCollectionUsecase(collectionRepo, nil, memberRepo, nil, nil, nil, nil, nil, nil)
+
+			// Expectations
+			collectionRepo.On("FindByID", uint(10)).Return(&entities.MusicCollection{ID: 10, UserID: 1}, nil)
+			if tc.role != "" {
+				memberRepo.On("FindByCollectionIDAndUserID", uint(10), tc.userID).Return(&entities.CollectionMember{CollectionID: 10, UserID: tc.userID, Role: tc.role}, nil)
+			}
+			memberRepo.On("DeleteByCollectionIDAndUserID", uint(10), tc.memberID).Return(nil)
+
+			// Execute
+			err := collectionUsecase.RemoveMember(tc.userID, 10, tc.memberID)
+
+			// Assert
+			assert.ErrorIs(t, err, tc.expectedErr)
+			if tc.expectedErr != nil {
+				memberRepo.AssertNotCalled(t, "DeleteByCollectionIDAndUserID", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
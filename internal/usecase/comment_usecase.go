@@ -0,0 +1,397 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/contentfilter"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/logging"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"go.uber.org/zap"
+)
+
+type CommentUsecase interface {
+	CreateComment(ctx context.Context, userID, postID uint, input *CreateCommentInput) (*CommentOutput, error)
+	GetComment(viewerID, commentID uint) (*CommentOutput, error)
+	ListComments(viewerID, postID uint, limit, offset *int) (*ListCommentsOutput, error)
+	PatchComment(ctx context.Context, userID, commentID uint, input *PatchCommentInput) (*CommentOutput, error)
+	DeleteComment(userID, commentID uint) error
+}
+
+const (
+	DefaultCommentMaxDepth = 5
+
+	// deletedCommentContent replaces the content of deleted comments that are
+	// kept because they still have replies.
+	deletedCommentContent = "[deleted]"
+	// hiddenCommentContent replaces the content of comments hidden by a
+	// moderator.
+	hiddenCommentContent = "[hidden]"
+	// blockedCommentContent replaces the content of comments by users the
+	// viewer blocked or who blocked the viewer.
+	blockedCommentContent = "[blocked]"
+)
+
+type commentUsecase struct {
+	commentRepo repositories.CommentRepository
+	postRepo    repositories.PostRepository
+	banRepo     repositories.CommunityBanRepository
+	blockRepo   repositories.UserBlockRepository
+
+	musicEmbedder *MusicEmbedder
+	screener      *ContentScreener
+	notifier      *Notifier
+	maxDepth      int
+}
+
+// NewCommentUsecase creates a comment usecase that accepts replies up to
+// maxDepth levels below a top-level comment. A non-positive maxDepth falls
+// back to DefaultCommentMaxDepth.
+func NewCommentUsecase(commentRepo repositories.CommentRepository, postRepo repositories.PostRepository, banRepo repositories.CommunityBanRepository, blockRepo repositories.UserBlockRepository, musicEmbedder *MusicEmbedder, maxDepth int, screener *ContentScreener, notifier *Notifier) CommentUsecase {
+	if maxDepth <= 0 {
+		maxDepth = DefaultCommentMaxDepth
+	}
+	return &commentUsecase{
+		commentRepo:   commentRepo,
+		postRepo:      postRepo,
+		banRepo:       banRepo,
+		blockRepo:     blockRepo,
+		musicEmbedder: musicEmbedder,
+		screener:      screener,
+		notifier:      notifier,
+		maxDepth:      maxDepth,
+	}
+}
+
+// CreateComment comments on the post, or replies to input.ParentID when set.
+// Replies must stay within the maximum depth and cannot be made to deleted
+// comments. The music the comment references is attached to it. Users banned
+// from the post's community cannot comment on it, and users cannot comment on
+// the posts of, or reply to, users they blocked or who blocked them. Comments
+// the content filter holds stay hidden until a moderator approves them.
+// Other comments notify the author of the post or parent comment and the
+// users they mention.
+func (u *commentUsecase) CreateComment(ctx context.Context, userID, postID uint, input *CreateCommentInput) (*CommentOutput, error) {
+	post, err := u.findPost(postID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := u.banRepo.FindByCommunityIDAndUserID(post.GenreCommunityID, userID); err == nil {
+		return nil, ErrCommunityBanned
+	} else if !errors.Is(err, repositories.ErrNotFound) {
+		return nil, ErrFindingRecord
+	}
+	if err := checkNotBlocked(u.blockRepo, userID, post.UserID); err != nil {
+		return nil, err
+	}
+	// Replies notify the author of the parent comment, and top-level
+	// comments the author of the post.
+	recipientID := post.UserID
+	if input.ParentID != nil {
+		parent, err := u.findComment(*input.ParentID)
+		if err != nil {
+			return nil, err
+		}
+		recipientID = parent.UserID
+		if parent.PostID != postID || parent.IsDeleted {
+			return nil, ErrInvalidCommentParent
+		}
+		if parent.Depth+1 > u.maxDepth {
+			return nil, ErrCommentTooDeep
+		}
+		if err := checkNotBlocked(u.blockRepo, userID, parent.UserID); err != nil {
+			return nil, err
+		}
+	}
+	screened, err := u.screener.screen(contentfilter.Content{Kind: contentfilter.KindComment, UserID: userID, PostID: postID, Body: input.Content})
+	if err != nil {
+		return nil, err
+	}
+	musicIDs, err := u.musicEmbedder.resolve(ctx, input.Music)
+	if err != nil {
+		return nil, err
+	}
+
+	comment := &entities.Comment{
+		UserID:   userID,
+		PostID:   postID,
+		ParentID: input.ParentID,
+		Content:  screened.Body,
+		IsHidden: screened.Action == contentfilter.ActionHold,
+	}
+	if err := u.commentRepo.Create(comment); err != nil {
+		return nil, ErrCreatingRecord
+	}
+	if comment.IsHidden {
+		if err := u.screener.hold(entities.ReportTargetComment, comment.ID, userID, post.GenreCommunityID, comment.Content, screened); err != nil {
+			return nil, err
+		}
+	}
+	// The comment is stored by now, so it is kept without its track cards
+	// rather than failing the request.
+	if err := u.musicEmbedder.attachToComment(comment.ID, musicIDs); err != nil {
+		logging.Log().Error("failed to attach music to comment", zap.Error(err), zap.Uint("comment_id", comment.ID))
+	}
+	if !comment.IsHidden {
+		u.notifier.notify(&entities.Notification{UserID: recipientID, ActorID: &userID, Type: entities.NotificationTypeReply, PostID: &postID, CommentID: &comment.ID})
+		u.notifier.notifyMentions(userID, comment.Content, &postID, &comment.ID, recipientID)
+	}
+	// Reload the comment so that the output includes the author.
+	return u.GetComment(userID, comment.ID)
+}
+
+// GetComment returns the comment with its replies. Comments by users the
+// viewer blocked or who blocked the viewer are shown as placeholders.
+func (u *commentUsecase) GetComment(viewerID, commentID uint) (*CommentOutput, error) {
+	comment, err := u.findComment(commentID)
+	if err != nil {
+		return nil, err
+	}
+	if viewerID != 0 && comment.UserID != viewerID {
+		if comment.Blocked, err = u.blockRepo.ExistsBetween(viewerID, comment.UserID); err != nil {
+			return nil, ErrFindingRecord
+		}
+	}
+	outputs, err := u.toCommentTree(repositories.Viewer{ID: viewerID}, []*entities.Comment{comment})
+	if err != nil {
+		return nil, err
+	}
+	return &outputs[0], nil
+}
+
+// ListComments pages through the post's top-level comments, oldest first,
+// each with all of its replies. Comments by users the viewer blocked or who
+// blocked the viewer are shown as placeholders, so that the replies below
+// them stay in place.
+func (u *commentUsecase) ListComments(viewerID, postID uint, limit, offset *int) (*ListCommentsOutput, error) {
+	if _, err := u.findPost(postID); err != nil {
+		return nil, err
+	}
+
+	l, o := pagination(limit, offset)
+	viewer := repositories.Viewer{ID: viewerID}
+	roots, err := u.commentRepo.FindRootsByPostID(viewer, postID, o, l)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	total, err := u.commentRepo.CountRootsByPostID(postID)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	comments, err := u.toCommentTree(viewer, roots)
+	if err != nil {
+		return nil, err
+	}
+	return &ListCommentsOutput{Comments: comments, Total: int(total)}, nil
+}
+
+// PatchComment updates the comment's content and attached music. Only the
+// author can edit a comment. Edited content goes through the content filter
+// like a new comment, and users it newly mentions are notified.
+func (u *commentUsecase) PatchComment(ctx context.Context, userID, commentID uint, input *PatchCommentInput) (*CommentOutput, error) {
+	comment, err := u.authoredComment(userID, commentID)
+	if err != nil {
+		return nil, err
+	}
+	mentionedBefore := comment.Content
+	var screened *contentfilter.Result
+	if input.Content != nil {
+		screened, err = u.screener.screen(contentfilter.Content{Kind: contentfilter.KindComment, ID: comment.ID, UserID: userID, PostID: comment.PostID, Body: *input.Content})
+		if err != nil {
+			return nil, err
+		}
+	}
+	var musicIDs []uint
+	if input.Music != nil {
+		if musicIDs, err = u.musicEmbedder.resolve(ctx, input.Music); err != nil {
+			return nil, err
+		}
+	}
+
+	var post *entities.Post
+	if screened != nil && screened.Action == contentfilter.ActionHold {
+		// The report goes to the moderators of the post's community.
+		if post, err = u.postRepo.FindByID(comment.PostID); err != nil {
+			return nil, ErrFindingRecord
+		}
+		comment.IsHidden = true
+	}
+	if screened != nil {
+		comment.Content = screened.Body
+	}
+	if err := u.commentRepo.Update(comment); err != nil {
+		return nil, ErrUpdatingRecord
+	}
+	if post != nil {
+		if err := u.screener.hold(entities.ReportTargetComment, comment.ID, userID, post.GenreCommunityID, comment.Content, screened); err != nil {
+			return nil, err
+		}
+	}
+	if input.Music != nil {
+		if err := u.musicEmbedder.attachToComment(comment.ID, musicIDs); err != nil {
+			logging.Log().Error("failed to attach music to comment", zap.Error(err), zap.Uint("comment_id", comment.ID))
+		}
+	}
+	if !comment.IsHidden {
+		u.notifier.notifyNewMentions(userID, mentionedBefore, comment.Content, &comment.PostID, &comment.ID)
+	}
+	return u.GetComment(userID, comment.ID)
+}
+
+// DeleteComment deletes the comment. Only the author can delete a comment.
+// A comment with replies is kept as a "[deleted]" tombstone so that the thread
+// stays intact; tombstones are removed once their last reply is gone.
+func (u *commentUsecase) DeleteComment(userID, commentID uint) error {
+	comment, err := u.authoredComment(userID, commentID)
+	if err != nil {
+		return err
+	}
+
+	if comment.ReplyCount > 0 {
+		comment.IsDeleted = true
+		comment.Content = ""
+		if err := u.commentRepo.Update(comment); err != nil {
+			return ErrUpdatingRecord
+		}
+		return u.musicEmbedder.attachToComment(comment.ID, nil)
+	}
+
+	for {
+		if err := u.commentRepo.Delete(comment.ID); err != nil {
+			return ErrDeletingRecord
+		}
+		if comment.ParentID == nil {
+			return nil
+		}
+		parent, err := u.commentRepo.FindByID(*comment.ParentID)
+		if err != nil {
+			return ErrFindingRecord
+		}
+		if !parent.IsDeleted || parent.ReplyCount > 0 {
+			return nil
+		}
+		comment = parent
+	}
+}
+
+// findPost returns the post unless a moderator has hidden it.
+func (u *commentUsecase) findPost(postID uint) (*entities.Post, error) {
+	post, err := u.postRepo.FindByID(postID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrPostNotFound
+		}
+		return nil, ErrFindingRecord
+	}
+	if post.IsHidden {
+		return nil, ErrPostNotFound
+	}
+	return post, nil
+}
+
+func (u *commentUsecase) findComment(commentID uint) (*entities.Comment, error) {
+	comment, err := u.commentRepo.FindByID(commentID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrCommentNotFound
+		}
+		return nil, ErrFindingRecord
+	}
+	return comment, nil
+}
+
+// authoredComment returns the comment if userID wrote it. Deleted comments
+// cannot be edited or deleted again.
+func (u *commentUsecase) authoredComment(userID, commentID uint) (*entities.Comment, error) {
+	comment, err := u.findComment(commentID)
+	if err != nil {
+		return nil, err
+	}
+	if comment.IsDeleted {
+		return nil, ErrCommentNotFound
+	}
+	if comment.UserID != userID {
+		return nil, ErrCommentPermissionDenied
+	}
+	return comment, nil
+}
+
+// toCommentTree loads the replies below the roots, with the track cards of
+// every comment, and nests them.
+func (u *commentUsecase) toCommentTree(viewer repositories.Viewer, roots []*entities.Comment) ([]CommentOutput, error) {
+	outputs := make([]CommentOutput, len(roots))
+	if len(roots) == 0 {
+		return outputs, nil
+	}
+
+	paths := make([]string, len(roots))
+	for i, root := range roots {
+		paths[i] = root.Path
+	}
+	replies, err := u.commentRepo.FindDescendants(viewer, roots[0].PostID, paths)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+
+	commentIDs := make([]uint, 0, len(roots)+len(replies))
+	for _, root := range roots {
+		commentIDs = append(commentIDs, root.ID)
+	}
+	// Replies are ordered by path, so siblings are appended oldest first.
+	children := make(map[uint][]*entities.Comment)
+	for _, reply := range replies {
+		commentIDs = append(commentIDs, reply.ID)
+		if reply.ParentID != nil {
+			children[*reply.ParentID] = append(children[*reply.ParentID], reply)
+		}
+	}
+	cards, err := u.musicEmbedder.commentCards(commentIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, root := range roots {
+		outputs[i] = toCommentOutput(root, children, cards)
+	}
+	return outputs, nil
+}
+
+func toCommentOutput(c *entities.Comment, children map[uint][]*entities.Comment, cards map[uint][]TrackCard) CommentOutput {
+	output := CommentOutput{
+		ID:         c.ID,
+		PostID:     c.PostID,
+		ParentID:   c.ParentID,
+		UserID:     c.UserID,
+		Nickname:   c.User.Nickname,
+		Content:    c.Content,
+		Depth:      c.Depth,
+		ReplyCount: c.ReplyCount,
+		Deleted:    c.IsDeleted,
+		Hidden:     c.IsHidden,
+		Blocked:    c.Blocked,
+		Tracks:     cards[c.ID],
+		CreatedAt:  c.CreatedAt,
+		UpdatedAt:  c.UpdatedAt,
+		Replies:    []CommentOutput{},
+	}
+	switch {
+	case c.IsDeleted:
+		output.UserID = 0
+		output.Nickname = ""
+		output.Content = deletedCommentContent
+		output.Tracks = nil
+	case c.Blocked:
+		output.UserID = 0
+		output.Nickname = ""
+		output.Content = blockedCommentContent
+		output.Tracks = nil
+	case c.IsHidden:
+		output.Content = hiddenCommentContent
+		output.Tracks = nil
+	}
+	for _, child := range children[c.ID] {
+		output.Replies = append(output.Replies, toCommentOutput(child, children, cards))
+	}
+	return output
+}
@@ -0,0 +1,286 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase/mocks"
+	"github.com/myjinjin/sonic-odyssey-backend/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCommentUsecase_CreateComment(t *testing.T) {
+	testCases := []struct {
+		name        string
+		parent      *entities.Comment
+		expectedErr error
+	}{
+		{name: "Reply", parent: &entities.Comment{ID: 7, UserID: 3, PostID: 5, Depth: 1}},
+		{name: "TooDeep", parent: &entities.Comment{ID: 7, PostID: 5, Depth: 2}, expectedErr: ErrCommentTooDeep},
+		{name: "DeletedParent", parent: &entities.Comment{ID: 7, PostID: 5, IsDeleted: true}, expectedErr: ErrInvalidCommentParent},
+		{name: "OtherPost", parent: &entities.Comment{ID: 7, PostID: 6}, expectedErr: ErrInvalidCommentParent},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup
+			commentRepo := &mocks.CommentRepository{}
+			attachmentRepo := &mocks.MusicAttachmentRepository{}
+			postRepo := &mocks.PostRepository{}
+			banRepo := &mocks.CommunityBanRepository{}
+			blockRepo := &mocks.UserBlockRepository{}
+			notificationRepo := &mocks.NotificationRepository{}
+			preferenceRepo := &mocks.NotificationPreferenceRepository{}
+			userRepo := &mocks.UserRepository{}
+
+			notifier := NewNotifier(notificationRepo, preferenceRepo, userRepo, blockRepo, nil, nil)
+			commentUsecase := NewCommentUsecase(commentRepo, postRepo, banRepo, blockRepo, &MusicEmbedder{attachmentRepo: attachmentRepo}, 2, unfilteredScreener, notifier)
+
+			// Expectations
+			postRepo.On("FindByID", uint(5)).Return(&entities.Post{ID: 5, UserID: 2, GenreCommunityID: 10}, nil)
+			banRepo.On("FindByCommunityIDAndUserID", uint(10), uint(1)).Return(nil, repositories.ErrNotFound)
+			blockRepo.On("ExistsBetween", uint(1), mock.Anything).Return(false, nil)
+			// Replies notify the author of the parent comment.
+			blockRepo.On("ExistsBetween", uint(3), uint(1)).Return(false, nil)
+			userRepo.On("FindByID", uint(1)).Return(&entities.User{ID: 1, Nickname: "author"}, nil)
+			preferenceRepo.On("FindByUserIDAndType", uint(3), entities.NotificationTypeReply).Return(nil, repositories.ErrNotFound)
+			notificationRepo.On("Create", mock.MatchedBy(func(n *entities.Notification) bool {
+				return n.UserID == 3 && n.Type == entities.NotificationTypeReply && *n.CommentID == 8
+			})).Return(nil)
+			commentRepo.On("FindByID", uint(7)).Return(tc.parent, nil)
+			commentRepo.On("Create", mock.MatchedBy(func(c *entities.Comment) bool {
+				return c.UserID == 1 && c.PostID == 5 && *c.ParentID == 7
+			})).Run(func(args mock.Arguments) {
+				args.Get(0).(*entities.Comment).ID = 8
+			}).Return(nil)
+			attachmentRepo.On("ReplaceByCommentID", uint(8), []uint{}).Return(nil)
+			commentRepo.On("FindByID", uint(8)).Return(&entities.Comment{ID: 8, UserID: 1, User: entities.User{Nickname: "author"}, PostID: 5, ParentID: utils.ToPtr(uint(7)), Path: "0000000007/0000000008/", Depth: 2}, nil)
+			commentRepo.On("FindDescendants", repositories.Viewer{ID: 1}, uint(5), []string{"0000000007/0000000008/"}).Return([]*entities.Comment{}, nil)
+			attachmentRepo.On("FindByCommentIDs", []uint{8}).Return([]*entities.MusicAttachment{}, nil)
+
+			// Execute
+			output, err := commentUsecase.CreateComment(context.Background(), 1, 5, &CreateCommentInput{Content: "Reply", ParentID: utils.ToPtr(uint(7))})
+
+			// Assert
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				commentRepo.AssertNotCalled(t, "Create", mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, uint(8), output.ID)
+			assert.Equal(t, "author", output.Nickname)
+
+			// Verify
+			commentRepo.AssertExpectations(t)
+			notificationRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCommentUsecase_CreateComment_AttachFails(t *testing.T) {
+	// Setup
+	commentRepo := &mocks.CommentRepository{}
+	attachmentRepo := &mocks.MusicAttachmentRepository{}
+	postRepo := &mocks.PostRepository{}
+	banRepo := &mocks.CommunityBanRepository{}
+	blockRepo := &mocks.UserBlockRepository{}
+
+	commentUsecase := NewCommentUsecase(commentRepo, postRepo, banRepo, blockRepo, &MusicEmbedder{attachmentRepo: attachmentRepo}, 2, unfilteredScreener, nil)
+
+	// Expectations
+	postRepo.On("FindByID", uint(5)).Return(&entities.Post{ID: 5, UserID: 1, GenreCommunityID: 10}, nil)
+	banRepo.On("FindByCommunityIDAndUserID", uint(10), uint(1)).Return(nil, repositories.ErrNotFound)
+	blockRepo.On("ExistsBetween", uint(1), uint(1)).Return(false, nil)
+	commentRepo.On("Create", mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(0).(*entities.Comment).ID = 8
+	}).Return(nil)
+	attachmentRepo.On("ReplaceByCommentID", uint(8), []uint{}).Return(repositories.ErrCreate)
+	commentRepo.On("FindByID", uint(8)).Return(&entities.Comment{ID: 8, UserID: 1, User: entities.User{Nickname: "author"}, PostID: 5, Path: "0000000008/"}, nil)
+	commentRepo.On("FindDescendants", repositories.Viewer{ID: 1}, uint(5), []string{"0000000008/"}).Return([]*entities.Comment{}, nil)
+	attachmentRepo.On("FindByCommentIDs", []uint{8}).Return([]*entities.MusicAttachment{}, nil)
+
+	// Execute
+	output, err := commentUsecase.CreateComment(context.Background(), 1, 5, &CreateCommentInput{Content: "Hi"})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, uint(8), output.ID)
+
+	// Verify
+	commentRepo.AssertExpectations(t)
+	attachmentRepo.AssertExpectations(t)
+}
+
+func TestCommentUsecase_CreateComment_Banned(t *testing.T) {
+	// Setup
+	commentRepo := &mocks.CommentRepository{}
+	postRepo := &mocks.PostRepository{}
+	banRepo := &mocks.CommunityBanRepository{}
+
+	commentUsecase := NewCommentUsecase(commentRepo, postRepo, banRepo, nil, &MusicEmbedder{}, 0, unfilteredScreener, nil)
+
+	// Expectations
+	postRepo.On("FindByID", uint(5)).Return(&entities.Post{ID: 5, GenreCommunityID: 10}, nil)
+	banRepo.On("FindByCommunityIDAndUserID", uint(10), uint(1)).Return(&entities.CommunityBan{CommunityID: 10, UserID: 1}, nil)
+
+	// Execute
+	output, err := commentUsecase.CreateComment(context.Background(), 1, 5, &CreateCommentInput{Content: "Hi"})
+
+	// Assert
+	assert.ErrorIs(t, err, ErrCommunityBanned)
+	assert.Nil(t, output)
+
+	// Verify
+	commentRepo.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func TestCommentUsecase_CreateComment_Blocked(t *testing.T) {
+	// Setup
+	commentRepo := &mocks.CommentRepository{}
+	postRepo := &mocks.PostRepository{}
+	banRepo := &mocks.CommunityBanRepository{}
+	blockRepo := &mocks.UserBlockRepository{}
+
+	commentUsecase := NewCommentUsecase(commentRepo, postRepo, banRepo, blockRepo, &MusicEmbedder{}, 2, unfilteredScreener, nil)
+
+	// Expectations
+	postRepo.On("FindByID", uint(5)).Return(&entities.Post{ID: 5, UserID: 2, GenreCommunityID: 10}, nil)
+	banRepo.On("FindByCommunityIDAndUserID", uint(10), uint(1)).Return(nil, repositories.ErrNotFound)
+	blockRepo.On("ExistsBetween", uint(1), uint(2)).Return(false, nil)
+	commentRepo.On("FindByID", uint(7)).Return(&entities.Comment{ID: 7, UserID: 3, PostID: 5}, nil)
+	blockRepo.On("ExistsBetween", uint(1), uint(3)).Return(true, nil)
+
+	// Execute
+	output, err := commentUsecase.CreateComment(context.Background(), 1, 5, &CreateCommentInput{Content: "Reply", ParentID: utils.ToPtr(uint(7))})
+
+	// Assert
+	assert.ErrorIs(t, err, ErrUserBlocked)
+	assert.Nil(t, output)
+
+	// Verify
+	blockRepo.AssertExpectations(t)
+	commentRepo.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func TestCommentUsecase_ListComments(t *testing.T) {
+	// Setup
+	commentRepo := &mocks.CommentRepository{}
+	attachmentRepo := &mocks.MusicAttachmentRepository{}
+	userLikeRepo := &mocks.UserLikeRepository{}
+	postRepo := &mocks.PostRepository{}
+
+	commentUsecase := NewCommentUsecase(commentRepo, postRepo, nil, nil, &MusicEmbedder{userLikeRepo: userLikeRepo, attachmentRepo: attachmentRepo}, 0, unfilteredScreener, nil)
+
+	roots := []*entities.Comment{
+		{ID: 1, PostID: 5, Path: "0000000001/", ReplyCount: 1, IsDeleted: true, UserID: 3},
+		{ID: 2, PostID: 5, Path: "0000000002/"},
+	}
+	replies := []*entities.Comment{
+		// Comments by blocked users keep their replies in the tree.
+		{ID: 3, PostID: 5, UserID: 6, User: entities.User{Nickname: "blocked"}, Content: "reply", ParentID: utils.ToPtr(uint(1)), Path: "0000000001/0000000003/", Depth: 1, ReplyCount: 1, Blocked: true},
+		{ID: 4, PostID: 5, ParentID: utils.ToPtr(uint(3)), Path: "0000000001/0000000003/0000000004/", Depth: 2},
+	}
+
+	// Expectations
+	postRepo.On("FindByID", uint(5)).Return(&entities.Post{ID: 5}, nil)
+	commentRepo.On("FindRootsByPostID", repositories.Viewer{ID: 1}, uint(5), 0, 20).Return(roots, nil)
+	commentRepo.On("CountRootsByPostID", uint(5)).Return(int64(2), nil)
+	commentRepo.On("FindDescendants", repositories.Viewer{ID: 1}, uint(5), []string{"0000000001/", "0000000002/"}).Return(replies, nil)
+	attachmentRepo.On("FindByCommentIDs", []uint{1, 2, 3, 4}).Return([]*entities.MusicAttachment{
+		{MusicID: 42, Music: entities.Music{ID: 42, Title: "Wise Up"}, CommentID: utils.ToPtr(uint(4))},
+	}, nil)
+	userLikeRepo.On("CountLikesAndDislikesByMusicID", uint(42)).Return(int64(2), int64(0), nil)
+
+	// Execute
+	output, err := commentUsecase.ListComments(1, 5, nil, nil)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 2, output.Total)
+	assert.Len(t, output.Comments, 2)
+	assert.Equal(t, "[deleted]", output.Comments[0].Content)
+	assert.Equal(t, uint(0), output.Comments[0].UserID)
+	assert.Equal(t, "[blocked]", output.Comments[0].Replies[0].Content)
+	assert.Empty(t, output.Comments[0].Replies[0].Nickname)
+	assert.True(t, output.Comments[0].Replies[0].Blocked)
+	assert.Equal(t, uint(4), output.Comments[0].Replies[0].Replies[0].ID)
+	assert.Equal(t, "Wise Up", output.Comments[0].Replies[0].Replies[0].Tracks[0].Title)
+	assert.Equal(t, int64(2), output.Comments[0].Replies[0].Replies[0].Tracks[0].Likes)
+	assert.Empty(t, output.Comments[1].Replies)
+
+	// Verify
+	commentRepo.AssertExpectations(t)
+}
+
+func TestCommentUsecase_DeleteComment(t *testing.T) {
+	t.Run("WithReplies", func(t *testing.T) {
+		// Setup
+		commentRepo := &mocks.CommentRepository{}
+		attachmentRepo := &mocks.MusicAttachmentRepository{}
+
+		commentUsecase := NewCommentUsecase(commentRepo, nil, nil, nil, &MusicEmbedder{attachmentRepo: attachmentRepo}, 0, unfilteredScreener, nil)
+
+		// Expectations
+		commentRepo.On("FindByID", uint(3)).Return(&entities.Comment{ID: 3, UserID: 1, Content: "Hello", ReplyCount: 2}, nil)
+		commentRepo.On("Update", mock.MatchedBy(func(c *entities.Comment) bool {
+			return c.IsDeleted && c.Content == ""
+		})).Return(nil)
+		attachmentRepo.On("ReplaceByCommentID", uint(3), []uint(nil)).Return(nil)
+
+		// Execute
+		err := commentUsecase.DeleteComment(1, 3)
+
+		// Assert
+		assert.NoError(t, err)
+
+		// Verify
+		commentRepo.AssertExpectations(t)
+		commentRepo.AssertNotCalled(t, "Delete", mock.Anything)
+	})
+
+	t.Run("PrunesTombstones", func(t *testing.T) {
+		// Setup
+		commentRepo := &mocks.CommentRepository{}
+		attachmentRepo := &mocks.MusicAttachmentRepository{}
+
+		commentUsecase := NewCommentUsecase(commentRepo, nil, nil, nil, &MusicEmbedder{attachmentRepo: attachmentRepo}, 0, unfilteredScreener, nil)
+
+		// Expectations
+		commentRepo.On("FindByID", uint(4)).Return(&entities.Comment{ID: 4, UserID: 1, ParentID: utils.ToPtr(uint(3))}, nil)
+		commentRepo.On("Delete", uint(4)).Return(nil)
+		commentRepo.On("FindByID", uint(3)).Return(&entities.Comment{ID: 3, ParentID: utils.ToPtr(uint(1)), IsDeleted: true}, nil)
+		commentRepo.On("Delete", uint(3)).Return(nil)
+		commentRepo.On("FindByID", uint(1)).Return(&entities.Comment{ID: 1, ReplyCount: 1}, nil)
+
+		// Execute
+		err := commentUsecase.DeleteComment(1, 4)
+
+		// Assert
+		assert.NoError(t, err)
+
+		// Verify
+		commentRepo.AssertExpectations(t)
+		commentRepo.AssertNotCalled(t, "Delete", uint(1))
+	})
+
+	t.Run("OtherUser", func(t *testing.T) {
+		// Setup
+		commentRepo := &mocks.CommentRepository{}
+		attachmentRepo := &mocks.MusicAttachmentRepository{}
+
+		commentUsecase := NewCommentUsecase(commentRepo, nil, nil, nil, &MusicEmbedder{attachmentRepo: attachmentRepo}, 0, unfilteredScreener, nil)
+
+		// Expectations
+		commentRepo.On("FindByID", uint(3)).Return(&entities.Comment{ID: 3, UserID: 1}, nil)
+
+		// Execute
+		err := commentUsecase.DeleteComment(2, 3)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrCommentPermissionDenied)
+	})
+}
@@ -0,0 +1,440 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/jpeg"
+	_ "image/png"
+	"time"
+
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/logging"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/storage"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"go.uber.org/zap"
+)
+
+type CommunityUsecase interface {
+	CreateCommunity(userID uint, input *CreateCommunityInput) (*CommunityOutput, error)
+	ListCommunities(genreID *uint, limit, offset *int) (*ListCommunitiesOutput, error)
+	GetCommunity(viewerID, communityID uint) (*GetCommunityOutput, error)
+	PatchCommunity(userID, communityID uint, input *PatchCommunityInput) (*CommunityOutput, error)
+	UpdateCommunityImage(userID, communityID uint, kind string, data []byte) (*CommunityOutput, error)
+	JoinCommunity(userID, communityID uint) (*CommunityMemberOutput, error)
+	LeaveCommunity(userID, communityID uint) error
+	ListModerators(communityID uint) (*ListCommunityMembersOutput, error)
+	AssignModerator(userID, communityID, memberID uint) (*CommunityMemberOutput, error)
+	RemoveModerator(userID, communityID, memberID uint) error
+}
+
+const (
+	CommunityImageBanner = "banner"
+	CommunityImageIcon   = "icon"
+
+	communityImageTimeout = 30 * time.Second
+)
+
+// communityImageMaxSize limits the pixel dimensions of uploaded community
+// images, so that decompression bombs are rejected before they are stored.
+var communityImageMaxSize = map[string]image.Point{
+	CommunityImageBanner: {X: 3000, Y: 1000},
+	CommunityImageIcon:   {X: 1000, Y: 1000},
+}
+
+var communityImageExtensions = map[string]string{
+	"png":  ".png",
+	"jpeg": ".jpg",
+}
+
+type communityUsecase struct {
+	communityRepo repositories.GenresCommunityRepository
+	memberRepo    repositories.CommunityMemberRepository
+	banRepo       repositories.CommunityBanRepository
+	genreRepo     repositories.GenreRepository
+	userRepo      repositories.UserRepository
+	storage       storage.Storage
+}
+
+func NewCommunityUsecase(communityRepo repositories.GenresCommunityRepository, memberRepo repositories.CommunityMemberRepository, banRepo repositories.CommunityBanRepository, genreRepo repositories.GenreRepository, userRepo repositories.UserRepository, storage storage.Storage) CommunityUsecase {
+	return &communityUsecase{
+		communityRepo: communityRepo,
+		memberRepo:    memberRepo,
+		banRepo:       banRepo,
+		genreRepo:     genreRepo,
+		userRepo:      userRepo,
+		storage:       storage,
+	}
+}
+
+// CreateCommunity creates a community for the genre. Only admins can create
+// communities.
+func (u *communityUsecase) CreateCommunity(userID uint, input *CreateCommunityInput) (*CommunityOutput, error) {
+	if err := u.requireAdmin(userID); err != nil {
+		return nil, err
+	}
+	if _, err := u.genreRepo.FindByID(input.GenreID); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrGenreNotFound
+		}
+		return nil, ErrFindingRecord
+	}
+
+	community := &entities.GenreCommunity{
+		GenreID:     input.GenreID,
+		Name:        input.Name,
+		Description: input.Description,
+		Rules:       input.Rules,
+	}
+	if err := u.communityRepo.Create(community); err != nil {
+		return nil, ErrCreatingRecord
+	}
+	output := toCommunityOutput(community)
+	return &output, nil
+}
+
+// ListCommunities returns communities ordered by member count, optionally
+// limited to a single genre.
+func (u *communityUsecase) ListCommunities(genreID *uint, limit, offset *int) (*ListCommunitiesOutput, error) {
+	l, o := pagination(limit, offset)
+	communities, err := u.communityRepo.FindAll(genreID, o, l)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	total, err := u.communityRepo.Count(genreID)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+
+	output := &ListCommunitiesOutput{Communities: make([]CommunityOutput, len(communities)), Total: int(total)}
+	for i, c := range communities {
+		output.Communities[i] = toCommunityOutput(c)
+	}
+	return output, nil
+}
+
+// GetCommunity returns the community with its moderators and the viewer's
+// role in it.
+func (u *communityUsecase) GetCommunity(viewerID, communityID uint) (*GetCommunityOutput, error) {
+	community, err := u.findCommunity(communityID)
+	if err != nil {
+		return nil, err
+	}
+	role, err := u.memberRole(community.ID, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	moderators, err := u.ListModerators(community.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &GetCommunityOutput{
+		Community:  toCommunityOutput(community),
+		Role:       role,
+		Moderators: moderators.Members,
+	}, nil
+}
+
+// PatchCommunity updates the community's details. Admins and the community's
+// moderators can update a community, but only admins can move it to another
+// genre.
+func (u *communityUsecase) PatchCommunity(userID, communityID uint, input *PatchCommunityInput) (*CommunityOutput, error) {
+	community, err := u.manageableCommunity(userID, communityID)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.GenreID != nil && *input.GenreID != community.GenreID {
+		if err := u.requireAdmin(userID); err != nil {
+			return nil, err
+		}
+		if _, err := u.genreRepo.FindByID(*input.GenreID); err != nil {
+			if errors.Is(err, repositories.ErrNotFound) {
+				return nil, ErrGenreNotFound
+			}
+			return nil, ErrFindingRecord
+		}
+		community.GenreID = *input.GenreID
+	}
+	if input.Name != nil {
+		community.Name = *input.Name
+	}
+	if input.Description != nil {
+		community.Description = *input.Description
+	}
+	if input.Rules != nil {
+		community.Rules = *input.Rules
+	}
+	if err := u.communityRepo.Update(community); err != nil {
+		return nil, ErrUpdatingRecord
+	}
+	output := toCommunityOutput(community)
+	return &output, nil
+}
+
+// UpdateCommunityImage stores a PNG or JPEG image as the community's banner
+// or icon. Images are stored under a key derived from their content, so a
+// new upload never overwrites an image that is still cached elsewhere.
+func (u *communityUsecase) UpdateCommunityImage(userID, communityID uint, kind string, data []byte) (*CommunityOutput, error) {
+	maxSize, ok := communityImageMaxSize[kind]
+	if !ok {
+		return nil, ErrInvalidCommunityImage
+	}
+	community, err := u.manageableCommunity(userID, communityID)
+	if err != nil {
+		return nil, err
+	}
+
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrInvalidCommunityImage
+	}
+	ext, ok := communityImageExtensions[format]
+	if !ok || config.Width > maxSize.X || config.Height > maxSize.Y {
+		return nil, ErrInvalidCommunityImage
+	}
+
+	sum := sha256.Sum256(data)
+	key := fmt.Sprintf("communities/%d/%s-%s%s", community.ID, kind, hex.EncodeToString(sum[:16]), ext)
+	ctx, cancel := context.WithTimeout(context.Background(), communityImageTimeout)
+	defer cancel()
+	url, err := u.storage.Put(ctx, key, data, "image/"+format)
+	if err != nil {
+		logging.Log().Error("failed to store community image", zap.Error(err), zap.Uint("community_id", community.ID))
+		return nil, ErrStoringFile
+	}
+
+	if kind == CommunityImageBanner {
+		community.BannerURL = url
+	} else {
+		community.IconURL = url
+	}
+	if err := u.communityRepo.Update(community); err != nil {
+		return nil, ErrUpdatingRecord
+	}
+	output := toCommunityOutput(community)
+	return &output, nil
+}
+
+// JoinCommunity makes the user a member of the community. Joining a community
+// the user is already a member of keeps the existing membership. Users banned
+// from the community cannot join it again.
+func (u *communityUsecase) JoinCommunity(userID, communityID uint) (*CommunityMemberOutput, error) {
+	community, err := u.findCommunity(communityID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := u.banRepo.FindByCommunityIDAndUserID(community.ID, userID); err == nil {
+		return nil, ErrCommunityBanned
+	} else if !errors.Is(err, repositories.ErrNotFound) {
+		return nil, ErrFindingRecord
+	}
+
+	member, err := u.memberRepo.FindByCommunityIDAndUserID(community.ID, userID)
+	switch {
+	case err == nil:
+	case errors.Is(err, repositories.ErrNotFound):
+		member = &entities.CommunityMember{CommunityID: community.ID, UserID: userID, Role: entities.CommunityRoleMember}
+		if err := u.memberRepo.Create(member); err != nil {
+			return nil, ErrCreatingRecord
+		}
+	default:
+		return nil, ErrFindingRecord
+	}
+	output := toCommunityMemberOutput(member)
+	return &output, nil
+}
+
+// LeaveCommunity removes the user from the community. Moderators who leave
+// lose their moderator role.
+func (u *communityUsecase) LeaveCommunity(userID, communityID uint) error {
+	community, err := u.findCommunity(communityID)
+	if err != nil {
+		return err
+	}
+	if err := u.memberRepo.DeleteByCommunityIDAndUserID(community.ID, userID); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrCommunityMemberNotFound
+		}
+		return ErrDeletingRecord
+	}
+	return nil
+}
+
+func (u *communityUsecase) ListModerators(communityID uint) (*ListCommunityMembersOutput, error) {
+	community, err := u.findCommunity(communityID)
+	if err != nil {
+		return nil, err
+	}
+	members, err := u.memberRepo.FindByCommunityIDAndRole(community.ID, entities.CommunityRoleModerator)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+
+	output := &ListCommunityMembersOutput{Members: make([]CommunityMemberOutput, len(members))}
+	for i, m := range members {
+		output.Members[i] = toCommunityMemberOutput(m)
+	}
+	return output, nil
+}
+
+// AssignModerator makes the user a moderator of the community, joining them
+// to it if they are not a member yet. Only admins can assign moderators.
+func (u *communityUsecase) AssignModerator(userID, communityID, memberID uint) (*CommunityMemberOutput, error) {
+	if err := u.requireAdmin(userID); err != nil {
+		return nil, err
+	}
+	community, err := u.findCommunity(communityID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := u.userRepo.FindByID(memberID); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, ErrFindingRecord
+	}
+
+	member, err := u.memberRepo.FindByCommunityIDAndUserID(community.ID, memberID)
+	switch {
+	case err == nil:
+		if member.Role != entities.CommunityRoleModerator {
+			member.Role = entities.CommunityRoleModerator
+			if err := u.memberRepo.Update(member); err != nil {
+				return nil, ErrUpdatingRecord
+			}
+		}
+	case errors.Is(err, repositories.ErrNotFound):
+		member = &entities.CommunityMember{CommunityID: community.ID, UserID: memberID, Role: entities.CommunityRoleModerator}
+		if err := u.memberRepo.Create(member); err != nil {
+			return nil, ErrCreatingRecord
+		}
+	default:
+		return nil, ErrFindingRecord
+	}
+	output := toCommunityMemberOutput(member)
+	return &output, nil
+}
+
+// RemoveModerator turns a moderator back into a regular member. Only admins
+// can remove moderators.
+func (u *communityUsecase) RemoveModerator(userID, communityID, memberID uint) error {
+	if err := u.requireAdmin(userID); err != nil {
+		return err
+	}
+	community, err := u.findCommunity(communityID)
+	if err != nil {
+		return err
+	}
+
+	member, err := u.memberRepo.FindByCommunityIDAndUserID(community.ID, memberID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrCommunityMemberNotFound
+		}
+		return ErrFindingRecord
+	}
+	if member.Role != entities.CommunityRoleModerator {
+		return ErrCommunityMemberNotFound
+	}
+	member.Role = entities.CommunityRoleMember
+	if err := u.memberRepo.Update(member); err != nil {
+		return ErrUpdatingRecord
+	}
+	return nil
+}
+
+func (u *communityUsecase) findCommunity(communityID uint) (*entities.GenreCommunity, error) {
+	community, err := u.communityRepo.FindByID(communityID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrCommunityNotFound
+		}
+		return nil, ErrFindingRecord
+	}
+	return community, nil
+}
+
+// memberRole returns the user's role in the community, or an empty string for
+// non-members.
+func (u *communityUsecase) memberRole(communityID, userID uint) (string, error) {
+	member, err := u.memberRepo.FindByCommunityIDAndUserID(communityID, userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return "", nil
+		}
+		return "", ErrFindingRecord
+	}
+	return member.Role, nil
+}
+
+func (u *communityUsecase) isAdmin(userID uint) (bool, error) {
+	user, err := u.userRepo.FindByID(userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return false, ErrUserNotFound
+		}
+		return false, ErrFindingRecord
+	}
+	return user.IsAdmin, nil
+}
+
+func (u *communityUsecase) requireAdmin(userID uint) error {
+	admin, err := u.isAdmin(userID)
+	if err != nil {
+		return err
+	}
+	if !admin {
+		return ErrCommunityPermissionDenied
+	}
+	return nil
+}
+
+// manageableCommunity returns the community if the user is an admin or one of
+// its moderators.
+func (u *communityUsecase) manageableCommunity(userID, communityID uint) (*entities.GenreCommunity, error) {
+	community, err := u.findCommunity(communityID)
+	if err != nil {
+		return nil, err
+	}
+	role, err := u.memberRole(community.ID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if role == entities.CommunityRoleModerator {
+		return community, nil
+	}
+	if err := u.requireAdmin(userID); err != nil {
+		return nil, err
+	}
+	return community, nil
+}
+
+func toCommunityOutput(c *entities.GenreCommunity) CommunityOutput {
+	return CommunityOutput{
+		ID:          c.ID,
+		GenreID:     c.GenreID,
+		Name:        c.Name,
+		Description: c.Description,
+		Rules:       c.Rules,
+		BannerURL:   c.BannerURL,
+		IconURL:     c.IconURL,
+		MemberCount: c.MemberCount,
+		CreatedAt:   c.CreatedAt,
+		UpdatedAt:   c.UpdatedAt,
+	}
+}
+
+func toCommunityMemberOutput(m *entities.CommunityMember) CommunityMemberOutput {
+	return CommunityMemberOutput{
+		CommunityID: m.CommunityID,
+		UserID:      m.UserID,
+		Nickname:    m.User.Nickname,
+		Role:        m.Role,
+		JoinedAt:    m.CreatedAt,
+	}
+}
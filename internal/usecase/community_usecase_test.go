@@ -0,0 +1,396 @@
+package usecase
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase/mocks"
+	"github.com/myjinjin/sonic-odyssey-backend/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCommunityUsecase_CreateCommunity(t *testing.T) {
+	t.Run("Admin", func(t *testing.T) {
+		// Setup
+		communityRepo := &mocks.GenresCommunityRepository{}
+		genreRepo := &mocks.GenreRepository{}
+		userRepo := &mocks.UserRepository{}
+
+		communityUsecase := NewCommunityUsecase(communityRepo, nil, nil, genreRepo, userRepo, nil)
+
+		// Expectations
+		userRepo.On("FindByID", uint(1)).Return(&entities.User{ID: 1, IsAdmin: true}, nil)
+		genreRepo.On("FindByID", uint(3)).Return(&entities.Genre{ID: 3, Name: "Indie Rock"}, nil)
+		communityRepo.On("Create", mock.MatchedBy(func(c *entities.GenreCommunity) bool {
+			return c.GenreID == 3 && c.Name == "Indie Rock Lovers" && c.Rules == "Be nice"
+		})).Run(func(args mock.Arguments) {
+			args.Get(0).(*entities.GenreCommunity).ID = 10
+		}).Return(nil)
+
+		// Execute
+		output, err := communityUsecase.CreateCommunity(1, &CreateCommunityInput{GenreID: 3, Name: "Indie Rock Lovers", Rules: "Be nice"})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, uint(10), output.ID)
+		assert.Equal(t, int64(0), output.MemberCount)
+
+		// Verify
+		communityRepo.AssertExpectations(t)
+	})
+
+	t.Run("NotAdmin", func(t *testing.T) {
+		// Setup
+		communityRepo := &mocks.GenresCommunityRepository{}
+		userRepo := &mocks.UserRepository{}
+
+		communityUsecase := NewCommunityUsecase(communityRepo, nil, nil, nil, userRepo, nil)
+
+		// Expectations
+		userRepo.On("FindByID", uint(2)).Return(&entities.User{ID: 2}, nil)
+
+		// Execute
+		_, err := communityUsecase.CreateCommunity(2, &CreateCommunityInput{GenreID: 3, Name: "Indie Rock Lovers"})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrCommunityPermissionDenied)
+
+		// Verify
+		communityRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+}
+
+func TestCommunityUsecase_GetCommunity(t *testing.T) {
+	// Setup
+	communityRepo := &mocks.GenresCommunityRepository{}
+	memberRepo := &mocks.CommunityMemberRepository{}
+
+	communityUsecase := NewCommunityUsecase(communityRepo, memberRepo, nil, nil, nil, nil)
+
+	// Expectations
+	communityRepo.On("FindByID", uint(10)).Return(&entities.GenreCommunity{ID: 10, Name: "Indie Rock Lovers", MemberCount: 2}, nil)
+	memberRepo.On("FindByCommunityIDAndUserID", uint(10), uint(1)).Return(&entities.CommunityMember{CommunityID: 10, UserID: 1, Role: entities.CommunityRoleMember}, nil)
+	memberRepo.On("FindByCommunityIDAndRole", uint(10), entities.CommunityRoleModerator).Return([]*entities.CommunityMember{
+		{CommunityID: 10, UserID: 2, User: entities.User{ID: 2, Nickname: "mod"}, Role: entities.CommunityRoleModerator},
+	}, nil)
+
+	// Execute
+	output, err := communityUsecase.GetCommunity(1, 10)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), output.Community.MemberCount)
+	assert.Equal(t, entities.CommunityRoleMember, output.Role)
+	assert.Len(t, output.Moderators, 1)
+	assert.Equal(t, "mod", output.Moderators[0].Nickname)
+
+	// Verify
+	memberRepo.AssertExpectations(t)
+}
+
+func TestCommunityUsecase_PatchCommunity(t *testing.T) {
+	t.Run("Moderator", func(t *testing.T) {
+		// Setup
+		communityRepo := &mocks.GenresCommunityRepository{}
+		memberRepo := &mocks.CommunityMemberRepository{}
+
+		communityUsecase := NewCommunityUsecase(communityRepo, memberRepo, nil, nil, nil, nil)
+
+		// Expectations
+		communityRepo.On("FindByID", uint(10)).Return(&entities.GenreCommunity{ID: 10, GenreID: 3, Name: "Old", Description: "Kept"}, nil)
+		memberRepo.On("FindByCommunityIDAndUserID", uint(10), uint(2)).Return(&entities.CommunityMember{Role: entities.CommunityRoleModerator}, nil)
+		communityRepo.On("Update", mock.MatchedBy(func(c *entities.GenreCommunity) bool {
+			return c.Name == "New" && c.Description == "Kept" && c.Rules == "No spam"
+		})).Return(nil)
+
+		// Execute
+		output, err := communityUsecase.PatchCommunity(2, 10, &PatchCommunityInput{
+			GenreID: utils.ToPtr(uint(3)),
+			Name:    utils.ToPtr("New"),
+			Rules:   utils.ToPtr("No spam"),
+		})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "New", output.Name)
+
+		// Verify
+		communityRepo.AssertExpectations(t)
+	})
+
+	t.Run("ModeratorCannotChangeGenre", func(t *testing.T) {
+		// Setup
+		communityRepo := &mocks.GenresCommunityRepository{}
+		memberRepo := &mocks.CommunityMemberRepository{}
+		userRepo := &mocks.UserRepository{}
+
+		communityUsecase := NewCommunityUsecase(communityRepo, memberRepo, nil, nil, userRepo, nil)
+
+		// Expectations
+		communityRepo.On("FindByID", uint(10)).Return(&entities.GenreCommunity{ID: 10, GenreID: 3}, nil)
+		memberRepo.On("FindByCommunityIDAndUserID", uint(10), uint(2)).Return(&entities.CommunityMember{Role: entities.CommunityRoleModerator}, nil)
+		userRepo.On("FindByID", uint(2)).Return(&entities.User{ID: 2}, nil)
+
+		// Execute
+		_, err := communityUsecase.PatchCommunity(2, 10, &PatchCommunityInput{GenreID: utils.ToPtr(uint(4))})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrCommunityPermissionDenied)
+
+		// Verify
+		communityRepo.AssertNotCalled(t, "Update", mock.Anything)
+	})
+
+	t.Run("Member", func(t *testing.T) {
+		// Setup
+		communityRepo := &mocks.GenresCommunityRepository{}
+		memberRepo := &mocks.CommunityMemberRepository{}
+		userRepo := &mocks.UserRepository{}
+
+		communityUsecase := NewCommunityUsecase(communityRepo, memberRepo, nil, nil, userRepo, nil)
+
+		// Expectations
+		communityRepo.On("FindByID", uint(10)).Return(&entities.GenreCommunity{ID: 10}, nil)
+		memberRepo.On("FindByCommunityIDAndUserID", uint(10), uint(3)).Return(&entities.CommunityMember{Role: entities.CommunityRoleMember}, nil)
+		userRepo.On("FindByID", uint(3)).Return(&entities.User{ID: 3}, nil)
+
+		// Execute
+		_, err := communityUsecase.PatchCommunity(3, 10, &PatchCommunityInput{Name: utils.ToPtr("New")})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrCommunityPermissionDenied)
+	})
+}
+
+func TestCommunityUsecase_UpdateCommunityImage(t *testing.T) {
+	var buf bytes.Buffer
+	_ = png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 64, 64)))
+	icon := buf.Bytes()
+
+	t.Run("Success", func(t *testing.T) {
+		// Setup
+		communityRepo := &mocks.GenresCommunityRepository{}
+		memberRepo := &mocks.CommunityMemberRepository{}
+		fileStorage := &mocks.Storage{}
+
+		communityUsecase := NewCommunityUsecase(communityRepo, memberRepo, nil, nil, nil, fileStorage)
+
+		// Expectations
+		communityRepo.On("FindByID", uint(10)).Return(&entities.GenreCommunity{ID: 10, BannerURL: "/static/banner.png"}, nil)
+		memberRepo.On("FindByCommunityIDAndUserID", uint(10), uint(2)).Return(&entities.CommunityMember{Role: entities.CommunityRoleModerator}, nil)
+		fileStorage.On("Put", mock.Anything, mock.MatchedBy(func(key string) bool {
+			return strings.HasPrefix(key, "communities/10/icon-") && strings.HasSuffix(key, ".png")
+		}), icon, "image/png").Return("/static/communities/10/icon.png", nil)
+		communityRepo.On("Update", mock.MatchedBy(func(c *entities.GenreCommunity) bool {
+			return c.IconURL == "/static/communities/10/icon.png" && c.BannerURL == "/static/banner.png"
+		})).Return(nil)
+
+		// Execute
+		output, err := communityUsecase.UpdateCommunityImage(2, 10, CommunityImageIcon, icon)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "/static/communities/10/icon.png", output.IconURL)
+
+		// Verify
+		fileStorage.AssertExpectations(t)
+		communityRepo.AssertExpectations(t)
+	})
+
+	t.Run("NotAnImage", func(t *testing.T) {
+		// Setup
+		communityRepo := &mocks.GenresCommunityRepository{}
+		memberRepo := &mocks.CommunityMemberRepository{}
+		fileStorage := &mocks.Storage{}
+
+		communityUsecase := NewCommunityUsecase(communityRepo, memberRepo, nil, nil, nil, fileStorage)
+
+		// Expectations
+		communityRepo.On("FindByID", uint(10)).Return(&entities.GenreCommunity{ID: 10}, nil)
+		memberRepo.On("FindByCommunityIDAndUserID", uint(10), uint(2)).Return(&entities.CommunityMember{Role: entities.CommunityRoleModerator}, nil)
+
+		// Execute
+		_, err := communityUsecase.UpdateCommunityImage(2, 10, CommunityImageBanner, []byte("<svg></svg>"))
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidCommunityImage)
+
+		// Verify
+		fileStorage.AssertNotCalled(t, "Put", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestCommunityUsecase_JoinCommunity(t *testing.T) {
+	t.Run("NewMember", func(t *testing.T) {
+		// Setup
+		communityRepo := &mocks.GenresCommunityRepository{}
+		memberRepo := &mocks.CommunityMemberRepository{}
+		banRepo := &mocks.CommunityBanRepository{}
+
+		communityUsecase := NewCommunityUsecase(communityRepo, memberRepo, banRepo, nil, nil, nil)
+
+		// Expectations
+		communityRepo.On("FindByID", uint(10)).Return(&entities.GenreCommunity{ID: 10}, nil)
+		banRepo.On("FindByCommunityIDAndUserID", uint(10), uint(1)).Return(nil, repositories.ErrNotFound)
+		memberRepo.On("FindByCommunityIDAndUserID", uint(10), uint(1)).Return(nil, repositories.ErrNotFound)
+		memberRepo.On("Create", &entities.CommunityMember{CommunityID: 10, UserID: 1, Role: entities.CommunityRoleMember}).Return(nil)
+
+		// Execute
+		output, err := communityUsecase.JoinCommunity(1, 10)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, entities.CommunityRoleMember, output.Role)
+
+		// Verify
+		memberRepo.AssertExpectations(t)
+	})
+
+	t.Run("AlreadyModerator", func(t *testing.T) {
+		// Setup
+		communityRepo := &mocks.GenresCommunityRepository{}
+		memberRepo := &mocks.CommunityMemberRepository{}
+		banRepo := &mocks.CommunityBanRepository{}
+
+		communityUsecase := NewCommunityUsecase(communityRepo, memberRepo, banRepo, nil, nil, nil)
+
+		// Expectations
+		communityRepo.On("FindByID", uint(10)).Return(&entities.GenreCommunity{ID: 10}, nil)
+		banRepo.On("FindByCommunityIDAndUserID", uint(10), uint(1)).Return(nil, repositories.ErrNotFound)
+		memberRepo.On("FindByCommunityIDAndUserID", uint(10), uint(1)).Return(&entities.CommunityMember{CommunityID: 10, UserID: 1, Role: entities.CommunityRoleModerator}, nil)
+
+		// Execute
+		output, err := communityUsecase.JoinCommunity(1, 10)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, entities.CommunityRoleModerator, output.Role)
+
+		// Verify
+		memberRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+	t.Run("Banned", func(t *testing.T) {
+		// Setup
+		communityRepo := &mocks.GenresCommunityRepository{}
+		memberRepo := &mocks.CommunityMemberRepository{}
+		banRepo := &mocks.CommunityBanRepository{}
+
+		communityUsecase := NewCommunityUsecase(communityRepo, memberRepo, banRepo, nil, nil, nil)
+
+		// Expectations
+		communityRepo.On("FindByID", uint(10)).Return(&entities.GenreCommunity{ID: 10}, nil)
+		banRepo.On("FindByCommunityIDAndUserID", uint(10), uint(1)).Return(&entities.CommunityBan{CommunityID: 10, UserID: 1}, nil)
+
+		// Execute
+		output, err := communityUsecase.JoinCommunity(1, 10)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrCommunityBanned)
+		assert.Nil(t, output)
+
+		// Verify
+		memberRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+}
+
+func TestCommunityUsecase_LeaveCommunity_NotMember(t *testing.T) {
+	// Setup
+	communityRepo := &mocks.GenresCommunityRepository{}
+	memberRepo := &mocks.CommunityMemberRepository{}
+
+	communityUsecase := NewCommunityUsecase(communityRepo, memberRepo, nil, nil, nil, nil)
+
+	// Expectations
+	communityRepo.On("FindByID", uint(10)).Return(&entities.GenreCommunity{ID: 10}, nil)
+	memberRepo.On("DeleteByCommunityIDAndUserID", uint(10), uint(1)).Return(repositories.ErrNotFound)
+
+	// Execute
+	err := communityUsecase.LeaveCommunity(1, 10)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrCommunityMemberNotFound)
+}
+
+func TestCommunityUsecase_AssignModerator(t *testing.T) {
+	t.Run("PromotesMember", func(t *testing.T) {
+		// Setup
+		communityRepo := &mocks.GenresCommunityRepository{}
+		memberRepo := &mocks.CommunityMemberRepository{}
+		userRepo := &mocks.UserRepository{}
+
+		communityUsecase := NewCommunityUsecase(communityRepo, memberRepo, nil, nil, userRepo, nil)
+
+		// Expectations
+		userRepo.On("FindByID", uint(1)).Return(&entities.User{ID: 1, IsAdmin: true}, nil)
+		userRepo.On("FindByID", uint(2)).Return(&entities.User{ID: 2}, nil)
+		communityRepo.On("FindByID", uint(10)).Return(&entities.GenreCommunity{ID: 10}, nil)
+		memberRepo.On("FindByCommunityIDAndUserID", uint(10), uint(2)).Return(&entities.CommunityMember{CommunityID: 10, UserID: 2, Role: entities.CommunityRoleMember}, nil)
+		memberRepo.On("Update", mock.MatchedBy(func(m *entities.CommunityMember) bool {
+			return m.UserID == 2 && m.Role == entities.CommunityRoleModerator
+		})).Return(nil)
+
+		// Execute
+		output, err := communityUsecase.AssignModerator(1, 10, 2)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, entities.CommunityRoleModerator, output.Role)
+
+		// Verify
+		memberRepo.AssertExpectations(t)
+	})
+
+	t.Run("JoinsNonMember", func(t *testing.T) {
+		// Setup
+		communityRepo := &mocks.GenresCommunityRepository{}
+		memberRepo := &mocks.CommunityMemberRepository{}
+		userRepo := &mocks.UserRepository{}
+
+		communityUsecase := NewCommunityUsecase(communityRepo, memberRepo, nil, nil, userRepo, nil)
+
+		// Expectations
+		userRepo.On("FindByID", uint(1)).Return(&entities.User{ID: 1, IsAdmin: true}, nil)
+		userRepo.On("FindByID", uint(2)).Return(&entities.User{ID: 2}, nil)
+		communityRepo.On("FindByID", uint(10)).Return(&entities.GenreCommunity{ID: 10}, nil)
+		memberRepo.On("FindByCommunityIDAndUserID", uint(10), uint(2)).Return(nil, repositories.ErrNotFound)
+		memberRepo.On("Create", &entities.CommunityMember{CommunityID: 10, UserID: 2, Role: entities.CommunityRoleModerator}).Return(nil)
+
+		// Execute
+		_, err := communityUsecase.AssignModerator(1, 10, 2)
+
+		// Assert
+		assert.NoError(t, err)
+
+		// Verify
+		memberRepo.AssertExpectations(t)
+	})
+}
+
+func TestCommunityUsecase_RemoveModerator_NotModerator(t *testing.T) {
+	// Setup
+	communityRepo := &mocks.GenresCommunityRepository{}
+	memberRepo := &mocks.CommunityMemberRepository{}
+	userRepo := &mocks.UserRepository{}
+
+	communityUsecase := NewCommunityUsecase(communityRepo, memberRepo, nil, nil, userRepo, nil)
+
+	// Expectations
+	userRepo.On("FindByID", uint(1)).Return(&entities.User{ID: 1, IsAdmin: true}, nil)
+	communityRepo.On("FindByID", uint(10)).Return(&entities.GenreCommunity{ID: 10}, nil)
+	memberRepo.On("FindByCommunityIDAndUserID", uint(10), uint(2)).Return(&entities.CommunityMember{Role: entities.CommunityRoleMember}, nil)
+
+	// Execute
+	err := communityUsecase.RemoveModerator(1, 10, 2)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrCommunityMemberNotFound)
+
+	// Verify
+	memberRepo.AssertNotCalled(t, "Update", mock.Anything)
+}
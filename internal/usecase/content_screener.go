@@ -0,0 +1,122 @@
+package usecase
+
+import (
+	"strings"
+	"time"
+
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/contentfilter"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/logging"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"go.uber.org/zap"
+)
+
+// contentHistoryLimit is how many of a user's latest posts or comments are
+// compared against new ones to detect duplicates.
+const contentHistoryLimit = 50
+
+// ContentScreener runs user-written text through the content filter before it
+// is stored, and reports the content the filter holds back to the moderators.
+// It is shared by the post, comment and user usecases.
+type ContentScreener struct {
+	filter     contentfilter.Filter
+	reportRepo repositories.ReportRepository
+}
+
+func NewContentScreener(filter contentfilter.Filter, reportRepo repositories.ReportRepository) *ContentScreener {
+	return &ContentScreener{
+		filter:     filter,
+		reportRepo: reportRepo,
+	}
+}
+
+// screen checks the content and returns the text to store in its place.
+// Rejected content is returned as an error.
+func (s *ContentScreener) screen(content contentfilter.Content) (*contentfilter.Result, error) {
+	result, err := s.filter.Check(content)
+	if err != nil {
+		logging.Log().Error("failed to filter content", zap.Error(err), zap.String("kind", content.Kind), zap.Uint("user_id", content.UserID))
+		return nil, ErrFilteringContent
+	}
+	if result.Action == contentfilter.ActionReject {
+		if result.HasReason(contentfilter.ReasonDuplicate) {
+			return nil, ErrDuplicateContent
+		}
+		return nil, ErrContentRejected
+	}
+	return result, nil
+}
+
+// screenProfile checks a nickname or bio. Profiles cannot be hidden while
+// they are reviewed, so profiles the filter would hold are rejected, and so
+// are nicknames it would change in any way.
+func (s *ContentScreener) screenProfile(kind string, userID uint, text string) (string, error) {
+	result, err := s.screen(contentfilter.Content{Kind: kind, UserID: userID, Body: text})
+	if err != nil {
+		return "", err
+	}
+	if result.Action == contentfilter.ActionHold || (kind == contentfilter.KindNickname && result.Action != contentfilter.ActionAllow) {
+		return "", ErrContentRejected
+	}
+	return result.Body, nil
+}
+
+// hold files an automated report on hidden content, which puts it in the
+// community's moderation queue. Dismissing the report shows the content.
+func (s *ContentScreener) hold(targetType string, targetID, targetUserID, communityID uint, text string, result *contentfilter.Result) error {
+	report := &entities.Report{
+		TargetType:   targetType,
+		TargetID:     targetID,
+		TargetUserID: targetUserID,
+		CommunityID:  &communityID,
+		Reason:       entities.ReportReasonAutomated,
+		Details:      strings.Join(result.Reasons, ", "),
+		Excerpt:      excerpt(text),
+		Status:       entities.ReportStatusOpen,
+	}
+	if err := s.reportRepo.Create(report); err != nil {
+		return ErrCreatingRecord
+	}
+	return nil
+}
+
+type contentHistory struct {
+	postRepo    repositories.PostRepository
+	commentRepo repositories.CommentRepository
+}
+
+// NewContentHistory returns the history the content filter checks new posts
+// and comments against for duplicates.
+func NewContentHistory(postRepo repositories.PostRepository, commentRepo repositories.CommentRepository) contentfilter.History {
+	return &contentHistory{
+		postRepo:    postRepo,
+		commentRepo: commentRepo,
+	}
+}
+
+func (h *contentHistory) Recent(userID uint, kind string, since time.Time) ([]contentfilter.Content, error) {
+	var contents []contentfilter.Content
+	switch kind {
+	case contentfilter.KindPost:
+		posts, err := h.postRepo.FindByUserID(userID, 0, contentHistoryLimit)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range posts {
+			if p.CreatedAt.After(since) {
+				contents = append(contents, contentfilter.Content{Kind: kind, ID: p.ID, UserID: p.UserID, Title: p.Title, Body: p.Content})
+			}
+		}
+	case contentfilter.KindComment:
+		comments, err := h.commentRepo.FindByUserID(userID, 0, contentHistoryLimit)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range comments {
+			if !c.IsDeleted && c.CreatedAt.After(since) {
+				contents = append(contents, contentfilter.Content{Kind: kind, ID: c.ID, UserID: c.UserID, PostID: c.PostID, Body: c.Content})
+			}
+		}
+	}
+	return contents, nil
+}
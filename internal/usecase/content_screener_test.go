@@ -0,0 +1,161 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/contentfilter"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/markdown"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// unfilteredScreener lets all content through, for tests of usecases that
+// screen content but are not about filtering.
+var unfilteredScreener = &ContentScreener{filter: contentfilter.NewPipeline()}
+
+func TestContentScreener_Screen(t *testing.T) {
+	history := &mocks.History{}
+	screener := NewContentScreener(contentfilter.NewPipeline(
+		contentfilter.NewProfanityFilter([]string{"shit"}, nil),
+		contentfilter.NewLinkSpamFilter(1, contentfilter.DefaultShortenerDomains),
+		contentfilter.NewDuplicateFilter(history, time.Hour),
+	), nil)
+
+	history.On("Recent", uint(1), contentfilter.KindComment, mock.Anything).Return([]contentfilter.Content{{ID: 3, Body: "same old"}}, nil)
+
+	t.Run("Masked", func(t *testing.T) {
+		result, err := screener.screen(contentfilter.Content{Kind: contentfilter.KindComment, UserID: 1, Body: "oh shit"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, contentfilter.ActionMask, result.Action)
+		assert.Equal(t, "oh ****", result.Body)
+	})
+
+	t.Run("Duplicate", func(t *testing.T) {
+		_, err := screener.screen(contentfilter.Content{Kind: contentfilter.KindComment, UserID: 1, Body: "Same old!"})
+
+		assert.ErrorIs(t, err, ErrDuplicateContent)
+	})
+
+	t.Run("NicknameRejected", func(t *testing.T) {
+		_, err := screener.screenProfile(contentfilter.KindNickname, 1, "shitlord")
+
+		assert.ErrorIs(t, err, ErrContentRejected)
+	})
+
+	t.Run("NicknameContainingWord", func(t *testing.T) {
+		nickname, err := screener.screenProfile(contentfilter.KindNickname, 1, "pushit")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "pushit", nickname)
+	})
+
+	t.Run("BioMasked", func(t *testing.T) {
+		bio, err := screener.screenProfile(contentfilter.KindBio, 1, "no shit")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "no ****", bio)
+	})
+
+	t.Run("BioHeldIsRejected", func(t *testing.T) {
+		_, err := screener.screenProfile(contentfilter.KindBio, 1, "http://a.com http://b.com")
+
+		assert.ErrorIs(t, err, ErrContentRejected)
+	})
+}
+
+func TestPostUsecase_CreatePost_Held(t *testing.T) {
+	// Setup
+	postRepo := &mocks.PostRepository{}
+	communityRepo := &mocks.GenresCommunityRepository{}
+	memberRepo := &mocks.CommunityMemberRepository{}
+	previewRepo := &mocks.LinkPreviewRepository{}
+	previewFetcher := &mocks.PreviewFetcher{}
+	attachmentRepo := &mocks.MusicAttachmentRepository{}
+	reportRepo := &mocks.ReportRepository{}
+
+	screener := NewContentScreener(contentfilter.NewLinkSpamFilter(3, contentfilter.DefaultShortenerDomains), reportRepo)
+	postUsecase := NewPostUsecase(postRepo, communityRepo, memberRepo, previewRepo, &MusicEmbedder{attachmentRepo: attachmentRepo}, markdown.New(), previewFetcher, screener, nil, nil)
+
+	// Expectations
+	communityRepo.On("FindByID", uint(10)).Return(&entities.GenreCommunity{ID: 10}, nil)
+	memberRepo.On("FindByCommunityIDAndUserID", uint(10), uint(1)).Return(&entities.CommunityMember{Role: entities.CommunityRoleMember}, nil)
+	postRepo.On("Create", mock.MatchedBy(func(p *entities.Post) bool { return p.IsHidden })).Run(func(args mock.Arguments) {
+		args.Get(0).(*entities.Post).ID = 5
+	}).Return(nil)
+	reportRepo.On("Create", mock.MatchedBy(func(r *entities.Report) bool {
+		return r.ReporterID == nil && r.Reason == entities.ReportReasonAutomated && r.TargetType == entities.ReportTargetPost &&
+			r.TargetID == 5 && r.TargetUserID == 1 && *r.CommunityID == 10 && r.Details == contentfilter.ReasonLinkSpam
+	})).Return(nil)
+	attachmentRepo.On("ReplaceByPostID", uint(5), []uint{}).Return(nil)
+	previewFetcher.On("Allowed", mock.Anything).Return(false)
+	previewRepo.On("ReplaceByPostID", uint(5), []*entities.LinkPreview{}).Return(nil)
+	postRepo.On("FindByID", uint(5)).Return(&entities.Post{ID: 5, UserID: 1, GenreCommunityID: 10, Title: "free", IsHidden: true}, nil)
+	postRepo.On("CountLikesAndDislikesByID", uint(5)).Return(int64(0), int64(0), nil)
+	attachmentRepo.On("FindByPostIDs", []uint{5}).Return([]*entities.MusicAttachment{}, nil)
+	previewRepo.On("FindByPostIDs", []uint{5}).Return([]*entities.LinkPreview{}, nil)
+
+	// Execute
+	output, err := postUsecase.CreatePost(context.Background(), 1, 10, &CreatePostInput{Title: "free", Content: "https://bit.ly/abc"})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, output.Hidden)
+
+	// Verify
+	postRepo.AssertExpectations(t)
+	reportRepo.AssertExpectations(t)
+}
+
+func TestCommentUsecase_CreateComment_Rejected(t *testing.T) {
+	// Setup
+	commentRepo := &mocks.CommentRepository{}
+	postRepo := &mocks.PostRepository{}
+	banRepo := &mocks.CommunityBanRepository{}
+	blockRepo := &mocks.UserBlockRepository{}
+	history := &mocks.History{}
+
+	screener := NewContentScreener(contentfilter.NewDuplicateFilter(history, time.Hour), nil)
+	commentUsecase := NewCommentUsecase(commentRepo, postRepo, banRepo, blockRepo, &MusicEmbedder{}, 0, screener, nil)
+
+	// Expectations
+	postRepo.On("FindByID", uint(5)).Return(&entities.Post{ID: 5, GenreCommunityID: 10}, nil)
+	banRepo.On("FindByCommunityIDAndUserID", uint(10), uint(1)).Return(nil, repositories.ErrNotFound)
+	blockRepo.On("ExistsBetween", uint(1), uint(0)).Return(false, nil)
+	history.On("Recent", uint(1), contentfilter.KindComment, mock.Anything).Return([]contentfilter.Content{{ID: 3, PostID: 5, Body: "first!"}}, nil)
+
+	// Execute
+	_, err := commentUsecase.CreateComment(context.Background(), 1, 5, &CreateCommentInput{Content: "FIRST"})
+
+	// Assert
+	assert.ErrorIs(t, err, ErrDuplicateContent)
+
+	// Verify
+	commentRepo.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func TestContentHistory_Recent(t *testing.T) {
+	// Setup
+	commentRepo := &mocks.CommentRepository{}
+	history := NewContentHistory(nil, commentRepo)
+	now := time.Now()
+
+	// Expectations
+	commentRepo.On("FindByUserID", uint(1), 0, contentHistoryLimit).Return([]*entities.Comment{
+		{ID: 3, UserID: 1, PostID: 5, Content: "new", CreatedAt: now},
+		{ID: 2, UserID: 1, IsDeleted: true, CreatedAt: now},
+		{ID: 1, UserID: 1, Content: "old", CreatedAt: now.Add(-2 * time.Hour)},
+	}, nil)
+
+	// Execute
+	contents, err := history.Recent(1, contentfilter.KindComment, now.Add(-time.Hour))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []contentfilter.Content{{Kind: contentfilter.KindComment, ID: 3, UserID: 1, PostID: 5, Body: "new"}}, contents)
+}
@@ -9,6 +9,7 @@ import (
 var (
 	ErrEmailAlreadyExists        = errors.New("email already exists")
 	ErrNicknameAlreadyExists     = errors.New("nickname already exists")
+	ErrNicknameReserved          = errors.New("nickname is reserved")
 	ErrInvalidPassword           = errors.New("invalid password")
 	ErrUserNotFound              = errors.New("user not found")
 	ErrPasswordResetFlowNotFound = errors.New("password reset flow not found")
@@ -33,4 +34,102 @@ var (
 	ErrDeletingRecord = repositories.ErrDelete
 
 	ErrSearchingSpotify = errors.New("failed to search spotify")
+	ErrFetchingSpotify  = errors.New("failed to fetch spotify")
+	ErrUpdatingSpotify  = errors.New("failed to update spotify")
+
+	ErrMusicNotFound       = errors.New("music not found")
+	ErrAlbumNotFound       = errors.New("album not found")
+	ErrArtistNotFound      = errors.New("artist not found")
+	ErrLastfmTrackNotFound = errors.New("lastfm track not found")
+	ErrFetchingLastfm      = errors.New("failed to fetch lastfm")
+
+	ErrGenreNotFound = errors.New("genre not found")
+
+	ErrPostNotFound    = errors.New("post not found")
+	ErrCommentNotFound = errors.New("comment not found")
+
+	ErrPostPermissionDenied   = errors.New("not allowed to modify the post")
+	ErrInvalidPostSort        = errors.New("invalid post sort")
+	ErrInvalidPostSearchField = errors.New("invalid post search field")
+	ErrRenderingContent       = errors.New("failed to render content")
+
+	ErrCommentPermissionDenied = errors.New("not allowed to modify the comment")
+	ErrInvalidCommentParent    = errors.New("invalid parent comment")
+	ErrCommentTooDeep          = errors.New("comment thread is too deep")
+
+	ErrInvalidMusicReference   = errors.New("invalid music reference")
+	ErrTooManyMusicAttachments = errors.New("too many music attachments")
+
+	ErrSpotifyLinkFlowNotFound     = errors.New("spotify link flow not found")
+	ErrSpotifyLinkFlowExpired      = errors.New("spotify link flow is expired")
+	ErrSpotifyAuthorizationFailed  = errors.New("spotify authorization failed")
+	ErrSpotifyAccountAlreadyLinked = errors.New("spotify account is already linked to another user")
+	ErrSpotifyNotLinked            = errors.New("spotify account is not linked")
+	ErrSpotifyImportInProgress     = errors.New("spotify import is already in progress")
+	ErrSpotifyImportJobNotFound    = errors.New("spotify import job not found")
+	ErrEncryptingToken             = errors.New("failed to encrypt token")
+	ErrDecryptingToken             = errors.New("failed to decrypt token")
+
+	ErrCollectionNotFound      = errors.New("collection not found")
+	ErrCollectionNotExportable = errors.New("collection cannot be exported")
+	ErrCollectionTrackNotFound = errors.New("track is not in the collection")
+
+	ErrCollectionPermissionDenied = errors.New("not allowed to modify the collection")
+	ErrCollectionMemberNotFound   = errors.New("collection member not found")
+	ErrCollectionOwnerImmutable   = errors.New("collection owner cannot be changed or removed")
+	ErrInvalidCollectionRole      = errors.New("invalid collection role")
+	ErrCollectionInviteNotFound   = errors.New("collection invite not found")
+	ErrCollectionInviteExpired    = errors.New("collection invite is expired")
+
+	ErrUnsupportedPlaylistFormat = errors.New("unsupported playlist format")
+	ErrInvalidPlaylistFile       = errors.New("invalid playlist file")
+	ErrPlaylistTooLarge          = errors.New("playlist has too many tracks")
+
+	ErrTopsterNotFound            = errors.New("topster not found")
+	ErrTopsterPermissionDenied    = errors.New("not allowed to modify the topster")
+	ErrInvalidTopsterGridSize     = errors.New("invalid topster grid size")
+	ErrInvalidTopsterTemplate     = errors.New("invalid topster template")
+	ErrInvalidAutofillSource      = errors.New("invalid topster auto-fill source")
+	ErrTopsterPositionOutOfBounds = errors.New("topster position is out of the grid")
+	ErrTopsterPositionConflict    = errors.New("topster position is already taken")
+	ErrDuplicateTopsterAlbum      = errors.New("album is already in the topster")
+
+	ErrCommunityNotFound         = errors.New("community not found")
+	ErrCommunityPermissionDenied = errors.New("not allowed to manage the community")
+	ErrCommunityMemberNotFound   = errors.New("community member not found")
+	ErrInvalidCommunityImage     = errors.New("invalid community image")
+
+	ErrCommunityMembershipRequired = errors.New("only community members can do this")
+	ErrCommunityBanned             = errors.New("banned from the community")
+
+	ErrReportNotFound          = errors.New("report not found")
+	ErrInvalidReportTarget     = errors.New("invalid report target")
+	ErrInvalidReportReason     = errors.New("invalid report reason")
+	ErrInvalidReportStatus     = errors.New("invalid report status")
+	ErrReportAlreadyExists     = errors.New("report is already pending")
+	ErrReportAlreadyClosed     = errors.New("report is already closed")
+	ErrInvalidModerationAction = errors.New("invalid moderation action")
+
+	ErrCannotFollowSelf = errors.New("cannot follow yourself")
+	ErrAlreadyFollowing = errors.New("already following the user")
+	ErrNotFollowing     = errors.New("not following the user")
+
+	ErrCannotBlockSelf = errors.New("cannot block yourself")
+	ErrAlreadyBlocked  = errors.New("already blocked the user")
+	ErrNotBlocked      = errors.New("not blocked the user")
+	ErrCannotMuteSelf  = errors.New("cannot mute yourself")
+	ErrAlreadyMuted    = errors.New("already muted the user")
+	ErrNotMuted        = errors.New("not muted the user")
+	ErrUserBlocked     = errors.New("blocked by or blocking the user")
+
+	ErrInvalidFeedCursor = errors.New("invalid feed cursor")
+
+	ErrInvalidNotificationType = errors.New("invalid notification type")
+	ErrNoNotificationsSelected = errors.New("no notifications selected")
+
+	ErrContentRejected  = errors.New("content is not allowed")
+	ErrDuplicateContent = errors.New("content duplicates a recent post or comment")
+	ErrFilteringContent = errors.New("failed to filter content")
+
+	ErrStoringFile = errors.New("failed to store file")
 )
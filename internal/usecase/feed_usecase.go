@@ -0,0 +1,151 @@
+package usecase
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+)
+
+// feedGroupWindow is how far apart the likes or follows of a user may be to
+// be grouped into one feed entry.
+const feedGroupWindow = 24 * time.Hour
+
+// feedGroupExtensionLimit is how many activities past the end of a page may be
+// pulled into it to complete the groups on the page.
+const feedGroupExtensionLimit = 100
+
+// groupedActivityTypes are the activities that are grouped per user in the
+// feed.
+var groupedActivityTypes = map[string]bool{
+	entities.ActivityTypeTrackLike: true,
+	entities.ActivityTypeFollow:    true,
+}
+
+type FeedUsecase interface {
+	GetFeed(userID uint, cursor string, limit *int) (*FeedOutput, error)
+}
+
+type feedUsecase struct {
+	activityRepo repositories.ActivityRepository
+}
+
+func NewFeedUsecase(activityRepo repositories.ActivityRepository) FeedUsecase {
+	return &feedUsecase{
+		activityRepo: activityRepo,
+	}
+}
+
+// GetFeed pages through what the users the user follows did, newest first,
+// leaving out muted users. cursor is the NextCursor of the previous page, or
+// empty for the first page. Likes and follows are grouped within a page, and
+// the ones right after a page that belong to a group on it are pulled into
+// the page, so that a run of them is not split across pages.
+func (u *feedUsecase) GetFeed(userID uint, cursor string, limit *int) (*FeedOutput, error) {
+	var beforeID uint64
+	if cursor != "" {
+		var err error
+		if beforeID, err = strconv.ParseUint(cursor, 10, 32); err != nil || beforeID == 0 {
+			return nil, ErrInvalidFeedCursor
+		}
+	}
+
+	l, _ := pagination(limit, nil)
+	viewer := repositories.Viewer{ID: userID, HideMuted: true}
+	// One more than the page is loaded to tell whether there is a next page.
+	activities, err := u.activityRepo.FindFeed(viewer, uint(beforeID), l+1)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	output := &FeedOutput{}
+	if len(activities) > l {
+		page, next := append([]*entities.Activity{}, activities[:l]...), activities[l:]
+		for extended := 0; extended < feedGroupExtensionLimit && joinsGroup(page, next[0]); extended++ {
+			page = append(page, next[0])
+			if next = next[1:]; len(next) > 0 {
+				continue
+			}
+			if next, err = u.activityRepo.FindFeed(viewer, page[len(page)-1].ID, l+1); err != nil {
+				return nil, ErrFindingRecord
+			}
+			if len(next) == 0 {
+				break
+			}
+		}
+		activities = page
+		if len(next) > 0 {
+			output.NextCursor = strconv.FormatUint(uint64(page[len(page)-1].ID), 10)
+		}
+	}
+	output.Activities = groupActivities(activities)
+	return output, nil
+}
+
+// joinsGroup reports whether the activity would be added to the entry of a
+// group on the page rather than start an entry of its own.
+func joinsGroup(page []*entities.Activity, activity *entities.Activity) bool {
+	if !groupedActivityTypes[activity.Type] {
+		return false
+	}
+	extended := append(page[:len(page):len(page)], activity)
+	return len(groupActivities(extended)) == len(groupActivities(page))
+}
+
+// groupActivities turns the activities into feed entries, adding grouped
+// activities to the entry of the user's latest one within feedGroupWindow.
+func groupActivities(activities []*entities.Activity) []FeedActivityOutput {
+	entries := []FeedActivityOutput{}
+	type groupKey struct {
+		actorID      uint
+		activityType string
+	}
+	latest := make(map[groupKey]int) // index of the latest entry
+	for _, a := range activities {
+		key := groupKey{a.ActorID, a.Type}
+		if groupedActivityTypes[a.Type] {
+			if i, ok := latest[key]; ok && entries[i].CreatedAt.Sub(a.CreatedAt) <= feedGroupWindow {
+				entries[i].Subjects = append(entries[i].Subjects, toFeedSubjectOutput(a))
+				continue
+			}
+			latest[key] = len(entries)
+		}
+		entries = append(entries, FeedActivityOutput{
+			ID:        a.ID,
+			Type:      a.Type,
+			Actor:     toFeedUserOutput(&a.Actor),
+			Subjects:  []FeedSubjectOutput{toFeedSubjectOutput(a)},
+			CreatedAt: a.CreatedAt,
+		})
+	}
+	return entries
+}
+
+func toFeedUserOutput(user *entities.User) FeedUserOutput {
+	output := FeedUserOutput{UserID: user.ID, Nickname: user.Nickname}
+	if user.UserProfile != nil {
+		output.ProfileImageURL = user.UserProfile.ProfileImageURL
+	}
+	return output
+}
+
+func toFeedSubjectOutput(a *entities.Activity) FeedSubjectOutput {
+	switch {
+	case a.Post != nil:
+		return FeedSubjectOutput{ID: a.Post.ID, Title: a.Post.Title}
+	case a.Topster != nil:
+		return FeedSubjectOutput{ID: a.Topster.ID, Title: a.Topster.Title, ImageURL: a.Topster.ImageURL}
+	case a.Collection != nil:
+		return FeedSubjectOutput{ID: a.Collection.ID, Title: a.Collection.Name}
+	case a.Music != nil:
+		output := FeedSubjectOutput{ID: a.Music.ID, Title: a.Music.Title}
+		if a.Music.Album != nil {
+			output.ImageURL = a.Music.Album.ImageURL
+		}
+		return output
+	case a.TargetUser != nil:
+		user := toFeedUserOutput(a.TargetUser)
+		return FeedSubjectOutput{ID: user.UserID, Title: user.Nickname, ImageURL: user.ProfileImageURL}
+	}
+	return FeedSubjectOutput{}
+}
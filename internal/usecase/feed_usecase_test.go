@@ -0,0 +1,139 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase/mocks"
+	"github.com/myjinjin/sonic-odyssey-backend/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFeedUsecase_GetFeed(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	alice := entities.User{ID: 2, Nickname: "alice", UserProfile: &entities.UserProfile{ProfileImageURL: "https://example.com/alice.png"}}
+	bob := entities.User{ID: 3, Nickname: "bob"}
+	like := func(id uint, actor entities.User, musicID uint, at time.Time) *entities.Activity {
+		return &entities.Activity{ID: id, ActorID: actor.ID, Actor: actor, Type: entities.ActivityTypeTrackLike, MusicID: &musicID,
+			Music: &entities.Music{ID: musicID, Title: "track", Album: &entities.Album{ImageURL: "https://example.com/cover.png"}}, CreatedAt: at}
+	}
+
+	t.Run("GroupsLikes", func(t *testing.T) {
+		// Setup
+		activityRepo := &mocks.ActivityRepository{}
+
+		feedUsecase := NewFeedUsecase(activityRepo)
+
+		postID := uint(10)
+		activities := []*entities.Activity{
+			like(9, alice, 100, now),
+			{ID: 8, ActorID: bob.ID, Actor: bob, Type: entities.ActivityTypePost, PostID: &postID, Post: &entities.Post{ID: postID, Title: "new post"}, CreatedAt: now.Add(-time.Hour)},
+			like(7, alice, 101, now.Add(-2*time.Hour)),
+			like(6, alice, 102, now.Add(-48*time.Hour)),
+		}
+
+		// Expectations
+		activityRepo.On("FindFeed", repositories.Viewer{ID: 1, HideMuted: true}, uint(0), 21).Return(activities, nil)
+
+		// Execute
+		output, err := feedUsecase.GetFeed(1, "", nil)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Empty(t, output.NextCursor)
+		assert.Len(t, output.Activities, 3)
+
+		assert.Equal(t, uint(9), output.Activities[0].ID)
+		assert.Equal(t, FeedUserOutput{UserID: 2, Nickname: "alice", ProfileImageURL: "https://example.com/alice.png"}, output.Activities[0].Actor)
+		assert.Equal(t, []FeedSubjectOutput{
+			{ID: 100, Title: "track", ImageURL: "https://example.com/cover.png"},
+			{ID: 101, Title: "track", ImageURL: "https://example.com/cover.png"},
+		}, output.Activities[0].Subjects)
+
+		assert.Equal(t, entities.ActivityTypePost, output.Activities[1].Type)
+		assert.Equal(t, []FeedSubjectOutput{{ID: 10, Title: "new post"}}, output.Activities[1].Subjects)
+
+		// Likes more than a day apart are not grouped.
+		assert.Equal(t, uint(6), output.Activities[2].ID)
+		assert.Len(t, output.Activities[2].Subjects, 1)
+
+		// Verify
+		activityRepo.AssertExpectations(t)
+	})
+
+	t.Run("NextCursor", func(t *testing.T) {
+		// Setup
+		activityRepo := &mocks.ActivityRepository{}
+
+		feedUsecase := NewFeedUsecase(activityRepo)
+
+		// Expectations
+		activityRepo.On("FindFeed", repositories.Viewer{ID: 1, HideMuted: true}, uint(50), 3).Return([]*entities.Activity{
+			like(40, alice, 100, now),
+			like(30, bob, 101, now),
+			like(20, alice, 102, now.Add(-48*time.Hour)),
+		}, nil)
+
+		// Execute
+		output, err := feedUsecase.GetFeed(1, "50", utils.ToPtr(2))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "30", output.NextCursor)
+		assert.Len(t, output.Activities, 2)
+
+		// Verify
+		activityRepo.AssertExpectations(t)
+	})
+
+	t.Run("GroupAcrossPageEnd", func(t *testing.T) {
+		// Setup
+		activityRepo := &mocks.ActivityRepository{}
+
+		feedUsecase := NewFeedUsecase(activityRepo)
+
+		// Expectations
+		activityRepo.On("FindFeed", repositories.Viewer{ID: 1, HideMuted: true}, uint(0), 3).Return([]*entities.Activity{
+			like(40, alice, 100, now),
+			like(30, bob, 101, now),
+			like(20, alice, 102, now.Add(-time.Hour)),
+		}, nil)
+		activityRepo.On("FindFeed", repositories.Viewer{ID: 1, HideMuted: true}, uint(20), 3).Return([]*entities.Activity{
+			like(15, alice, 103, now.Add(-2*time.Hour)),
+			like(10, bob, 104, now.Add(-48*time.Hour)),
+		}, nil)
+
+		// Execute
+		output, err := feedUsecase.GetFeed(1, "", utils.ToPtr(2))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "15", output.NextCursor)
+		assert.Len(t, output.Activities, 2)
+		assert.Len(t, output.Activities[0].Subjects, 3)
+
+		// Verify
+		activityRepo.AssertExpectations(t)
+	})
+
+	t.Run("InvalidCursor", func(t *testing.T) {
+		// Setup
+		activityRepo := &mocks.ActivityRepository{}
+
+		feedUsecase := NewFeedUsecase(activityRepo)
+
+		for _, cursor := range []string{"abc", "0", "-1"} {
+			// Execute
+			_, err := feedUsecase.GetFeed(1, cursor, nil)
+
+			// Assert
+			assert.ErrorIs(t, err, ErrInvalidFeedCursor)
+		}
+
+		// Verify
+		activityRepo.AssertNotCalled(t, "FindFeed", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
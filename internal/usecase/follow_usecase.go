@@ -0,0 +1,206 @@
+package usecase
+
+import (
+	"errors"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+)
+
+type FollowUsecase interface {
+	Follow(userID, targetID uint) (*FollowOutput, error)
+	Unfollow(userID, targetID uint) (*FollowOutput, error)
+	ListFollowers(viewerID, userID uint, limit, offset *int) (*ListFollowsOutput, error)
+	ListFollowing(viewerID, userID uint, limit, offset *int) (*ListFollowsOutput, error)
+}
+
+type followUsecase struct {
+	followRepo repositories.UserFollowRepository
+	userRepo   repositories.UserRepository
+	blockRepo  repositories.UserBlockRepository
+	activities *ActivityRecorder
+	notifier   *Notifier
+}
+
+func NewFollowUsecase(followRepo repositories.UserFollowRepository, userRepo repositories.UserRepository, blockRepo repositories.UserBlockRepository, activities *ActivityRecorder, notifier *Notifier) FollowUsecase {
+	return &followUsecase{
+		followRepo: followRepo,
+		userRepo:   userRepo,
+		blockRepo:  blockRepo,
+		activities: activities,
+		notifier:   notifier,
+	}
+}
+
+// Follow makes userID follow targetID. Users cannot follow themselves, follow
+// someone twice, or follow someone they blocked or who blocked them.
+func (u *followUsecase) Follow(userID, targetID uint) (*FollowOutput, error) {
+	if userID == targetID {
+		return nil, ErrCannotFollowSelf
+	}
+	if err := u.findUser(targetID); err != nil {
+		return nil, err
+	}
+	if err := checkNotBlocked(u.blockRepo, userID, targetID); err != nil {
+		return nil, err
+	}
+
+	_, err := u.followRepo.FindByFollowerIDAndFollowingID(userID, targetID)
+	switch {
+	case err == nil:
+		return nil, ErrAlreadyFollowing
+	case !errors.Is(err, repositories.ErrNotFound):
+		return nil, ErrFindingRecord
+	}
+	if err := u.followRepo.Create(&entities.UserFollow{FollowerID: userID, FollowingID: targetID}); err != nil {
+		return nil, ErrCreatingRecord
+	}
+	u.activities.record(&entities.Activity{ActorID: userID, Type: entities.ActivityTypeFollow, TargetUserID: &targetID})
+	u.notifier.notify(&entities.Notification{UserID: targetID, ActorID: &userID, Type: entities.NotificationTypeFollow})
+	return u.followOutput(userID, targetID, true)
+}
+
+func (u *followUsecase) Unfollow(userID, targetID uint) (*FollowOutput, error) {
+	if err := u.findUser(targetID); err != nil {
+		return nil, err
+	}
+	if err := u.followRepo.DeleteByFollowerIDAndFollowingID(userID, targetID); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrNotFollowing
+		}
+		return nil, ErrDeletingRecord
+	}
+	return u.followOutput(userID, targetID, false)
+}
+
+// ListFollowers pages through the user's followers, most recent first, with
+// how each of them relates to the viewer. Followers the viewer blocked or who
+// blocked the viewer are left out, and the user is not found at all if either
+// blocked the other. The list is empty if the user hid their follows.
+func (u *followUsecase) ListFollowers(viewerID, userID uint, limit, offset *int) (*ListFollowsOutput, error) {
+	user, err := findVisibleUser(u.userRepo, u.blockRepo, viewerID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if sectionHidden(viewerID, user, ProfileSectionFollows) {
+		return &ListFollowsOutput{Users: []FollowUserOutput{}}, nil
+	}
+
+	l, o := pagination(limit, offset)
+	viewer := repositories.Viewer{ID: viewerID}
+	follows, err := u.followRepo.FindFollowersByUserID(viewer, userID, o, l)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	total, err := u.followRepo.CountFollowersByUserID(viewer, userID)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	users := make([]*entities.User, len(follows))
+	for i, f := range follows {
+		users[i] = &f.Follower
+	}
+	return u.toListFollowsOutput(viewerID, follows, users, total)
+}
+
+// ListFollowing pages through the users the user follows, most recently
+// followed first, with how each of them relates to the viewer. Users the
+// viewer blocked or who blocked the viewer are left out, and the user is not
+// found at all if either blocked the other. The list is empty if the user hid
+// their follows.
+func (u *followUsecase) ListFollowing(viewerID, userID uint, limit, offset *int) (*ListFollowsOutput, error) {
+	user, err := findVisibleUser(u.userRepo, u.blockRepo, viewerID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if sectionHidden(viewerID, user, ProfileSectionFollows) {
+		return &ListFollowsOutput{Users: []FollowUserOutput{}}, nil
+	}
+
+	l, o := pagination(limit, offset)
+	viewer := repositories.Viewer{ID: viewerID}
+	follows, err := u.followRepo.FindFollowingsByUserID(viewer, userID, o, l)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	total, err := u.followRepo.CountFollowingsByUserID(viewer, userID)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	users := make([]*entities.User, len(follows))
+	for i, f := range follows {
+		users[i] = &f.Following
+	}
+	return u.toListFollowsOutput(viewerID, follows, users, total)
+}
+
+func (u *followUsecase) findUser(userID uint) error {
+	if _, err := u.userRepo.FindByID(userID); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrUserNotFound
+		}
+		return ErrFindingRecord
+	}
+	return nil
+}
+
+func (u *followUsecase) followOutput(userID, targetID uint, following bool) (*FollowOutput, error) {
+	followsYou := true
+	if _, err := u.followRepo.FindByFollowerIDAndFollowingID(targetID, userID); err != nil {
+		if !errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrFindingRecord
+		}
+		followsYou = false
+	}
+	followers, err := u.followRepo.CountFollowersByUserID(repositories.Viewer{ID: userID}, targetID)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	return &FollowOutput{
+		UserID:        targetID,
+		Following:     following,
+		FollowsYou:    followsYou,
+		FollowerCount: followers,
+	}, nil
+}
+
+// toListFollowsOutput flags which of the listed users the viewer follows and
+// which follow the viewer back. users holds the listed user of each follow.
+func (u *followUsecase) toListFollowsOutput(viewerID uint, follows []*entities.UserFollow, users []*entities.User, total int64) (*ListFollowsOutput, error) {
+	userIDs := make([]uint, len(users))
+	for i, user := range users {
+		userIDs[i] = user.ID
+	}
+	followingIDs, err := u.followRepo.FindFollowingIDs(viewerID, userIDs)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	followerIDs, err := u.followRepo.FindFollowerIDs(viewerID, userIDs)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	following := make(map[uint]bool, len(followingIDs))
+	for _, id := range followingIDs {
+		following[id] = true
+	}
+	followsYou := make(map[uint]bool, len(followerIDs))
+	for _, id := range followerIDs {
+		followsYou[id] = true
+	}
+
+	output := &ListFollowsOutput{Users: make([]FollowUserOutput, len(follows)), Total: int(total)}
+	for i, f := range follows {
+		user := users[i]
+		output.Users[i] = FollowUserOutput{
+			UserID:     user.ID,
+			Nickname:   user.Nickname,
+			Following:  following[user.ID],
+			FollowsYou: followsYou[user.ID],
+			FollowedAt: f.CreatedAt,
+		}
+		if user.UserProfile != nil {
+			output.Users[i].ProfileImageURL = user.UserProfile.ProfileImageURL
+		}
+	}
+	return output, nil
+}
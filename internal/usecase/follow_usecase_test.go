@@ -0,0 +1,228 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFollowUsecase_Follow(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// Setup
+		followRepo := &mocks.UserFollowRepository{}
+		userRepo := &mocks.UserRepository{}
+		blockRepo := &mocks.UserBlockRepository{}
+
+		followUsecase := NewFollowUsecase(followRepo, userRepo, blockRepo, nil, nil)
+
+		// Expectations
+		userRepo.On("FindByID", uint(2)).Return(&entities.User{ID: 2}, nil)
+		blockRepo.On("ExistsBetween", uint(1), uint(2)).Return(false, nil)
+		followRepo.On("FindByFollowerIDAndFollowingID", uint(1), uint(2)).Return(nil, repositories.ErrNotFound)
+		followRepo.On("Create", mock.MatchedBy(func(f *entities.UserFollow) bool {
+			return f.FollowerID == 1 && f.FollowingID == 2
+		})).Return(nil)
+		followRepo.On("FindByFollowerIDAndFollowingID", uint(2), uint(1)).Return(&entities.UserFollow{ID: 9}, nil)
+		followRepo.On("CountFollowersByUserID", repositories.Viewer{ID: 1}, uint(2)).Return(int64(5), nil)
+
+		// Execute
+		output, err := followUsecase.Follow(1, 2)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, &FollowOutput{UserID: 2, Following: true, FollowsYou: true, FollowerCount: 5}, output)
+
+		// Verify
+		followRepo.AssertExpectations(t)
+	})
+
+	t.Run("Self", func(t *testing.T) {
+		// Setup
+		followRepo := &mocks.UserFollowRepository{}
+
+		followUsecase := NewFollowUsecase(followRepo, nil, nil, nil, nil)
+
+		// Execute
+		_, err := followUsecase.Follow(1, 1)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrCannotFollowSelf)
+
+		// Verify
+		followRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+
+	t.Run("Blocked", func(t *testing.T) {
+		// Setup
+		followRepo := &mocks.UserFollowRepository{}
+		userRepo := &mocks.UserRepository{}
+		blockRepo := &mocks.UserBlockRepository{}
+
+		followUsecase := NewFollowUsecase(followRepo, userRepo, blockRepo, nil, nil)
+
+		// Expectations
+		userRepo.On("FindByID", uint(2)).Return(&entities.User{ID: 2}, nil)
+		blockRepo.On("ExistsBetween", uint(1), uint(2)).Return(true, nil)
+
+		// Execute
+		_, err := followUsecase.Follow(1, 2)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrUserBlocked)
+
+		// Verify
+		blockRepo.AssertExpectations(t)
+		followRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+
+	t.Run("AlreadyFollowing", func(t *testing.T) {
+		// Setup
+		followRepo := &mocks.UserFollowRepository{}
+		userRepo := &mocks.UserRepository{}
+		blockRepo := &mocks.UserBlockRepository{}
+
+		followUsecase := NewFollowUsecase(followRepo, userRepo, blockRepo, nil, nil)
+
+		// Expectations
+		userRepo.On("FindByID", uint(2)).Return(&entities.User{ID: 2}, nil)
+		blockRepo.On("ExistsBetween", uint(1), uint(2)).Return(false, nil)
+		followRepo.On("FindByFollowerIDAndFollowingID", uint(1), uint(2)).Return(&entities.UserFollow{ID: 3}, nil)
+
+		// Execute
+		_, err := followUsecase.Follow(1, 2)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrAlreadyFollowing)
+
+		// Verify
+		followRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+
+	t.Run("UserNotFound", func(t *testing.T) {
+		// Setup
+		userRepo := &mocks.UserRepository{}
+
+		followUsecase := NewFollowUsecase(nil, userRepo, nil, nil, nil)
+
+		// Expectations
+		userRepo.On("FindByID", uint(2)).Return(nil, repositories.ErrNotFound)
+
+		// Execute
+		_, err := followUsecase.Follow(1, 2)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrUserNotFound)
+	})
+}
+
+func TestFollowUsecase_Unfollow_NotFollowing(t *testing.T) {
+	// Setup
+	followRepo := &mocks.UserFollowRepository{}
+	userRepo := &mocks.UserRepository{}
+
+	followUsecase := NewFollowUsecase(followRepo, userRepo, nil, nil, nil)
+
+	// Expectations
+	userRepo.On("FindByID", uint(2)).Return(&entities.User{ID: 2}, nil)
+	followRepo.On("DeleteByFollowerIDAndFollowingID", uint(1), uint(2)).Return(repositories.ErrNotFound)
+
+	// Execute
+	_, err := followUsecase.Unfollow(1, 2)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrNotFollowing)
+}
+
+func TestFollowUsecase_ListFollowers(t *testing.T) {
+	// Setup
+	followRepo := &mocks.UserFollowRepository{}
+	userRepo := &mocks.UserRepository{}
+	blockRepo := &mocks.UserBlockRepository{}
+
+	followUsecase := NewFollowUsecase(followRepo, userRepo, blockRepo, nil, nil)
+	followedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	// Expectations
+	userRepo.On("FindByID", uint(2)).Return(&entities.User{ID: 2}, nil)
+	blockRepo.On("ExistsBetween", uint(1), uint(2)).Return(false, nil)
+	followRepo.On("FindFollowersByUserID", repositories.Viewer{ID: 1}, uint(2), 0, 20).Return([]*entities.UserFollow{
+		{FollowerID: 3, Follower: entities.User{ID: 3, Nickname: "mutual", UserProfile: &entities.UserProfile{ProfileImageURL: "https://example.com/3.png"}}, FollowingID: 2, CreatedAt: followedAt},
+		{FollowerID: 4, Follower: entities.User{ID: 4, Nickname: "stranger"}, FollowingID: 2, CreatedAt: followedAt},
+	}, nil)
+	followRepo.On("CountFollowersByUserID", repositories.Viewer{ID: 1}, uint(2)).Return(int64(2), nil)
+	followRepo.On("FindFollowingIDs", uint(1), []uint{3, 4}).Return([]uint{3}, nil)
+	followRepo.On("FindFollowerIDs", uint(1), []uint{3, 4}).Return([]uint{3}, nil)
+
+	// Execute
+	output, err := followUsecase.ListFollowers(1, 2, nil, nil)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, &ListFollowsOutput{
+		Users: []FollowUserOutput{
+			{UserID: 3, Nickname: "mutual", ProfileImageURL: "https://example.com/3.png", Following: true, FollowsYou: true, FollowedAt: followedAt},
+			{UserID: 4, Nickname: "stranger", FollowedAt: followedAt},
+		},
+		Total: 2,
+	}, output)
+
+	// Verify
+	followRepo.AssertExpectations(t)
+}
+
+func TestFollowUsecase_ListFollows_Blocked(t *testing.T) {
+	// Setup
+	followRepo := &mocks.UserFollowRepository{}
+	userRepo := &mocks.UserRepository{}
+	blockRepo := &mocks.UserBlockRepository{}
+
+	followUsecase := NewFollowUsecase(followRepo, userRepo, blockRepo, nil, nil)
+
+	// Expectations
+	userRepo.On("FindByID", uint(2)).Return(&entities.User{ID: 2}, nil)
+	blockRepo.On("ExistsBetween", uint(1), uint(2)).Return(true, nil)
+
+	// Execute
+	_, followersErr := followUsecase.ListFollowers(1, 2, nil, nil)
+	_, followingErr := followUsecase.ListFollowing(1, 2, nil, nil)
+
+	// Assert
+	assert.ErrorIs(t, followersErr, ErrUserNotFound)
+	assert.ErrorIs(t, followingErr, ErrUserNotFound)
+
+	// Verify
+	followRepo.AssertNotCalled(t, "FindFollowersByUserID", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	followRepo.AssertNotCalled(t, "FindFollowingsByUserID", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestFollowUsecase_ListFollows_Hidden(t *testing.T) {
+	// Setup
+	followRepo := &mocks.UserFollowRepository{}
+	userRepo := &mocks.UserRepository{}
+	blockRepo := &mocks.UserBlockRepository{}
+
+	followUsecase := NewFollowUsecase(followRepo, userRepo, blockRepo, nil, nil)
+
+	// Expectations
+	userRepo.On("FindByID", uint(2)).Return(&entities.User{ID: 2, UserProfile: &entities.UserProfile{HideFollows: true}}, nil)
+	blockRepo.On("ExistsBetween", uint(1), uint(2)).Return(false, nil)
+
+	// Execute
+	followers, followersErr := followUsecase.ListFollowers(1, 2, nil, nil)
+	following, followingErr := followUsecase.ListFollowing(1, 2, nil, nil)
+
+	// Assert
+	assert.NoError(t, followersErr)
+	assert.NoError(t, followingErr)
+	assert.Equal(t, &ListFollowsOutput{Users: []FollowUserOutput{}}, followers)
+	assert.Equal(t, &ListFollowsOutput{Users: []FollowUserOutput{}}, following)
+
+	// Verify
+	followRepo.AssertNotCalled(t, "FindFollowersByUserID", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	followRepo.AssertNotCalled(t, "FindFollowingsByUserID", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
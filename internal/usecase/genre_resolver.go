@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/taxonomy"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+)
+
+// genreResolver matches free-form genre names from external providers, such as
+// Spotify artist genres and Last.fm tags, onto the imported genre taxonomy.
+type genreResolver struct {
+	genreRepo      repositories.GenreRepository
+	genreAliasRepo repositories.GenreAliasRepository
+}
+
+// resolve tries the full name first and then drops leading qualifiers, so that
+// "korean indie rock" falls back to "indie rock" and then to "rock". Aliases
+// only match the full name: a fallback must be the exact name of a genre, so
+// that a fragment such as "live" of "seen live" never matches by alias.
+func (r *genreResolver) resolve(name string) (*entities.Genre, error) {
+	words := strings.Fields(taxonomy.Normalize(name))
+	if len(words) == 0 {
+		return nil, repositories.ErrNotFound
+	}
+	genre, err := r.find(strings.Join(words, " "))
+	if err == nil {
+		return genre, nil
+	}
+	if !errors.Is(err, repositories.ErrNotFound) {
+		return nil, err
+	}
+	for i := 1; i < len(words); i++ {
+		genre, err := r.genreRepo.FindByName(strings.Join(words[i:], " "))
+		if err == nil {
+			return genre, nil
+		}
+		if !errors.Is(err, repositories.ErrNotFound) {
+			return nil, err
+		}
+	}
+	return nil, repositories.ErrNotFound
+}
+
+func (r *genreResolver) find(normalized string) (*entities.Genre, error) {
+	alias, err := r.genreAliasRepo.FindByAlias(normalized)
+	if err == nil {
+		return r.genreRepo.FindByID(alias.GenreID)
+	}
+	if !errors.Is(err, repositories.ErrNotFound) {
+		return nil, err
+	}
+	return r.genreRepo.FindByName(normalized)
+}
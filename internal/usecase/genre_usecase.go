@@ -0,0 +1,218 @@
+package usecase
+
+import (
+	"errors"
+
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/taxonomy"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+)
+
+type GenreUsecase interface {
+	ImportTaxonomy(genres []taxonomy.Genre) (*ImportTaxonomyOutput, error)
+	ListGenres() (*ListGenresOutput, error)
+	GetGenreTracks(genreID uint, limit, offset *int) (*GetGenreTracksOutput, error)
+	GetGenreCommunities(genreID uint) (*GetGenreCommunitiesOutput, error)
+}
+
+type genreUsecase struct {
+	genreRepo          repositories.GenreRepository
+	genreAliasRepo     repositories.GenreAliasRepository
+	musicRepo          repositories.MusicRepository
+	genreCommunityRepo repositories.GenresCommunityRepository
+}
+
+func NewGenreUsecase(genreRepo repositories.GenreRepository, genreAliasRepo repositories.GenreAliasRepository, musicRepo repositories.MusicRepository, genreCommunityRepo repositories.GenresCommunityRepository) GenreUsecase {
+	return &genreUsecase{
+		genreRepo:          genreRepo,
+		genreAliasRepo:     genreAliasRepo,
+		musicRepo:          musicRepo,
+		genreCommunityRepo: genreCommunityRepo,
+	}
+}
+
+// ImportTaxonomy upserts the given genre tree. Existing genres are matched by
+// name, so running the import repeatedly only applies the differences.
+func (u *genreUsecase) ImportTaxonomy(genres []taxonomy.Genre) (*ImportTaxonomyOutput, error) {
+	output := &ImportTaxonomyOutput{ConflictingAliases: []string{}}
+	for _, g := range genres {
+		if err := u.importGenre(g, nil, output); err != nil {
+			return nil, err
+		}
+	}
+	return output, nil
+}
+
+func (u *genreUsecase) importGenre(g taxonomy.Genre, parentID *uint, output *ImportTaxonomyOutput) error {
+	genre, err := u.genreRepo.FindByName(g.Name)
+	switch {
+	case err == nil:
+		if !sameID(genre.ParentID, parentID) || (g.Description != "" && genre.Description != g.Description) {
+			genre.ParentID = parentID
+			if g.Description != "" {
+				genre.Description = g.Description
+			}
+			if err := u.genreRepo.Update(genre); err != nil {
+				return ErrUpdatingRecord
+			}
+			output.GenresUpdated++
+		}
+	case errors.Is(err, repositories.ErrNotFound):
+		genre = &entities.Genre{ParentID: parentID, Name: g.Name, Description: g.Description}
+		if err := u.genreRepo.Create(genre); err != nil {
+			return ErrCreatingRecord
+		}
+		output.GenresCreated++
+	default:
+		return ErrFindingRecord
+	}
+
+	for _, a := range append([]string{g.Name}, g.Aliases...) {
+		alias := taxonomy.Normalize(a)
+		existing, err := u.genreAliasRepo.FindByAlias(alias)
+		if err == nil {
+			if existing.GenreID != genre.ID {
+				output.ConflictingAliases = append(output.ConflictingAliases, a)
+			}
+			continue
+		}
+		if !errors.Is(err, repositories.ErrNotFound) {
+			return ErrFindingRecord
+		}
+		if err := u.genreAliasRepo.Create(&entities.GenreAlias{GenreID: genre.ID, Alias: alias}); err != nil {
+			return ErrCreatingRecord
+		}
+		output.AliasesCreated++
+	}
+
+	for _, child := range g.Children {
+		if err := u.importGenre(child, &genre.ID, output); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (u *genreUsecase) ListGenres() (*ListGenresOutput, error) {
+	genres, err := u.genreRepo.FindAll()
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+
+	children := make(map[uint][]*entities.Genre)
+	roots := []*entities.Genre{}
+	for _, g := range genres {
+		if g.ParentID == nil {
+			roots = append(roots, g)
+			continue
+		}
+		children[*g.ParentID] = append(children[*g.ParentID], g)
+	}
+
+	var build func(g *entities.Genre) GenreNode
+	build = func(g *entities.Genre) GenreNode {
+		aliases := make([]string, len(g.Aliases))
+		for i, a := range g.Aliases {
+			aliases[i] = a.Alias
+		}
+		node := GenreNode{
+			ID:          g.ID,
+			ParentID:    g.ParentID,
+			Name:        g.Name,
+			Description: g.Description,
+			Aliases:     aliases,
+			Children:    []GenreNode{},
+		}
+		for _, c := range children[g.ID] {
+			node.Children = append(node.Children, build(c))
+		}
+		return node
+	}
+
+	output := &ListGenresOutput{Genres: make([]GenreNode, len(roots))}
+	for i, g := range roots {
+		output.Genres[i] = build(g)
+	}
+	return output, nil
+}
+
+func (u *genreUsecase) GetGenreTracks(genreID uint, limit, offset *int) (*GetGenreTracksOutput, error) {
+	if _, err := u.findGenre(genreID); err != nil {
+		return nil, err
+	}
+
+	l, o := pagination(limit, offset)
+	music, err := u.musicRepo.FindByGenreID(genreID, o, l)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	total, err := u.musicRepo.CountByGenreID(genreID)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+
+	tracks := make([]CatalogTrack, len(music))
+	for i, m := range music {
+		tracks[i] = toCatalogTrack(m)
+	}
+
+	output := &GetGenreTracksOutput{
+		Tracks: tracks,
+		Total:  int(total),
+	}
+	return output, nil
+}
+
+func (u *genreUsecase) GetGenreCommunities(genreID uint) (*GetGenreCommunitiesOutput, error) {
+	if _, err := u.findGenre(genreID); err != nil {
+		return nil, err
+	}
+
+	communities, err := u.genreCommunityRepo.FindByGenreID(genreID)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+
+	output := &GetGenreCommunitiesOutput{Communities: make([]GenreCommunity, len(communities))}
+	for i, c := range communities {
+		output.Communities[i] = GenreCommunity{
+			ID:          c.ID,
+			GenreID:     c.GenreID,
+			Name:        c.Name,
+			Description: c.Description,
+		}
+	}
+	return output, nil
+}
+
+func (u *genreUsecase) findGenre(genreID uint) (*entities.Genre, error) {
+	genre, err := u.genreRepo.FindByID(genreID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrGenreNotFound
+		}
+		return nil, ErrFindingRecord
+	}
+	return genre, nil
+}
+
+func toCatalogTrack(m *entities.Music) CatalogTrack {
+	artists := make([]CatalogArtist, len(m.MusicArtistMapping))
+	for i, a := range m.MusicArtistMapping {
+		artists[i] = CatalogArtist{ID: a.Artist.ID, Name: a.Artist.Name}
+	}
+	return CatalogTrack{
+		ID:        m.ID,
+		Title:     m.Title,
+		AlbumID:   m.AlbumID,
+		SpotifyID: m.SpotifyID,
+		Artists:   artists,
+	}
+}
+
+func sameID(a, b *uint) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,257 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/taxonomy"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase/mocks"
+	"github.com/myjinjin/sonic-odyssey-backend/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGenreUsecase_ImportTaxonomy_Success(t *testing.T) {
+	// Setup
+	genreRepo := &mocks.GenreRepository{}
+	genreAliasRepo := &mocks.GenreAliasRepository{}
+
+	genreUsecase := NewGenreUsecase(genreRepo, genreAliasRepo, nil, nil)
+
+	genres := []taxonomy.Genre{
+		{
+			Name:        "Rock",
+			Description: "Guitar music",
+			Children: []taxonomy.Genre{
+				{Name: "Indie Rock", Aliases: []string{"K-Indie", "indie"}},
+			},
+		},
+	}
+
+	// Expectations
+	genreRepo.On("FindByName", "Rock").Return(&entities.Genre{ID: 1, Name: "Rock", Description: "Guitar music"}, nil)
+	genreAliasRepo.On("FindByAlias", "rock").Return(&entities.GenreAlias{GenreID: 1, Alias: "rock"}, nil)
+
+	genreRepo.On("FindByName", "Indie Rock").Return(nil, repositories.ErrNotFound)
+	genreRepo.On("Create", mock.MatchedBy(func(g *entities.Genre) bool {
+		g.ID = 2
+		return g.Name == "Indie Rock" && g.ParentID != nil && *g.ParentID == 1
+	})).Return(nil)
+	genreAliasRepo.On("FindByAlias", "indie rock").Return(nil, repositories.ErrNotFound)
+	genreAliasRepo.On("FindByAlias", "k indie").Return(nil, repositories.ErrNotFound)
+	genreAliasRepo.On("FindByAlias", "indie").Return(&entities.GenreAlias{GenreID: 3, Alias: "indie"}, nil)
+	genreAliasRepo.On("Create", &entities.GenreAlias{GenreID: 2, Alias: "indie rock"}).Return(nil)
+	genreAliasRepo.On("Create", &entities.GenreAlias{GenreID: 2, Alias: "k indie"}).Return(nil)
+
+	// Execute
+	output, err := genreUsecase.ImportTaxonomy(genres)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, output.GenresCreated)
+	assert.Equal(t, 0, output.GenresUpdated)
+	assert.Equal(t, 2, output.AliasesCreated)
+	assert.Equal(t, []string{"indie"}, output.ConflictingAliases)
+
+	// Verify
+	genreRepo.AssertExpectations(t)
+	genreAliasRepo.AssertExpectations(t)
+	genreRepo.AssertNotCalled(t, "Update", mock.Anything)
+}
+
+func TestGenreUsecase_ImportTaxonomy_UpdatesParent(t *testing.T) {
+	// Setup
+	genreRepo := &mocks.GenreRepository{}
+	genreAliasRepo := &mocks.GenreAliasRepository{}
+
+	genreUsecase := NewGenreUsecase(genreRepo, genreAliasRepo, nil, nil)
+
+	genres := []taxonomy.Genre{
+		{Name: "Pop", Children: []taxonomy.Genre{{Name: "K-Pop"}}},
+	}
+
+	// Expectations
+	genreRepo.On("FindByName", "Pop").Return(&entities.Genre{ID: 1, Name: "Pop"}, nil)
+	genreAliasRepo.On("FindByAlias", "pop").Return(&entities.GenreAlias{GenreID: 1, Alias: "pop"}, nil)
+	genreRepo.On("FindByName", "K-Pop").Return(&entities.Genre{ID: 2, Name: "K-Pop"}, nil)
+	genreRepo.On("Update", mock.MatchedBy(func(g *entities.Genre) bool {
+		return g.ID == 2 && g.ParentID != nil && *g.ParentID == 1
+	})).Return(nil)
+	genreAliasRepo.On("FindByAlias", "k pop").Return(&entities.GenreAlias{GenreID: 2, Alias: "k pop"}, nil)
+
+	// Execute
+	output, err := genreUsecase.ImportTaxonomy(genres)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 0, output.GenresCreated)
+	assert.Equal(t, 1, output.GenresUpdated)
+	assert.Equal(t, 0, output.AliasesCreated)
+
+	// Verify
+	genreRepo.AssertExpectations(t)
+	genreAliasRepo.AssertExpectations(t)
+}
+
+func TestGenreUsecase_ImportTaxonomy_CreatingRecordError(t *testing.T) {
+	// Setup
+	genreRepo := &mocks.GenreRepository{}
+
+	genreUsecase := NewGenreUsecase(genreRepo, nil, nil, nil)
+
+	// Expectations
+	genreRepo.On("FindByName", "Rock").Return(nil, repositories.ErrNotFound)
+	genreRepo.On("Create", mock.AnythingOfType("*entities.Genre")).Return(repositories.ErrCreate)
+
+	// Execute
+	output, err := genreUsecase.ImportTaxonomy([]taxonomy.Genre{{Name: "Rock"}})
+
+	// Assert
+	assert.ErrorIs(t, err, ErrCreatingRecord)
+	assert.Nil(t, output)
+
+	// Verify
+	genreRepo.AssertExpectations(t)
+}
+
+func TestGenreUsecase_ListGenres_Success(t *testing.T) {
+	// Setup
+	genreRepo := &mocks.GenreRepository{}
+
+	genreUsecase := NewGenreUsecase(genreRepo, nil, nil, nil)
+
+	// Expectations
+	genreRepo.On("FindAll").Return([]*entities.Genre{
+		{ID: 1, Name: "Rock", Aliases: []entities.GenreAlias{{Alias: "rock"}}},
+		{ID: 2, ParentID: utils.ToPtr(uint(1)), Name: "Indie Rock"},
+		{ID: 3, Name: "Pop"},
+		{ID: 4, ParentID: utils.ToPtr(uint(2)), Name: "Shoegaze"},
+	}, nil)
+
+	// Execute
+	output, err := genreUsecase.ListGenres()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, output.Genres, 2)
+	assert.Equal(t, "Rock", output.Genres[0].Name)
+	assert.Equal(t, []string{"rock"}, output.Genres[0].Aliases)
+	assert.Equal(t, "Indie Rock", output.Genres[0].Children[0].Name)
+	assert.Equal(t, "Shoegaze", output.Genres[0].Children[0].Children[0].Name)
+	assert.Equal(t, "Pop", output.Genres[1].Name)
+	assert.Empty(t, output.Genres[1].Children)
+
+	// Verify
+	genreRepo.AssertExpectations(t)
+}
+
+func TestGenreUsecase_GetGenreTracks_Success(t *testing.T) {
+	// Setup
+	genreRepo := &mocks.GenreRepository{}
+	musicRepo := &mocks.MusicRepository{}
+
+	genreUsecase := NewGenreUsecase(genreRepo, nil, musicRepo, nil)
+
+	genreID := uint(1)
+	limit := 10
+	offset := 0
+
+	// Expectations
+	genreRepo.On("FindByID", genreID).Return(&entities.Genre{ID: genreID, Name: "Rock"}, nil)
+	musicRepo.On("FindByGenreID", genreID, offset, limit).Return([]*entities.Music{
+		{
+			ID:        1,
+			Title:     "One",
+			AlbumID:   1,
+			SpotifyID: "2up3OPMp9Tb4dAKM2erWXQ",
+			MusicArtistMapping: []entities.MusicArtistMapping{
+				{Artist: entities.Artist{ID: 1, Name: "Aimee Mann"}},
+			},
+		},
+	}, nil)
+	musicRepo.On("CountByGenreID", genreID).Return(int64(1), nil)
+
+	// Execute
+	output, err := genreUsecase.GetGenreTracks(genreID, &limit, &offset)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, output.Total)
+	assert.Equal(t, "One", output.Tracks[0].Title)
+	assert.Equal(t, "Aimee Mann", output.Tracks[0].Artists[0].Name)
+
+	// Verify
+	genreRepo.AssertExpectations(t)
+	musicRepo.AssertExpectations(t)
+}
+
+func TestGenreUsecase_GetGenreTracks_DefaultPagination(t *testing.T) {
+	// Setup
+	genreRepo := &mocks.GenreRepository{}
+	musicRepo := &mocks.MusicRepository{}
+
+	genreUsecase := NewGenreUsecase(genreRepo, nil, musicRepo, nil)
+
+	// Expectations
+	genreRepo.On("FindByID", uint(1)).Return(&entities.Genre{ID: 1}, nil)
+	musicRepo.On("FindByGenreID", uint(1), 0, defaultPageLimit).Return([]*entities.Music{}, nil)
+	musicRepo.On("CountByGenreID", uint(1)).Return(int64(0), nil)
+
+	// Execute
+	output, err := genreUsecase.GetGenreTracks(1, nil, nil)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, output.Tracks)
+
+	// Verify
+	musicRepo.AssertExpectations(t)
+}
+
+func TestGenreUsecase_GetGenreTracks_GenreNotFound(t *testing.T) {
+	// Setup
+	genreRepo := &mocks.GenreRepository{}
+	musicRepo := &mocks.MusicRepository{}
+
+	genreUsecase := NewGenreUsecase(genreRepo, nil, musicRepo, nil)
+
+	// Expectations
+	genreRepo.On("FindByID", uint(1)).Return(nil, repositories.ErrNotFound)
+
+	// Execute
+	output, err := genreUsecase.GetGenreTracks(1, nil, nil)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrGenreNotFound)
+	assert.Nil(t, output)
+
+	// Verify
+	genreRepo.AssertExpectations(t)
+	musicRepo.AssertNotCalled(t, "FindByGenreID", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGenreUsecase_GetGenreCommunities_Success(t *testing.T) {
+	// Setup
+	genreRepo := &mocks.GenreRepository{}
+	genreCommunityRepo := &mocks.GenresCommunityRepository{}
+
+	genreUsecase := NewGenreUsecase(genreRepo, nil, nil, genreCommunityRepo)
+
+	// Expectations
+	genreRepo.On("FindByID", uint(1)).Return(&entities.Genre{ID: 1}, nil)
+	genreCommunityRepo.On("FindByGenreID", uint(1)).Return([]*entities.GenreCommunity{
+		{ID: 1, GenreID: 1, Name: "Rock Lovers", Description: "rock"},
+	}, nil)
+
+	// Execute
+	output, err := genreUsecase.GetGenreCommunities(1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []GenreCommunity{{ID: 1, GenreID: 1, Name: "Rock Lovers", Description: "rock"}}, output.Communities)
+
+	// Verify
+	genreRepo.AssertExpectations(t)
+	genreCommunityRepo.AssertExpectations(t)
+}
@@ -0,0 +1,232 @@
+package usecase
+
+import (
+	"errors"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+)
+
+type LikeUsecase interface {
+	ReactToMusic(userID, musicID uint, liked bool) (*ReactionOutput, error)
+	ClearMusicReaction(userID, musicID uint) (*ReactionOutput, error)
+	ReactToPost(userID, postID uint, liked bool) (*ReactionOutput, error)
+	ClearPostReaction(userID, postID uint) (*ReactionOutput, error)
+	ReactToComment(userID, commentID uint, liked bool) (*ReactionOutput, error)
+	ClearCommentReaction(userID, commentID uint) (*ReactionOutput, error)
+	GetLikedTracks(userID uint, limit, offset *int) (*GetLikedTracksOutput, error)
+}
+
+type likeUsecase struct {
+	userLikeRepo repositories.UserLikeRepository
+	musicRepo    repositories.MusicRepository
+	postRepo     repositories.PostRepository
+	commentRepo  repositories.CommentRepository
+	activities   *ActivityRecorder
+	notifier     *Notifier
+}
+
+func NewLikeUsecase(userLikeRepo repositories.UserLikeRepository, musicRepo repositories.MusicRepository, postRepo repositories.PostRepository, commentRepo repositories.CommentRepository, activities *ActivityRecorder, notifier *Notifier) LikeUsecase {
+	return &likeUsecase{
+		userLikeRepo: userLikeRepo,
+		musicRepo:    musicRepo,
+		postRepo:     postRepo,
+		commentRepo:  commentRepo,
+		activities:   activities,
+		notifier:     notifier,
+	}
+}
+
+// likeTarget abstracts over the three kinds of rows a user can react to.
+// activity is set for targets whose likes show up in the followers' feeds,
+// and notification for targets whose owners are notified about likes.
+type likeTarget struct {
+	find         func(userID uint) (*entities.UserLike, error)
+	build        func(userID uint, liked bool) *entities.UserLike
+	count        func() (likes int64, dislikes int64, err error)
+	activity     func(userID uint) *entities.Activity
+	notification func(userID uint) *entities.Notification
+}
+
+func (u *likeUsecase) ReactToMusic(userID, musicID uint, liked bool) (*ReactionOutput, error) {
+	target, err := u.musicTarget(musicID)
+	if err != nil {
+		return nil, err
+	}
+	return u.react(target, userID, &liked)
+}
+
+func (u *likeUsecase) ClearMusicReaction(userID, musicID uint) (*ReactionOutput, error) {
+	target, err := u.musicTarget(musicID)
+	if err != nil {
+		return nil, err
+	}
+	return u.react(target, userID, nil)
+}
+
+func (u *likeUsecase) ReactToPost(userID, postID uint, liked bool) (*ReactionOutput, error) {
+	target, err := u.postTarget(postID)
+	if err != nil {
+		return nil, err
+	}
+	return u.react(target, userID, &liked)
+}
+
+func (u *likeUsecase) ClearPostReaction(userID, postID uint) (*ReactionOutput, error) {
+	target, err := u.postTarget(postID)
+	if err != nil {
+		return nil, err
+	}
+	return u.react(target, userID, nil)
+}
+
+func (u *likeUsecase) ReactToComment(userID, commentID uint, liked bool) (*ReactionOutput, error) {
+	target, err := u.commentTarget(commentID)
+	if err != nil {
+		return nil, err
+	}
+	return u.react(target, userID, &liked)
+}
+
+func (u *likeUsecase) ClearCommentReaction(userID, commentID uint) (*ReactionOutput, error) {
+	target, err := u.commentTarget(commentID)
+	if err != nil {
+		return nil, err
+	}
+	return u.react(target, userID, nil)
+}
+
+func (u *likeUsecase) GetLikedTracks(userID uint, limit, offset *int) (*GetLikedTracksOutput, error) {
+	l, o := pagination(limit, offset)
+	music, err := u.musicRepo.FindLikedByUserID(userID, o, l)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	total, err := u.musicRepo.CountLikedByUserID(userID)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+
+	tracks := make([]CatalogTrack, len(music))
+	for i, m := range music {
+		tracks[i] = toCatalogTrack(m)
+	}
+	return &GetLikedTracksOutput{Tracks: tracks, Total: int(total)}, nil
+}
+
+func (u *likeUsecase) musicTarget(musicID uint) (*likeTarget, error) {
+	music, err := u.musicRepo.FindByID(musicID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrMusicNotFound
+		}
+		return nil, ErrFindingRecord
+	}
+	// musicID may be an alias of a merged track; reactions go to the canonical row.
+	musicID = music.ID
+	return &likeTarget{
+		find: func(userID uint) (*entities.UserLike, error) {
+			return u.userLikeRepo.FindByUserIDAndMusicID(userID, musicID)
+		},
+		build: func(userID uint, liked bool) *entities.UserLike {
+			return &entities.UserLike{UserID: userID, MusicID: &musicID, Liked: liked}
+		},
+		count: func() (int64, int64, error) {
+			return u.userLikeRepo.CountLikesAndDislikesByMusicID(musicID)
+		},
+		activity: func(userID uint) *entities.Activity {
+			return &entities.Activity{ActorID: userID, Type: entities.ActivityTypeTrackLike, MusicID: &musicID}
+		},
+	}, nil
+}
+
+func (u *likeUsecase) postTarget(postID uint) (*likeTarget, error) {
+	post, err := u.postRepo.FindByID(postID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrPostNotFound
+		}
+		return nil, ErrFindingRecord
+	}
+	return &likeTarget{
+		find: func(userID uint) (*entities.UserLike, error) {
+			return u.userLikeRepo.FindByUserIDAndPostID(userID, postID)
+		},
+		build: func(userID uint, liked bool) *entities.UserLike {
+			return &entities.UserLike{UserID: userID, PostID: &postID, Liked: liked}
+		},
+		count: func() (int64, int64, error) {
+			return u.userLikeRepo.CountLikesAndDislikesByPostID(postID)
+		},
+		notification: func(userID uint) *entities.Notification {
+			return &entities.Notification{UserID: post.UserID, ActorID: &userID, Type: entities.NotificationTypePostLike, PostID: &postID}
+		},
+	}, nil
+}
+
+func (u *likeUsecase) commentTarget(commentID uint) (*likeTarget, error) {
+	if _, err := u.commentRepo.FindByID(commentID); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrCommentNotFound
+		}
+		return nil, ErrFindingRecord
+	}
+	return &likeTarget{
+		find: func(userID uint) (*entities.UserLike, error) {
+			return u.userLikeRepo.FindByUserIDAndCommentID(userID, commentID)
+		},
+		build: func(userID uint, liked bool) *entities.UserLike {
+			return &entities.UserLike{UserID: userID, CommentID: &commentID, Liked: liked}
+		},
+		count: func() (int64, int64, error) {
+			return u.userLikeRepo.CountLikesAndDislikesByCommentID(commentID)
+		},
+	}, nil
+}
+
+// react sets the caller's reaction to liked, or clears it when liked is nil.
+// Repeating the same request leaves the stored reaction unchanged.
+func (u *likeUsecase) react(target *likeTarget, userID uint, liked *bool) (*ReactionOutput, error) {
+	existing, err := target.find(userID)
+	if err != nil && !errors.Is(err, repositories.ErrNotFound) {
+		return nil, ErrFindingRecord
+	}
+	// New likes are recorded for the feed and notified, unless the author has
+	// not read the notification of an earlier like yet. Undone likes drop out
+	// of the feed on their own.
+	newLike := liked != nil && *liked && (existing == nil || !existing.Liked)
+
+	switch {
+	case liked == nil && existing != nil:
+		if err := u.userLikeRepo.Delete(existing.ID); err != nil {
+			return nil, ErrDeletingRecord
+		}
+	case liked != nil && existing == nil:
+		if err := u.userLikeRepo.Create(target.build(userID, *liked)); err != nil {
+			// A concurrent request may have inserted the same reaction first;
+			// the unique index rejects ours, which is fine as long as it matches.
+			current, findErr := target.find(userID)
+			if findErr != nil || current.Liked != *liked {
+				return nil, ErrCreatingRecord
+			}
+		}
+	case liked != nil && existing.Liked != *liked:
+		existing.Liked = *liked
+		if err := u.userLikeRepo.Update(existing); err != nil {
+			return nil, ErrUpdatingRecord
+		}
+	}
+
+	if newLike && target.activity != nil {
+		u.activities.record(target.activity(userID))
+	}
+	if newLike && target.notification != nil {
+		u.notifier.notifyOnce(target.notification(userID))
+	}
+
+	likes, dislikes, err := target.count()
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	return &ReactionOutput{Likes: likes, Dislikes: dislikes, Liked: liked}, nil
+}
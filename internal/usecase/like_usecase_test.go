@@ -0,0 +1,308 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestLikeUsecase_ReactToMusic_CreatesLike(t *testing.T) {
+	// Setup
+	userLikeRepo := &mocks.UserLikeRepository{}
+	musicRepo := &mocks.MusicRepository{}
+
+	likeUsecase := NewLikeUsecase(userLikeRepo, musicRepo, nil, nil, nil, nil)
+
+	userID := uint(1)
+	musicID := uint(2)
+
+	// Expectations
+	musicRepo.On("FindByID", musicID).Return(&entities.Music{ID: musicID}, nil)
+	userLikeRepo.On("FindByUserIDAndMusicID", userID, musicID).Return(nil, repositories.ErrNotFound)
+	userLikeRepo.On("Create", mock.MatchedBy(func(l *entities.UserLike) bool {
+		return l.UserID == userID && *l.MusicID == musicID && l.PostID == nil && l.CommentID == nil && l.Liked
+	})).Return(nil)
+	userLikeRepo.On("CountLikesAndDislikesByMusicID", musicID).Return(int64(1), int64(0), nil)
+
+	// Execute
+	output, err := likeUsecase.ReactToMusic(userID, musicID, true)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), output.Likes)
+	assert.True(t, *output.Liked)
+
+	// Verify
+	musicRepo.AssertExpectations(t)
+	userLikeRepo.AssertExpectations(t)
+}
+
+func TestLikeUsecase_ReactToMusic_Idempotent(t *testing.T) {
+	// Setup
+	userLikeRepo := &mocks.UserLikeRepository{}
+	musicRepo := &mocks.MusicRepository{}
+
+	likeUsecase := NewLikeUsecase(userLikeRepo, musicRepo, nil, nil, nil, nil)
+
+	// Expectations
+	musicRepo.On("FindByID", uint(2)).Return(&entities.Music{ID: 2}, nil)
+	userLikeRepo.On("FindByUserIDAndMusicID", uint(1), uint(2)).Return(&entities.UserLike{ID: 3, Liked: true}, nil)
+	userLikeRepo.On("CountLikesAndDislikesByMusicID", uint(2)).Return(int64(1), int64(0), nil)
+
+	// Execute
+	output, err := likeUsecase.ReactToMusic(1, 2, true)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), output.Likes)
+
+	// Verify
+	userLikeRepo.AssertExpectations(t)
+	userLikeRepo.AssertNotCalled(t, "Create", mock.Anything)
+	userLikeRepo.AssertNotCalled(t, "Update", mock.Anything)
+}
+
+func TestLikeUsecase_ReactToMusic_ConcurrentCreate(t *testing.T) {
+	// Setup
+	userLikeRepo := &mocks.UserLikeRepository{}
+	musicRepo := &mocks.MusicRepository{}
+
+	likeUsecase := NewLikeUsecase(userLikeRepo, musicRepo, nil, nil, nil, nil)
+
+	// Expectations
+	musicRepo.On("FindByID", uint(2)).Return(&entities.Music{ID: 2}, nil)
+	userLikeRepo.On("FindByUserIDAndMusicID", uint(1), uint(2)).Return(nil, repositories.ErrNotFound).Once()
+	userLikeRepo.On("Create", mock.AnythingOfType("*entities.UserLike")).Return(repositories.ErrCreate)
+	userLikeRepo.On("FindByUserIDAndMusicID", uint(1), uint(2)).Return(&entities.UserLike{ID: 3, Liked: true}, nil).Once()
+	userLikeRepo.On("CountLikesAndDislikesByMusicID", uint(2)).Return(int64(1), int64(0), nil)
+
+	// Execute
+	output, err := likeUsecase.ReactToMusic(1, 2, true)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, *output.Liked)
+
+	// Verify
+	userLikeRepo.AssertExpectations(t)
+}
+
+func TestLikeUsecase_ReactToPost_SwitchesToDislike(t *testing.T) {
+	// Setup
+	userLikeRepo := &mocks.UserLikeRepository{}
+	postRepo := &mocks.PostRepository{}
+
+	likeUsecase := NewLikeUsecase(userLikeRepo, nil, postRepo, nil, nil, nil)
+
+	// Expectations
+	postRepo.On("FindByID", uint(2)).Return(&entities.Post{ID: 2}, nil)
+	userLikeRepo.On("FindByUserIDAndPostID", uint(1), uint(2)).Return(&entities.UserLike{ID: 3, Liked: true}, nil)
+	userLikeRepo.On("Update", mock.MatchedBy(func(l *entities.UserLike) bool {
+		return l.ID == 3 && !l.Liked
+	})).Return(nil)
+	userLikeRepo.On("CountLikesAndDislikesByPostID", uint(2)).Return(int64(0), int64(1), nil)
+
+	// Execute
+	output, err := likeUsecase.ReactToPost(1, 2, false)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), output.Dislikes)
+	assert.False(t, *output.Liked)
+
+	// Verify
+	postRepo.AssertExpectations(t)
+	userLikeRepo.AssertExpectations(t)
+}
+
+func TestLikeUsecase_ReactToPost_PostNotFound(t *testing.T) {
+	// Setup
+	userLikeRepo := &mocks.UserLikeRepository{}
+	postRepo := &mocks.PostRepository{}
+
+	likeUsecase := NewLikeUsecase(userLikeRepo, nil, postRepo, nil, nil, nil)
+
+	// Expectations
+	postRepo.On("FindByID", uint(2)).Return(nil, repositories.ErrNotFound)
+
+	// Execute
+	output, err := likeUsecase.ReactToPost(1, 2, true)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrPostNotFound)
+	assert.Nil(t, output)
+
+	// Verify
+	postRepo.AssertExpectations(t)
+	userLikeRepo.AssertNotCalled(t, "FindByUserIDAndPostID", mock.Anything, mock.Anything)
+}
+
+func TestLikeUsecase_ClearCommentReaction_DeletesLike(t *testing.T) {
+	// Setup
+	userLikeRepo := &mocks.UserLikeRepository{}
+	commentRepo := &mocks.CommentRepository{}
+
+	likeUsecase := NewLikeUsecase(userLikeRepo, nil, nil, commentRepo, nil, nil)
+
+	// Expectations
+	commentRepo.On("FindByID", uint(2)).Return(&entities.Comment{ID: 2}, nil)
+	userLikeRepo.On("FindByUserIDAndCommentID", uint(1), uint(2)).Return(&entities.UserLike{ID: 3, Liked: false}, nil)
+	userLikeRepo.On("Delete", uint(3)).Return(nil)
+	userLikeRepo.On("CountLikesAndDislikesByCommentID", uint(2)).Return(int64(0), int64(0), nil)
+
+	// Execute
+	output, err := likeUsecase.ClearCommentReaction(1, 2)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Nil(t, output.Liked)
+
+	// Verify
+	commentRepo.AssertExpectations(t)
+	userLikeRepo.AssertExpectations(t)
+}
+
+func TestLikeUsecase_ClearCommentReaction_NoReaction(t *testing.T) {
+	// Setup
+	userLikeRepo := &mocks.UserLikeRepository{}
+	commentRepo := &mocks.CommentRepository{}
+
+	likeUsecase := NewLikeUsecase(userLikeRepo, nil, nil, commentRepo, nil, nil)
+
+	// Expectations
+	commentRepo.On("FindByID", uint(2)).Return(&entities.Comment{ID: 2}, nil)
+	userLikeRepo.On("FindByUserIDAndCommentID", uint(1), uint(2)).Return(nil, repositories.ErrNotFound)
+	userLikeRepo.On("CountLikesAndDislikesByCommentID", uint(2)).Return(int64(0), int64(0), nil)
+
+	// Execute
+	output, err := likeUsecase.ClearCommentReaction(1, 2)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Nil(t, output.Liked)
+
+	// Verify
+	userLikeRepo.AssertExpectations(t)
+	userLikeRepo.AssertNotCalled(t, "Delete", mock.Anything)
+}
+
+func TestLikeUsecase_GetLikedTracks_Success(t *testing.T) {
+	// Setup
+	musicRepo := &mocks.MusicRepository{}
+
+	likeUsecase := NewLikeUsecase(nil, musicRepo, nil, nil, nil, nil)
+
+	limit := 10
+	offset := 10
+
+	// Expectations
+	musicRepo.On("FindLikedByUserID", uint(1), offset, limit).Return([]*entities.Music{{ID: 2, Title: "One"}}, nil)
+	musicRepo.On("CountLikedByUserID", uint(1)).Return(int64(11), nil)
+
+	// Execute
+	output, err := likeUsecase.GetLikedTracks(1, &limit, &offset)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 11, output.Total)
+	assert.Equal(t, "One", output.Tracks[0].Title)
+
+	// Verify
+	musicRepo.AssertExpectations(t)
+}
+
+func TestLikeUsecase_ReactToMusic_RecordsActivity(t *testing.T) {
+	// Setup
+	userLikeRepo := &mocks.UserLikeRepository{}
+	musicRepo := &mocks.MusicRepository{}
+	activityRepo := &mocks.ActivityRepository{}
+	followRepo := &mocks.UserFollowRepository{}
+
+	likeUsecase := NewLikeUsecase(userLikeRepo, musicRepo, nil, nil, NewActivityRecorder(activityRepo, followRepo, 0), nil)
+
+	// Expectations
+	musicRepo.On("FindByID", uint(5)).Return(&entities.Music{ID: 5}, nil)
+	userLikeRepo.On("FindByUserIDAndMusicID", uint(1), uint(5)).Return(&entities.UserLike{ID: 3, Liked: false}, nil)
+	userLikeRepo.On("Update", mock.Anything).Return(nil)
+	userLikeRepo.On("CountLikesAndDislikesByMusicID", uint(5)).Return(int64(1), int64(0), nil)
+	followRepo.On("CountFollowersByUserID", repositories.Viewer{}, uint(1)).Return(int64(2), nil)
+	activityRepo.On("Create", mock.MatchedBy(func(a *entities.Activity) bool {
+		return a.ActorID == 1 && a.Type == entities.ActivityTypeTrackLike && *a.MusicID == 5 && a.FannedOut
+	})).Return(nil)
+
+	// Execute
+	_, err := likeUsecase.ReactToMusic(1, 5, true)
+
+	// Assert
+	assert.NoError(t, err)
+
+	// Verify
+	activityRepo.AssertExpectations(t)
+}
+
+func TestLikeUsecase_ReactToPost_NotifiesAuthor(t *testing.T) {
+	// Setup
+	userLikeRepo := &mocks.UserLikeRepository{}
+	postRepo := &mocks.PostRepository{}
+	notificationRepo := &mocks.NotificationRepository{}
+	preferenceRepo := &mocks.NotificationPreferenceRepository{}
+	userRepo := &mocks.UserRepository{}
+	blockRepo := &mocks.UserBlockRepository{}
+
+	likeUsecase := NewLikeUsecase(userLikeRepo, nil, postRepo, nil, nil, NewNotifier(notificationRepo, preferenceRepo, userRepo, blockRepo, nil, nil))
+
+	// Expectations
+	postRepo.On("FindByID", uint(2)).Return(&entities.Post{ID: 2, UserID: 4}, nil)
+	userLikeRepo.On("FindByUserIDAndPostID", uint(1), uint(2)).Return(nil, repositories.ErrNotFound)
+	userLikeRepo.On("Create", mock.Anything).Return(nil)
+	userLikeRepo.On("CountLikesAndDislikesByPostID", uint(2)).Return(int64(1), int64(0), nil)
+	notificationRepo.On("ExistsUnread", mock.Anything).Return(false, nil)
+	blockRepo.On("ExistsBetween", uint(4), uint(1)).Return(false, nil)
+	userRepo.On("FindByID", uint(1)).Return(&entities.User{ID: 1, Nickname: "alice"}, nil)
+	preferenceRepo.On("FindByUserIDAndType", uint(4), entities.NotificationTypePostLike).Return(nil, repositories.ErrNotFound)
+	notificationRepo.On("Create", mock.MatchedBy(func(n *entities.Notification) bool {
+		return n.UserID == 4 && *n.ActorID == 1 && n.Type == entities.NotificationTypePostLike && *n.PostID == 2
+	})).Return(nil)
+
+	// Execute
+	_, err := likeUsecase.ReactToPost(1, 2, true)
+
+	// Assert
+	assert.NoError(t, err)
+
+	// Verify
+	notificationRepo.AssertExpectations(t)
+}
+
+func TestLikeUsecase_ReactToPost_UnreadNotification(t *testing.T) {
+	// Setup
+	userLikeRepo := &mocks.UserLikeRepository{}
+	postRepo := &mocks.PostRepository{}
+	notificationRepo := &mocks.NotificationRepository{}
+
+	likeUsecase := NewLikeUsecase(userLikeRepo, nil, postRepo, nil, nil, NewNotifier(notificationRepo, nil, nil, nil, nil, nil))
+
+	// Expectations
+	postRepo.On("FindByID", uint(2)).Return(&entities.Post{ID: 2, UserID: 4}, nil)
+	// The like was cleared before, and is now given again.
+	userLikeRepo.On("FindByUserIDAndPostID", uint(1), uint(2)).Return(nil, repositories.ErrNotFound)
+	userLikeRepo.On("Create", mock.Anything).Return(nil)
+	userLikeRepo.On("CountLikesAndDislikesByPostID", uint(2)).Return(int64(1), int64(0), nil)
+	notificationRepo.On("ExistsUnread", mock.MatchedBy(func(n *entities.Notification) bool {
+		return n.UserID == 4 && *n.ActorID == 1 && n.Type == entities.NotificationTypePostLike && *n.PostID == 2
+	})).Return(true, nil)
+
+	// Execute
+	_, err := likeUsecase.ReactToPost(1, 2, true)
+
+	// Assert
+	assert.NoError(t, err)
+
+	// Verify
+	notificationRepo.AssertExpectations(t)
+	notificationRepo.AssertNotCalled(t, "Create", mock.Anything)
+}
@@ -0,0 +1,77 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+
+	repositories "github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+)
+
+// ActivityRepository is an autogenerated mock type for the ActivityRepository type
+type ActivityRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: activity
+func (_m *ActivityRepository) Create(activity *entities.Activity) error {
+	ret := _m.Called(activity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.Activity) error); ok {
+		r0 = rf(activity)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindFeed provides a mock function with given fields: viewer, beforeID, limit
+func (_m *ActivityRepository) FindFeed(viewer repositories.Viewer, beforeID uint, limit int) ([]*entities.Activity, error) {
+	ret := _m.Called(viewer, beforeID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindFeed")
+	}
+
+	var r0 []*entities.Activity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(repositories.Viewer, uint, int) ([]*entities.Activity, error)); ok {
+		return rf(viewer, beforeID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(repositories.Viewer, uint, int) []*entities.Activity); ok {
+		r0 = rf(viewer, beforeID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Activity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(repositories.Viewer, uint, int) error); ok {
+		r1 = rf(viewer, beforeID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewActivityRepository creates a new instance of ActivityRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewActivityRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ActivityRepository {
+	mock := &ActivityRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,369 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AlbumRepository is an autogenerated mock type for the AlbumRepository type
+type AlbumRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: album
+func (_m *AlbumRepository) Create(album *entities.Album) error {
+	ret := _m.Called(album)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.Album) error); ok {
+		r0 = rf(album)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields: id
+func (_m *AlbumRepository) Delete(id uint) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindByArtistID provides a mock function with given fields: artistID, offset, limit
+func (_m *AlbumRepository) FindByArtistID(artistID uint, offset int, limit int) ([]*entities.Album, error) {
+	ret := _m.Called(artistID, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByArtistID")
+	}
+
+	var r0 []*entities.Album
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, int, int) ([]*entities.Album, error)); ok {
+		return rf(artistID, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(uint, int, int) []*entities.Album); ok {
+		r0 = rf(artistID, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Album)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, int, int) error); ok {
+		r1 = rf(artistID, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByCollectionID provides a mock function with given fields: collectionID, limit
+func (_m *AlbumRepository) FindByCollectionID(collectionID uint, limit int) ([]*entities.Album, error) {
+	ret := _m.Called(collectionID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByCollectionID")
+	}
+
+	var r0 []*entities.Album
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, int) ([]*entities.Album, error)); ok {
+		return rf(collectionID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(uint, int) []*entities.Album); ok {
+		r0 = rf(collectionID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Album)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, int) error); ok {
+		r1 = rf(collectionID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByID provides a mock function with given fields: id
+func (_m *AlbumRepository) FindByID(id uint) (*entities.Album, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *entities.Album
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (*entities.Album, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uint) *entities.Album); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Album)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindBySpotifyID provides a mock function with given fields: spotifyID
+func (_m *AlbumRepository) FindBySpotifyID(spotifyID string) (*entities.Album, error) {
+	ret := _m.Called(spotifyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindBySpotifyID")
+	}
+
+	var r0 *entities.Album
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*entities.Album, error)); ok {
+		return rf(spotifyID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *entities.Album); ok {
+		r0 = rf(spotifyID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Album)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(spotifyID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByUPC provides a mock function with given fields: upc
+func (_m *AlbumRepository) FindByUPC(upc string) ([]*entities.Album, error) {
+	ret := _m.Called(upc)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByUPC")
+	}
+
+	var r0 []*entities.Album
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*entities.Album, error)); ok {
+		return rf(upc)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*entities.Album); ok {
+		r0 = rf(upc)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Album)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(upc)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindDuplicateUPCs provides a mock function with given fields:
+func (_m *AlbumRepository) FindDuplicateUPCs() ([]string, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindDuplicateUPCs")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]string, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindMostLikedByGenreID provides a mock function with given fields: genreID, limit
+func (_m *AlbumRepository) FindMostLikedByGenreID(genreID uint, limit int) ([]*entities.Album, error) {
+	ret := _m.Called(genreID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindMostLikedByGenreID")
+	}
+
+	var r0 []*entities.Album
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, int) ([]*entities.Album, error)); ok {
+		return rf(genreID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(uint, int) []*entities.Album); ok {
+		r0 = rf(genreID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Album)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, int) error); ok {
+		r1 = rf(genreID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindMostLikedByUserID provides a mock function with given fields: userID, limit
+func (_m *AlbumRepository) FindMostLikedByUserID(userID uint, limit int) ([]*entities.Album, error) {
+	ret := _m.Called(userID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindMostLikedByUserID")
+	}
+
+	var r0 []*entities.Album
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, int) ([]*entities.Album, error)); ok {
+		return rf(userID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(uint, int) []*entities.Album); ok {
+		r0 = rf(userID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Album)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, int) error); ok {
+		r1 = rf(userID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Merge provides a mock function with given fields: canonicalID, duplicateIDs
+func (_m *AlbumRepository) Merge(canonicalID uint, duplicateIDs []uint) error {
+	ret := _m.Called(canonicalID, duplicateIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Merge")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, []uint) error); ok {
+		r0 = rf(canonicalID, duplicateIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SearchByName provides a mock function with given fields: name, offset, limit
+func (_m *AlbumRepository) SearchByName(name string, offset int, limit int) ([]*entities.Album, error) {
+	ret := _m.Called(name, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchByName")
+	}
+
+	var r0 []*entities.Album
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, int) ([]*entities.Album, error)); ok {
+		return rf(name, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) []*entities.Album); ok {
+		r0 = rf(name, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Album)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) error); ok {
+		r1 = rf(name, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: album
+func (_m *AlbumRepository) Update(album *entities.Album) error {
+	ret := _m.Called(album)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.Album) error); ok {
+		r0 = rf(album)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewAlbumRepository creates a new instance of AlbumRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAlbumRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AlbumRepository {
+	mock := &AlbumRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
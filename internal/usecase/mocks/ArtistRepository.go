@@ -0,0 +1,171 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ArtistRepository is an autogenerated mock type for the ArtistRepository type
+type ArtistRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: artist
+func (_m *ArtistRepository) Create(artist *entities.Artist) error {
+	ret := _m.Called(artist)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.Artist) error); ok {
+		r0 = rf(artist)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields: id
+func (_m *ArtistRepository) Delete(id uint) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindByID provides a mock function with given fields: id
+func (_m *ArtistRepository) FindByID(id uint) (*entities.Artist, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *entities.Artist
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (*entities.Artist, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uint) *entities.Artist); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Artist)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByName provides a mock function with given fields: name
+func (_m *ArtistRepository) FindByName(name string) ([]*entities.Artist, error) {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByName")
+	}
+
+	var r0 []*entities.Artist
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*entities.Artist, error)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*entities.Artist); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Artist)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindBySpotifyID provides a mock function with given fields: spotifyID
+func (_m *ArtistRepository) FindBySpotifyID(spotifyID string) (*entities.Artist, error) {
+	ret := _m.Called(spotifyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindBySpotifyID")
+	}
+
+	var r0 *entities.Artist
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*entities.Artist, error)); ok {
+		return rf(spotifyID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *entities.Artist); ok {
+		r0 = rf(spotifyID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Artist)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(spotifyID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: artist
+func (_m *ArtistRepository) Update(artist *entities.Artist) error {
+	ret := _m.Called(artist)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.Artist) error); ok {
+		r0 = rf(artist)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewArtistRepository creates a new instance of ArtistRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewArtistRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ArtistRepository {
+	mock := &ArtistRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,75 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CollectionInviteRepository is an autogenerated mock type for the CollectionInviteRepository type
+type CollectionInviteRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: invite
+func (_m *CollectionInviteRepository) Create(invite *entities.CollectionInvite) error {
+	ret := _m.Called(invite)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.CollectionInvite) error); ok {
+		r0 = rf(invite)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindByToken provides a mock function with given fields: token
+func (_m *CollectionInviteRepository) FindByToken(token string) (*entities.CollectionInvite, error) {
+	ret := _m.Called(token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByToken")
+	}
+
+	var r0 *entities.CollectionInvite
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*entities.CollectionInvite, error)); ok {
+		return rf(token)
+	}
+	if rf, ok := ret.Get(0).(func(string) *entities.CollectionInvite); ok {
+		r0 = rf(token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.CollectionInvite)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewCollectionInviteRepository creates a new instance of CollectionInviteRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCollectionInviteRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CollectionInviteRepository {
+	mock := &CollectionInviteRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,141 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CollectionMemberRepository is an autogenerated mock type for the CollectionMemberRepository type
+type CollectionMemberRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: member
+func (_m *CollectionMemberRepository) Create(member *entities.CollectionMember) error {
+	ret := _m.Called(member)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.CollectionMember) error); ok {
+		r0 = rf(member)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteByCollectionIDAndUserID provides a mock function with given fields: collectionID, userID
+func (_m *CollectionMemberRepository) DeleteByCollectionIDAndUserID(collectionID uint, userID uint) error {
+	ret := _m.Called(collectionID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByCollectionIDAndUserID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint) error); ok {
+		r0 = rf(collectionID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindByCollectionID provides a mock function with given fields: collectionID
+func (_m *CollectionMemberRepository) FindByCollectionID(collectionID uint) ([]*entities.CollectionMember, error) {
+	ret := _m.Called(collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByCollectionID")
+	}
+
+	var r0 []*entities.CollectionMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]*entities.CollectionMember, error)); ok {
+		return rf(collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []*entities.CollectionMember); ok {
+		r0 = rf(collectionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.CollectionMember)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByCollectionIDAndUserID provides a mock function with given fields: collectionID, userID
+func (_m *CollectionMemberRepository) FindByCollectionIDAndUserID(collectionID uint, userID uint) (*entities.CollectionMember, error) {
+	ret := _m.Called(collectionID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByCollectionIDAndUserID")
+	}
+
+	var r0 *entities.CollectionMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint) (*entities.CollectionMember, error)); ok {
+		return rf(collectionID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint) *entities.CollectionMember); ok {
+		r0 = rf(collectionID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.CollectionMember)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(collectionID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: member
+func (_m *CollectionMemberRepository) Update(member *entities.CollectionMember) error {
+	ret := _m.Called(member)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.CollectionMember) error); ok {
+		r0 = rf(member)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewCollectionMemberRepository creates a new instance of CollectionMemberRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCollectionMemberRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CollectionMemberRepository {
+	mock := &CollectionMemberRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
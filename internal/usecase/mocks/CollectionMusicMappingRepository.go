@@ -0,0 +1,235 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CollectionMusicMappingRepository is an autogenerated mock type for the CollectionMusicMappingRepository type
+type CollectionMusicMappingRepository struct {
+	mock.Mock
+}
+
+// CountCollectionsByMusicID provides a mock function with given fields: musicID
+func (_m *CollectionMusicMappingRepository) CountCollectionsByMusicID(musicID uint) (int64, error) {
+	ret := _m.Called(musicID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountCollectionsByMusicID")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (int64, error)); ok {
+		return rf(musicID)
+	}
+	if rf, ok := ret.Get(0).(func(uint) int64); ok {
+		r0 = rf(musicID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(musicID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Create provides a mock function with given fields: collectionMusicMapping
+func (_m *CollectionMusicMappingRepository) Create(collectionMusicMapping *entities.CollectionMusicMapping) error {
+	ret := _m.Called(collectionMusicMapping)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.CollectionMusicMapping) error); ok {
+		r0 = rf(collectionMusicMapping)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateBatch provides a mock function with given fields: collectionMusicMappings
+func (_m *CollectionMusicMappingRepository) CreateBatch(collectionMusicMappings []*entities.CollectionMusicMapping) error {
+	ret := _m.Called(collectionMusicMappings)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBatch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]*entities.CollectionMusicMapping) error); ok {
+		r0 = rf(collectionMusicMappings)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields: id
+func (_m *CollectionMusicMappingRepository) Delete(id uint) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteByCollectionIDAndMusicID provides a mock function with given fields: collectionID, musicID
+func (_m *CollectionMusicMappingRepository) DeleteByCollectionIDAndMusicID(collectionID uint, musicID uint) error {
+	ret := _m.Called(collectionID, musicID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByCollectionIDAndMusicID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint) error); ok {
+		r0 = rf(collectionID, musicID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindByCollectionID provides a mock function with given fields: collectionID
+func (_m *CollectionMusicMappingRepository) FindByCollectionID(collectionID uint) ([]*entities.CollectionMusicMapping, error) {
+	ret := _m.Called(collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByCollectionID")
+	}
+
+	var r0 []*entities.CollectionMusicMapping
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]*entities.CollectionMusicMapping, error)); ok {
+		return rf(collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []*entities.CollectionMusicMapping); ok {
+		r0 = rf(collectionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.CollectionMusicMapping)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByID provides a mock function with given fields: id
+func (_m *CollectionMusicMappingRepository) FindByID(id uint) (*entities.CollectionMusicMapping, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *entities.CollectionMusicMapping
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (*entities.CollectionMusicMapping, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uint) *entities.CollectionMusicMapping); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.CollectionMusicMapping)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByMusicID provides a mock function with given fields: musicID
+func (_m *CollectionMusicMappingRepository) FindByMusicID(musicID uint) ([]*entities.CollectionMusicMapping, error) {
+	ret := _m.Called(musicID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByMusicID")
+	}
+
+	var r0 []*entities.CollectionMusicMapping
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]*entities.CollectionMusicMapping, error)); ok {
+		return rf(musicID)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []*entities.CollectionMusicMapping); ok {
+		r0 = rf(musicID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.CollectionMusicMapping)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(musicID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Move provides a mock function with given fields: collectionID, musicID, position
+func (_m *CollectionMusicMappingRepository) Move(collectionID uint, musicID uint, position int) error {
+	ret := _m.Called(collectionID, musicID, position)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Move")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint, int) error); ok {
+		r0 = rf(collectionID, musicID, position)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewCollectionMusicMappingRepository creates a new instance of CollectionMusicMappingRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCollectionMusicMappingRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CollectionMusicMappingRepository {
+	mock := &CollectionMusicMappingRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
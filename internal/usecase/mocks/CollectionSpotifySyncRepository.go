@@ -0,0 +1,105 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CollectionSpotifySyncRepository is an autogenerated mock type for the CollectionSpotifySyncRepository type
+type CollectionSpotifySyncRepository struct {
+	mock.Mock
+}
+
+// FindByCollectionID provides a mock function with given fields: collectionID
+func (_m *CollectionSpotifySyncRepository) FindByCollectionID(collectionID uint) (*entities.CollectionSpotifySync, error) {
+	ret := _m.Called(collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByCollectionID")
+	}
+
+	var r0 *entities.CollectionSpotifySync
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (*entities.CollectionSpotifySync, error)); ok {
+		return rf(collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(uint) *entities.CollectionSpotifySync); ok {
+		r0 = rf(collectionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.CollectionSpotifySync)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindReconcilable provides a mock function with given fields: afterID, limit
+func (_m *CollectionSpotifySyncRepository) FindReconcilable(afterID uint, limit int) ([]*entities.CollectionSpotifySync, error) {
+	ret := _m.Called(afterID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindReconcilable")
+	}
+
+	var r0 []*entities.CollectionSpotifySync
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, int) ([]*entities.CollectionSpotifySync, error)); ok {
+		return rf(afterID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(uint, int) []*entities.CollectionSpotifySync); ok {
+		r0 = rf(afterID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.CollectionSpotifySync)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, int) error); ok {
+		r1 = rf(afterID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Save provides a mock function with given fields: sync
+func (_m *CollectionSpotifySyncRepository) Save(sync *entities.CollectionSpotifySync) error {
+	ret := _m.Called(sync)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.CollectionSpotifySync) error); ok {
+		r0 = rf(sync)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewCollectionSpotifySyncRepository creates a new instance of CollectionSpotifySyncRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCollectionSpotifySyncRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CollectionSpotifySyncRepository {
+	mock := &CollectionSpotifySyncRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
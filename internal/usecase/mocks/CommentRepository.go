@@ -0,0 +1,261 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+
+	repositories "github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+)
+
+// CommentRepository is an autogenerated mock type for the CommentRepository type
+type CommentRepository struct {
+	mock.Mock
+}
+
+// CountRootsByPostID provides a mock function with given fields: postID
+func (_m *CommentRepository) CountRootsByPostID(postID uint) (int64, error) {
+	ret := _m.Called(postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountRootsByPostID")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (int64, error)); ok {
+		return rf(postID)
+	}
+	if rf, ok := ret.Get(0).(func(uint) int64); ok {
+		r0 = rf(postID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(postID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Create provides a mock function with given fields: comment
+func (_m *CommentRepository) Create(comment *entities.Comment) error {
+	ret := _m.Called(comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.Comment) error); ok {
+		r0 = rf(comment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields: id
+func (_m *CommentRepository) Delete(id uint) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindByID provides a mock function with given fields: id
+func (_m *CommentRepository) FindByID(id uint) (*entities.Comment, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *entities.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (*entities.Comment, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uint) *entities.Comment); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByPostID provides a mock function with given fields: postID, offset, limit
+func (_m *CommentRepository) FindByPostID(postID uint, offset int, limit int) ([]*entities.Comment, error) {
+	ret := _m.Called(postID, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByPostID")
+	}
+
+	var r0 []*entities.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, int, int) ([]*entities.Comment, error)); ok {
+		return rf(postID, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(uint, int, int) []*entities.Comment); ok {
+		r0 = rf(postID, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, int, int) error); ok {
+		r1 = rf(postID, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByUserID provides a mock function with given fields: userID, offset, limit
+func (_m *CommentRepository) FindByUserID(userID uint, offset int, limit int) ([]*entities.Comment, error) {
+	ret := _m.Called(userID, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByUserID")
+	}
+
+	var r0 []*entities.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, int, int) ([]*entities.Comment, error)); ok {
+		return rf(userID, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(uint, int, int) []*entities.Comment); ok {
+		r0 = rf(userID, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, int, int) error); ok {
+		r1 = rf(userID, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindDescendants provides a mock function with given fields: viewer, postID, paths
+func (_m *CommentRepository) FindDescendants(viewer repositories.Viewer, postID uint, paths []string) ([]*entities.Comment, error) {
+	ret := _m.Called(viewer, postID, paths)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindDescendants")
+	}
+
+	var r0 []*entities.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(repositories.Viewer, uint, []string) ([]*entities.Comment, error)); ok {
+		return rf(viewer, postID, paths)
+	}
+	if rf, ok := ret.Get(0).(func(repositories.Viewer, uint, []string) []*entities.Comment); ok {
+		r0 = rf(viewer, postID, paths)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(repositories.Viewer, uint, []string) error); ok {
+		r1 = rf(viewer, postID, paths)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindRootsByPostID provides a mock function with given fields: viewer, postID, offset, limit
+func (_m *CommentRepository) FindRootsByPostID(viewer repositories.Viewer, postID uint, offset int, limit int) ([]*entities.Comment, error) {
+	ret := _m.Called(viewer, postID, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindRootsByPostID")
+	}
+
+	var r0 []*entities.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(repositories.Viewer, uint, int, int) ([]*entities.Comment, error)); ok {
+		return rf(viewer, postID, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(repositories.Viewer, uint, int, int) []*entities.Comment); ok {
+		r0 = rf(viewer, postID, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(repositories.Viewer, uint, int, int) error); ok {
+		r1 = rf(viewer, postID, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: comment
+func (_m *CommentRepository) Update(comment *entities.Comment) error {
+	ret := _m.Called(comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.Comment) error); ok {
+		r0 = rf(comment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewCommentRepository creates a new instance of CommentRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCommentRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CommentRepository {
+	mock := &CommentRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,75 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CommunityBanRepository is an autogenerated mock type for the CommunityBanRepository type
+type CommunityBanRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ban
+func (_m *CommunityBanRepository) Create(ban *entities.CommunityBan) error {
+	ret := _m.Called(ban)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.CommunityBan) error); ok {
+		r0 = rf(ban)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindByCommunityIDAndUserID provides a mock function with given fields: communityID, userID
+func (_m *CommunityBanRepository) FindByCommunityIDAndUserID(communityID uint, userID uint) (*entities.CommunityBan, error) {
+	ret := _m.Called(communityID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByCommunityIDAndUserID")
+	}
+
+	var r0 *entities.CommunityBan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint) (*entities.CommunityBan, error)); ok {
+		return rf(communityID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint) *entities.CommunityBan); ok {
+		r0 = rf(communityID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.CommunityBan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(communityID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewCommunityBanRepository creates a new instance of CommunityBanRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCommunityBanRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CommunityBanRepository {
+	mock := &CommunityBanRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
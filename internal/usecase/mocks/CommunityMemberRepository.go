@@ -0,0 +1,141 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CommunityMemberRepository is an autogenerated mock type for the CommunityMemberRepository type
+type CommunityMemberRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: member
+func (_m *CommunityMemberRepository) Create(member *entities.CommunityMember) error {
+	ret := _m.Called(member)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.CommunityMember) error); ok {
+		r0 = rf(member)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteByCommunityIDAndUserID provides a mock function with given fields: communityID, userID
+func (_m *CommunityMemberRepository) DeleteByCommunityIDAndUserID(communityID uint, userID uint) error {
+	ret := _m.Called(communityID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByCommunityIDAndUserID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint) error); ok {
+		r0 = rf(communityID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindByCommunityIDAndRole provides a mock function with given fields: communityID, role
+func (_m *CommunityMemberRepository) FindByCommunityIDAndRole(communityID uint, role string) ([]*entities.CommunityMember, error) {
+	ret := _m.Called(communityID, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByCommunityIDAndRole")
+	}
+
+	var r0 []*entities.CommunityMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string) ([]*entities.CommunityMember, error)); ok {
+		return rf(communityID, role)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string) []*entities.CommunityMember); ok {
+		r0 = rf(communityID, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.CommunityMember)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string) error); ok {
+		r1 = rf(communityID, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByCommunityIDAndUserID provides a mock function with given fields: communityID, userID
+func (_m *CommunityMemberRepository) FindByCommunityIDAndUserID(communityID uint, userID uint) (*entities.CommunityMember, error) {
+	ret := _m.Called(communityID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByCommunityIDAndUserID")
+	}
+
+	var r0 *entities.CommunityMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint) (*entities.CommunityMember, error)); ok {
+		return rf(communityID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint) *entities.CommunityMember); ok {
+		r0 = rf(communityID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.CommunityMember)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(communityID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: member
+func (_m *CommunityMemberRepository) Update(member *entities.CommunityMember) error {
+	ret := _m.Called(member)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.CommunityMember) error); ok {
+		r0 = rf(member)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewCommunityMemberRepository creates a new instance of CommunityMemberRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCommunityMemberRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CommunityMemberRepository {
+	mock := &CommunityMemberRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
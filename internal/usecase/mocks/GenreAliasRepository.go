@@ -0,0 +1,123 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// GenreAliasRepository is an autogenerated mock type for the GenreAliasRepository type
+type GenreAliasRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: genreAlias
+func (_m *GenreAliasRepository) Create(genreAlias *entities.GenreAlias) error {
+	ret := _m.Called(genreAlias)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.GenreAlias) error); ok {
+		r0 = rf(genreAlias)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields: id
+func (_m *GenreAliasRepository) Delete(id uint) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindByAlias provides a mock function with given fields: alias
+func (_m *GenreAliasRepository) FindByAlias(alias string) (*entities.GenreAlias, error) {
+	ret := _m.Called(alias)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByAlias")
+	}
+
+	var r0 *entities.GenreAlias
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*entities.GenreAlias, error)); ok {
+		return rf(alias)
+	}
+	if rf, ok := ret.Get(0).(func(string) *entities.GenreAlias); ok {
+		r0 = rf(alias)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.GenreAlias)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(alias)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByGenreID provides a mock function with given fields: genreID
+func (_m *GenreAliasRepository) FindByGenreID(genreID uint) ([]*entities.GenreAlias, error) {
+	ret := _m.Called(genreID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByGenreID")
+	}
+
+	var r0 []*entities.GenreAlias
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]*entities.GenreAlias, error)); ok {
+		return rf(genreID)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []*entities.GenreAlias); ok {
+		r0 = rf(genreID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.GenreAlias)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(genreID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewGenreAliasRepository creates a new instance of GenreAliasRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewGenreAliasRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *GenreAliasRepository {
+	mock := &GenreAliasRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
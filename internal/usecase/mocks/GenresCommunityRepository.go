@@ -0,0 +1,199 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// GenresCommunityRepository is an autogenerated mock type for the GenresCommunityRepository type
+type GenresCommunityRepository struct {
+	mock.Mock
+}
+
+// Count provides a mock function with given fields: genreID
+func (_m *GenresCommunityRepository) Count(genreID *uint) (int64, error) {
+	ret := _m.Called(genreID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Count")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uint) (int64, error)); ok {
+		return rf(genreID)
+	}
+	if rf, ok := ret.Get(0).(func(*uint) int64); ok {
+		r0 = rf(genreID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(*uint) error); ok {
+		r1 = rf(genreID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Create provides a mock function with given fields: genreCommunity
+func (_m *GenresCommunityRepository) Create(genreCommunity *entities.GenreCommunity) error {
+	ret := _m.Called(genreCommunity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.GenreCommunity) error); ok {
+		r0 = rf(genreCommunity)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields: id
+func (_m *GenresCommunityRepository) Delete(id uint) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindAll provides a mock function with given fields: genreID, offset, limit
+func (_m *GenresCommunityRepository) FindAll(genreID *uint, offset int, limit int) ([]*entities.GenreCommunity, error) {
+	ret := _m.Called(genreID, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAll")
+	}
+
+	var r0 []*entities.GenreCommunity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uint, int, int) ([]*entities.GenreCommunity, error)); ok {
+		return rf(genreID, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(*uint, int, int) []*entities.GenreCommunity); ok {
+		r0 = rf(genreID, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.GenreCommunity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uint, int, int) error); ok {
+		r1 = rf(genreID, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByGenreID provides a mock function with given fields: genreID
+func (_m *GenresCommunityRepository) FindByGenreID(genreID uint) ([]*entities.GenreCommunity, error) {
+	ret := _m.Called(genreID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByGenreID")
+	}
+
+	var r0 []*entities.GenreCommunity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]*entities.GenreCommunity, error)); ok {
+		return rf(genreID)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []*entities.GenreCommunity); ok {
+		r0 = rf(genreID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.GenreCommunity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(genreID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByID provides a mock function with given fields: id
+func (_m *GenresCommunityRepository) FindByID(id uint) (*entities.GenreCommunity, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *entities.GenreCommunity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (*entities.GenreCommunity, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uint) *entities.GenreCommunity); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.GenreCommunity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: genresCommunity
+func (_m *GenresCommunityRepository) Update(genresCommunity *entities.GenreCommunity) error {
+	ret := _m.Called(genresCommunity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.GenreCommunity) error); ok {
+		r0 = rf(genresCommunity)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewGenresCommunityRepository creates a new instance of GenresCommunityRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewGenresCommunityRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *GenresCommunityRepository {
+	mock := &GenresCommunityRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
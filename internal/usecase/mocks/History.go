@@ -0,0 +1,59 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	contentfilter "github.com/myjinjin/sonic-odyssey-backend/infrastructure/contentfilter"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// History is an autogenerated mock type for the History type
+type History struct {
+	mock.Mock
+}
+
+// Recent provides a mock function with given fields: userID, kind, since
+func (_m *History) Recent(userID uint, kind string, since time.Time) ([]contentfilter.Content, error) {
+	ret := _m.Called(userID, kind, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Recent")
+	}
+
+	var r0 []contentfilter.Content
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string, time.Time) ([]contentfilter.Content, error)); ok {
+		return rf(userID, kind, since)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string, time.Time) []contentfilter.Content); ok {
+		r0 = rf(userID, kind, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]contentfilter.Content)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string, time.Time) error); ok {
+		r1 = rf(userID, kind, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewHistory creates a new instance of History. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewHistory(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *History {
+	mock := &History{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
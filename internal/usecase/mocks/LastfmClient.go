@@ -0,0 +1,119 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	lastfmclient "github.com/myjinjin/sonic-odyssey-backend/infrastructure/lastfmclient"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// LastfmClient is an autogenerated mock type for the LastfmClient type
+type LastfmClient struct {
+	mock.Mock
+}
+
+// GetTrackInfo provides a mock function with given fields: ctx, artist, track
+func (_m *LastfmClient) GetTrackInfo(ctx context.Context, artist string, track string) (*lastfmclient.Track, error) {
+	ret := _m.Called(ctx, artist, track)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTrackInfo")
+	}
+
+	var r0 *lastfmclient.Track
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*lastfmclient.Track, error)); ok {
+		return rf(ctx, artist, track)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *lastfmclient.Track); ok {
+		r0 = rf(ctx, artist, track)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*lastfmclient.Track)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, artist, track)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTrackInfoByMBID provides a mock function with given fields: ctx, mbid
+func (_m *LastfmClient) GetTrackInfoByMBID(ctx context.Context, mbid string) (*lastfmclient.Track, error) {
+	ret := _m.Called(ctx, mbid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTrackInfoByMBID")
+	}
+
+	var r0 *lastfmclient.Track
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*lastfmclient.Track, error)); ok {
+		return rf(ctx, mbid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *lastfmclient.Track); ok {
+		r0 = rf(ctx, mbid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*lastfmclient.Track)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, mbid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTrackTopTags provides a mock function with given fields: ctx, artist, track
+func (_m *LastfmClient) GetTrackTopTags(ctx context.Context, artist string, track string) ([]lastfmclient.Tag, error) {
+	ret := _m.Called(ctx, artist, track)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTrackTopTags")
+	}
+
+	var r0 []lastfmclient.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]lastfmclient.Tag, error)); ok {
+		return rf(ctx, artist, track)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []lastfmclient.Tag); ok {
+		r0 = rf(ctx, artist, track)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]lastfmclient.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, artist, track)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewLastfmClient creates a new instance of LastfmClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLastfmClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LastfmClient {
+	mock := &LastfmClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
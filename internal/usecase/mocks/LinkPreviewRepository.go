@@ -0,0 +1,75 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// LinkPreviewRepository is an autogenerated mock type for the LinkPreviewRepository type
+type LinkPreviewRepository struct {
+	mock.Mock
+}
+
+// FindByPostIDs provides a mock function with given fields: postIDs
+func (_m *LinkPreviewRepository) FindByPostIDs(postIDs []uint) ([]*entities.LinkPreview, error) {
+	ret := _m.Called(postIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByPostIDs")
+	}
+
+	var r0 []*entities.LinkPreview
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]uint) ([]*entities.LinkPreview, error)); ok {
+		return rf(postIDs)
+	}
+	if rf, ok := ret.Get(0).(func([]uint) []*entities.LinkPreview); ok {
+		r0 = rf(postIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.LinkPreview)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]uint) error); ok {
+		r1 = rf(postIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReplaceByPostID provides a mock function with given fields: postID, previews
+func (_m *LinkPreviewRepository) ReplaceByPostID(postID uint, previews []*entities.LinkPreview) error {
+	ret := _m.Called(postID, previews)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceByPostID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, []*entities.LinkPreview) error); ok {
+		r0 = rf(postID, previews)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewLinkPreviewRepository creates a new instance of LinkPreviewRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLinkPreviewRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LinkPreviewRepository {
+	mock := &LinkPreviewRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
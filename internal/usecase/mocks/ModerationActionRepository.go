@@ -0,0 +1,161 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ModerationActionRepository is an autogenerated mock type for the ModerationActionRepository type
+type ModerationActionRepository struct {
+	mock.Mock
+}
+
+// CountByCommunityID provides a mock function with given fields: communityID
+func (_m *ModerationActionRepository) CountByCommunityID(communityID uint) (int64, error) {
+	ret := _m.Called(communityID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByCommunityID")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (int64, error)); ok {
+		return rf(communityID)
+	}
+	if rf, ok := ret.Get(0).(func(uint) int64); ok {
+		r0 = rf(communityID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(communityID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountByTargetUserID provides a mock function with given fields: userID, actions
+func (_m *ModerationActionRepository) CountByTargetUserID(userID uint, actions []string) (int64, error) {
+	ret := _m.Called(userID, actions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByTargetUserID")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, []string) (int64, error)); ok {
+		return rf(userID, actions)
+	}
+	if rf, ok := ret.Get(0).(func(uint, []string) int64); ok {
+		r0 = rf(userID, actions)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, []string) error); ok {
+		r1 = rf(userID, actions)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Create provides a mock function with given fields: action
+func (_m *ModerationActionRepository) Create(action *entities.ModerationAction) error {
+	ret := _m.Called(action)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.ModerationAction) error); ok {
+		r0 = rf(action)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindByCommunityID provides a mock function with given fields: communityID, offset, limit
+func (_m *ModerationActionRepository) FindByCommunityID(communityID uint, offset int, limit int) ([]*entities.ModerationAction, error) {
+	ret := _m.Called(communityID, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByCommunityID")
+	}
+
+	var r0 []*entities.ModerationAction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, int, int) ([]*entities.ModerationAction, error)); ok {
+		return rf(communityID, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(uint, int, int) []*entities.ModerationAction); ok {
+		r0 = rf(communityID, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.ModerationAction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, int, int) error); ok {
+		r1 = rf(communityID, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByTargetUserID provides a mock function with given fields: userID, actions, offset, limit
+func (_m *ModerationActionRepository) FindByTargetUserID(userID uint, actions []string, offset int, limit int) ([]*entities.ModerationAction, error) {
+	ret := _m.Called(userID, actions, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByTargetUserID")
+	}
+
+	var r0 []*entities.ModerationAction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, []string, int, int) ([]*entities.ModerationAction, error)); ok {
+		return rf(userID, actions, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(uint, []string, int, int) []*entities.ModerationAction); ok {
+		r0 = rf(userID, actions, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.ModerationAction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, []string, int, int) error); ok {
+		r1 = rf(userID, actions, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewModerationActionRepository creates a new instance of ModerationActionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewModerationActionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ModerationActionRepository {
+	mock := &ModerationActionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
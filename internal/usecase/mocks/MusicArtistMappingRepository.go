@@ -0,0 +1,153 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MusicArtistMappingRepository is an autogenerated mock type for the MusicArtistMappingRepository type
+type MusicArtistMappingRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: musicArtistMapping
+func (_m *MusicArtistMappingRepository) Create(musicArtistMapping *entities.MusicArtistMapping) error {
+	ret := _m.Called(musicArtistMapping)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.MusicArtistMapping) error); ok {
+		r0 = rf(musicArtistMapping)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields: id
+func (_m *MusicArtistMappingRepository) Delete(id uint) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindByArtistID provides a mock function with given fields: artistID
+func (_m *MusicArtistMappingRepository) FindByArtistID(artistID uint) ([]*entities.MusicArtistMapping, error) {
+	ret := _m.Called(artistID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByArtistID")
+	}
+
+	var r0 []*entities.MusicArtistMapping
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]*entities.MusicArtistMapping, error)); ok {
+		return rf(artistID)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []*entities.MusicArtistMapping); ok {
+		r0 = rf(artistID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.MusicArtistMapping)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(artistID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByID provides a mock function with given fields: id
+func (_m *MusicArtistMappingRepository) FindByID(id uint) (*entities.MusicArtistMapping, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *entities.MusicArtistMapping
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (*entities.MusicArtistMapping, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uint) *entities.MusicArtistMapping); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.MusicArtistMapping)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByMusicID provides a mock function with given fields: musicID
+func (_m *MusicArtistMappingRepository) FindByMusicID(musicID uint) ([]*entities.MusicArtistMapping, error) {
+	ret := _m.Called(musicID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByMusicID")
+	}
+
+	var r0 []*entities.MusicArtistMapping
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]*entities.MusicArtistMapping, error)); ok {
+		return rf(musicID)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []*entities.MusicArtistMapping); ok {
+		r0 = rf(musicID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.MusicArtistMapping)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(musicID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMusicArtistMappingRepository creates a new instance of MusicArtistMappingRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMusicArtistMappingRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MusicArtistMappingRepository {
+	mock := &MusicArtistMappingRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,123 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MusicAttachmentRepository is an autogenerated mock type for the MusicAttachmentRepository type
+type MusicAttachmentRepository struct {
+	mock.Mock
+}
+
+// FindByCommentIDs provides a mock function with given fields: commentIDs
+func (_m *MusicAttachmentRepository) FindByCommentIDs(commentIDs []uint) ([]*entities.MusicAttachment, error) {
+	ret := _m.Called(commentIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByCommentIDs")
+	}
+
+	var r0 []*entities.MusicAttachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]uint) ([]*entities.MusicAttachment, error)); ok {
+		return rf(commentIDs)
+	}
+	if rf, ok := ret.Get(0).(func([]uint) []*entities.MusicAttachment); ok {
+		r0 = rf(commentIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.MusicAttachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]uint) error); ok {
+		r1 = rf(commentIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByPostIDs provides a mock function with given fields: postIDs
+func (_m *MusicAttachmentRepository) FindByPostIDs(postIDs []uint) ([]*entities.MusicAttachment, error) {
+	ret := _m.Called(postIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByPostIDs")
+	}
+
+	var r0 []*entities.MusicAttachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]uint) ([]*entities.MusicAttachment, error)); ok {
+		return rf(postIDs)
+	}
+	if rf, ok := ret.Get(0).(func([]uint) []*entities.MusicAttachment); ok {
+		r0 = rf(postIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.MusicAttachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]uint) error); ok {
+		r1 = rf(postIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReplaceByCommentID provides a mock function with given fields: commentID, musicIDs
+func (_m *MusicAttachmentRepository) ReplaceByCommentID(commentID uint, musicIDs []uint) error {
+	ret := _m.Called(commentID, musicIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceByCommentID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, []uint) error); ok {
+		r0 = rf(commentID, musicIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReplaceByPostID provides a mock function with given fields: postID, musicIDs
+func (_m *MusicAttachmentRepository) ReplaceByPostID(postID uint, musicIDs []uint) error {
+	ret := _m.Called(postID, musicIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceByPostID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, []uint) error); ok {
+		r0 = rf(postID, musicIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewMusicAttachmentRepository creates a new instance of MusicAttachmentRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMusicAttachmentRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MusicAttachmentRepository {
+	mock := &MusicAttachmentRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,199 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MusicCollectionRepository is an autogenerated mock type for the MusicCollectionRepository type
+type MusicCollectionRepository struct {
+	mock.Mock
+}
+
+// CountByUserID provides a mock function with given fields: userID, includePrivate
+func (_m *MusicCollectionRepository) CountByUserID(userID uint, includePrivate bool) (int64, error) {
+	ret := _m.Called(userID, includePrivate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByUserID")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, bool) (int64, error)); ok {
+		return rf(userID, includePrivate)
+	}
+	if rf, ok := ret.Get(0).(func(uint, bool) int64); ok {
+		r0 = rf(userID, includePrivate)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, bool) error); ok {
+		r1 = rf(userID, includePrivate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Create provides a mock function with given fields: musicCollection
+func (_m *MusicCollectionRepository) Create(musicCollection *entities.MusicCollection) error {
+	ret := _m.Called(musicCollection)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.MusicCollection) error); ok {
+		r0 = rf(musicCollection)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields: id
+func (_m *MusicCollectionRepository) Delete(id uint) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindByID provides a mock function with given fields: id
+func (_m *MusicCollectionRepository) FindByID(id uint) (*entities.MusicCollection, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *entities.MusicCollection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (*entities.MusicCollection, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uint) *entities.MusicCollection); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.MusicCollection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByUserID provides a mock function with given fields: userID, includePrivate, offset, limit
+func (_m *MusicCollectionRepository) FindByUserID(userID uint, includePrivate bool, offset int, limit int) ([]*entities.MusicCollection, error) {
+	ret := _m.Called(userID, includePrivate, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByUserID")
+	}
+
+	var r0 []*entities.MusicCollection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, bool, int, int) ([]*entities.MusicCollection, error)); ok {
+		return rf(userID, includePrivate, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(uint, bool, int, int) []*entities.MusicCollection); ok {
+		r0 = rf(userID, includePrivate, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.MusicCollection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, bool, int, int) error); ok {
+		r1 = rf(userID, includePrivate, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByUserIDAndSpotifyPlaylistID provides a mock function with given fields: userID, spotifyPlaylistID
+func (_m *MusicCollectionRepository) FindByUserIDAndSpotifyPlaylistID(userID uint, spotifyPlaylistID string) (*entities.MusicCollection, error) {
+	ret := _m.Called(userID, spotifyPlaylistID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByUserIDAndSpotifyPlaylistID")
+	}
+
+	var r0 *entities.MusicCollection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string) (*entities.MusicCollection, error)); ok {
+		return rf(userID, spotifyPlaylistID)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string) *entities.MusicCollection); ok {
+		r0 = rf(userID, spotifyPlaylistID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.MusicCollection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string) error); ok {
+		r1 = rf(userID, spotifyPlaylistID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: musicCollection
+func (_m *MusicCollectionRepository) Update(musicCollection *entities.MusicCollection) error {
+	ret := _m.Called(musicCollection)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.MusicCollection) error); ok {
+		r0 = rf(musicCollection)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewMusicCollectionRepository creates a new instance of MusicCollectionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMusicCollectionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MusicCollectionRepository {
+	mock := &MusicCollectionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
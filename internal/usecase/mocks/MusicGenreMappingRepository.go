@@ -0,0 +1,153 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MusicGenreMappingRepository is an autogenerated mock type for the MusicGenreMappingRepository type
+type MusicGenreMappingRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: musicGenreMapping
+func (_m *MusicGenreMappingRepository) Create(musicGenreMapping *entities.MusicGenreMapping) error {
+	ret := _m.Called(musicGenreMapping)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.MusicGenreMapping) error); ok {
+		r0 = rf(musicGenreMapping)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields: id
+func (_m *MusicGenreMappingRepository) Delete(id uint) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindByGenreID provides a mock function with given fields: genreID
+func (_m *MusicGenreMappingRepository) FindByGenreID(genreID uint) ([]*entities.MusicGenreMapping, error) {
+	ret := _m.Called(genreID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByGenreID")
+	}
+
+	var r0 []*entities.MusicGenreMapping
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]*entities.MusicGenreMapping, error)); ok {
+		return rf(genreID)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []*entities.MusicGenreMapping); ok {
+		r0 = rf(genreID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.MusicGenreMapping)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(genreID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByID provides a mock function with given fields: id
+func (_m *MusicGenreMappingRepository) FindByID(id uint) (*entities.MusicGenreMapping, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *entities.MusicGenreMapping
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (*entities.MusicGenreMapping, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uint) *entities.MusicGenreMapping); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.MusicGenreMapping)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByMusicID provides a mock function with given fields: musicID
+func (_m *MusicGenreMappingRepository) FindByMusicID(musicID uint) ([]*entities.MusicGenreMapping, error) {
+	ret := _m.Called(musicID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByMusicID")
+	}
+
+	var r0 []*entities.MusicGenreMapping
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]*entities.MusicGenreMapping, error)); ok {
+		return rf(musicID)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []*entities.MusicGenreMapping); ok {
+		r0 = rf(musicID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.MusicGenreMapping)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(musicID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMusicGenreMappingRepository creates a new instance of MusicGenreMappingRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMusicGenreMappingRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MusicGenreMappingRepository {
+	mock := &MusicGenreMappingRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
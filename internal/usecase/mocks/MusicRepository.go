@@ -0,0 +1,610 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MusicRepository is an autogenerated mock type for the MusicRepository type
+type MusicRepository struct {
+	mock.Mock
+}
+
+// CountByGenreID provides a mock function with given fields: genreID
+func (_m *MusicRepository) CountByGenreID(genreID uint) (int64, error) {
+	ret := _m.Called(genreID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByGenreID")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (int64, error)); ok {
+		return rf(genreID)
+	}
+	if rf, ok := ret.Get(0).(func(uint) int64); ok {
+		r0 = rf(genreID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(genreID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountLikedByUserID provides a mock function with given fields: userID
+func (_m *MusicRepository) CountLikedByUserID(userID uint) (int64, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountLikedByUserID")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (int64, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(uint) int64); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountLikesAndDislikesByID provides a mock function with given fields: id
+func (_m *MusicRepository) CountLikesAndDislikesByID(id uint) (int64, int64, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountLikesAndDislikesByID")
+	}
+
+	var r0 int64
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(uint) (int64, int64, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uint) int64); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) int64); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(uint) error); ok {
+		r2 = rf(id)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Create provides a mock function with given fields: music
+func (_m *MusicRepository) Create(music *entities.Music) error {
+	ret := _m.Called(music)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.Music) error); ok {
+		r0 = rf(music)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields: id
+func (_m *MusicRepository) Delete(id uint) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindByAlbumID provides a mock function with given fields: albumID
+func (_m *MusicRepository) FindByAlbumID(albumID uint) ([]*entities.Music, error) {
+	ret := _m.Called(albumID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByAlbumID")
+	}
+
+	var r0 []*entities.Music
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]*entities.Music, error)); ok {
+		return rf(albumID)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []*entities.Music); ok {
+		r0 = rf(albumID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Music)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(albumID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByArtistID provides a mock function with given fields: artistID, offset, limit
+func (_m *MusicRepository) FindByArtistID(artistID uint, offset int, limit int) ([]*entities.Music, error) {
+	ret := _m.Called(artistID, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByArtistID")
+	}
+
+	var r0 []*entities.Music
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, int, int) ([]*entities.Music, error)); ok {
+		return rf(artistID, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(uint, int, int) []*entities.Music); ok {
+		r0 = rf(artistID, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Music)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, int, int) error); ok {
+		r1 = rf(artistID, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByCollectionID provides a mock function with given fields: collectionID
+func (_m *MusicRepository) FindByCollectionID(collectionID uint) ([]*entities.Music, error) {
+	ret := _m.Called(collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByCollectionID")
+	}
+
+	var r0 []*entities.Music
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]*entities.Music, error)); ok {
+		return rf(collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []*entities.Music); ok {
+		r0 = rf(collectionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Music)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByGenreID provides a mock function with given fields: genreID, offset, limit
+func (_m *MusicRepository) FindByGenreID(genreID uint, offset int, limit int) ([]*entities.Music, error) {
+	ret := _m.Called(genreID, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByGenreID")
+	}
+
+	var r0 []*entities.Music
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, int, int) ([]*entities.Music, error)); ok {
+		return rf(genreID, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(uint, int, int) []*entities.Music); ok {
+		r0 = rf(genreID, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Music)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, int, int) error); ok {
+		r1 = rf(genreID, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByID provides a mock function with given fields: id
+func (_m *MusicRepository) FindByID(id uint) (*entities.Music, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *entities.Music
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (*entities.Music, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uint) *entities.Music); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Music)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByISRC provides a mock function with given fields: isrc
+func (_m *MusicRepository) FindByISRC(isrc string) ([]*entities.Music, error) {
+	ret := _m.Called(isrc)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByISRC")
+	}
+
+	var r0 []*entities.Music
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*entities.Music, error)); ok {
+		return rf(isrc)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*entities.Music); ok {
+		r0 = rf(isrc)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Music)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(isrc)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByLastfmID provides a mock function with given fields: lastfmID
+func (_m *MusicRepository) FindByLastfmID(lastfmID string) (*entities.Music, error) {
+	ret := _m.Called(lastfmID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByLastfmID")
+	}
+
+	var r0 *entities.Music
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*entities.Music, error)); ok {
+		return rf(lastfmID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *entities.Music); ok {
+		r0 = rf(lastfmID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Music)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(lastfmID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindBySpotifyID provides a mock function with given fields: spotifyID
+func (_m *MusicRepository) FindBySpotifyID(spotifyID string) (*entities.Music, error) {
+	ret := _m.Called(spotifyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindBySpotifyID")
+	}
+
+	var r0 *entities.Music
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*entities.Music, error)); ok {
+		return rf(spotifyID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *entities.Music); ok {
+		r0 = rf(spotifyID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Music)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(spotifyID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByTitle provides a mock function with given fields: title
+func (_m *MusicRepository) FindByTitle(title string) ([]*entities.Music, error) {
+	ret := _m.Called(title)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByTitle")
+	}
+
+	var r0 []*entities.Music
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*entities.Music, error)); ok {
+		return rf(title)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*entities.Music); ok {
+		r0 = rf(title)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Music)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(title)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindDuplicateISRCs provides a mock function with given fields:
+func (_m *MusicRepository) FindDuplicateISRCs() ([]string, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindDuplicateISRCs")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]string, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindLikedByUserID provides a mock function with given fields: userID, offset, limit
+func (_m *MusicRepository) FindLikedByUserID(userID uint, offset int, limit int) ([]*entities.Music, error) {
+	ret := _m.Called(userID, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindLikedByUserID")
+	}
+
+	var r0 []*entities.Music
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, int, int) ([]*entities.Music, error)); ok {
+		return rf(userID, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(uint, int, int) []*entities.Music); ok {
+		r0 = rf(userID, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Music)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, int, int) error); ok {
+		r1 = rf(userID, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Merge provides a mock function with given fields: canonicalID, duplicateIDs
+func (_m *MusicRepository) Merge(canonicalID uint, duplicateIDs []uint) error {
+	ret := _m.Called(canonicalID, duplicateIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Merge")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, []uint) error); ok {
+		r0 = rf(canonicalID, duplicateIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SearchByAlbum provides a mock function with given fields: albumName, offset, limit
+func (_m *MusicRepository) SearchByAlbum(albumName string, offset int, limit int) ([]*entities.Music, error) {
+	ret := _m.Called(albumName, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchByAlbum")
+	}
+
+	var r0 []*entities.Music
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, int) ([]*entities.Music, error)); ok {
+		return rf(albumName, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) []*entities.Music); ok {
+		r0 = rf(albumName, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Music)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) error); ok {
+		r1 = rf(albumName, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchByArtist provides a mock function with given fields: artistName, offset, limit
+func (_m *MusicRepository) SearchByArtist(artistName string, offset int, limit int) ([]*entities.Music, error) {
+	ret := _m.Called(artistName, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchByArtist")
+	}
+
+	var r0 []*entities.Music
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, int) ([]*entities.Music, error)); ok {
+		return rf(artistName, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) []*entities.Music); ok {
+		r0 = rf(artistName, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Music)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) error); ok {
+		r1 = rf(artistName, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchByTitle provides a mock function with given fields: title, offset, limit
+func (_m *MusicRepository) SearchByTitle(title string, offset int, limit int) ([]*entities.Music, error) {
+	ret := _m.Called(title, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchByTitle")
+	}
+
+	var r0 []*entities.Music
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, int) ([]*entities.Music, error)); ok {
+		return rf(title, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) []*entities.Music); ok {
+		r0 = rf(title, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Music)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) error); ok {
+		r1 = rf(title, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: music
+func (_m *MusicRepository) Update(music *entities.Music) error {
+	ret := _m.Called(music)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.Music) error); ok {
+		r0 = rf(music)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewMusicRepository creates a new instance of MusicRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMusicRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MusicRepository {
+	mock := &MusicRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
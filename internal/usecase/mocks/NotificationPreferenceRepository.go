@@ -0,0 +1,105 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NotificationPreferenceRepository is an autogenerated mock type for the NotificationPreferenceRepository type
+type NotificationPreferenceRepository struct {
+	mock.Mock
+}
+
+// FindByUserID provides a mock function with given fields: userID
+func (_m *NotificationPreferenceRepository) FindByUserID(userID uint) ([]*entities.NotificationPreference, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByUserID")
+	}
+
+	var r0 []*entities.NotificationPreference
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]*entities.NotificationPreference, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []*entities.NotificationPreference); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.NotificationPreference)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByUserIDAndType provides a mock function with given fields: userID, notificationType
+func (_m *NotificationPreferenceRepository) FindByUserIDAndType(userID uint, notificationType string) (*entities.NotificationPreference, error) {
+	ret := _m.Called(userID, notificationType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByUserIDAndType")
+	}
+
+	var r0 *entities.NotificationPreference
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string) (*entities.NotificationPreference, error)); ok {
+		return rf(userID, notificationType)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string) *entities.NotificationPreference); ok {
+		r0 = rf(userID, notificationType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.NotificationPreference)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string) error); ok {
+		r1 = rf(userID, notificationType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Save provides a mock function with given fields: preference
+func (_m *NotificationPreferenceRepository) Save(preference *entities.NotificationPreference) error {
+	ret := _m.Called(preference)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.NotificationPreference) error); ok {
+		r0 = rf(preference)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewNotificationPreferenceRepository creates a new instance of NotificationPreferenceRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNotificationPreferenceRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NotificationPreferenceRepository {
+	mock := &NotificationPreferenceRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
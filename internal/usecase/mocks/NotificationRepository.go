@@ -0,0 +1,167 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NotificationRepository is an autogenerated mock type for the NotificationRepository type
+type NotificationRepository struct {
+	mock.Mock
+}
+
+// CountByUserID provides a mock function with given fields: userID, unreadOnly
+func (_m *NotificationRepository) CountByUserID(userID uint, unreadOnly bool) (int64, error) {
+	ret := _m.Called(userID, unreadOnly)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByUserID")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, bool) (int64, error)); ok {
+		return rf(userID, unreadOnly)
+	}
+	if rf, ok := ret.Get(0).(func(uint, bool) int64); ok {
+		r0 = rf(userID, unreadOnly)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, bool) error); ok {
+		r1 = rf(userID, unreadOnly)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Create provides a mock function with given fields: notification
+func (_m *NotificationRepository) Create(notification *entities.Notification) error {
+	ret := _m.Called(notification)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.Notification) error); ok {
+		r0 = rf(notification)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExistsUnread provides a mock function with given fields: notification
+func (_m *NotificationRepository) ExistsUnread(notification *entities.Notification) (bool, error) {
+	ret := _m.Called(notification)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExistsUnread")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*entities.Notification) (bool, error)); ok {
+		return rf(notification)
+	}
+	if rf, ok := ret.Get(0).(func(*entities.Notification) bool); ok {
+		r0 = rf(notification)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(*entities.Notification) error); ok {
+		r1 = rf(notification)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByUserID provides a mock function with given fields: userID, unreadOnly, offset, limit
+func (_m *NotificationRepository) FindByUserID(userID uint, unreadOnly bool, offset int, limit int) ([]*entities.Notification, error) {
+	ret := _m.Called(userID, unreadOnly, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByUserID")
+	}
+
+	var r0 []*entities.Notification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, bool, int, int) ([]*entities.Notification, error)); ok {
+		return rf(userID, unreadOnly, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(uint, bool, int, int) []*entities.Notification); ok {
+		r0 = rf(userID, unreadOnly, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, bool, int, int) error); ok {
+		r1 = rf(userID, unreadOnly, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkAllRead provides a mock function with given fields: userID
+func (_m *NotificationRepository) MarkAllRead(userID uint) error {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkAllRead")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint) error); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MarkRead provides a mock function with given fields: userID, ids
+func (_m *NotificationRepository) MarkRead(userID uint, ids []uint) error {
+	ret := _m.Called(userID, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkRead")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, []uint) error); ok {
+		r0 = rf(userID, ids)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewNotificationRepository creates a new instance of NotificationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNotificationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NotificationRepository {
+	mock := &NotificationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,410 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+
+	repositories "github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+)
+
+// PostRepository is an autogenerated mock type for the PostRepository type
+type PostRepository struct {
+	mock.Mock
+}
+
+// CountAll provides a mock function with given fields: viewer
+func (_m *PostRepository) CountAll(viewer repositories.Viewer) (int64, error) {
+	ret := _m.Called(viewer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountAll")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(repositories.Viewer) (int64, error)); ok {
+		return rf(viewer)
+	}
+	if rf, ok := ret.Get(0).(func(repositories.Viewer) int64); ok {
+		r0 = rf(viewer)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(repositories.Viewer) error); ok {
+		r1 = rf(viewer)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountByContent provides a mock function with given fields: viewer, content, genreCommunityID
+func (_m *PostRepository) CountByContent(viewer repositories.Viewer, content string, genreCommunityID *uint) (int64, error) {
+	ret := _m.Called(viewer, content, genreCommunityID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByContent")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(repositories.Viewer, string, *uint) (int64, error)); ok {
+		return rf(viewer, content, genreCommunityID)
+	}
+	if rf, ok := ret.Get(0).(func(repositories.Viewer, string, *uint) int64); ok {
+		r0 = rf(viewer, content, genreCommunityID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(repositories.Viewer, string, *uint) error); ok {
+		r1 = rf(viewer, content, genreCommunityID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountByGenreCommunityID provides a mock function with given fields: viewer, genreCommunityID
+func (_m *PostRepository) CountByGenreCommunityID(viewer repositories.Viewer, genreCommunityID uint) (int64, error) {
+	ret := _m.Called(viewer, genreCommunityID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByGenreCommunityID")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(repositories.Viewer, uint) (int64, error)); ok {
+		return rf(viewer, genreCommunityID)
+	}
+	if rf, ok := ret.Get(0).(func(repositories.Viewer, uint) int64); ok {
+		r0 = rf(viewer, genreCommunityID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(repositories.Viewer, uint) error); ok {
+		r1 = rf(viewer, genreCommunityID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountByTitle provides a mock function with given fields: viewer, title, genreCommunityID
+func (_m *PostRepository) CountByTitle(viewer repositories.Viewer, title string, genreCommunityID *uint) (int64, error) {
+	ret := _m.Called(viewer, title, genreCommunityID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByTitle")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(repositories.Viewer, string, *uint) (int64, error)); ok {
+		return rf(viewer, title, genreCommunityID)
+	}
+	if rf, ok := ret.Get(0).(func(repositories.Viewer, string, *uint) int64); ok {
+		r0 = rf(viewer, title, genreCommunityID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(repositories.Viewer, string, *uint) error); ok {
+		r1 = rf(viewer, title, genreCommunityID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountLikesAndDislikesByID provides a mock function with given fields: id
+func (_m *PostRepository) CountLikesAndDislikesByID(id uint) (int64, int64, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountLikesAndDislikesByID")
+	}
+
+	var r0 int64
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(uint) (int64, int64, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uint) int64); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) int64); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(uint) error); ok {
+		r2 = rf(id)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Create provides a mock function with given fields: post
+func (_m *PostRepository) Create(post *entities.Post) error {
+	ret := _m.Called(post)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.Post) error); ok {
+		r0 = rf(post)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields: id
+func (_m *PostRepository) Delete(id uint) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindAll provides a mock function with given fields: viewer, sort, offset, limit
+func (_m *PostRepository) FindAll(viewer repositories.Viewer, sort string, offset int, limit int) ([]*entities.Post, error) {
+	ret := _m.Called(viewer, sort, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAll")
+	}
+
+	var r0 []*entities.Post
+	var r1 error
+	if rf, ok := ret.Get(0).(func(repositories.Viewer, string, int, int) ([]*entities.Post, error)); ok {
+		return rf(viewer, sort, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(repositories.Viewer, string, int, int) []*entities.Post); ok {
+		r0 = rf(viewer, sort, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Post)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(repositories.Viewer, string, int, int) error); ok {
+		r1 = rf(viewer, sort, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByGenreCommunityID provides a mock function with given fields: viewer, genreCommunityID, sort, offset, limit
+func (_m *PostRepository) FindByGenreCommunityID(viewer repositories.Viewer, genreCommunityID uint, sort string, offset int, limit int) ([]*entities.Post, error) {
+	ret := _m.Called(viewer, genreCommunityID, sort, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByGenreCommunityID")
+	}
+
+	var r0 []*entities.Post
+	var r1 error
+	if rf, ok := ret.Get(0).(func(repositories.Viewer, uint, string, int, int) ([]*entities.Post, error)); ok {
+		return rf(viewer, genreCommunityID, sort, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(repositories.Viewer, uint, string, int, int) []*entities.Post); ok {
+		r0 = rf(viewer, genreCommunityID, sort, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Post)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(repositories.Viewer, uint, string, int, int) error); ok {
+		r1 = rf(viewer, genreCommunityID, sort, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByID provides a mock function with given fields: id
+func (_m *PostRepository) FindByID(id uint) (*entities.Post, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *entities.Post
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (*entities.Post, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uint) *entities.Post); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Post)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByUserID provides a mock function with given fields: userID, offset, limit
+func (_m *PostRepository) FindByUserID(userID uint, offset int, limit int) ([]*entities.Post, error) {
+	ret := _m.Called(userID, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByUserID")
+	}
+
+	var r0 []*entities.Post
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, int, int) ([]*entities.Post, error)); ok {
+		return rf(userID, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(uint, int, int) []*entities.Post); ok {
+		r0 = rf(userID, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Post)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, int, int) error); ok {
+		r1 = rf(userID, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchByContent provides a mock function with given fields: viewer, content, genreCommunityID, offset, limit
+func (_m *PostRepository) SearchByContent(viewer repositories.Viewer, content string, genreCommunityID *uint, offset int, limit int) ([]*entities.Post, error) {
+	ret := _m.Called(viewer, content, genreCommunityID, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchByContent")
+	}
+
+	var r0 []*entities.Post
+	var r1 error
+	if rf, ok := ret.Get(0).(func(repositories.Viewer, string, *uint, int, int) ([]*entities.Post, error)); ok {
+		return rf(viewer, content, genreCommunityID, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(repositories.Viewer, string, *uint, int, int) []*entities.Post); ok {
+		r0 = rf(viewer, content, genreCommunityID, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Post)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(repositories.Viewer, string, *uint, int, int) error); ok {
+		r1 = rf(viewer, content, genreCommunityID, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchByTitle provides a mock function with given fields: viewer, title, genreCommunityID, offset, limit
+func (_m *PostRepository) SearchByTitle(viewer repositories.Viewer, title string, genreCommunityID *uint, offset int, limit int) ([]*entities.Post, error) {
+	ret := _m.Called(viewer, title, genreCommunityID, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchByTitle")
+	}
+
+	var r0 []*entities.Post
+	var r1 error
+	if rf, ok := ret.Get(0).(func(repositories.Viewer, string, *uint, int, int) ([]*entities.Post, error)); ok {
+		return rf(viewer, title, genreCommunityID, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(repositories.Viewer, string, *uint, int, int) []*entities.Post); ok {
+		r0 = rf(viewer, title, genreCommunityID, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Post)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(repositories.Viewer, string, *uint, int, int) error); ok {
+		r1 = rf(viewer, title, genreCommunityID, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: post
+func (_m *PostRepository) Update(post *entities.Post) error {
+	ret := _m.Called(post)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.Post) error); ok {
+		r0 = rf(post)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewPostRepository creates a new instance of PostRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPostRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PostRepository {
+	mock := &PostRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,77 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	markdown "github.com/myjinjin/sonic-odyssey-backend/infrastructure/markdown"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PreviewFetcher is an autogenerated mock type for the PreviewFetcher type
+type PreviewFetcher struct {
+	mock.Mock
+}
+
+// Allowed provides a mock function with given fields: rawURL
+func (_m *PreviewFetcher) Allowed(rawURL string) bool {
+	ret := _m.Called(rawURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Allowed")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(rawURL)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Fetch provides a mock function with given fields: ctx, rawURL
+func (_m *PreviewFetcher) Fetch(ctx context.Context, rawURL string) (*markdown.LinkPreview, error) {
+	ret := _m.Called(ctx, rawURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Fetch")
+	}
+
+	var r0 *markdown.LinkPreview
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*markdown.LinkPreview, error)); ok {
+		return rf(ctx, rawURL)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *markdown.LinkPreview); ok {
+		r0 = rf(ctx, rawURL)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*markdown.LinkPreview)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, rawURL)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewPreviewFetcher creates a new instance of PreviewFetcher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPreviewFetcher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PreviewFetcher {
+	mock := &PreviewFetcher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,113 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	topsterimage "github.com/myjinjin/sonic-odyssey-backend/infrastructure/topsterimage"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Renderer is an autogenerated mock type for the Renderer type
+type Renderer struct {
+	mock.Mock
+}
+
+// ContentType provides a mock function with given fields:
+func (_m *Renderer) ContentType() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ContentType")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Extension provides a mock function with given fields:
+func (_m *Renderer) Extension() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Extension")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Fingerprint provides a mock function with given fields: layout
+func (_m *Renderer) Fingerprint(layout *topsterimage.Layout) string {
+	ret := _m.Called(layout)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Fingerprint")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*topsterimage.Layout) string); ok {
+		r0 = rf(layout)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Render provides a mock function with given fields: ctx, layout
+func (_m *Renderer) Render(ctx context.Context, layout *topsterimage.Layout) ([]byte, error) {
+	ret := _m.Called(ctx, layout)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Render")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *topsterimage.Layout) ([]byte, error)); ok {
+		return rf(ctx, layout)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *topsterimage.Layout) []byte); ok {
+		r0 = rf(ctx, layout)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *topsterimage.Layout) error); ok {
+		r1 = rf(ctx, layout)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewRenderer creates a new instance of Renderer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRenderer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Renderer {
+	mock := &Renderer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
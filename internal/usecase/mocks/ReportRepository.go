@@ -0,0 +1,257 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ReportRepository is an autogenerated mock type for the ReportRepository type
+type ReportRepository struct {
+	mock.Mock
+}
+
+// ClosePendingByTarget provides a mock function with given fields: targetType, targetID, status, resolvedByID
+func (_m *ReportRepository) ClosePendingByTarget(targetType string, targetID uint, status string, resolvedByID uint) error {
+	ret := _m.Called(targetType, targetID, status, resolvedByID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClosePendingByTarget")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, uint, string, uint) error); ok {
+		r0 = rf(targetType, targetID, status, resolvedByID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CountAdminQueue provides a mock function with given fields:
+func (_m *ReportRepository) CountAdminQueue() (int64, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountAdminQueue")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (int64, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountByCommunityID provides a mock function with given fields: communityID, status
+func (_m *ReportRepository) CountByCommunityID(communityID uint, status string) (int64, error) {
+	ret := _m.Called(communityID, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByCommunityID")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string) (int64, error)); ok {
+		return rf(communityID, status)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string) int64); ok {
+		r0 = rf(communityID, status)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string) error); ok {
+		r1 = rf(communityID, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Create provides a mock function with given fields: report
+func (_m *ReportRepository) Create(report *entities.Report) error {
+	ret := _m.Called(report)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.Report) error); ok {
+		r0 = rf(report)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindAdminQueue provides a mock function with given fields: offset, limit
+func (_m *ReportRepository) FindAdminQueue(offset int, limit int) ([]*entities.Report, error) {
+	ret := _m.Called(offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAdminQueue")
+	}
+
+	var r0 []*entities.Report
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int) ([]*entities.Report, error)); ok {
+		return rf(offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []*entities.Report); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Report)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByCommunityID provides a mock function with given fields: communityID, status, offset, limit
+func (_m *ReportRepository) FindByCommunityID(communityID uint, status string, offset int, limit int) ([]*entities.Report, error) {
+	ret := _m.Called(communityID, status, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByCommunityID")
+	}
+
+	var r0 []*entities.Report
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string, int, int) ([]*entities.Report, error)); ok {
+		return rf(communityID, status, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string, int, int) []*entities.Report); ok {
+		r0 = rf(communityID, status, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Report)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string, int, int) error); ok {
+		r1 = rf(communityID, status, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByID provides a mock function with given fields: id
+func (_m *ReportRepository) FindByID(id uint) (*entities.Report, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *entities.Report
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (*entities.Report, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uint) *entities.Report); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Report)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindPendingByReporterAndTarget provides a mock function with given fields: reporterID, targetType, targetID
+func (_m *ReportRepository) FindPendingByReporterAndTarget(reporterID uint, targetType string, targetID uint) (*entities.Report, error) {
+	ret := _m.Called(reporterID, targetType, targetID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPendingByReporterAndTarget")
+	}
+
+	var r0 *entities.Report
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string, uint) (*entities.Report, error)); ok {
+		return rf(reporterID, targetType, targetID)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string, uint) *entities.Report); ok {
+		r0 = rf(reporterID, targetType, targetID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Report)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string, uint) error); ok {
+		r1 = rf(reporterID, targetType, targetID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: report
+func (_m *ReportRepository) Update(report *entities.Report) error {
+	ret := _m.Called(report)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.Report) error); ok {
+		r0 = rf(report)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewReportRepository creates a new instance of ReportRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReportRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReportRepository {
+	mock := &ReportRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
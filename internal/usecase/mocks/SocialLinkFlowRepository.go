@@ -0,0 +1,93 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SocialLinkFlowRepository is an autogenerated mock type for the SocialLinkFlowRepository type
+type SocialLinkFlowRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: flow
+func (_m *SocialLinkFlowRepository) Create(flow *entities.SocialLinkFlow) error {
+	ret := _m.Called(flow)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.SocialLinkFlow) error); ok {
+		r0 = rf(flow)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteByState provides a mock function with given fields: state
+func (_m *SocialLinkFlowRepository) DeleteByState(state string) error {
+	ret := _m.Called(state)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByState")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(state)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindByState provides a mock function with given fields: state
+func (_m *SocialLinkFlowRepository) FindByState(state string) (*entities.SocialLinkFlow, error) {
+	ret := _m.Called(state)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByState")
+	}
+
+	var r0 *entities.SocialLinkFlow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*entities.SocialLinkFlow, error)); ok {
+		return rf(state)
+	}
+	if rf, ok := ret.Get(0).(func(string) *entities.SocialLinkFlow); ok {
+		r0 = rf(state)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.SocialLinkFlow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(state)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewSocialLinkFlowRepository creates a new instance of SocialLinkFlowRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSocialLinkFlowRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SocialLinkFlowRepository {
+	mock := &SocialLinkFlowRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
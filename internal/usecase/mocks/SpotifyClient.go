@@ -14,6 +14,96 @@ type SpotifyClient struct {
 	mock.Mock
 }
 
+// GetAlbum provides a mock function with given fields: ctx, id
+func (_m *SpotifyClient) GetAlbum(ctx context.Context, id spotify.ID) (*spotify.FullAlbum, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAlbum")
+	}
+
+	var r0 *spotify.FullAlbum
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, spotify.ID) (*spotify.FullAlbum, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, spotify.ID) *spotify.FullAlbum); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*spotify.FullAlbum)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, spotify.ID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetArtist provides a mock function with given fields: ctx, id
+func (_m *SpotifyClient) GetArtist(ctx context.Context, id spotify.ID) (*spotify.FullArtist, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetArtist")
+	}
+
+	var r0 *spotify.FullArtist
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, spotify.ID) (*spotify.FullArtist, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, spotify.ID) *spotify.FullArtist); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*spotify.FullArtist)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, spotify.ID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTrack provides a mock function with given fields: ctx, id
+func (_m *SpotifyClient) GetTrack(ctx context.Context, id spotify.ID) (*spotify.FullTrack, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTrack")
+	}
+
+	var r0 *spotify.FullTrack
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, spotify.ID) (*spotify.FullTrack, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, spotify.ID) *spotify.FullTrack); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*spotify.FullTrack)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, spotify.ID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Search provides a mock function with given fields: ctx, query, t, opts
 func (_m *SpotifyClient) Search(ctx context.Context, query string, t spotify.SearchType, opts ...spotify.RequestOption) (*spotify.SearchResult, error) {
 	_va := make([]interface{}, len(opts))
@@ -0,0 +1,123 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SpotifyImportJobRepository is an autogenerated mock type for the SpotifyImportJobRepository type
+type SpotifyImportJobRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: job
+func (_m *SpotifyImportJobRepository) Create(job *entities.SpotifyImportJob) error {
+	ret := _m.Called(job)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.SpotifyImportJob) error); ok {
+		r0 = rf(job)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindActiveByUserID provides a mock function with given fields: userID
+func (_m *SpotifyImportJobRepository) FindActiveByUserID(userID uint) (*entities.SpotifyImportJob, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindActiveByUserID")
+	}
+
+	var r0 *entities.SpotifyImportJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (*entities.SpotifyImportJob, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(uint) *entities.SpotifyImportJob); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.SpotifyImportJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByID provides a mock function with given fields: id
+func (_m *SpotifyImportJobRepository) FindByID(id uint) (*entities.SpotifyImportJob, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *entities.SpotifyImportJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (*entities.SpotifyImportJob, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uint) *entities.SpotifyImportJob); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.SpotifyImportJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: job
+func (_m *SpotifyImportJobRepository) Update(job *entities.SpotifyImportJob) error {
+	ret := _m.Called(job)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.SpotifyImportJob) error); ok {
+		r0 = rf(job)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewSpotifyImportJobRepository creates a new instance of SpotifyImportJobRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSpotifyImportJobRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SpotifyImportJobRepository {
+	mock := &SpotifyImportJobRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
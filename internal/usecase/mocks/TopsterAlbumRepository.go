@@ -0,0 +1,159 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TopsterAlbumRepository is an autogenerated mock type for the TopsterAlbumRepository type
+type TopsterAlbumRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: topsterAlbum
+func (_m *TopsterAlbumRepository) Create(topsterAlbum *entities.TopsterAlbum) error {
+	ret := _m.Called(topsterAlbum)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.TopsterAlbum) error); ok {
+		r0 = rf(topsterAlbum)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields: id
+func (_m *TopsterAlbumRepository) Delete(id uint) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindByID provides a mock function with given fields: id
+func (_m *TopsterAlbumRepository) FindByID(id uint) (*entities.TopsterAlbum, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *entities.TopsterAlbum
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (*entities.TopsterAlbum, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uint) *entities.TopsterAlbum); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.TopsterAlbum)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByTopsterID provides a mock function with given fields: topsterID
+func (_m *TopsterAlbumRepository) FindByTopsterID(topsterID uint) ([]*entities.TopsterAlbum, error) {
+	ret := _m.Called(topsterID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByTopsterID")
+	}
+
+	var r0 []*entities.TopsterAlbum
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]*entities.TopsterAlbum, error)); ok {
+		return rf(topsterID)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []*entities.TopsterAlbum); ok {
+		r0 = rf(topsterID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.TopsterAlbum)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(topsterID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReplaceByTopsterID provides a mock function with given fields: topsterID, topsterAlbums
+func (_m *TopsterAlbumRepository) ReplaceByTopsterID(topsterID uint, topsterAlbums []*entities.TopsterAlbum) error {
+	ret := _m.Called(topsterID, topsterAlbums)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceByTopsterID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, []*entities.TopsterAlbum) error); ok {
+		r0 = rf(topsterID, topsterAlbums)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: topsterAlbum
+func (_m *TopsterAlbumRepository) Update(topsterAlbum *entities.TopsterAlbum) error {
+	ret := _m.Called(topsterAlbum)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.TopsterAlbum) error); ok {
+		r0 = rf(topsterAlbum)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewTopsterAlbumRepository creates a new instance of TopsterAlbumRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTopsterAlbumRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TopsterAlbumRepository {
+	mock := &TopsterAlbumRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
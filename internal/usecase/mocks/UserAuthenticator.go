@@ -0,0 +1,99 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	oauth2 "golang.org/x/oauth2"
+
+	spotifyclient "github.com/myjinjin/sonic-odyssey-backend/infrastructure/spotifyclient"
+)
+
+// UserAuthenticator is an autogenerated mock type for the UserAuthenticator type
+type UserAuthenticator struct {
+	mock.Mock
+}
+
+// AuthURL provides a mock function with given fields: state
+func (_m *UserAuthenticator) AuthURL(state string) string {
+	ret := _m.Called(state)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AuthURL")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(state)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Exchange provides a mock function with given fields: ctx, code
+func (_m *UserAuthenticator) Exchange(ctx context.Context, code string) (*oauth2.Token, error) {
+	ret := _m.Called(ctx, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exchange")
+	}
+
+	var r0 *oauth2.Token
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*oauth2.Token, error)); ok {
+		return rf(ctx, code)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *oauth2.Token); ok {
+		r0 = rf(ctx, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*oauth2.Token)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewUserClient provides a mock function with given fields: ctx, token
+func (_m *UserAuthenticator) NewUserClient(ctx context.Context, token *oauth2.Token) spotifyclient.UserClient {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NewUserClient")
+	}
+
+	var r0 spotifyclient.UserClient
+	if rf, ok := ret.Get(0).(func(context.Context, *oauth2.Token) spotifyclient.UserClient); ok {
+		r0 = rf(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(spotifyclient.UserClient)
+		}
+	}
+
+	return r0
+}
+
+// NewUserAuthenticator creates a new instance of UserAuthenticator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUserAuthenticator(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UserAuthenticator {
+	mock := &UserAuthenticator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,179 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UserBlockRepository is an autogenerated mock type for the UserBlockRepository type
+type UserBlockRepository struct {
+	mock.Mock
+}
+
+// CountByBlockerID provides a mock function with given fields: blockerID
+func (_m *UserBlockRepository) CountByBlockerID(blockerID uint) (int64, error) {
+	ret := _m.Called(blockerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByBlockerID")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (int64, error)); ok {
+		return rf(blockerID)
+	}
+	if rf, ok := ret.Get(0).(func(uint) int64); ok {
+		r0 = rf(blockerID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(blockerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Create provides a mock function with given fields: block
+func (_m *UserBlockRepository) Create(block *entities.UserBlock) error {
+	ret := _m.Called(block)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.UserBlock) error); ok {
+		r0 = rf(block)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteByBlockerIDAndBlockedID provides a mock function with given fields: blockerID, blockedID
+func (_m *UserBlockRepository) DeleteByBlockerIDAndBlockedID(blockerID uint, blockedID uint) error {
+	ret := _m.Called(blockerID, blockedID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByBlockerIDAndBlockedID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint) error); ok {
+		r0 = rf(blockerID, blockedID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExistsBetween provides a mock function with given fields: userID, otherID
+func (_m *UserBlockRepository) ExistsBetween(userID uint, otherID uint) (bool, error) {
+	ret := _m.Called(userID, otherID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExistsBetween")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint) (bool, error)); ok {
+		return rf(userID, otherID)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint) bool); ok {
+		r0 = rf(userID, otherID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(userID, otherID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByBlockerID provides a mock function with given fields: blockerID, offset, limit
+func (_m *UserBlockRepository) FindByBlockerID(blockerID uint, offset int, limit int) ([]*entities.UserBlock, error) {
+	ret := _m.Called(blockerID, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByBlockerID")
+	}
+
+	var r0 []*entities.UserBlock
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, int, int) ([]*entities.UserBlock, error)); ok {
+		return rf(blockerID, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(uint, int, int) []*entities.UserBlock); ok {
+		r0 = rf(blockerID, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.UserBlock)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, int, int) error); ok {
+		r1 = rf(blockerID, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByBlockerIDAndBlockedID provides a mock function with given fields: blockerID, blockedID
+func (_m *UserBlockRepository) FindByBlockerIDAndBlockedID(blockerID uint, blockedID uint) (*entities.UserBlock, error) {
+	ret := _m.Called(blockerID, blockedID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByBlockerIDAndBlockedID")
+	}
+
+	var r0 *entities.UserBlock
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint) (*entities.UserBlock, error)); ok {
+		return rf(blockerID, blockedID)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint) *entities.UserBlock); ok {
+		r0 = rf(blockerID, blockedID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.UserBlock)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(blockerID, blockedID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewUserBlockRepository creates a new instance of UserBlockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUserBlockRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UserBlockRepository {
+	mock := &UserBlockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,350 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	oauth2 "golang.org/x/oauth2"
+
+	spotify "github.com/zmb3/spotify/v2"
+)
+
+// UserClient is an autogenerated mock type for the UserClient type
+type UserClient struct {
+	mock.Mock
+}
+
+// AddTracksToPlaylist provides a mock function with given fields: ctx, playlistID, trackIDs
+func (_m *UserClient) AddTracksToPlaylist(ctx context.Context, playlistID spotify.ID, trackIDs ...spotify.ID) (string, error) {
+	_va := make([]interface{}, len(trackIDs))
+	for _i := range trackIDs {
+		_va[_i] = trackIDs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, playlistID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddTracksToPlaylist")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, spotify.ID, ...spotify.ID) (string, error)); ok {
+		return rf(ctx, playlistID, trackIDs...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, spotify.ID, ...spotify.ID) string); ok {
+		r0 = rf(ctx, playlistID, trackIDs...)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, spotify.ID, ...spotify.ID) error); ok {
+		r1 = rf(ctx, playlistID, trackIDs...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreatePlaylist provides a mock function with given fields: ctx, userID, name, description, public
+func (_m *UserClient) CreatePlaylist(ctx context.Context, userID string, name string, description string, public bool) (*spotify.FullPlaylist, error) {
+	ret := _m.Called(ctx, userID, name, description, public)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePlaylist")
+	}
+
+	var r0 *spotify.FullPlaylist
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, bool) (*spotify.FullPlaylist, error)); ok {
+		return rf(ctx, userID, name, description, public)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, bool) *spotify.FullPlaylist); ok {
+		r0 = rf(ctx, userID, name, description, public)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*spotify.FullPlaylist)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, bool) error); ok {
+		r1 = rf(ctx, userID, name, description, public)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CurrentUser provides a mock function with given fields: ctx
+func (_m *UserClient) CurrentUser(ctx context.Context) (*spotify.PrivateUser, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CurrentUser")
+	}
+
+	var r0 *spotify.PrivateUser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*spotify.PrivateUser, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *spotify.PrivateUser); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*spotify.PrivateUser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CurrentUsersPlaylists provides a mock function with given fields: ctx, opts
+func (_m *UserClient) CurrentUsersPlaylists(ctx context.Context, opts ...spotify.RequestOption) (*spotify.SimplePlaylistPage, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CurrentUsersPlaylists")
+	}
+
+	var r0 *spotify.SimplePlaylistPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ...spotify.RequestOption) (*spotify.SimplePlaylistPage, error)); ok {
+		return rf(ctx, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ...spotify.RequestOption) *spotify.SimplePlaylistPage); ok {
+		r0 = rf(ctx, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*spotify.SimplePlaylistPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ...spotify.RequestOption) error); ok {
+		r1 = rf(ctx, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CurrentUsersTracks provides a mock function with given fields: ctx, opts
+func (_m *UserClient) CurrentUsersTracks(ctx context.Context, opts ...spotify.RequestOption) (*spotify.SavedTrackPage, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CurrentUsersTracks")
+	}
+
+	var r0 *spotify.SavedTrackPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ...spotify.RequestOption) (*spotify.SavedTrackPage, error)); ok {
+		return rf(ctx, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ...spotify.RequestOption) *spotify.SavedTrackPage); ok {
+		r0 = rf(ctx, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*spotify.SavedTrackPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ...spotify.RequestOption) error); ok {
+		r1 = rf(ctx, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPlaylist provides a mock function with given fields: ctx, playlistID
+func (_m *UserClient) GetPlaylist(ctx context.Context, playlistID spotify.ID) (*spotify.FullPlaylist, error) {
+	ret := _m.Called(ctx, playlistID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPlaylist")
+	}
+
+	var r0 *spotify.FullPlaylist
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, spotify.ID) (*spotify.FullPlaylist, error)); ok {
+		return rf(ctx, playlistID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, spotify.ID) *spotify.FullPlaylist); ok {
+		r0 = rf(ctx, playlistID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*spotify.FullPlaylist)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, spotify.ID) error); ok {
+		r1 = rf(ctx, playlistID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPlaylistItems provides a mock function with given fields: ctx, playlistID, opts
+func (_m *UserClient) GetPlaylistItems(ctx context.Context, playlistID spotify.ID, opts ...spotify.RequestOption) (*spotify.PlaylistItemPage, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, playlistID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPlaylistItems")
+	}
+
+	var r0 *spotify.PlaylistItemPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, spotify.ID, ...spotify.RequestOption) (*spotify.PlaylistItemPage, error)); ok {
+		return rf(ctx, playlistID, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, spotify.ID, ...spotify.RequestOption) *spotify.PlaylistItemPage); ok {
+		r0 = rf(ctx, playlistID, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*spotify.PlaylistItemPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, spotify.ID, ...spotify.RequestOption) error); ok {
+		r1 = rf(ctx, playlistID, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveTracksFromPlaylist provides a mock function with given fields: ctx, playlistID, trackIDs
+func (_m *UserClient) RemoveTracksFromPlaylist(ctx context.Context, playlistID spotify.ID, trackIDs ...spotify.ID) (string, error) {
+	_va := make([]interface{}, len(trackIDs))
+	for _i := range trackIDs {
+		_va[_i] = trackIDs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, playlistID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveTracksFromPlaylist")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, spotify.ID, ...spotify.ID) (string, error)); ok {
+		return rf(ctx, playlistID, trackIDs...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, spotify.ID, ...spotify.ID) string); ok {
+		r0 = rf(ctx, playlistID, trackIDs...)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, spotify.ID, ...spotify.ID) error); ok {
+		r1 = rf(ctx, playlistID, trackIDs...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Token provides a mock function with given fields:
+func (_m *UserClient) Token() (*oauth2.Token, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Token")
+	}
+
+	var r0 *oauth2.Token
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*oauth2.Token, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *oauth2.Token); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*oauth2.Token)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdatePlaylistDetails provides a mock function with given fields: ctx, playlistID, name, description, public
+func (_m *UserClient) UpdatePlaylistDetails(ctx context.Context, playlistID spotify.ID, name string, description string, public bool) error {
+	ret := _m.Called(ctx, playlistID, name, description, public)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePlaylistDetails")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, spotify.ID, string, string, bool) error); ok {
+		r0 = rf(ctx, playlistID, name, description, public)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewUserClient creates a new instance of UserClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUserClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UserClient {
+	mock := &UserClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,319 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+
+	repositories "github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+)
+
+// UserFollowRepository is an autogenerated mock type for the UserFollowRepository type
+type UserFollowRepository struct {
+	mock.Mock
+}
+
+// CountFollowersByUserID provides a mock function with given fields: viewer, userID
+func (_m *UserFollowRepository) CountFollowersByUserID(viewer repositories.Viewer, userID uint) (int64, error) {
+	ret := _m.Called(viewer, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountFollowersByUserID")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(repositories.Viewer, uint) (int64, error)); ok {
+		return rf(viewer, userID)
+	}
+	if rf, ok := ret.Get(0).(func(repositories.Viewer, uint) int64); ok {
+		r0 = rf(viewer, userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(repositories.Viewer, uint) error); ok {
+		r1 = rf(viewer, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountFollowingsByUserID provides a mock function with given fields: viewer, userID
+func (_m *UserFollowRepository) CountFollowingsByUserID(viewer repositories.Viewer, userID uint) (int64, error) {
+	ret := _m.Called(viewer, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountFollowingsByUserID")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(repositories.Viewer, uint) (int64, error)); ok {
+		return rf(viewer, userID)
+	}
+	if rf, ok := ret.Get(0).(func(repositories.Viewer, uint) int64); ok {
+		r0 = rf(viewer, userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(repositories.Viewer, uint) error); ok {
+		r1 = rf(viewer, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Create provides a mock function with given fields: userFollow
+func (_m *UserFollowRepository) Create(userFollow *entities.UserFollow) error {
+	ret := _m.Called(userFollow)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.UserFollow) error); ok {
+		r0 = rf(userFollow)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields: id
+func (_m *UserFollowRepository) Delete(id uint) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteByFollowerIDAndFollowingID provides a mock function with given fields: followerID, followingID
+func (_m *UserFollowRepository) DeleteByFollowerIDAndFollowingID(followerID uint, followingID uint) error {
+	ret := _m.Called(followerID, followingID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByFollowerIDAndFollowingID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint) error); ok {
+		r0 = rf(followerID, followingID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindByFollowerIDAndFollowingID provides a mock function with given fields: followerID, followingID
+func (_m *UserFollowRepository) FindByFollowerIDAndFollowingID(followerID uint, followingID uint) (*entities.UserFollow, error) {
+	ret := _m.Called(followerID, followingID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByFollowerIDAndFollowingID")
+	}
+
+	var r0 *entities.UserFollow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint) (*entities.UserFollow, error)); ok {
+		return rf(followerID, followingID)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint) *entities.UserFollow); ok {
+		r0 = rf(followerID, followingID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.UserFollow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(followerID, followingID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByID provides a mock function with given fields: id
+func (_m *UserFollowRepository) FindByID(id uint) (*entities.UserFollow, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *entities.UserFollow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (*entities.UserFollow, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uint) *entities.UserFollow); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.UserFollow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindFollowerIDs provides a mock function with given fields: followingID, userIDs
+func (_m *UserFollowRepository) FindFollowerIDs(followingID uint, userIDs []uint) ([]uint, error) {
+	ret := _m.Called(followingID, userIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindFollowerIDs")
+	}
+
+	var r0 []uint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, []uint) ([]uint, error)); ok {
+		return rf(followingID, userIDs)
+	}
+	if rf, ok := ret.Get(0).(func(uint, []uint) []uint); ok {
+		r0 = rf(followingID, userIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, []uint) error); ok {
+		r1 = rf(followingID, userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindFollowersByUserID provides a mock function with given fields: viewer, userID, offset, limit
+func (_m *UserFollowRepository) FindFollowersByUserID(viewer repositories.Viewer, userID uint, offset int, limit int) ([]*entities.UserFollow, error) {
+	ret := _m.Called(viewer, userID, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindFollowersByUserID")
+	}
+
+	var r0 []*entities.UserFollow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(repositories.Viewer, uint, int, int) ([]*entities.UserFollow, error)); ok {
+		return rf(viewer, userID, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(repositories.Viewer, uint, int, int) []*entities.UserFollow); ok {
+		r0 = rf(viewer, userID, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.UserFollow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(repositories.Viewer, uint, int, int) error); ok {
+		r1 = rf(viewer, userID, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindFollowingIDs provides a mock function with given fields: followerID, userIDs
+func (_m *UserFollowRepository) FindFollowingIDs(followerID uint, userIDs []uint) ([]uint, error) {
+	ret := _m.Called(followerID, userIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindFollowingIDs")
+	}
+
+	var r0 []uint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, []uint) ([]uint, error)); ok {
+		return rf(followerID, userIDs)
+	}
+	if rf, ok := ret.Get(0).(func(uint, []uint) []uint); ok {
+		r0 = rf(followerID, userIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, []uint) error); ok {
+		r1 = rf(followerID, userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindFollowingsByUserID provides a mock function with given fields: viewer, userID, offset, limit
+func (_m *UserFollowRepository) FindFollowingsByUserID(viewer repositories.Viewer, userID uint, offset int, limit int) ([]*entities.UserFollow, error) {
+	ret := _m.Called(viewer, userID, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindFollowingsByUserID")
+	}
+
+	var r0 []*entities.UserFollow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(repositories.Viewer, uint, int, int) ([]*entities.UserFollow, error)); ok {
+		return rf(viewer, userID, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(repositories.Viewer, uint, int, int) []*entities.UserFollow); ok {
+		r0 = rf(viewer, userID, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.UserFollow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(repositories.Viewer, uint, int, int) error); ok {
+		r1 = rf(viewer, userID, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewUserFollowRepository creates a new instance of UserFollowRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUserFollowRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UserFollowRepository {
+	mock := &UserFollowRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
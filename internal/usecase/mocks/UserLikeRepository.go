@@ -0,0 +1,376 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UserLikeRepository is an autogenerated mock type for the UserLikeRepository type
+type UserLikeRepository struct {
+	mock.Mock
+}
+
+// CountLikesAndDislikesByAlbumID provides a mock function with given fields: albumID
+func (_m *UserLikeRepository) CountLikesAndDislikesByAlbumID(albumID uint) (int64, int64, error) {
+	ret := _m.Called(albumID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountLikesAndDislikesByAlbumID")
+	}
+
+	var r0 int64
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(uint) (int64, int64, error)); ok {
+		return rf(albumID)
+	}
+	if rf, ok := ret.Get(0).(func(uint) int64); ok {
+		r0 = rf(albumID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) int64); ok {
+		r1 = rf(albumID)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(uint) error); ok {
+		r2 = rf(albumID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// CountLikesAndDislikesByArtistID provides a mock function with given fields: artistID
+func (_m *UserLikeRepository) CountLikesAndDislikesByArtistID(artistID uint) (int64, int64, error) {
+	ret := _m.Called(artistID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountLikesAndDislikesByArtistID")
+	}
+
+	var r0 int64
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(uint) (int64, int64, error)); ok {
+		return rf(artistID)
+	}
+	if rf, ok := ret.Get(0).(func(uint) int64); ok {
+		r0 = rf(artistID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) int64); ok {
+		r1 = rf(artistID)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(uint) error); ok {
+		r2 = rf(artistID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// CountLikesAndDislikesByCommentID provides a mock function with given fields: commentID
+func (_m *UserLikeRepository) CountLikesAndDislikesByCommentID(commentID uint) (int64, int64, error) {
+	ret := _m.Called(commentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountLikesAndDislikesByCommentID")
+	}
+
+	var r0 int64
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(uint) (int64, int64, error)); ok {
+		return rf(commentID)
+	}
+	if rf, ok := ret.Get(0).(func(uint) int64); ok {
+		r0 = rf(commentID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) int64); ok {
+		r1 = rf(commentID)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(uint) error); ok {
+		r2 = rf(commentID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// CountLikesAndDislikesByMusicID provides a mock function with given fields: musicID
+func (_m *UserLikeRepository) CountLikesAndDislikesByMusicID(musicID uint) (int64, int64, error) {
+	ret := _m.Called(musicID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountLikesAndDislikesByMusicID")
+	}
+
+	var r0 int64
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(uint) (int64, int64, error)); ok {
+		return rf(musicID)
+	}
+	if rf, ok := ret.Get(0).(func(uint) int64); ok {
+		r0 = rf(musicID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) int64); ok {
+		r1 = rf(musicID)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(uint) error); ok {
+		r2 = rf(musicID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// CountLikesAndDislikesByPostID provides a mock function with given fields: postID
+func (_m *UserLikeRepository) CountLikesAndDislikesByPostID(postID uint) (int64, int64, error) {
+	ret := _m.Called(postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountLikesAndDislikesByPostID")
+	}
+
+	var r0 int64
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(uint) (int64, int64, error)); ok {
+		return rf(postID)
+	}
+	if rf, ok := ret.Get(0).(func(uint) int64); ok {
+		r0 = rf(postID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) int64); ok {
+		r1 = rf(postID)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(uint) error); ok {
+		r2 = rf(postID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Create provides a mock function with given fields: userLike
+func (_m *UserLikeRepository) Create(userLike *entities.UserLike) error {
+	ret := _m.Called(userLike)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.UserLike) error); ok {
+		r0 = rf(userLike)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields: id
+func (_m *UserLikeRepository) Delete(id uint) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindByID provides a mock function with given fields: id
+func (_m *UserLikeRepository) FindByID(id uint) (*entities.UserLike, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *entities.UserLike
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (*entities.UserLike, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uint) *entities.UserLike); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.UserLike)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByUserIDAndCommentID provides a mock function with given fields: userID, commentID
+func (_m *UserLikeRepository) FindByUserIDAndCommentID(userID uint, commentID uint) (*entities.UserLike, error) {
+	ret := _m.Called(userID, commentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByUserIDAndCommentID")
+	}
+
+	var r0 *entities.UserLike
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint) (*entities.UserLike, error)); ok {
+		return rf(userID, commentID)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint) *entities.UserLike); ok {
+		r0 = rf(userID, commentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.UserLike)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(userID, commentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByUserIDAndMusicID provides a mock function with given fields: userID, musicID
+func (_m *UserLikeRepository) FindByUserIDAndMusicID(userID uint, musicID uint) (*entities.UserLike, error) {
+	ret := _m.Called(userID, musicID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByUserIDAndMusicID")
+	}
+
+	var r0 *entities.UserLike
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint) (*entities.UserLike, error)); ok {
+		return rf(userID, musicID)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint) *entities.UserLike); ok {
+		r0 = rf(userID, musicID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.UserLike)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(userID, musicID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByUserIDAndPostID provides a mock function with given fields: userID, postID
+func (_m *UserLikeRepository) FindByUserIDAndPostID(userID uint, postID uint) (*entities.UserLike, error) {
+	ret := _m.Called(userID, postID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByUserIDAndPostID")
+	}
+
+	var r0 *entities.UserLike
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint) (*entities.UserLike, error)); ok {
+		return rf(userID, postID)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint) *entities.UserLike); ok {
+		r0 = rf(userID, postID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.UserLike)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(userID, postID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: userLike
+func (_m *UserLikeRepository) Update(userLike *entities.UserLike) error {
+	ret := _m.Called(userLike)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.UserLike) error); ok {
+		r0 = rf(userLike)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewUserLikeRepository creates a new instance of UserLikeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUserLikeRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UserLikeRepository {
+	mock := &UserLikeRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,151 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UserMuteRepository is an autogenerated mock type for the UserMuteRepository type
+type UserMuteRepository struct {
+	mock.Mock
+}
+
+// CountByMuterID provides a mock function with given fields: muterID
+func (_m *UserMuteRepository) CountByMuterID(muterID uint) (int64, error) {
+	ret := _m.Called(muterID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByMuterID")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (int64, error)); ok {
+		return rf(muterID)
+	}
+	if rf, ok := ret.Get(0).(func(uint) int64); ok {
+		r0 = rf(muterID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(muterID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Create provides a mock function with given fields: mute
+func (_m *UserMuteRepository) Create(mute *entities.UserMute) error {
+	ret := _m.Called(mute)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.UserMute) error); ok {
+		r0 = rf(mute)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteByMuterIDAndMutedID provides a mock function with given fields: muterID, mutedID
+func (_m *UserMuteRepository) DeleteByMuterIDAndMutedID(muterID uint, mutedID uint) error {
+	ret := _m.Called(muterID, mutedID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByMuterIDAndMutedID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint) error); ok {
+		r0 = rf(muterID, mutedID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindByMuterID provides a mock function with given fields: muterID, offset, limit
+func (_m *UserMuteRepository) FindByMuterID(muterID uint, offset int, limit int) ([]*entities.UserMute, error) {
+	ret := _m.Called(muterID, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByMuterID")
+	}
+
+	var r0 []*entities.UserMute
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, int, int) ([]*entities.UserMute, error)); ok {
+		return rf(muterID, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(uint, int, int) []*entities.UserMute); ok {
+		r0 = rf(muterID, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.UserMute)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, int, int) error); ok {
+		r1 = rf(muterID, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByMuterIDAndMutedID provides a mock function with given fields: muterID, mutedID
+func (_m *UserMuteRepository) FindByMuterIDAndMutedID(muterID uint, mutedID uint) (*entities.UserMute, error) {
+	ret := _m.Called(muterID, mutedID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByMuterIDAndMutedID")
+	}
+
+	var r0 *entities.UserMute
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint) (*entities.UserMute, error)); ok {
+		return rf(muterID, mutedID)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint) *entities.UserMute); ok {
+		r0 = rf(muterID, mutedID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.UserMute)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(muterID, mutedID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewUserMuteRepository creates a new instance of UserMuteRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUserMuteRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UserMuteRepository {
+	mock := &UserMuteRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
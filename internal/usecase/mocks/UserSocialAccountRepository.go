@@ -0,0 +1,171 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UserSocialAccountRepository is an autogenerated mock type for the UserSocialAccountRepository type
+type UserSocialAccountRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: userSocialAccount
+func (_m *UserSocialAccountRepository) Create(userSocialAccount *entities.UserSocialAccount) error {
+	ret := _m.Called(userSocialAccount)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.UserSocialAccount) error); ok {
+		r0 = rf(userSocialAccount)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields: id
+func (_m *UserSocialAccountRepository) Delete(id uint) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindByID provides a mock function with given fields: id
+func (_m *UserSocialAccountRepository) FindByID(id uint) (*entities.UserSocialAccount, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *entities.UserSocialAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (*entities.UserSocialAccount, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uint) *entities.UserSocialAccount); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.UserSocialAccount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByProviderAndProviderUserID provides a mock function with given fields: provider, providerUserID
+func (_m *UserSocialAccountRepository) FindByProviderAndProviderUserID(provider string, providerUserID string) (*entities.UserSocialAccount, error) {
+	ret := _m.Called(provider, providerUserID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByProviderAndProviderUserID")
+	}
+
+	var r0 *entities.UserSocialAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*entities.UserSocialAccount, error)); ok {
+		return rf(provider, providerUserID)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *entities.UserSocialAccount); ok {
+		r0 = rf(provider, providerUserID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.UserSocialAccount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(provider, providerUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByUserIDAndProvider provides a mock function with given fields: userID, provider
+func (_m *UserSocialAccountRepository) FindByUserIDAndProvider(userID uint, provider string) (*entities.UserSocialAccount, error) {
+	ret := _m.Called(userID, provider)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByUserIDAndProvider")
+	}
+
+	var r0 *entities.UserSocialAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string) (*entities.UserSocialAccount, error)); ok {
+		return rf(userID, provider)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string) *entities.UserSocialAccount); ok {
+		r0 = rf(userID, provider)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.UserSocialAccount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string) error); ok {
+		r1 = rf(userID, provider)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: userSocialAccount
+func (_m *UserSocialAccountRepository) Update(userSocialAccount *entities.UserSocialAccount) error {
+	ret := _m.Called(userSocialAccount)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.UserSocialAccount) error); ok {
+		r0 = rf(userSocialAccount)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewUserSocialAccountRepository creates a new instance of UserSocialAccountRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUserSocialAccountRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UserSocialAccountRepository {
+	mock := &UserSocialAccountRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,169 @@
+// Code generated by mockery v2.43.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UserTopsterRepository is an autogenerated mock type for the UserTopsterRepository type
+type UserTopsterRepository struct {
+	mock.Mock
+}
+
+// CountByUserID provides a mock function with given fields: userID, includePrivate
+func (_m *UserTopsterRepository) CountByUserID(userID uint, includePrivate bool) (int64, error) {
+	ret := _m.Called(userID, includePrivate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByUserID")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, bool) (int64, error)); ok {
+		return rf(userID, includePrivate)
+	}
+	if rf, ok := ret.Get(0).(func(uint, bool) int64); ok {
+		r0 = rf(userID, includePrivate)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, bool) error); ok {
+		r1 = rf(userID, includePrivate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Create provides a mock function with given fields: userTopster
+func (_m *UserTopsterRepository) Create(userTopster *entities.UserTopster) error {
+	ret := _m.Called(userTopster)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.UserTopster) error); ok {
+		r0 = rf(userTopster)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields: id
+func (_m *UserTopsterRepository) Delete(id uint) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindByID provides a mock function with given fields: id
+func (_m *UserTopsterRepository) FindByID(id uint) (*entities.UserTopster, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *entities.UserTopster
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (*entities.UserTopster, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uint) *entities.UserTopster); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.UserTopster)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByUserID provides a mock function with given fields: userID, includePrivate, offset, limit
+func (_m *UserTopsterRepository) FindByUserID(userID uint, includePrivate bool, offset int, limit int) ([]*entities.UserTopster, error) {
+	ret := _m.Called(userID, includePrivate, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByUserID")
+	}
+
+	var r0 []*entities.UserTopster
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, bool, int, int) ([]*entities.UserTopster, error)); ok {
+		return rf(userID, includePrivate, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(uint, bool, int, int) []*entities.UserTopster); ok {
+		r0 = rf(userID, includePrivate, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.UserTopster)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, bool, int, int) error); ok {
+		r1 = rf(userID, includePrivate, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: userTopster
+func (_m *UserTopsterRepository) Update(userTopster *entities.UserTopster) error {
+	ret := _m.Called(userTopster)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.UserTopster) error); ok {
+		r0 = rf(userTopster)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewUserTopsterRepository creates a new instance of UserTopsterRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUserTopsterRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UserTopsterRepository {
+	mock := &UserTopsterRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
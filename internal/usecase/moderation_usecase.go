@@ -0,0 +1,568 @@
+package usecase
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+)
+
+type ModerationUsecase interface {
+	ReportContent(userID uint, input *CreateReportInput) (*ReportOutput, error)
+	ListCommunityReports(userID, communityID uint, status string, limit, offset *int) (*ListReportsOutput, error)
+	ListAdminReports(userID uint, limit, offset *int) (*ListReportsOutput, error)
+	TakeAction(userID, reportID uint, input *ModerationActionInput) (*ModerationActionOutput, error)
+	ListCommunityModerationLog(userID, communityID uint, limit, offset *int) (*ListModerationActionsOutput, error)
+	ListMyModerationLog(userID uint, limit, offset *int) (*ListModerationActionsOutput, error)
+}
+
+// reportExcerptLength limits the snapshot of the reported content that is
+// kept with the report, in runes.
+const reportExcerptLength = 500
+
+var reportReasons = map[string]bool{
+	entities.ReportReasonSpam:           true,
+	entities.ReportReasonHarassment:     true,
+	entities.ReportReasonHateSpeech:     true,
+	entities.ReportReasonSexualContent:  true,
+	entities.ReportReasonViolence:       true,
+	entities.ReportReasonMisinformation: true,
+	entities.ReportReasonOther:          true,
+}
+
+var reportStatuses = map[string]bool{
+	entities.ReportStatusOpen:      true,
+	entities.ReportStatusEscalated: true,
+	entities.ReportStatusResolved:  true,
+	entities.ReportStatusDismissed: true,
+}
+
+// userVisibleActions are the actions listed in the affected user's moderation
+// log. Escalations and dismissals only concern moderators.
+var userVisibleActions = []string{
+	entities.ModerationActionHide,
+	entities.ModerationActionRemove,
+	entities.ModerationActionWarn,
+	entities.ModerationActionBan,
+}
+
+type moderationUsecase struct {
+	reportRepo    repositories.ReportRepository
+	actionRepo    repositories.ModerationActionRepository
+	banRepo       repositories.CommunityBanRepository
+	postRepo      repositories.PostRepository
+	commentRepo   repositories.CommentRepository
+	communityRepo repositories.GenresCommunityRepository
+	memberRepo    repositories.CommunityMemberRepository
+	userRepo      repositories.UserRepository
+	notifier      *Notifier
+}
+
+func NewModerationUsecase(reportRepo repositories.ReportRepository, actionRepo repositories.ModerationActionRepository, banRepo repositories.CommunityBanRepository, postRepo repositories.PostRepository, commentRepo repositories.CommentRepository, communityRepo repositories.GenresCommunityRepository, memberRepo repositories.CommunityMemberRepository, userRepo repositories.UserRepository, notifier *Notifier) ModerationUsecase {
+	return &moderationUsecase{
+		reportRepo:    reportRepo,
+		actionRepo:    actionRepo,
+		banRepo:       banRepo,
+		postRepo:      postRepo,
+		commentRepo:   commentRepo,
+		communityRepo: communityRepo,
+		memberRepo:    memberRepo,
+		userRepo:      userRepo,
+		notifier:      notifier,
+	}
+}
+
+// ReportContent reports a post, comment or profile. Reports on posts and
+// comments go to the moderation queue of the post's community, and reports on
+// profiles go to the admins. A user can have one pending report per target.
+func (u *moderationUsecase) ReportContent(userID uint, input *CreateReportInput) (*ReportOutput, error) {
+	if !reportReasons[input.Reason] {
+		return nil, ErrInvalidReportReason
+	}
+	report := &entities.Report{
+		ReporterID: &userID,
+		TargetType: input.TargetType,
+		TargetID:   input.TargetID,
+		Reason:     input.Reason,
+		Details:    input.Details,
+		Status:     entities.ReportStatusOpen,
+	}
+	if err := u.describeTarget(report); err != nil {
+		return nil, err
+	}
+	if report.TargetUserID == userID {
+		return nil, ErrInvalidReportTarget
+	}
+
+	_, err := u.reportRepo.FindPendingByReporterAndTarget(userID, report.TargetType, report.TargetID)
+	switch {
+	case err == nil:
+		return nil, ErrReportAlreadyExists
+	case !errors.Is(err, repositories.ErrNotFound):
+		return nil, ErrFindingRecord
+	}
+	if err := u.reportRepo.Create(report); err != nil {
+		return nil, ErrCreatingRecord
+	}
+	output := toReportOutput(report)
+	return &output, nil
+}
+
+// ListCommunityReports pages through the community's moderation queue, oldest
+// first. Only moderators of the community and admins can see it.
+func (u *moderationUsecase) ListCommunityReports(userID, communityID uint, status string, limit, offset *int) (*ListReportsOutput, error) {
+	if status == "" {
+		status = entities.ReportStatusOpen
+	}
+	if !reportStatuses[status] {
+		return nil, ErrInvalidReportStatus
+	}
+	community, err := u.moderatedCommunity(userID, communityID)
+	if err != nil {
+		return nil, err
+	}
+
+	l, o := pagination(limit, offset)
+	reports, err := u.reportRepo.FindByCommunityID(community.ID, status, o, l)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	total, err := u.reportRepo.CountByCommunityID(community.ID, status)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	return toListReportsOutput(reports, total), nil
+}
+
+// ListAdminReports pages through the reports escalated by moderators and the
+// reports on profiles, oldest first. Only admins can see them.
+func (u *moderationUsecase) ListAdminReports(userID uint, limit, offset *int) (*ListReportsOutput, error) {
+	if err := u.requireAdmin(userID); err != nil {
+		return nil, err
+	}
+
+	l, o := pagination(limit, offset)
+	reports, err := u.reportRepo.FindAdminQueue(o, l)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	total, err := u.reportRepo.CountAdminQueue()
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	return toListReportsOutput(reports, total), nil
+}
+
+// TakeAction acts on a pending report and records the action in the
+// moderation log. Moderators of the report's community can act on its open
+// reports; escalated reports and reports on profiles are left to admins.
+// Escalating keeps the report pending for the admins, while any other action
+// closes every pending report on the same target. The affected user is
+// notified about the actions listed in their moderation log.
+func (u *moderationUsecase) TakeAction(userID, reportID uint, input *ModerationActionInput) (*ModerationActionOutput, error) {
+	report, err := u.reportRepo.FindByID(reportID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrReportNotFound
+		}
+		return nil, ErrFindingRecord
+	}
+	if report.Status != entities.ReportStatusOpen && report.Status != entities.ReportStatusEscalated {
+		return nil, ErrReportAlreadyClosed
+	}
+
+	admin, err := u.isAdmin(userID)
+	if err != nil {
+		return nil, err
+	}
+	if !admin {
+		if report.Status == entities.ReportStatusEscalated || report.CommunityID == nil {
+			return nil, ErrCommunityPermissionDenied
+		}
+		role, err := u.memberRole(*report.CommunityID, userID)
+		if err != nil {
+			return nil, err
+		}
+		if role != entities.CommunityRoleModerator {
+			return nil, ErrCommunityPermissionDenied
+		}
+	}
+
+	status := entities.ReportStatusResolved
+	switch input.Action {
+	case entities.ModerationActionHide:
+		err = u.setTargetHidden(report, true)
+	case entities.ModerationActionRemove:
+		err = u.removeTarget(report)
+	case entities.ModerationActionWarn:
+	case entities.ModerationActionBan:
+		err = u.banTarget(userID, admin, report, input.Reason)
+	case entities.ModerationActionEscalate:
+		if report.Status == entities.ReportStatusEscalated || report.CommunityID == nil {
+			return nil, ErrInvalidModerationAction
+		}
+		status = entities.ReportStatusEscalated
+	case entities.ModerationActionDismiss:
+		// Dismissing a report filed by the content filter approves the
+		// content it held back.
+		if report.Reason == entities.ReportReasonAutomated {
+			err = u.setTargetHidden(report, false)
+		}
+		status = entities.ReportStatusDismissed
+	default:
+		return nil, ErrInvalidModerationAction
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	action := &entities.ModerationAction{
+		CommunityID:  report.CommunityID,
+		ReportID:     &report.ID,
+		ModeratorID:  userID,
+		TargetUserID: report.TargetUserID,
+		Action:       input.Action,
+		TargetType:   report.TargetType,
+		TargetID:     report.TargetID,
+		Excerpt:      report.Excerpt,
+		Reason:       input.Reason,
+	}
+	if err := u.actionRepo.Create(action); err != nil {
+		return nil, ErrCreatingRecord
+	}
+	if status == entities.ReportStatusEscalated {
+		report.Status = status
+		if err := u.reportRepo.Update(report); err != nil {
+			return nil, ErrUpdatingRecord
+		}
+	} else if err := u.reportRepo.ClosePendingByTarget(report.TargetType, report.TargetID, status, userID); err != nil {
+		return nil, ErrUpdatingRecord
+	}
+	if slices.Contains(userVisibleActions, action.Action) {
+		u.notifier.notify(&entities.Notification{UserID: action.TargetUserID, Type: entities.NotificationTypeModeration, ModerationActionID: &action.ID})
+	}
+	output := toModerationActionOutput(action)
+	return &output, nil
+}
+
+// ListCommunityModerationLog pages through the actions taken in the
+// community, newest first. Only moderators of the community and admins can
+// see it.
+func (u *moderationUsecase) ListCommunityModerationLog(userID, communityID uint, limit, offset *int) (*ListModerationActionsOutput, error) {
+	community, err := u.moderatedCommunity(userID, communityID)
+	if err != nil {
+		return nil, err
+	}
+
+	l, o := pagination(limit, offset)
+	actions, err := u.actionRepo.FindByCommunityID(community.ID, o, l)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	total, err := u.actionRepo.CountByCommunityID(community.ID)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	return toListModerationActionsOutput(actions, total), nil
+}
+
+// ListMyModerationLog pages through the actions taken against the user's
+// content and account, newest first. The moderators who took them are not
+// disclosed.
+func (u *moderationUsecase) ListMyModerationLog(userID uint, limit, offset *int) (*ListModerationActionsOutput, error) {
+	l, o := pagination(limit, offset)
+	actions, err := u.actionRepo.FindByTargetUserID(userID, userVisibleActions, o, l)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	total, err := u.actionRepo.CountByTargetUserID(userID, userVisibleActions)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	output := toListModerationActionsOutput(actions, total)
+	for i := range output.Actions {
+		output.Actions[i].ModeratorID = 0
+	}
+	return output, nil
+}
+
+// describeTarget fills in the author, the community and an excerpt of the
+// reported target.
+func (u *moderationUsecase) describeTarget(report *entities.Report) error {
+	switch report.TargetType {
+	case entities.ReportTargetPost:
+		post, err := u.findPost(report.TargetID)
+		if err != nil {
+			return err
+		}
+		report.TargetUserID = post.UserID
+		report.CommunityID = &post.GenreCommunityID
+		report.Excerpt = excerpt(post.Title + "\n\n" + post.Content)
+	case entities.ReportTargetComment:
+		comment, err := u.findComment(report.TargetID)
+		if err != nil {
+			return err
+		}
+		post, err := u.findPost(comment.PostID)
+		if err != nil {
+			return err
+		}
+		report.TargetUserID = comment.UserID
+		report.CommunityID = &post.GenreCommunityID
+		report.Excerpt = excerpt(comment.Content)
+	case entities.ReportTargetUser:
+		user, err := u.userRepo.FindByID(report.TargetID)
+		if err != nil {
+			if errors.Is(err, repositories.ErrNotFound) {
+				return ErrUserNotFound
+			}
+			return ErrFindingRecord
+		}
+		report.TargetUserID = user.ID
+		report.Excerpt = user.Nickname
+	default:
+		return ErrInvalidReportTarget
+	}
+	return nil
+}
+
+// setTargetHidden hides a reported post or comment, or shows it again.
+func (u *moderationUsecase) setTargetHidden(report *entities.Report, hidden bool) error {
+	switch report.TargetType {
+	case entities.ReportTargetPost:
+		post, err := u.findPost(report.TargetID)
+		if err != nil {
+			return err
+		}
+		post.IsHidden = hidden
+		if err := u.postRepo.Update(post); err != nil {
+			return ErrUpdatingRecord
+		}
+	case entities.ReportTargetComment:
+		comment, err := u.findComment(report.TargetID)
+		if err != nil {
+			return err
+		}
+		comment.IsHidden = hidden
+		if err := u.commentRepo.Update(comment); err != nil {
+			return ErrUpdatingRecord
+		}
+	default:
+		return ErrInvalidModerationAction
+	}
+	return nil
+}
+
+// removeTarget deletes a reported post. Reported comments are turned into
+// tombstones, so that the replies to them stay in place. Reported profiles
+// are cleared: the bio, website and profile image are removed and the
+// nickname is replaced with a placeholder.
+func (u *moderationUsecase) removeTarget(report *entities.Report) error {
+	switch report.TargetType {
+	case entities.ReportTargetPost:
+		if _, err := u.findPost(report.TargetID); err != nil {
+			return err
+		}
+		if err := u.postRepo.Delete(report.TargetID); err != nil {
+			return ErrDeletingRecord
+		}
+	case entities.ReportTargetComment:
+		comment, err := u.findComment(report.TargetID)
+		if err != nil {
+			return err
+		}
+		comment.IsDeleted = true
+		comment.Content = ""
+		if err := u.commentRepo.Update(comment); err != nil {
+			return ErrUpdatingRecord
+		}
+	case entities.ReportTargetUser:
+		user, err := u.userRepo.FindByID(report.TargetID)
+		if err != nil {
+			if errors.Is(err, repositories.ErrNotFound) {
+				return ErrUserNotFound
+			}
+			return ErrFindingRecord
+		}
+		user.Nickname = placeholderNickname(user.ID)
+		if user.UserProfile != nil {
+			user.UserProfile.Bio = ""
+			user.UserProfile.Website = ""
+			user.UserProfile.ProfileImageURL = ""
+		}
+		if err := u.userRepo.Update(user); err != nil {
+			return ErrUpdatingRecord
+		}
+	default:
+		return ErrInvalidModerationAction
+	}
+	return nil
+}
+
+// banTarget bans the author of the reported content from the community. Only
+// admins can ban moderators.
+func (u *moderationUsecase) banTarget(userID uint, admin bool, report *entities.Report, reason string) error {
+	if report.CommunityID == nil {
+		return ErrInvalidModerationAction
+	}
+	if !admin {
+		role, err := u.memberRole(*report.CommunityID, report.TargetUserID)
+		if err != nil {
+			return err
+		}
+		if role == entities.CommunityRoleModerator {
+			return ErrCommunityPermissionDenied
+		}
+	}
+	ban := &entities.CommunityBan{
+		CommunityID: *report.CommunityID,
+		UserID:      report.TargetUserID,
+		ModeratorID: userID,
+		Reason:      reason,
+	}
+	if err := u.banRepo.Create(ban); err != nil {
+		return ErrCreatingRecord
+	}
+	return nil
+}
+
+func (u *moderationUsecase) findPost(postID uint) (*entities.Post, error) {
+	post, err := u.postRepo.FindByID(postID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrPostNotFound
+		}
+		return nil, ErrFindingRecord
+	}
+	return post, nil
+}
+
+// findComment returns the comment unless it has been deleted.
+func (u *moderationUsecase) findComment(commentID uint) (*entities.Comment, error) {
+	comment, err := u.commentRepo.FindByID(commentID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrCommentNotFound
+		}
+		return nil, ErrFindingRecord
+	}
+	if comment.IsDeleted {
+		return nil, ErrCommentNotFound
+	}
+	return comment, nil
+}
+
+// moderatedCommunity returns the community if the user is an admin or one of
+// its moderators.
+func (u *moderationUsecase) moderatedCommunity(userID, communityID uint) (*entities.GenreCommunity, error) {
+	community, err := u.communityRepo.FindByID(communityID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrCommunityNotFound
+		}
+		return nil, ErrFindingRecord
+	}
+	role, err := u.memberRole(community.ID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if role == entities.CommunityRoleModerator {
+		return community, nil
+	}
+	if err := u.requireAdmin(userID); err != nil {
+		return nil, err
+	}
+	return community, nil
+}
+
+func (u *moderationUsecase) memberRole(communityID, userID uint) (string, error) {
+	member, err := u.memberRepo.FindByCommunityIDAndUserID(communityID, userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return "", nil
+		}
+		return "", ErrFindingRecord
+	}
+	return member.Role, nil
+}
+
+func (u *moderationUsecase) isAdmin(userID uint) (bool, error) {
+	user, err := u.userRepo.FindByID(userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return false, ErrUserNotFound
+		}
+		return false, ErrFindingRecord
+	}
+	return user.IsAdmin, nil
+}
+
+func (u *moderationUsecase) requireAdmin(userID uint) error {
+	admin, err := u.isAdmin(userID)
+	if err != nil {
+		return err
+	}
+	if !admin {
+		return ErrCommunityPermissionDenied
+	}
+	return nil
+}
+
+func excerpt(text string) string {
+	runes := []rune(text)
+	if len(runes) <= reportExcerptLength {
+		return text
+	}
+	return string(runes[:reportExcerptLength]) + "…"
+}
+
+func toReportOutput(r *entities.Report) ReportOutput {
+	return ReportOutput{
+		ID:           r.ID,
+		ReporterID:   r.ReporterID,
+		TargetType:   r.TargetType,
+		TargetID:     r.TargetID,
+		TargetUserID: r.TargetUserID,
+		CommunityID:  r.CommunityID,
+		Reason:       r.Reason,
+		Details:      r.Details,
+		Excerpt:      r.Excerpt,
+		Status:       r.Status,
+		ResolvedByID: r.ResolvedByID,
+		ResolvedAt:   r.ResolvedAt,
+		CreatedAt:    r.CreatedAt,
+	}
+}
+
+func toListReportsOutput(reports []*entities.Report, total int64) *ListReportsOutput {
+	output := &ListReportsOutput{Reports: make([]ReportOutput, len(reports)), Total: int(total)}
+	for i, r := range reports {
+		output.Reports[i] = toReportOutput(r)
+	}
+	return output
+}
+
+func toModerationActionOutput(a *entities.ModerationAction) ModerationActionOutput {
+	return ModerationActionOutput{
+		ID:           a.ID,
+		CommunityID:  a.CommunityID,
+		ReportID:     a.ReportID,
+		ModeratorID:  a.ModeratorID,
+		TargetUserID: a.TargetUserID,
+		Action:       a.Action,
+		TargetType:   a.TargetType,
+		TargetID:     a.TargetID,
+		Excerpt:      a.Excerpt,
+		Reason:       a.Reason,
+		CreatedAt:    a.CreatedAt,
+	}
+}
+
+func toListModerationActionsOutput(actions []*entities.ModerationAction, total int64) *ListModerationActionsOutput {
+	output := &ListModerationActionsOutput{Actions: make([]ModerationActionOutput, len(actions)), Total: int(total)}
+	for i, a := range actions {
+		output.Actions[i] = toModerationActionOutput(a)
+	}
+	return output
+}
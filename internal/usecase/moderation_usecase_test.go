@@ -0,0 +1,298 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase/mocks"
+	"github.com/myjinjin/sonic-odyssey-backend/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestModerationUsecase_ReportContent(t *testing.T) {
+	t.Run("Comment", func(t *testing.T) {
+		// Setup
+		reportRepo := &mocks.ReportRepository{}
+		postRepo := &mocks.PostRepository{}
+		commentRepo := &mocks.CommentRepository{}
+
+		moderationUsecase := NewModerationUsecase(reportRepo, nil, nil, postRepo, commentRepo, nil, nil, nil, nil)
+
+		// Expectations
+		commentRepo.On("FindByID", uint(7)).Return(&entities.Comment{ID: 7, PostID: 5, UserID: 3, Content: "buy followers"}, nil)
+		postRepo.On("FindByID", uint(5)).Return(&entities.Post{ID: 5, GenreCommunityID: 10}, nil)
+		reportRepo.On("FindPendingByReporterAndTarget", uint(1), entities.ReportTargetComment, uint(7)).Return(nil, repositories.ErrNotFound)
+		reportRepo.On("Create", mock.MatchedBy(func(r *entities.Report) bool {
+			return r.TargetUserID == 3 && *r.CommunityID == 10 && r.Excerpt == "buy followers" && r.Status == entities.ReportStatusOpen
+		})).Return(nil)
+
+		// Execute
+		output, err := moderationUsecase.ReportContent(1, &CreateReportInput{TargetType: entities.ReportTargetComment, TargetID: 7, Reason: entities.ReportReasonSpam})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, uint(3), output.TargetUserID)
+		assert.Equal(t, uint(10), *output.CommunityID)
+
+		// Verify
+		reportRepo.AssertExpectations(t)
+	})
+
+	t.Run("AlreadyPending", func(t *testing.T) {
+		// Setup
+		reportRepo := &mocks.ReportRepository{}
+		userRepo := &mocks.UserRepository{}
+
+		moderationUsecase := NewModerationUsecase(reportRepo, nil, nil, nil, nil, nil, nil, userRepo, nil)
+
+		// Expectations
+		userRepo.On("FindByID", uint(3)).Return(&entities.User{ID: 3, Nickname: "spammer"}, nil)
+		reportRepo.On("FindPendingByReporterAndTarget", uint(1), entities.ReportTargetUser, uint(3)).Return(&entities.Report{ID: 2}, nil)
+
+		// Execute
+		output, err := moderationUsecase.ReportContent(1, &CreateReportInput{TargetType: entities.ReportTargetUser, TargetID: 3, Reason: entities.ReportReasonHarassment})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrReportAlreadyExists)
+		assert.Nil(t, output)
+
+		// Verify
+		reportRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+
+	t.Run("OwnPost", func(t *testing.T) {
+		// Setup
+		reportRepo := &mocks.ReportRepository{}
+		postRepo := &mocks.PostRepository{}
+
+		moderationUsecase := NewModerationUsecase(reportRepo, nil, nil, postRepo, nil, nil, nil, nil, nil)
+
+		// Expectations
+		postRepo.On("FindByID", uint(5)).Return(&entities.Post{ID: 5, UserID: 1, GenreCommunityID: 10}, nil)
+
+		// Execute
+		_, err := moderationUsecase.ReportContent(1, &CreateReportInput{TargetType: entities.ReportTargetPost, TargetID: 5, Reason: entities.ReportReasonOther})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidReportTarget)
+
+		// Verify
+		reportRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+}
+
+func TestModerationUsecase_TakeAction(t *testing.T) {
+	t.Run("ModeratorHidesPost", func(t *testing.T) {
+		// Setup
+		reportRepo := &mocks.ReportRepository{}
+		actionRepo := &mocks.ModerationActionRepository{}
+		postRepo := &mocks.PostRepository{}
+		memberRepo := &mocks.CommunityMemberRepository{}
+		userRepo := &mocks.UserRepository{}
+
+		moderationUsecase := NewModerationUsecase(reportRepo, actionRepo, nil, postRepo, nil, nil, memberRepo, userRepo, nil)
+
+		// Expectations
+		reportRepo.On("FindByID", uint(2)).Return(&entities.Report{ID: 2, TargetType: entities.ReportTargetPost, TargetID: 5, TargetUserID: 3, CommunityID: utils.ToPtr(uint(10)), Status: entities.ReportStatusOpen}, nil)
+		userRepo.On("FindByID", uint(4)).Return(&entities.User{ID: 4}, nil)
+		memberRepo.On("FindByCommunityIDAndUserID", uint(10), uint(4)).Return(&entities.CommunityMember{Role: entities.CommunityRoleModerator}, nil)
+		postRepo.On("FindByID", uint(5)).Return(&entities.Post{ID: 5, UserID: 3}, nil)
+		postRepo.On("Update", mock.MatchedBy(func(p *entities.Post) bool { return p.ID == 5 && p.IsHidden })).Return(nil)
+		actionRepo.On("Create", mock.MatchedBy(func(a *entities.ModerationAction) bool {
+			return a.Action == entities.ModerationActionHide && a.ModeratorID == 4 && a.TargetUserID == 3 && *a.ReportID == 2
+		})).Return(nil)
+		reportRepo.On("ClosePendingByTarget", entities.ReportTargetPost, uint(5), entities.ReportStatusResolved, uint(4)).Return(nil)
+
+		// Execute
+		output, err := moderationUsecase.TakeAction(4, 2, &ModerationActionInput{Action: entities.ModerationActionHide, Reason: "off-topic"})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, entities.ModerationActionHide, output.Action)
+
+		// Verify
+		postRepo.AssertExpectations(t)
+		actionRepo.AssertExpectations(t)
+		reportRepo.AssertExpectations(t)
+	})
+
+	t.Run("AdminClearsProfile", func(t *testing.T) {
+		// Setup
+		reportRepo := &mocks.ReportRepository{}
+		actionRepo := &mocks.ModerationActionRepository{}
+		userRepo := &mocks.UserRepository{}
+
+		moderationUsecase := NewModerationUsecase(reportRepo, actionRepo, nil, nil, nil, nil, nil, userRepo, nil)
+
+		// Expectations
+		reportRepo.On("FindByID", uint(2)).Return(&entities.Report{ID: 2, TargetType: entities.ReportTargetUser, TargetID: 3, TargetUserID: 3, Excerpt: "slur", Status: entities.ReportStatusOpen}, nil)
+		userRepo.On("FindByID", uint(4)).Return(&entities.User{ID: 4, IsAdmin: true}, nil)
+		userRepo.On("FindByID", uint(3)).Return(&entities.User{ID: 3, Nickname: "slur", UserProfile: &entities.UserProfile{UserID: 3, Bio: "abuse", Website: "https://example.com", ProfileImageURL: "https://example.com/me.png"}}, nil)
+		userRepo.On("Update", mock.MatchedBy(func(u *entities.User) bool {
+			return u.ID == 3 && u.Nickname == "user-3" && u.UserProfile.Bio == "" && u.UserProfile.Website == "" && u.UserProfile.ProfileImageURL == ""
+		})).Return(nil)
+		actionRepo.On("Create", mock.MatchedBy(func(a *entities.ModerationAction) bool {
+			return a.Action == entities.ModerationActionRemove && a.TargetUserID == 3 && a.Excerpt == "slur"
+		})).Return(nil)
+		reportRepo.On("ClosePendingByTarget", entities.ReportTargetUser, uint(3), entities.ReportStatusResolved, uint(4)).Return(nil)
+
+		// Execute
+		output, err := moderationUsecase.TakeAction(4, 2, &ModerationActionInput{Action: entities.ModerationActionRemove, Reason: "abusive profile"})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, entities.ModerationActionRemove, output.Action)
+
+		// Verify
+		userRepo.AssertExpectations(t)
+		actionRepo.AssertExpectations(t)
+		reportRepo.AssertExpectations(t)
+	})
+
+	t.Run("DismissApprovesHeldComment", func(t *testing.T) {
+		// Setup
+		reportRepo := &mocks.ReportRepository{}
+		actionRepo := &mocks.ModerationActionRepository{}
+		commentRepo := &mocks.CommentRepository{}
+		memberRepo := &mocks.CommunityMemberRepository{}
+		userRepo := &mocks.UserRepository{}
+
+		moderationUsecase := NewModerationUsecase(reportRepo, actionRepo, nil, nil, commentRepo, nil, memberRepo, userRepo, nil)
+
+		// Expectations
+		reportRepo.On("FindByID", uint(2)).Return(&entities.Report{ID: 2, TargetType: entities.ReportTargetComment, TargetID: 7, TargetUserID: 3, CommunityID: utils.ToPtr(uint(10)), Reason: entities.ReportReasonAutomated, Status: entities.ReportStatusOpen}, nil)
+		userRepo.On("FindByID", uint(4)).Return(&entities.User{ID: 4}, nil)
+		memberRepo.On("FindByCommunityIDAndUserID", uint(10), uint(4)).Return(&entities.CommunityMember{Role: entities.CommunityRoleModerator}, nil)
+		commentRepo.On("FindByID", uint(7)).Return(&entities.Comment{ID: 7, UserID: 3, IsHidden: true}, nil)
+		commentRepo.On("Update", mock.MatchedBy(func(c *entities.Comment) bool { return c.ID == 7 && !c.IsHidden })).Return(nil)
+		actionRepo.On("Create", mock.Anything).Return(nil)
+		reportRepo.On("ClosePendingByTarget", entities.ReportTargetComment, uint(7), entities.ReportStatusDismissed, uint(4)).Return(nil)
+
+		// Execute
+		output, err := moderationUsecase.TakeAction(4, 2, &ModerationActionInput{Action: entities.ModerationActionDismiss})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, entities.ModerationActionDismiss, output.Action)
+
+		// Verify
+		commentRepo.AssertExpectations(t)
+		reportRepo.AssertExpectations(t)
+	})
+
+	t.Run("Escalate", func(t *testing.T) {
+		// Setup
+		reportRepo := &mocks.ReportRepository{}
+		actionRepo := &mocks.ModerationActionRepository{}
+		memberRepo := &mocks.CommunityMemberRepository{}
+		userRepo := &mocks.UserRepository{}
+
+		moderationUsecase := NewModerationUsecase(reportRepo, actionRepo, nil, nil, nil, nil, memberRepo, userRepo, nil)
+
+		// Expectations
+		reportRepo.On("FindByID", uint(2)).Return(&entities.Report{ID: 2, TargetType: entities.ReportTargetComment, TargetID: 7, TargetUserID: 3, CommunityID: utils.ToPtr(uint(10)), Status: entities.ReportStatusOpen}, nil)
+		userRepo.On("FindByID", uint(4)).Return(&entities.User{ID: 4}, nil)
+		memberRepo.On("FindByCommunityIDAndUserID", uint(10), uint(4)).Return(&entities.CommunityMember{Role: entities.CommunityRoleModerator}, nil)
+		actionRepo.On("Create", mock.Anything).Return(nil)
+		reportRepo.On("Update", mock.MatchedBy(func(r *entities.Report) bool { return r.Status == entities.ReportStatusEscalated })).Return(nil)
+
+		// Execute
+		_, err := moderationUsecase.TakeAction(4, 2, &ModerationActionInput{Action: entities.ModerationActionEscalate})
+
+		// Assert
+		assert.NoError(t, err)
+
+		// Verify
+		reportRepo.AssertExpectations(t)
+		reportRepo.AssertNotCalled(t, "ClosePendingByTarget", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("ModeratorOnEscalatedReport", func(t *testing.T) {
+		// Setup
+		reportRepo := &mocks.ReportRepository{}
+		actionRepo := &mocks.ModerationActionRepository{}
+		userRepo := &mocks.UserRepository{}
+
+		moderationUsecase := NewModerationUsecase(reportRepo, actionRepo, nil, nil, nil, nil, nil, userRepo, nil)
+
+		// Expectations
+		reportRepo.On("FindByID", uint(2)).Return(&entities.Report{ID: 2, CommunityID: utils.ToPtr(uint(10)), Status: entities.ReportStatusEscalated}, nil)
+		userRepo.On("FindByID", uint(4)).Return(&entities.User{ID: 4}, nil)
+
+		// Execute
+		_, err := moderationUsecase.TakeAction(4, 2, &ModerationActionInput{Action: entities.ModerationActionWarn})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrCommunityPermissionDenied)
+
+		// Verify
+		actionRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+
+	t.Run("ModeratorCannotBanModerator", func(t *testing.T) {
+		// Setup
+		reportRepo := &mocks.ReportRepository{}
+		actionRepo := &mocks.ModerationActionRepository{}
+		banRepo := &mocks.CommunityBanRepository{}
+		memberRepo := &mocks.CommunityMemberRepository{}
+		userRepo := &mocks.UserRepository{}
+
+		moderationUsecase := NewModerationUsecase(reportRepo, actionRepo, banRepo, nil, nil, nil, memberRepo, userRepo, nil)
+
+		// Expectations
+		reportRepo.On("FindByID", uint(2)).Return(&entities.Report{ID: 2, TargetType: entities.ReportTargetPost, TargetID: 5, TargetUserID: 3, CommunityID: utils.ToPtr(uint(10)), Status: entities.ReportStatusOpen}, nil)
+		userRepo.On("FindByID", uint(4)).Return(&entities.User{ID: 4}, nil)
+		memberRepo.On("FindByCommunityIDAndUserID", uint(10), uint(4)).Return(&entities.CommunityMember{Role: entities.CommunityRoleModerator}, nil)
+		memberRepo.On("FindByCommunityIDAndUserID", uint(10), uint(3)).Return(&entities.CommunityMember{Role: entities.CommunityRoleModerator}, nil)
+
+		// Execute
+		_, err := moderationUsecase.TakeAction(4, 2, &ModerationActionInput{Action: entities.ModerationActionBan})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrCommunityPermissionDenied)
+
+		// Verify
+		banRepo.AssertNotCalled(t, "Create", mock.Anything)
+		actionRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+
+	t.Run("AlreadyClosed", func(t *testing.T) {
+		// Setup
+		reportRepo := &mocks.ReportRepository{}
+
+		moderationUsecase := NewModerationUsecase(reportRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// Expectations
+		reportRepo.On("FindByID", uint(2)).Return(&entities.Report{ID: 2, Status: entities.ReportStatusDismissed}, nil)
+
+		// Execute
+		_, err := moderationUsecase.TakeAction(4, 2, &ModerationActionInput{Action: entities.ModerationActionWarn})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrReportAlreadyClosed)
+	})
+}
+
+func TestModerationUsecase_ListMyModerationLog(t *testing.T) {
+	// Setup
+	actionRepo := &mocks.ModerationActionRepository{}
+
+	moderationUsecase := NewModerationUsecase(nil, actionRepo, nil, nil, nil, nil, nil, nil, nil)
+
+	// Expectations
+	actionRepo.On("FindByTargetUserID", uint(3), userVisibleActions, 0, 20).Return([]*entities.ModerationAction{
+		{ID: 1, ModeratorID: 4, TargetUserID: 3, Action: entities.ModerationActionWarn, Reason: "be nice"},
+	}, nil)
+	actionRepo.On("CountByTargetUserID", uint(3), userVisibleActions).Return(int64(1), nil)
+
+	// Execute
+	output, err := moderationUsecase.ListMyModerationLog(3, nil, nil)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, output.Total)
+	assert.Equal(t, "be nice", output.Actions[0].Reason)
+	assert.Zero(t, output.Actions[0].ModeratorID)
+}
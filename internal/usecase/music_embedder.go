@@ -0,0 +1,158 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/playlistformat"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/spotifyclient"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+)
+
+const maxMusicAttachments = 10
+
+// MusicEmbedder resolves the music referenced by posts and comments and turns
+// their attachments into track cards. It is shared by the post and comment
+// usecases.
+type MusicEmbedder struct {
+	musicRepo      repositories.MusicRepository
+	userLikeRepo   repositories.UserLikeRepository
+	attachmentRepo repositories.MusicAttachmentRepository
+
+	ingester *catalogIngester
+}
+
+func NewMusicEmbedder(spotifyClient spotifyclient.SpotifyClient, musicRepo repositories.MusicRepository, albumRepo repositories.AlbumRepository, artistRepo repositories.ArtistRepository, musicArtistRepo repositories.MusicArtistMappingRepository, genreRepo repositories.GenreRepository, genreAliasRepo repositories.GenreAliasRepository, musicGenreRepo repositories.MusicGenreMappingRepository, userLikeRepo repositories.UserLikeRepository, attachmentRepo repositories.MusicAttachmentRepository) *MusicEmbedder {
+	return &MusicEmbedder{
+		musicRepo:      musicRepo,
+		userLikeRepo:   userLikeRepo,
+		attachmentRepo: attachmentRepo,
+		ingester: &catalogIngester{
+			spotifyClient:   spotifyClient,
+			musicRepo:       musicRepo,
+			albumRepo:       albumRepo,
+			artistRepo:      artistRepo,
+			musicArtistRepo: musicArtistRepo,
+			musicGenreRepo:  musicGenreRepo,
+			genreResolver:   &genreResolver{genreRepo: genreRepo, genreAliasRepo: genreAliasRepo},
+		},
+	}
+}
+
+// resolve maps each reference to a catalog track, ingesting tracks from
+// Spotify as needed. A reference is a local music ID, a Spotify track URL or a
+// "spotify:track:" URI. Duplicates are dropped, keeping the first occurrence.
+func (e *MusicEmbedder) resolve(ctx context.Context, refs []string) ([]uint, error) {
+	if len(refs) > maxMusicAttachments {
+		return nil, ErrTooManyMusicAttachments
+	}
+
+	musicIDs := []uint{}
+	seen := make(map[uint]bool)
+	for _, ref := range refs {
+		music, err := e.resolveReference(ctx, strings.TrimSpace(ref))
+		if err != nil {
+			return nil, err
+		}
+		if seen[music.ID] {
+			continue
+		}
+		seen[music.ID] = true
+		musicIDs = append(musicIDs, music.ID)
+	}
+	return musicIDs, nil
+}
+
+func (e *MusicEmbedder) resolveReference(ctx context.Context, ref string) (*entities.Music, error) {
+	if localID, ok := parseLocalID(ref); ok {
+		music, err := e.musicRepo.FindByID(localID)
+		if err != nil {
+			if errors.Is(err, repositories.ErrNotFound) {
+				return nil, ErrMusicNotFound
+			}
+			return nil, ErrFindingRecord
+		}
+		return music, nil
+	}
+
+	spotifyID := playlistformat.ParseSpotifyTrackID(ref)
+	if spotifyID == "" {
+		return nil, ErrInvalidMusicReference
+	}
+	return e.ingester.ingestTrack(ctx, spotifyID)
+}
+
+func (e *MusicEmbedder) attachToPost(postID uint, musicIDs []uint) error {
+	if err := e.attachmentRepo.ReplaceByPostID(postID, musicIDs); err != nil {
+		return ErrUpdatingRecord
+	}
+	return nil
+}
+
+func (e *MusicEmbedder) attachToComment(commentID uint, musicIDs []uint) error {
+	if err := e.attachmentRepo.ReplaceByCommentID(commentID, musicIDs); err != nil {
+		return ErrUpdatingRecord
+	}
+	return nil
+}
+
+// postCards returns the track cards of each post, keyed by post ID.
+func (e *MusicEmbedder) postCards(postIDs []uint) (map[uint][]TrackCard, error) {
+	attachments, err := e.attachmentRepo.FindByPostIDs(postIDs)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	return e.toTrackCards(attachments, func(a *entities.MusicAttachment) *uint { return a.PostID })
+}
+
+// commentCards returns the track cards of each comment, keyed by comment ID.
+func (e *MusicEmbedder) commentCards(commentIDs []uint) (map[uint][]TrackCard, error) {
+	attachments, err := e.attachmentRepo.FindByCommentIDs(commentIDs)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	return e.toTrackCards(attachments, func(a *entities.MusicAttachment) *uint { return a.CommentID })
+}
+
+func (e *MusicEmbedder) toTrackCards(attachments []*entities.MusicAttachment, owner func(*entities.MusicAttachment) *uint) (map[uint][]TrackCard, error) {
+	cards := make(map[uint][]TrackCard)
+	// The same track is often shared across a thread, so count its likes once.
+	counted := make(map[uint]TrackCard)
+	for _, a := range attachments {
+		ownerID := owner(a)
+		if ownerID == nil {
+			continue
+		}
+
+		card, ok := counted[a.MusicID]
+		if !ok {
+			likes, dislikes, err := e.userLikeRepo.CountLikesAndDislikesByMusicID(a.MusicID)
+			if err != nil {
+				return nil, ErrFindingRecord
+			}
+			card = toTrackCard(&a.Music)
+			card.Likes, card.Dislikes = likes, dislikes
+			counted[a.MusicID] = card
+		}
+		cards[*ownerID] = append(cards[*ownerID], card)
+	}
+	return cards, nil
+}
+
+func toTrackCard(m *entities.Music) TrackCard {
+	track := toCatalogTrack(m)
+	card := TrackCard{
+		ID:        track.ID,
+		Title:     track.Title,
+		SpotifyID: track.SpotifyID,
+		Artists:   track.Artists,
+		AlbumID:   m.AlbumID,
+	}
+	if m.Album != nil {
+		card.AlbumName = m.Album.Name
+		card.ImageURL = m.Album.ImageURL
+	}
+	return card
+}
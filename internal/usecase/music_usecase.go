@@ -2,22 +2,66 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"strconv"
+	"time"
 
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/lastfmclient"
 	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/spotifyclient"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"github.com/myjinjin/sonic-odyssey-backend/pkg/utils"
 	"github.com/zmb3/spotify/v2"
 )
 
+const (
+	// artistDetailLimit bounds the albums and tracks listed on an artist page.
+	artistDetailLimit = 20
+
+	// Last.fm tag counts are relative weights in the range 0-100.
+	lastfmMinTagCount = 10
+	lastfmMaxTags     = 5
+)
+
 type MusicUsecase interface {
 	SearchTrack(ctx context.Context, keyword string, limit, offset *int) (*SearchTrackOutput, error)
+	SyncLastfm(ctx context.Context, musicID uint) (*SyncLastfmOutput, error)
+	GetTrack(ctx context.Context, userID uint, id string) (*GetTrackOutput, error)
+	GetAlbum(ctx context.Context, id string) (*GetAlbumOutput, error)
+	GetArtist(ctx context.Context, id string) (*GetArtistOutput, error)
 }
 
 type musicUsecase struct {
 	spotifyClient spotifyclient.SpotifyClient
+	lastfmClient  lastfmclient.LastfmClient
+
+	musicRepo           repositories.MusicRepository
+	albumRepo           repositories.AlbumRepository
+	artistRepo          repositories.ArtistRepository
+	userLikeRepo        repositories.UserLikeRepository
+	collectionMusicRepo repositories.CollectionMusicMappingRepository
+
+	ingester *catalogIngester
 }
 
-func NewMusicUsecase(ctx context.Context, spotifyClient spotifyclient.SpotifyClient) MusicUsecase {
+func NewMusicUsecase(ctx context.Context, spotifyClient spotifyclient.SpotifyClient, lastfmClient lastfmclient.LastfmClient, musicRepo repositories.MusicRepository, albumRepo repositories.AlbumRepository, artistRepo repositories.ArtistRepository, musicArtistRepo repositories.MusicArtistMappingRepository, genreRepo repositories.GenreRepository, genreAliasRepo repositories.GenreAliasRepository, musicGenreRepo repositories.MusicGenreMappingRepository, userLikeRepo repositories.UserLikeRepository, collectionMusicRepo repositories.CollectionMusicMappingRepository) MusicUsecase {
 	return &musicUsecase{
-		spotifyClient: spotifyClient,
+		spotifyClient:       spotifyClient,
+		lastfmClient:        lastfmClient,
+		musicRepo:           musicRepo,
+		albumRepo:           albumRepo,
+		artistRepo:          artistRepo,
+		userLikeRepo:        userLikeRepo,
+		collectionMusicRepo: collectionMusicRepo,
+		ingester: &catalogIngester{
+			spotifyClient:   spotifyClient,
+			musicRepo:       musicRepo,
+			albumRepo:       albumRepo,
+			artistRepo:      artistRepo,
+			musicArtistRepo: musicArtistRepo,
+			musicGenreRepo:  musicGenreRepo,
+			genreResolver:   &genreResolver{genreRepo: genreRepo, genreAliasRepo: genreAliasRepo},
+		},
 	}
 }
 
@@ -48,3 +92,279 @@ func (u *musicUsecase) SearchTrack(ctx context.Context, keyword string, limit, o
 	searchOutput.Total = int(total)
 	return searchOutput, nil
 }
+
+func (u *musicUsecase) SyncLastfm(ctx context.Context, musicID uint) (*SyncLastfmOutput, error) {
+	music, err := u.musicRepo.FindByID(musicID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrMusicNotFound
+		}
+		return nil, ErrFindingRecord
+	}
+
+	track, err := u.resolveLastfmTrack(ctx, music)
+	if err != nil {
+		return nil, err
+	}
+
+	if track.MBID != "" {
+		music.LastfmID = track.MBID
+	}
+	music.LastfmListeners = track.Listeners
+	music.LastfmPlaycount = track.Playcount
+	music.LastfmSyncedAt = utils.ToPtr(time.Now())
+	if err := u.musicRepo.Update(music); err != nil {
+		return nil, ErrUpdatingRecord
+	}
+
+	tags, err := u.lastfmClient.GetTrackTopTags(ctx, track.Artist, track.Name)
+	if err != nil {
+		return nil, ErrFetchingLastfm
+	}
+	genres, err := u.seedGenres(ctx, music, tags)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &SyncLastfmOutput{
+		MusicID:   music.ID,
+		LastfmID:  music.LastfmID,
+		Listeners: music.LastfmListeners,
+		Playcount: music.LastfmPlaycount,
+		Genres:    genres,
+	}
+	return output, nil
+}
+
+func (u *musicUsecase) resolveLastfmTrack(ctx context.Context, music *entities.Music) (*lastfmclient.Track, error) {
+	var track *lastfmclient.Track
+	var err error
+	switch {
+	case music.LastfmID != "":
+		track, err = u.lastfmClient.GetTrackInfoByMBID(ctx, music.LastfmID)
+	case len(music.MusicArtistMapping) > 0:
+		track, err = u.lastfmClient.GetTrackInfo(ctx, music.MusicArtistMapping[0].Artist.Name, music.Title)
+	default:
+		return nil, ErrLastfmTrackNotFound
+	}
+	if err != nil {
+		if errors.Is(err, lastfmclient.ErrTrackNotFound) {
+			return nil, ErrLastfmTrackNotFound
+		}
+		return nil, ErrFetchingLastfm
+	}
+	return track, nil
+}
+
+// seedGenres maps the most relevant Last.fm tags and the Spotify genres of the
+// track's artists onto the genre taxonomy. Artist genres are mapped when a
+// track is ingested, but syncing maps them again for tracks ingested before
+// the taxonomy was imported. Artists Spotify no longer knows are skipped.
+func (u *musicUsecase) seedGenres(ctx context.Context, music *entities.Music, tags []lastfmclient.Tag) ([]Genre, error) {
+	names := []string{}
+	for i, tag := range tags {
+		if i >= lastfmMaxTags || tag.Count < lastfmMinTagCount {
+			break
+		}
+		names = append(names, tag.Name)
+	}
+	for _, m := range music.MusicArtistMapping {
+		if m.Artist.SpotifyID == "" {
+			continue
+		}
+		artist, err := u.spotifyClient.GetArtist(ctx, spotify.ID(m.Artist.SpotifyID))
+		if err != nil {
+			if isSpotifyNotFound(err) {
+				continue
+			}
+			return nil, ErrFetchingSpotify
+		}
+		names = append(names, artist.Genres...)
+	}
+	return u.ingester.mapGenres(music, names)
+}
+
+func (u *musicUsecase) GetTrack(ctx context.Context, userID uint, id string) (*GetTrackOutput, error) {
+	music, err := u.findMusic(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Tracks without an album are returned with an empty album.
+	var album CatalogAlbum
+	if music.AlbumID != 0 {
+		a, err := u.albumRepo.FindByID(music.AlbumID)
+		if err != nil {
+			return nil, ErrFindingRecord
+		}
+		album = toCatalogAlbum(a)
+	}
+
+	likes, dislikes, err := u.userLikeRepo.CountLikesAndDislikesByMusicID(music.ID)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	collections, err := u.collectionMusicRepo.CountCollectionsByMusicID(music.ID)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+
+	var liked *bool
+	userLike, err := u.userLikeRepo.FindByUserIDAndMusicID(userID, music.ID)
+	if err != nil && !errors.Is(err, repositories.ErrNotFound) {
+		return nil, ErrFindingRecord
+	}
+	if userLike != nil {
+		liked = &userLike.Liked
+	}
+
+	genres := make([]Genre, len(music.MusicGenreMapping))
+	for i, m := range music.MusicGenreMapping {
+		genres[i] = Genre{ID: m.Genre.ID, Name: m.Genre.Name}
+	}
+
+	output := &GetTrackOutput{
+		Track:           toCatalogTrack(music),
+		Album:           album,
+		Genres:          genres,
+		LastfmListeners: music.LastfmListeners,
+		LastfmPlaycount: music.LastfmPlaycount,
+		Likes:           likes,
+		Dislikes:        dislikes,
+		CollectionCount: collections,
+		Liked:           liked,
+	}
+	return output, nil
+}
+
+func (u *musicUsecase) GetAlbum(ctx context.Context, id string) (*GetAlbumOutput, error) {
+	var album *entities.Album
+	var err error
+	if localID, ok := parseLocalID(id); ok {
+		album, err = u.albumRepo.FindByID(localID)
+		if err != nil {
+			if errors.Is(err, repositories.ErrNotFound) {
+				return nil, ErrAlbumNotFound
+			}
+			return nil, ErrFindingRecord
+		}
+	} else {
+		album, err = u.ingester.ingestAlbum(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	music, err := u.musicRepo.FindByAlbumID(album.ID)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	likes, dislikes, err := u.userLikeRepo.CountLikesAndDislikesByAlbumID(album.ID)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+
+	tracks := make([]CatalogTrack, len(music))
+	for i, m := range music {
+		tracks[i] = toCatalogTrack(m)
+	}
+
+	output := &GetAlbumOutput{
+		Album:    toCatalogAlbum(album),
+		Tracks:   tracks,
+		Likes:    likes,
+		Dislikes: dislikes,
+	}
+	return output, nil
+}
+
+func (u *musicUsecase) GetArtist(ctx context.Context, id string) (*GetArtistOutput, error) {
+	var artist *entities.Artist
+	var err error
+	if localID, ok := parseLocalID(id); ok {
+		artist, err = u.artistRepo.FindByID(localID)
+		if err != nil {
+			if errors.Is(err, repositories.ErrNotFound) {
+				return nil, ErrArtistNotFound
+			}
+			return nil, ErrFindingRecord
+		}
+	} else {
+		artist, err = u.ingester.ingestArtist(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	albums, err := u.albumRepo.FindByArtistID(artist.ID, 0, artistDetailLimit)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	music, err := u.musicRepo.FindByArtistID(artist.ID, 0, artistDetailLimit)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	likes, dislikes, err := u.userLikeRepo.CountLikesAndDislikesByArtistID(artist.ID)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+
+	catalogAlbums := make([]CatalogAlbum, len(albums))
+	for i, a := range albums {
+		catalogAlbums[i] = toCatalogAlbum(a)
+	}
+	tracks := make([]CatalogTrack, len(music))
+	for i, m := range music {
+		tracks[i] = toCatalogTrack(m)
+	}
+
+	output := &GetArtistOutput{
+		Artist:      CatalogArtist{ID: artist.ID, Name: artist.Name},
+		Description: artist.Description,
+		SpotifyID:   artist.SpotifyID,
+		Albums:      catalogAlbums,
+		Tracks:      tracks,
+		Likes:       likes,
+		Dislikes:    dislikes,
+	}
+	return output, nil
+}
+
+// findMusic looks a track up by local ID, or by Spotify ID when id is not
+// numeric, ingesting it from Spotify if it is not in the catalog yet.
+func (u *musicUsecase) findMusic(ctx context.Context, id string) (*entities.Music, error) {
+	localID, ok := parseLocalID(id)
+	if !ok {
+		return u.ingester.ingestTrack(ctx, id)
+	}
+
+	music, err := u.musicRepo.FindByID(localID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrMusicNotFound
+		}
+		return nil, ErrFindingRecord
+	}
+	return music, nil
+}
+
+// parseLocalID reports whether id is a local catalog ID. Spotify IDs are
+// base-62 strings and never parse as a plain number in practice.
+func parseLocalID(id string) (uint, bool) {
+	localID, err := strconv.ParseUint(id, 10, 64)
+	if err != nil || localID == 0 {
+		return 0, false
+	}
+	return uint(localID), true
+}
+
+func toCatalogAlbum(a *entities.Album) CatalogAlbum {
+	return CatalogAlbum{
+		ID:          a.ID,
+		Name:        a.Name,
+		SpotifyID:   a.SpotifyID,
+		ImageURL:    a.ImageURL,
+		ReleaseDate: a.ReleaseDate,
+		Artist:      CatalogArtist{ID: a.Artist.ID, Name: a.Artist.Name},
+	}
+}
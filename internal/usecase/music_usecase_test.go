@@ -2,8 +2,12 @@ package usecase
 
 import (
 	"context"
+	"net/http"
 	"testing"
 
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/lastfmclient"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
 	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -15,7 +19,7 @@ func TestMusicUsecase_SearchTrack_Success(t *testing.T) {
 	spotifyClient := &mocks.SpotifyClient{}
 
 	ctx := context.Background()
-	musicUsecase := NewMusicUsecase(ctx, spotifyClient)
+	musicUsecase := NewMusicUsecase(ctx, spotifyClient, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 	keyword := "One"
 	limit := 10
@@ -59,7 +63,7 @@ func TestMusicUsecase_SearchTrack_SearchingSpotifyError(t *testing.T) {
 	spotifyClient := &mocks.SpotifyClient{}
 
 	ctx := context.Background()
-	musicUsecase := NewMusicUsecase(ctx, spotifyClient)
+	musicUsecase := NewMusicUsecase(ctx, spotifyClient, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 	keyword := "One"
 	limit := 10
@@ -85,7 +89,7 @@ func TestMusicUsecase_SearchTrack_EmptyResult(t *testing.T) {
 	spotifyClient := &mocks.SpotifyClient{}
 
 	ctx := context.Background()
-	musicUsecase := NewMusicUsecase(ctx, spotifyClient)
+	musicUsecase := NewMusicUsecase(ctx, spotifyClient, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 	keyword := "NonExistentTrack"
 	limit := 10
@@ -110,3 +114,561 @@ func TestMusicUsecase_SearchTrack_EmptyResult(t *testing.T) {
 	// Verify
 	spotifyClient.AssertExpectations(t)
 }
+
+func TestMusicUsecase_SyncLastfm_Success(t *testing.T) {
+	// Setup
+	lastfmClient := &mocks.LastfmClient{}
+	musicRepo := &mocks.MusicRepository{}
+	genreRepo := &mocks.GenreRepository{}
+	genreAliasRepo := &mocks.GenreAliasRepository{}
+	musicGenreRepo := &mocks.MusicGenreMappingRepository{}
+
+	ctx := context.Background()
+	musicUsecase := NewMusicUsecase(ctx, nil, lastfmClient, musicRepo, nil, nil, nil, genreRepo, genreAliasRepo, musicGenreRepo, nil, nil)
+
+	musicID := uint(1)
+	music := &entities.Music{
+		ID:    musicID,
+		Title: "Believe",
+		MusicArtistMapping: []entities.MusicArtistMapping{
+			{MusicID: musicID, ArtistID: 1, Artist: entities.Artist{ID: 1, Name: "Cher"}},
+		},
+		MusicGenreMapping: []entities.MusicGenreMapping{
+			{MusicID: musicID, GenreID: 2},
+		},
+	}
+	track := &lastfmclient.Track{
+		Name:      "Believe",
+		Artist:    "Cher",
+		MBID:      "32ca187e-ee25-4f18-b7d0-3b6713f24635",
+		Listeners: 1027457,
+		Playcount: 7863914,
+	}
+	tags := []lastfmclient.Tag{
+		{Name: "pop", Count: 100},
+		{Name: "dance", Count: 41},
+		{Name: "seen live", Count: 20},
+		{Name: "cher", Count: 5},
+	}
+
+	// Expectations
+	musicRepo.On("FindByID", musicID).Return(music, nil)
+	lastfmClient.On("GetTrackInfo", ctx, "Cher", "Believe").Return(track, nil)
+	musicRepo.On("Update", mock.MatchedBy(func(m *entities.Music) bool {
+		return m.LastfmID == track.MBID &&
+			m.LastfmListeners == track.Listeners &&
+			m.LastfmPlaycount == track.Playcount &&
+			m.LastfmSyncedAt != nil
+	})).Return(nil)
+	lastfmClient.On("GetTrackTopTags", ctx, "Cher", "Believe").Return(tags, nil)
+	genreAliasRepo.On("FindByAlias", "pop").Return(&entities.GenreAlias{GenreID: 1, Alias: "pop"}, nil)
+	genreRepo.On("FindByID", uint(1)).Return(&entities.Genre{ID: 1, Name: "Pop"}, nil)
+	genreAliasRepo.On("FindByAlias", "dance").Return(nil, repositories.ErrNotFound)
+	genreRepo.On("FindByName", "dance").Return(&entities.Genre{ID: 2, Name: "Dance"}, nil)
+	genreAliasRepo.On("FindByAlias", "seen live").Return(nil, repositories.ErrNotFound)
+	genreRepo.On("FindByName", "seen live").Return(nil, repositories.ErrNotFound)
+	// Fallbacks only match genre names, not aliases.
+	genreRepo.On("FindByName", "live").Return(nil, repositories.ErrNotFound)
+	musicGenreRepo.On("Create", &entities.MusicGenreMapping{MusicID: musicID, GenreID: 1}).Return(nil).Once()
+
+	// Execute
+	output, err := musicUsecase.SyncLastfm(ctx, musicID)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, track.MBID, output.LastfmID)
+	assert.Equal(t, track.Listeners, output.Listeners)
+	assert.Equal(t, track.Playcount, output.Playcount)
+	assert.Equal(t, []Genre{{ID: 1, Name: "Pop"}, {ID: 2, Name: "Dance"}}, output.Genres)
+
+	// Verify
+	musicRepo.AssertExpectations(t)
+	lastfmClient.AssertExpectations(t)
+	genreRepo.AssertExpectations(t)
+	genreAliasRepo.AssertExpectations(t)
+	musicGenreRepo.AssertExpectations(t)
+	genreAliasRepo.AssertNotCalled(t, "FindByAlias", "cher")
+	genreAliasRepo.AssertNotCalled(t, "FindByAlias", "live")
+}
+
+func TestMusicUsecase_SyncLastfm_SpotifyArtistGenres(t *testing.T) {
+	// Setup
+	spotifyClient := &mocks.SpotifyClient{}
+	lastfmClient := &mocks.LastfmClient{}
+	musicRepo := &mocks.MusicRepository{}
+	genreRepo := &mocks.GenreRepository{}
+	genreAliasRepo := &mocks.GenreAliasRepository{}
+	musicGenreRepo := &mocks.MusicGenreMappingRepository{}
+
+	ctx := context.Background()
+	musicUsecase := NewMusicUsecase(ctx, spotifyClient, lastfmClient, musicRepo, nil, nil, nil, genreRepo, genreAliasRepo, musicGenreRepo, nil, nil)
+
+	musicID := uint(1)
+	music := &entities.Music{
+		ID:    musicID,
+		Title: "Tomboy",
+		MusicArtistMapping: []entities.MusicArtistMapping{
+			{MusicID: musicID, ArtistID: 1, Artist: entities.Artist{ID: 1, Name: "Hyukoh", SpotifyID: "5dCvSnVduaFleCnyy98JMo"}},
+			{MusicID: musicID, ArtistID: 2, Artist: entities.Artist{ID: 2, Name: "Gone", SpotifyID: "0000000000000000000000"}},
+		},
+	}
+	track := &lastfmclient.Track{Name: "Tomboy", Artist: "Hyukoh"}
+
+	// Expectations
+	musicRepo.On("FindByID", musicID).Return(music, nil)
+	lastfmClient.On("GetTrackInfo", ctx, "Hyukoh", "Tomboy").Return(track, nil)
+	musicRepo.On("Update", music).Return(nil)
+	lastfmClient.On("GetTrackTopTags", ctx, "Hyukoh", "Tomboy").Return([]lastfmclient.Tag{}, nil)
+	spotifyClient.On("GetArtist", ctx, spotify.ID("5dCvSnVduaFleCnyy98JMo")).Return(&spotify.FullArtist{
+		Genres: []string{"korean indie rock"},
+	}, nil)
+	// Artists removed from Spotify are skipped.
+	spotifyClient.On("GetArtist", ctx, spotify.ID("0000000000000000000000")).Return(nil, spotify.Error{Status: http.StatusNotFound})
+	genreAliasRepo.On("FindByAlias", "korean indie rock").Return(nil, repositories.ErrNotFound)
+	genreRepo.On("FindByName", "korean indie rock").Return(nil, repositories.ErrNotFound)
+	genreRepo.On("FindByName", "indie rock").Return(&entities.Genre{ID: 3, Name: "Indie Rock"}, nil)
+	musicGenreRepo.On("Create", &entities.MusicGenreMapping{MusicID: musicID, GenreID: 3}).Return(nil)
+
+	// Execute
+	output, err := musicUsecase.SyncLastfm(ctx, musicID)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []Genre{{ID: 3, Name: "Indie Rock"}}, output.Genres)
+
+	// Verify
+	spotifyClient.AssertExpectations(t)
+	genreRepo.AssertExpectations(t)
+	musicGenreRepo.AssertExpectations(t)
+	genreAliasRepo.AssertNotCalled(t, "FindByAlias", "indie rock")
+}
+
+func TestMusicUsecase_SyncLastfm_ByMBID(t *testing.T) {
+	// Setup
+	lastfmClient := &mocks.LastfmClient{}
+	musicRepo := &mocks.MusicRepository{}
+
+	ctx := context.Background()
+	musicUsecase := NewMusicUsecase(ctx, nil, lastfmClient, musicRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	musicID := uint(1)
+	mbid := "32ca187e-ee25-4f18-b7d0-3b6713f24635"
+	music := &entities.Music{ID: musicID, Title: "Believe", LastfmID: mbid}
+	track := &lastfmclient.Track{Name: "Believe", Artist: "Cher", MBID: mbid}
+
+	// Expectations
+	musicRepo.On("FindByID", musicID).Return(music, nil)
+	lastfmClient.On("GetTrackInfoByMBID", ctx, mbid).Return(track, nil)
+	musicRepo.On("Update", music).Return(nil)
+	lastfmClient.On("GetTrackTopTags", ctx, "Cher", "Believe").Return([]lastfmclient.Tag{}, nil)
+
+	// Execute
+	output, err := musicUsecase.SyncLastfm(ctx, musicID)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, output.Genres)
+
+	// Verify
+	musicRepo.AssertExpectations(t)
+	lastfmClient.AssertExpectations(t)
+}
+
+func TestMusicUsecase_SyncLastfm_MusicNotFound(t *testing.T) {
+	// Setup
+	musicRepo := &mocks.MusicRepository{}
+
+	ctx := context.Background()
+	musicUsecase := NewMusicUsecase(ctx, nil, nil, musicRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	// Expectations
+	musicRepo.On("FindByID", uint(1)).Return(nil, repositories.ErrNotFound)
+
+	// Execute
+	output, err := musicUsecase.SyncLastfm(ctx, 1)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrMusicNotFound)
+	assert.Nil(t, output)
+
+	// Verify
+	musicRepo.AssertExpectations(t)
+}
+
+func TestMusicUsecase_SyncLastfm_LastfmTrackNotFound(t *testing.T) {
+	// Setup
+	lastfmClient := &mocks.LastfmClient{}
+	musicRepo := &mocks.MusicRepository{}
+
+	ctx := context.Background()
+	musicUsecase := NewMusicUsecase(ctx, nil, lastfmClient, musicRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	music := &entities.Music{
+		ID:    1,
+		Title: "Unknown",
+		MusicArtistMapping: []entities.MusicArtistMapping{
+			{Artist: entities.Artist{Name: "Cher"}},
+		},
+	}
+
+	// Expectations
+	musicRepo.On("FindByID", uint(1)).Return(music, nil)
+	lastfmClient.On("GetTrackInfo", ctx, "Cher", "Unknown").Return(nil, lastfmclient.ErrTrackNotFound)
+
+	// Execute
+	output, err := musicUsecase.SyncLastfm(ctx, 1)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrLastfmTrackNotFound)
+	assert.Nil(t, output)
+
+	// Verify
+	musicRepo.AssertExpectations(t)
+	lastfmClient.AssertExpectations(t)
+	musicRepo.AssertNotCalled(t, "Update", mock.Anything)
+}
+
+func TestMusicUsecase_GetTrack_Success(t *testing.T) {
+	// Setup
+	musicRepo := &mocks.MusicRepository{}
+	albumRepo := &mocks.AlbumRepository{}
+	userLikeRepo := &mocks.UserLikeRepository{}
+	collectionMusicRepo := &mocks.CollectionMusicMappingRepository{}
+
+	ctx := context.Background()
+	musicUsecase := NewMusicUsecase(ctx, nil, nil, musicRepo, albumRepo, nil, nil, nil, nil, nil, userLikeRepo, collectionMusicRepo)
+
+	userID := uint(1)
+	music := &entities.Music{
+		ID:              1,
+		Title:           "One",
+		AlbumID:         2,
+		SpotifyID:       "2up3OPMp9Tb4dAKM2erWXQ",
+		LastfmListeners: 1000,
+		MusicArtistMapping: []entities.MusicArtistMapping{
+			{Artist: entities.Artist{ID: 3, Name: "Aimee Mann"}},
+		},
+		MusicGenreMapping: []entities.MusicGenreMapping{
+			{Genre: entities.Genre{ID: 4, Name: "Rock"}},
+		},
+	}
+
+	// Expectations
+	musicRepo.On("FindByID", uint(1)).Return(music, nil)
+	albumRepo.On("FindByID", uint(2)).Return(&entities.Album{ID: 2, Name: "Magnolia", Artist: entities.Artist{ID: 3, Name: "Aimee Mann"}}, nil)
+	userLikeRepo.On("CountLikesAndDislikesByMusicID", uint(1)).Return(int64(12), int64(1), nil)
+	collectionMusicRepo.On("CountCollectionsByMusicID", uint(1)).Return(int64(4), nil)
+	userLikeRepo.On("FindByUserIDAndMusicID", userID, uint(1)).Return(&entities.UserLike{Liked: true}, nil)
+
+	// Execute
+	output, err := musicUsecase.GetTrack(ctx, userID, "1")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "One", output.Track.Title)
+	assert.Equal(t, "Aimee Mann", output.Track.Artists[0].Name)
+	assert.Equal(t, "Magnolia", output.Album.Name)
+	assert.Equal(t, []Genre{{ID: 4, Name: "Rock"}}, output.Genres)
+	assert.Equal(t, int64(1000), output.LastfmListeners)
+	assert.Equal(t, int64(12), output.Likes)
+	assert.Equal(t, int64(1), output.Dislikes)
+	assert.Equal(t, int64(4), output.CollectionCount)
+	assert.True(t, *output.Liked)
+
+	// Verify
+	musicRepo.AssertExpectations(t)
+	albumRepo.AssertExpectations(t)
+	userLikeRepo.AssertExpectations(t)
+	collectionMusicRepo.AssertExpectations(t)
+}
+
+func TestMusicUsecase_GetTrack_NoAlbum(t *testing.T) {
+	// Setup
+	musicRepo := &mocks.MusicRepository{}
+	albumRepo := &mocks.AlbumRepository{}
+	userLikeRepo := &mocks.UserLikeRepository{}
+	collectionMusicRepo := &mocks.CollectionMusicMappingRepository{}
+
+	ctx := context.Background()
+	musicUsecase := NewMusicUsecase(ctx, nil, nil, musicRepo, albumRepo, nil, nil, nil, nil, nil, userLikeRepo, collectionMusicRepo)
+
+	// Expectations
+	musicRepo.On("FindByID", uint(1)).Return(&entities.Music{ID: 1, Title: "Demo"}, nil)
+	userLikeRepo.On("CountLikesAndDislikesByMusicID", uint(1)).Return(int64(0), int64(0), nil)
+	collectionMusicRepo.On("CountCollectionsByMusicID", uint(1)).Return(int64(0), nil)
+	userLikeRepo.On("FindByUserIDAndMusicID", uint(1), uint(1)).Return(nil, repositories.ErrNotFound)
+
+	// Execute
+	output, err := musicUsecase.GetTrack(ctx, 1, "1")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "Demo", output.Track.Title)
+	assert.Equal(t, CatalogAlbum{}, output.Album)
+
+	// Verify
+	albumRepo.AssertNotCalled(t, "FindByID", mock.Anything)
+}
+
+func TestMusicUsecase_GetTrack_NoReaction(t *testing.T) {
+	// Setup
+	musicRepo := &mocks.MusicRepository{}
+	albumRepo := &mocks.AlbumRepository{}
+	userLikeRepo := &mocks.UserLikeRepository{}
+	collectionMusicRepo := &mocks.CollectionMusicMappingRepository{}
+
+	ctx := context.Background()
+	musicUsecase := NewMusicUsecase(ctx, nil, nil, musicRepo, albumRepo, nil, nil, nil, nil, nil, userLikeRepo, collectionMusicRepo)
+
+	// Expectations
+	musicRepo.On("FindBySpotifyID", "2up3OPMp9Tb4dAKM2erWXQ").Return(&entities.Music{ID: 1, AlbumID: 2}, nil)
+	albumRepo.On("FindByID", uint(2)).Return(&entities.Album{ID: 2}, nil)
+	userLikeRepo.On("CountLikesAndDislikesByMusicID", uint(1)).Return(int64(0), int64(0), nil)
+	collectionMusicRepo.On("CountCollectionsByMusicID", uint(1)).Return(int64(0), nil)
+	userLikeRepo.On("FindByUserIDAndMusicID", uint(1), uint(1)).Return(nil, repositories.ErrNotFound)
+
+	// Execute
+	output, err := musicUsecase.GetTrack(ctx, 1, "2up3OPMp9Tb4dAKM2erWXQ")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Nil(t, output.Liked)
+
+	// Verify
+	musicRepo.AssertExpectations(t)
+	userLikeRepo.AssertExpectations(t)
+}
+
+func TestMusicUsecase_GetTrack_IngestsFromSpotify(t *testing.T) {
+	// Setup
+	spotifyClient := &mocks.SpotifyClient{}
+	musicRepo := &mocks.MusicRepository{}
+	albumRepo := &mocks.AlbumRepository{}
+	artistRepo := &mocks.ArtistRepository{}
+	musicArtistRepo := &mocks.MusicArtistMappingRepository{}
+	genreRepo := &mocks.GenreRepository{}
+	genreAliasRepo := &mocks.GenreAliasRepository{}
+	musicGenreRepo := &mocks.MusicGenreMappingRepository{}
+	userLikeRepo := &mocks.UserLikeRepository{}
+	collectionMusicRepo := &mocks.CollectionMusicMappingRepository{}
+
+	ctx := context.Background()
+	musicUsecase := NewMusicUsecase(ctx, spotifyClient, nil, musicRepo, albumRepo, artistRepo, musicArtistRepo, genreRepo, genreAliasRepo, musicGenreRepo, userLikeRepo, collectionMusicRepo)
+
+	spotifyArtist := spotify.SimpleArtist{ID: "6lGiIBsLhPUMFGRmh3Vqsd", Name: "Aimee Mann"}
+	track := &spotify.FullTrack{
+		SimpleTrack: spotify.SimpleTrack{
+			ID:      "2up3OPMp9Tb4dAKM2erWXQ",
+			Name:    "One",
+			Artists: []spotify.SimpleArtist{spotifyArtist},
+		},
+		ExternalIDs: map[string]string{"isrc": "USUM71703861"},
+		Album: spotify.SimpleAlbum{
+			ID:                   "3M3cfh4JyWpuL0nVwEPSdo",
+			Name:                 "Magnolia",
+			Artists:              []spotify.SimpleArtist{spotifyArtist},
+			ReleaseDate:          "1999-12-07",
+			ReleaseDatePrecision: "day",
+			Images:               []spotify.Image{{URL: "https://i.scdn.co/image/magnolia"}},
+		},
+	}
+
+	// Expectations
+	musicRepo.On("FindBySpotifyID", "2up3OPMp9Tb4dAKM2erWXQ").Return(nil, repositories.ErrNotFound)
+	spotifyClient.On("GetTrack", ctx, spotify.ID("2up3OPMp9Tb4dAKM2erWXQ")).Return(track, nil)
+	albumRepo.On("FindBySpotifyID", "3M3cfh4JyWpuL0nVwEPSdo").Return(nil, repositories.ErrNotFound)
+	spotifyClient.On("GetArtist", ctx, spotify.ID("6lGiIBsLhPUMFGRmh3Vqsd")).Return(&spotify.FullArtist{
+		SimpleArtist: spotifyArtist,
+		Genres:       []string{"singer-songwriter"},
+	}, nil).Once()
+	artistRepo.On("FindBySpotifyID", "6lGiIBsLhPUMFGRmh3Vqsd").Return(nil, repositories.ErrNotFound)
+	artistRepo.On("Create", mock.MatchedBy(func(a *entities.Artist) bool {
+		a.ID = 3
+		return a.Name == "Aimee Mann"
+	})).Return(nil)
+	albumRepo.On("Create", mock.MatchedBy(func(a *entities.Album) bool {
+		a.ID = 2
+		return a.Name == "Magnolia" && a.ArtistID == 3 && a.ImageURL == "https://i.scdn.co/image/magnolia" && a.ReleaseDate.Year() == 1999
+	})).Return(nil)
+	musicRepo.On("Create", mock.MatchedBy(func(m *entities.Music) bool {
+		m.ID = 1
+		return m.Title == "One" && m.AlbumID == 2 && m.ISRC == "USUM71703861"
+	})).Return(nil)
+	musicArtistRepo.On("Create", &entities.MusicArtistMapping{MusicID: 1, ArtistID: 3}).Return(nil)
+	genreAliasRepo.On("FindByAlias", "singer songwriter").Return(&entities.GenreAlias{GenreID: 5, Alias: "singer songwriter"}, nil)
+	genreRepo.On("FindByID", uint(5)).Return(&entities.Genre{ID: 5, Name: "Singer-Songwriter"}, nil)
+	musicGenreRepo.On("Create", &entities.MusicGenreMapping{MusicID: 1, GenreID: 5}).Return(nil)
+	albumRepo.On("FindByID", uint(2)).Return(&entities.Album{ID: 2, Name: "Magnolia"}, nil)
+	userLikeRepo.On("CountLikesAndDislikesByMusicID", uint(1)).Return(int64(0), int64(0), nil)
+	collectionMusicRepo.On("CountCollectionsByMusicID", uint(1)).Return(int64(0), nil)
+	userLikeRepo.On("FindByUserIDAndMusicID", uint(1), uint(1)).Return(nil, repositories.ErrNotFound)
+
+	// Execute
+	output, err := musicUsecase.GetTrack(ctx, 1, "2up3OPMp9Tb4dAKM2erWXQ")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, uint(1), output.Track.ID)
+	assert.Equal(t, []CatalogArtist{{ID: 3, Name: "Aimee Mann"}}, output.Track.Artists)
+	assert.Equal(t, []Genre{{ID: 5, Name: "Singer-Songwriter"}}, output.Genres)
+
+	// Verify
+	spotifyClient.AssertExpectations(t)
+	musicRepo.AssertExpectations(t)
+	albumRepo.AssertExpectations(t)
+	artistRepo.AssertExpectations(t)
+	musicArtistRepo.AssertExpectations(t)
+	musicGenreRepo.AssertExpectations(t)
+}
+
+func TestMusicUsecase_GetTrack_SpotifyTrackNotFound(t *testing.T) {
+	// Setup
+	spotifyClient := &mocks.SpotifyClient{}
+	musicRepo := &mocks.MusicRepository{}
+
+	ctx := context.Background()
+	musicUsecase := NewMusicUsecase(ctx, spotifyClient, nil, musicRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	// Expectations
+	musicRepo.On("FindBySpotifyID", "unknown").Return(nil, repositories.ErrNotFound)
+	spotifyClient.On("GetTrack", ctx, spotify.ID("unknown")).Return(nil, spotify.Error{Message: "invalid id", Status: 400})
+
+	// Execute
+	output, err := musicUsecase.GetTrack(ctx, 1, "unknown")
+
+	// Assert
+	assert.ErrorIs(t, err, ErrMusicNotFound)
+	assert.Nil(t, output)
+
+	// Verify
+	spotifyClient.AssertExpectations(t)
+	musicRepo.AssertExpectations(t)
+}
+
+func TestMusicUsecase_GetTrack_MusicNotFound(t *testing.T) {
+	// Setup
+	musicRepo := &mocks.MusicRepository{}
+
+	ctx := context.Background()
+	musicUsecase := NewMusicUsecase(ctx, nil, nil, musicRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	// Expectations
+	musicRepo.On("FindByID", uint(1)).Return(nil, repositories.ErrNotFound)
+
+	// Execute
+	output, err := musicUsecase.GetTrack(ctx, 1, "1")
+
+	// Assert
+	assert.ErrorIs(t, err, ErrMusicNotFound)
+	assert.Nil(t, output)
+
+	// Verify
+	musicRepo.AssertExpectations(t)
+}
+
+func TestMusicUsecase_GetAlbum_Success(t *testing.T) {
+	// Setup
+	musicRepo := &mocks.MusicRepository{}
+	albumRepo := &mocks.AlbumRepository{}
+	userLikeRepo := &mocks.UserLikeRepository{}
+
+	ctx := context.Background()
+	musicUsecase := NewMusicUsecase(ctx, nil, nil, musicRepo, albumRepo, nil, nil, nil, nil, nil, userLikeRepo, nil)
+
+	// Expectations
+	albumRepo.On("FindByID", uint(2)).Return(&entities.Album{ID: 2, Name: "Magnolia", Artist: entities.Artist{ID: 3, Name: "Aimee Mann"}}, nil)
+	musicRepo.On("FindByAlbumID", uint(2)).Return([]*entities.Music{{ID: 1, Title: "One", AlbumID: 2}, {ID: 2, Title: "Momentum", AlbumID: 2}}, nil)
+	userLikeRepo.On("CountLikesAndDislikesByAlbumID", uint(2)).Return(int64(30), int64(2), nil)
+
+	// Execute
+	output, err := musicUsecase.GetAlbum(ctx, "2")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "Magnolia", output.Album.Name)
+	assert.Equal(t, "Aimee Mann", output.Album.Artist.Name)
+	assert.Len(t, output.Tracks, 2)
+	assert.Equal(t, int64(30), output.Likes)
+	assert.Equal(t, int64(2), output.Dislikes)
+
+	// Verify
+	albumRepo.AssertExpectations(t)
+	musicRepo.AssertExpectations(t)
+	userLikeRepo.AssertExpectations(t)
+}
+
+func TestMusicUsecase_GetAlbum_AlbumNotFound(t *testing.T) {
+	// Setup
+	albumRepo := &mocks.AlbumRepository{}
+
+	ctx := context.Background()
+	musicUsecase := NewMusicUsecase(ctx, nil, nil, nil, albumRepo, nil, nil, nil, nil, nil, nil, nil)
+
+	// Expectations
+	albumRepo.On("FindByID", uint(2)).Return(nil, repositories.ErrNotFound)
+
+	// Execute
+	output, err := musicUsecase.GetAlbum(ctx, "2")
+
+	// Assert
+	assert.ErrorIs(t, err, ErrAlbumNotFound)
+	assert.Nil(t, output)
+
+	// Verify
+	albumRepo.AssertExpectations(t)
+}
+
+func TestMusicUsecase_GetArtist_Success(t *testing.T) {
+	// Setup
+	musicRepo := &mocks.MusicRepository{}
+	albumRepo := &mocks.AlbumRepository{}
+	artistRepo := &mocks.ArtistRepository{}
+	userLikeRepo := &mocks.UserLikeRepository{}
+
+	ctx := context.Background()
+	musicUsecase := NewMusicUsecase(ctx, nil, nil, musicRepo, albumRepo, artistRepo, nil, nil, nil, nil, userLikeRepo, nil)
+
+	// Expectations
+	artistRepo.On("FindByID", uint(3)).Return(&entities.Artist{ID: 3, Name: "Aimee Mann", SpotifyID: "6lGiIBsLhPUMFGRmh3Vqsd"}, nil)
+	albumRepo.On("FindByArtistID", uint(3), 0, artistDetailLimit).Return([]*entities.Album{{ID: 2, Name: "Magnolia"}}, nil)
+	musicRepo.On("FindByArtistID", uint(3), 0, artistDetailLimit).Return([]*entities.Music{{ID: 1, Title: "One"}}, nil)
+	userLikeRepo.On("CountLikesAndDislikesByArtistID", uint(3)).Return(int64(120), int64(5), nil)
+
+	// Execute
+	output, err := musicUsecase.GetArtist(ctx, "3")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, CatalogArtist{ID: 3, Name: "Aimee Mann"}, output.Artist)
+	assert.Equal(t, "6lGiIBsLhPUMFGRmh3Vqsd", output.SpotifyID)
+	assert.Equal(t, "Magnolia", output.Albums[0].Name)
+	assert.Equal(t, "One", output.Tracks[0].Title)
+	assert.Equal(t, int64(120), output.Likes)
+
+	// Verify
+	artistRepo.AssertExpectations(t)
+	albumRepo.AssertExpectations(t)
+	musicRepo.AssertExpectations(t)
+	userLikeRepo.AssertExpectations(t)
+}
+
+func TestMusicUsecase_GetArtist_SpotifyFetchError(t *testing.T) {
+	// Setup
+	spotifyClient := &mocks.SpotifyClient{}
+
+	ctx := context.Background()
+	musicUsecase := NewMusicUsecase(ctx, spotifyClient, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	// Expectations
+	spotifyClient.On("GetArtist", ctx, spotify.ID("6lGiIBsLhPUMFGRmh3Vqsd")).Return(nil, spotify.Error{Message: "server error", Status: 500})
+
+	// Execute
+	output, err := musicUsecase.GetArtist(ctx, "6lGiIBsLhPUMFGRmh3Vqsd")
+
+	// Assert
+	assert.ErrorIs(t, err, ErrFetchingSpotify)
+	assert.Nil(t, output)
+
+	// Verify
+	spotifyClient.AssertExpectations(t)
+}
@@ -0,0 +1,161 @@
+package usecase
+
+import (
+	"slices"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+)
+
+type NotificationUsecase interface {
+	ListNotifications(userID uint, unreadOnly bool, limit, offset *int) (*ListNotificationsOutput, error)
+	MarkRead(userID uint, input *MarkNotificationsReadInput) (*MarkNotificationsReadOutput, error)
+	GetPreferences(userID uint) (*NotificationPreferencesOutput, error)
+	UpdatePreferences(userID uint, input []UpdateNotificationPreferenceInput) (*NotificationPreferencesOutput, error)
+}
+
+type notificationUsecase struct {
+	notificationRepo repositories.NotificationRepository
+	preferenceRepo   repositories.NotificationPreferenceRepository
+}
+
+func NewNotificationUsecase(notificationRepo repositories.NotificationRepository, preferenceRepo repositories.NotificationPreferenceRepository) NotificationUsecase {
+	return &notificationUsecase{
+		notificationRepo: notificationRepo,
+		preferenceRepo:   preferenceRepo,
+	}
+}
+
+// ListNotifications pages through the user's notifications, newest first,
+// with the number of unread ones.
+func (u *notificationUsecase) ListNotifications(userID uint, unreadOnly bool, limit, offset *int) (*ListNotificationsOutput, error) {
+	l, o := pagination(limit, offset)
+	notifications, err := u.notificationRepo.FindByUserID(userID, unreadOnly, o, l)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	total, err := u.notificationRepo.CountByUserID(userID, unreadOnly)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	unread := total
+	if !unreadOnly {
+		if unread, err = u.notificationRepo.CountByUserID(userID, true); err != nil {
+			return nil, ErrFindingRecord
+		}
+	}
+
+	output := &ListNotificationsOutput{
+		Notifications: make([]NotificationOutput, len(notifications)),
+		Total:         int(total),
+		UnreadCount:   int(unread),
+	}
+	for i, n := range notifications {
+		output.Notifications[i] = toNotificationOutput(n)
+	}
+	return output, nil
+}
+
+// MarkRead marks the selected notifications of the user as read and returns
+// the number left unread.
+func (u *notificationUsecase) MarkRead(userID uint, input *MarkNotificationsReadInput) (*MarkNotificationsReadOutput, error) {
+	switch {
+	case input.All:
+		if err := u.notificationRepo.MarkAllRead(userID); err != nil {
+			return nil, ErrUpdatingRecord
+		}
+	case len(input.IDs) > 0:
+		if err := u.notificationRepo.MarkRead(userID, input.IDs); err != nil {
+			return nil, ErrUpdatingRecord
+		}
+	default:
+		return nil, ErrNoNotificationsSelected
+	}
+
+	unread, err := u.notificationRepo.CountByUserID(userID, true)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	return &MarkNotificationsReadOutput{UnreadCount: int(unread)}, nil
+}
+
+// GetPreferences returns how the user receives each type of notification,
+// with the defaults for the types they have not set.
+func (u *notificationUsecase) GetPreferences(userID uint) (*NotificationPreferencesOutput, error) {
+	preferences, err := u.preferences(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &NotificationPreferencesOutput{Preferences: make([]NotificationPreferenceOutput, len(entities.NotificationTypes))}
+	for i, t := range entities.NotificationTypes {
+		p := preferences[t]
+		output.Preferences[i] = NotificationPreferenceOutput{Type: p.Type, InApp: p.InApp, Email: p.Email}
+	}
+	return output, nil
+}
+
+// UpdatePreferences changes the user's preferences for the given types. No
+// preference is changed if any of the types is invalid.
+func (u *notificationUsecase) UpdatePreferences(userID uint, input []UpdateNotificationPreferenceInput) (*NotificationPreferencesOutput, error) {
+	for _, in := range input {
+		if !slices.Contains(entities.NotificationTypes, in.Type) {
+			return nil, ErrInvalidNotificationType
+		}
+	}
+	preferences, err := u.preferences(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, in := range input {
+		p := preferences[in.Type]
+		if in.InApp != nil {
+			p.InApp = *in.InApp
+		}
+		if in.Email != nil {
+			p.Email = *in.Email
+		}
+		if err := u.preferenceRepo.Save(p); err != nil {
+			return nil, ErrUpdatingRecord
+		}
+	}
+	return u.GetPreferences(userID)
+}
+
+// preferences returns the user's preference for every notification type,
+// keyed by type.
+func (u *notificationUsecase) preferences(userID uint) (map[string]*entities.NotificationPreference, error) {
+	stored, err := u.preferenceRepo.FindByUserID(userID)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	preferences := make(map[string]*entities.NotificationPreference, len(entities.NotificationTypes))
+	for _, t := range entities.NotificationTypes {
+		preferences[t] = defaultNotificationPreference(userID, t)
+	}
+	for _, p := range stored {
+		preferences[p.Type] = p
+	}
+	return preferences, nil
+}
+
+func toNotificationOutput(n *entities.Notification) NotificationOutput {
+	output := NotificationOutput{
+		ID:                 n.ID,
+		Type:               n.Type,
+		Message:            notificationMessage(n.Type, n.Actor),
+		PostID:             n.PostID,
+		CommentID:          n.CommentID,
+		ModerationActionID: n.ModerationActionID,
+		Read:               n.ReadAt != nil,
+		CreatedAt:          n.CreatedAt,
+	}
+	if n.Actor != nil {
+		output.Actor = &NotificationActorOutput{UserID: n.Actor.ID, Nickname: n.Actor.Nickname}
+		if n.Actor.UserProfile != nil {
+			output.Actor.ProfileImageURL = n.Actor.UserProfile.ProfileImageURL
+		}
+	}
+	return output
+}
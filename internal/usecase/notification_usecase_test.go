@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase/mocks"
+	"github.com/myjinjin/sonic-odyssey-backend/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNotificationUsecase_ListNotifications(t *testing.T) {
+	// Setup
+	notificationRepo := &mocks.NotificationRepository{}
+
+	notificationUsecase := NewNotificationUsecase(notificationRepo, nil)
+	postID := uint(10)
+	readAt := time.Now()
+
+	// Expectations
+	notificationRepo.On("FindByUserID", uint(1), false, 0, 20).Return([]*entities.Notification{
+		{ID: 2, UserID: 1, ActorID: utils.ToPtr(uint(2)), Actor: &entities.User{ID: 2, Nickname: "alice"}, Type: entities.NotificationTypePostLike, PostID: &postID},
+		{ID: 1, UserID: 1, Type: entities.NotificationTypeModeration, ModerationActionID: utils.ToPtr(uint(5)), ReadAt: &readAt},
+	}, nil)
+	notificationRepo.On("CountByUserID", uint(1), false).Return(int64(2), nil)
+	notificationRepo.On("CountByUserID", uint(1), true).Return(int64(1), nil)
+
+	// Execute
+	output, err := notificationUsecase.ListNotifications(1, false, nil, nil)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 2, output.Total)
+	assert.Equal(t, 1, output.UnreadCount)
+	assert.Len(t, output.Notifications, 2)
+	assert.Equal(t, "alice", output.Notifications[0].Actor.Nickname)
+	assert.Equal(t, "alice님이 회원님의 게시글을 좋아합니다.", output.Notifications[0].Message)
+	assert.False(t, output.Notifications[0].Read)
+	assert.Nil(t, output.Notifications[1].Actor)
+	assert.True(t, output.Notifications[1].Read)
+
+	// Verify
+	notificationRepo.AssertExpectations(t)
+}
+
+func TestNotificationUsecase_MarkRead(t *testing.T) {
+	t.Run("Selected", func(t *testing.T) {
+		// Setup
+		notificationRepo := &mocks.NotificationRepository{}
+
+		notificationUsecase := NewNotificationUsecase(notificationRepo, nil)
+
+		// Expectations
+		notificationRepo.On("MarkRead", uint(1), []uint{3, 4}).Return(nil)
+		notificationRepo.On("CountByUserID", uint(1), true).Return(int64(2), nil)
+
+		// Execute
+		output, err := notificationUsecase.MarkRead(1, &MarkNotificationsReadInput{IDs: []uint{3, 4}})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 2, output.UnreadCount)
+
+		// Verify
+		notificationRepo.AssertExpectations(t)
+	})
+
+	t.Run("All", func(t *testing.T) {
+		// Setup
+		notificationRepo := &mocks.NotificationRepository{}
+
+		notificationUsecase := NewNotificationUsecase(notificationRepo, nil)
+
+		// Expectations
+		notificationRepo.On("MarkAllRead", uint(1)).Return(nil)
+		notificationRepo.On("CountByUserID", uint(1), true).Return(int64(0), nil)
+
+		// Execute
+		output, err := notificationUsecase.MarkRead(1, &MarkNotificationsReadInput{IDs: []uint{3}, All: true})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 0, output.UnreadCount)
+
+		// Verify
+		notificationRepo.AssertExpectations(t)
+		notificationRepo.AssertNotCalled(t, "MarkRead", mock.Anything, mock.Anything)
+	})
+
+	t.Run("NothingSelected", func(t *testing.T) {
+		// Setup
+		notificationUsecase := NewNotificationUsecase(nil, nil)
+
+		// Execute
+		_, err := notificationUsecase.MarkRead(1, &MarkNotificationsReadInput{})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrNoNotificationsSelected)
+	})
+}
+
+func TestNotificationUsecase_UpdatePreferences(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// Setup
+		preferenceRepo := &mocks.NotificationPreferenceRepository{}
+
+		notificationUsecase := NewNotificationUsecase(nil, preferenceRepo)
+		stored := &entities.NotificationPreference{UserID: 1, Type: entities.NotificationTypeFollow, InApp: false, Email: true}
+
+		// Expectations
+		preferenceRepo.On("FindByUserID", uint(1)).Return([]*entities.NotificationPreference{stored}, nil)
+		preferenceRepo.On("Save", &entities.NotificationPreference{UserID: 1, Type: entities.NotificationTypeFollow, InApp: true, Email: true}).Return(nil)
+		preferenceRepo.On("Save", &entities.NotificationPreference{UserID: 1, Type: entities.NotificationTypeModeration, InApp: true, Email: false}).Return(nil)
+
+		// Execute
+		output, err := notificationUsecase.UpdatePreferences(1, []UpdateNotificationPreferenceInput{
+			{Type: entities.NotificationTypeFollow, InApp: utils.ToPtr(true)},
+			{Type: entities.NotificationTypeModeration, Email: utils.ToPtr(false)},
+		})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, output.Preferences, len(entities.NotificationTypes))
+		assert.Equal(t, NotificationPreferenceOutput{Type: entities.NotificationTypeReply, InApp: true, Email: false}, output.Preferences[0])
+
+		// Verify
+		preferenceRepo.AssertExpectations(t)
+	})
+
+	t.Run("InvalidType", func(t *testing.T) {
+		// Setup
+		preferenceRepo := &mocks.NotificationPreferenceRepository{}
+
+		notificationUsecase := NewNotificationUsecase(nil, preferenceRepo)
+
+		// Execute
+		_, err := notificationUsecase.UpdatePreferences(1, []UpdateNotificationPreferenceInput{
+			{Type: entities.NotificationTypeFollow, InApp: utils.ToPtr(false)},
+			{Type: "UNKNOWN", InApp: utils.ToPtr(false)},
+		})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidNotificationType)
+
+		// Verify
+		preferenceRepo.AssertNotCalled(t, "Save", mock.Anything)
+	})
+}
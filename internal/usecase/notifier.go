@@ -0,0 +1,231 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/email"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/encryption"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/logging"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"go.uber.org/zap"
+)
+
+// maxMentions limits the users notified about one post or comment.
+const maxMentions = 10
+
+// mentionPattern matches @nickname not preceded by a word character, so that
+// email addresses are not taken for mentions.
+var mentionPattern = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_])@([\p{L}\p{N}_.\-]+)`)
+
+// Notifier notifies users about what others did to them or their content. It
+// is shared by the comment, post, like, follow and moderation usecases. A nil
+// notifier notifies no one.
+type Notifier struct {
+	notificationRepo repositories.NotificationRepository
+	preferenceRepo   repositories.NotificationPreferenceRepository
+	userRepo         repositories.UserRepository
+	blockRepo        repositories.UserBlockRepository
+	emailEncryptor   encryption.Encryptor
+	emailSender      email.EmailSender
+}
+
+func NewNotifier(notificationRepo repositories.NotificationRepository, preferenceRepo repositories.NotificationPreferenceRepository, userRepo repositories.UserRepository, blockRepo repositories.UserBlockRepository, emailEncryptor encryption.Encryptor, emailSender email.EmailSender) *Notifier {
+	return &Notifier{
+		notificationRepo: notificationRepo,
+		preferenceRepo:   preferenceRepo,
+		userRepo:         userRepo,
+		blockRepo:        blockRepo,
+		emailEncryptor:   emailEncryptor,
+		emailSender:      emailSender,
+	}
+}
+
+// defaultNotificationPreference is used for the types a user has not set a
+// preference for. Only moderation actions are emailed by default.
+func defaultNotificationPreference(userID uint, notificationType string) *entities.NotificationPreference {
+	return &entities.NotificationPreference{
+		UserID: userID,
+		Type:   notificationType,
+		InApp:  true,
+		Email:  notificationType == entities.NotificationTypeModeration,
+	}
+}
+
+// notify delivers the notification in-app and by email, as the recipient
+// prefers. Users are not notified about their own actions, nor about those of
+// users they blocked or who blocked them. Notifications are secondary to what
+// the actor did, so failures are logged rather than returned.
+func (n *Notifier) notify(notification *entities.Notification) {
+	if n == nil {
+		return
+	}
+	var actor *entities.User
+	if notification.ActorID != nil {
+		if *notification.ActorID == notification.UserID {
+			return
+		}
+		blocked, err := n.blockRepo.ExistsBetween(notification.UserID, *notification.ActorID)
+		if err != nil {
+			logging.Log().Error("failed to check blocks for notification", zap.Error(err), zap.Uint("user_id", notification.UserID))
+			return
+		}
+		if blocked {
+			return
+		}
+		if actor, err = n.userRepo.FindByID(*notification.ActorID); err != nil {
+			logging.Log().Error("failed to find notification actor", zap.Error(err), zap.Uint("actor_id", *notification.ActorID))
+			return
+		}
+	}
+
+	preference, err := n.preferenceRepo.FindByUserIDAndType(notification.UserID, notification.Type)
+	switch {
+	case errors.Is(err, repositories.ErrNotFound):
+		preference = defaultNotificationPreference(notification.UserID, notification.Type)
+	case err != nil:
+		logging.Log().Error("failed to find notification preference", zap.Error(err), zap.Uint("user_id", notification.UserID))
+		return
+	}
+
+	if preference.InApp {
+		if err := n.notificationRepo.Create(notification); err != nil {
+			logging.Log().Error("failed to create notification", zap.Error(err), zap.String("type", notification.Type), zap.Uint("user_id", notification.UserID))
+		}
+	}
+	if preference.Email {
+		n.sendEmail(notification.UserID, notificationMessage(notification.Type, actor))
+	}
+}
+
+// notifyOnce is notify for notifications that can be triggered again by
+// undoing and redoing the same action, such as likes. It skips the
+// notification while an identical one is still unread.
+func (n *Notifier) notifyOnce(notification *entities.Notification) {
+	if n == nil {
+		return
+	}
+	exists, err := n.notificationRepo.ExistsUnread(notification)
+	if err != nil {
+		logging.Log().Error("failed to check unread notifications", zap.Error(err), zap.Uint("user_id", notification.UserID))
+		return
+	}
+	if exists {
+		return
+	}
+	n.notify(notification)
+}
+
+// notifyMentions notifies the users mentioned as @nickname in text, except
+// the ones in skip, who were already notified about the same content.
+func (n *Notifier) notifyMentions(actorID uint, text string, postID, commentID *uint, skip ...uint) {
+	if n == nil {
+		return
+	}
+	n.notifyMentioned(actorID, parseMentions(text), postID, commentID, skip)
+}
+
+// notifyNewMentions notifies the users mentioned in edited text who were not
+// mentioned before the edit, so that editing does not notify anyone twice.
+func (n *Notifier) notifyNewMentions(actorID uint, before, after string, postID, commentID *uint) {
+	if n == nil {
+		return
+	}
+	previous := make(map[string]bool)
+	for _, nickname := range parseMentions(before) {
+		previous[nickname] = true
+	}
+	var added []string
+	for _, nickname := range parseMentions(after) {
+		if !previous[nickname] {
+			added = append(added, nickname)
+		}
+	}
+	n.notifyMentioned(actorID, added, postID, commentID, nil)
+}
+
+func (n *Notifier) notifyMentioned(actorID uint, nicknames []string, postID, commentID *uint, skip []uint) {
+	notified := make(map[uint]bool, len(skip))
+	for _, id := range skip {
+		notified[id] = true
+	}
+	for _, nickname := range nicknames {
+		user, err := n.userRepo.FindByNickname(nickname)
+		if err != nil {
+			if !errors.Is(err, repositories.ErrNotFound) {
+				logging.Log().Error("failed to find mentioned user", zap.Error(err), zap.String("nickname", nickname))
+			}
+			continue
+		}
+		if notified[user.ID] {
+			continue
+		}
+		notified[user.ID] = true
+		n.notify(&entities.Notification{UserID: user.ID, ActorID: &actorID, Type: entities.NotificationTypeMention, PostID: postID, CommentID: commentID})
+	}
+}
+
+// parseMentions returns the distinct nicknames mentioned in text, up to
+// maxMentions.
+func parseMentions(text string) []string {
+	var nicknames []string
+	seen := make(map[string]bool)
+	for _, match := range mentionPattern.FindAllStringSubmatch(text, -1) {
+		// Punctuation ending a sentence is not part of the nickname.
+		nickname := strings.TrimRight(match[1], ".-")
+		if nickname == "" || seen[nickname] {
+			continue
+		}
+		seen[nickname] = true
+		nicknames = append(nicknames, nickname)
+		if len(nicknames) == maxMentions {
+			break
+		}
+	}
+	return nicknames
+}
+
+// sendEmail emails the message in the background, so that slow mail servers
+// do not hold up the request.
+func (n *Notifier) sendEmail(userID uint, message string) {
+	user, err := n.userRepo.FindByID(userID)
+	if err != nil {
+		logging.Log().Error("failed to find notification recipient", zap.Error(err), zap.Uint("user_id", userID))
+		return
+	}
+	address, err := n.emailEncryptor.Decrypt(user.Email)
+	if err != nil {
+		logging.Log().Error("failed to decrypt notification recipient email", zap.Error(err), zap.Uint("user_id", userID))
+		return
+	}
+	go func() {
+		data := email.NotificationData{Name: user.Name, Message: message}
+		if err := n.emailSender.SendEmail(address, email.TemplateNotification, data); err != nil {
+			logging.Log().Error("failed to send notification email", zap.Error(err), zap.Uint("user_id", userID))
+		}
+	}()
+}
+
+// notificationMessage describes the notification to its recipient.
+func notificationMessage(notificationType string, actor *entities.User) string {
+	name := "알 수 없는 사용자"
+	if actor != nil {
+		name = actor.Nickname
+	}
+	switch notificationType {
+	case entities.NotificationTypeReply:
+		return fmt.Sprintf("%s님이 회원님의 글에 댓글을 남겼습니다.", name)
+	case entities.NotificationTypePostLike:
+		return fmt.Sprintf("%s님이 회원님의 게시글을 좋아합니다.", name)
+	case entities.NotificationTypeFollow:
+		return fmt.Sprintf("%s님이 회원님을 팔로우하기 시작했습니다.", name)
+	case entities.NotificationTypeMention:
+		return fmt.Sprintf("%s님이 회원님을 언급했습니다.", name)
+	case entities.NotificationTypeModeration:
+		return "회원님의 계정 또는 콘텐츠에 운영 조치가 취해졌습니다."
+	}
+	return ""
+}
@@ -0,0 +1,177 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/email"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNotifier_Notify(t *testing.T) {
+	actorID := uint(2)
+	actor := &entities.User{ID: actorID, Nickname: "alice"}
+
+	t.Run("DefaultPreference", func(t *testing.T) {
+		// Setup
+		notificationRepo := &mocks.NotificationRepository{}
+		preferenceRepo := &mocks.NotificationPreferenceRepository{}
+		userRepo := &mocks.UserRepository{}
+		blockRepo := &mocks.UserBlockRepository{}
+		emailSender := &mocks.EmailSender{}
+
+		notifier := NewNotifier(notificationRepo, preferenceRepo, userRepo, blockRepo, nil, emailSender)
+		notification := &entities.Notification{UserID: 1, ActorID: &actorID, Type: entities.NotificationTypeFollow}
+
+		// Expectations
+		blockRepo.On("ExistsBetween", uint(1), actorID).Return(false, nil)
+		userRepo.On("FindByID", actorID).Return(actor, nil)
+		preferenceRepo.On("FindByUserIDAndType", uint(1), entities.NotificationTypeFollow).Return(nil, repositories.ErrNotFound)
+		notificationRepo.On("Create", notification).Return(nil)
+
+		// Execute
+		notifier.notify(notification)
+
+		// Verify
+		notificationRepo.AssertExpectations(t)
+		emailSender.AssertNotCalled(t, "SendEmail", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("EmailOnly", func(t *testing.T) {
+		// Setup
+		notificationRepo := &mocks.NotificationRepository{}
+		preferenceRepo := &mocks.NotificationPreferenceRepository{}
+		userRepo := &mocks.UserRepository{}
+		blockRepo := &mocks.UserBlockRepository{}
+		emailEncryptor := &mocks.Encryptor{}
+		emailSender := &mocks.EmailSender{}
+
+		notifier := NewNotifier(notificationRepo, preferenceRepo, userRepo, blockRepo, emailEncryptor, emailSender)
+		sent := make(chan struct{})
+
+		// Expectations
+		blockRepo.On("ExistsBetween", uint(1), actorID).Return(false, nil)
+		userRepo.On("FindByID", actorID).Return(actor, nil)
+		userRepo.On("FindByID", uint(1)).Return(&entities.User{ID: 1, Name: "Bob", Email: "encrypted"}, nil)
+		preferenceRepo.On("FindByUserIDAndType", uint(1), entities.NotificationTypePostLike).
+			Return(&entities.NotificationPreference{UserID: 1, Type: entities.NotificationTypePostLike, Email: true}, nil)
+		emailEncryptor.On("Decrypt", "encrypted").Return("bob@example.com", nil)
+		emailSender.On("SendEmail", "bob@example.com", email.TemplateNotification,
+			email.NotificationData{Name: "Bob", Message: "alice님이 회원님의 게시글을 좋아합니다."}).
+			Run(func(mock.Arguments) { close(sent) }).Return(nil)
+
+		// Execute
+		notifier.notify(&entities.Notification{UserID: 1, ActorID: &actorID, Type: entities.NotificationTypePostLike})
+
+		// Assert
+		select {
+		case <-sent:
+		case <-time.After(time.Second):
+			t.Fatal("notification email was not sent")
+		}
+
+		// Verify
+		notificationRepo.AssertNotCalled(t, "Create", mock.Anything)
+		emailSender.AssertExpectations(t)
+	})
+
+	t.Run("SkipsSelfAndBlocked", func(t *testing.T) {
+		// Setup
+		notificationRepo := &mocks.NotificationRepository{}
+		blockRepo := &mocks.UserBlockRepository{}
+
+		notifier := NewNotifier(notificationRepo, nil, nil, blockRepo, nil, nil)
+
+		// Expectations
+		blockRepo.On("ExistsBetween", uint(1), actorID).Return(true, nil)
+
+		// Execute
+		notifier.notify(&entities.Notification{UserID: actorID, ActorID: &actorID, Type: entities.NotificationTypeMention})
+		notifier.notify(&entities.Notification{UserID: 1, ActorID: &actorID, Type: entities.NotificationTypeMention})
+
+		// Verify
+		blockRepo.AssertNumberOfCalls(t, "ExistsBetween", 1)
+		notificationRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+}
+
+func TestNotifier_NotifyMentions(t *testing.T) {
+	// Setup
+	notificationRepo := &mocks.NotificationRepository{}
+	preferenceRepo := &mocks.NotificationPreferenceRepository{}
+	userRepo := &mocks.UserRepository{}
+	blockRepo := &mocks.UserBlockRepository{}
+
+	notifier := NewNotifier(notificationRepo, preferenceRepo, userRepo, blockRepo, nil, nil)
+	postID := uint(10)
+
+	// Expectations
+	userRepo.On("FindByNickname", "bob").Return(&entities.User{ID: 3, Nickname: "bob"}, nil)
+	userRepo.On("FindByNickname", "carol").Return(&entities.User{ID: 4, Nickname: "carol"}, nil)
+	userRepo.On("FindByNickname", "nobody").Return(nil, repositories.ErrNotFound)
+	userRepo.On("FindByID", uint(2)).Return(&entities.User{ID: 2, Nickname: "alice"}, nil)
+	blockRepo.On("ExistsBetween", uint(4), uint(2)).Return(false, nil)
+	preferenceRepo.On("FindByUserIDAndType", uint(4), entities.NotificationTypeMention).Return(nil, repositories.ErrNotFound)
+	notificationRepo.On("Create", mock.MatchedBy(func(n *entities.Notification) bool {
+		return n.UserID == 4 && n.Type == entities.NotificationTypeMention && *n.PostID == postID
+	})).Return(nil).Once()
+
+	// Execute
+	// bob was already notified about the same content.
+	notifier.notifyMentions(2, "@bob @carol and @nobody, mail me at alice@example.com", &postID, nil, 3)
+
+	// Verify
+	notificationRepo.AssertExpectations(t)
+}
+
+func TestNotifier_NotifyNewMentions(t *testing.T) {
+	// Setup
+	notificationRepo := &mocks.NotificationRepository{}
+	preferenceRepo := &mocks.NotificationPreferenceRepository{}
+	userRepo := &mocks.UserRepository{}
+	blockRepo := &mocks.UserBlockRepository{}
+
+	notifier := NewNotifier(notificationRepo, preferenceRepo, userRepo, blockRepo, nil, nil)
+	postID, commentID := uint(10), uint(20)
+
+	// Expectations
+	userRepo.On("FindByNickname", "carol").Return(&entities.User{ID: 4, Nickname: "carol"}, nil)
+	userRepo.On("FindByID", uint(2)).Return(&entities.User{ID: 2, Nickname: "alice"}, nil)
+	blockRepo.On("ExistsBetween", uint(4), uint(2)).Return(false, nil)
+	preferenceRepo.On("FindByUserIDAndType", uint(4), entities.NotificationTypeMention).Return(nil, repositories.ErrNotFound)
+	notificationRepo.On("Create", mock.MatchedBy(func(n *entities.Notification) bool {
+		return n.UserID == 4 && n.Type == entities.NotificationTypeMention && *n.CommentID == commentID
+	})).Return(nil).Once()
+
+	// Execute
+	// bob was mentioned before the edit and is not notified again.
+	notifier.notifyNewMentions(2, "thanks @bob", "thanks @bob and @carol", &postID, &commentID)
+
+	// Verify
+	notificationRepo.AssertExpectations(t)
+	userRepo.AssertNotCalled(t, "FindByNickname", "bob")
+}
+
+func TestParseMentions(t *testing.T) {
+	testCases := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{name: "Plain", text: "@alice thanks", want: []string{"alice"}},
+		{name: "Punctuation", text: "thanks @alice. and (@bob)!", want: []string{"alice", "bob"}},
+		{name: "Duplicate", text: "@alice @alice", want: []string{"alice"}},
+		{name: "Unicode", text: "@음악사랑 추천 감사해요", want: []string{"음악사랑"}},
+		{name: "Email", text: "alice@example.com", want: nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, parseMentions(tc.text))
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package usecase
+
+const (
+	defaultPageLimit = 20
+	maxPageLimit     = 50
+)
+
+func pagination(limit, offset *int) (int, int) {
+	l, o := defaultPageLimit, 0
+	if limit != nil && *limit > 0 {
+		l = *limit
+	}
+	if l > maxPageLimit {
+		l = maxPageLimit
+	}
+	if offset != nil && *offset > 0 {
+		o = *offset
+	}
+	return l, o
+}
@@ -0,0 +1,175 @@
+package usecase
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/playlistformat"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+)
+
+const (
+	matchedBySpotifyID = "spotify_id"
+	matchedByISRC      = "isrc"
+	matchedByFuzzy     = "fuzzy"
+
+	fuzzyMatchCandidates = 20
+	minTitleSimilarity   = 0.85
+	minArtistSimilarity  = 0.8
+	// Without an artist the title alone has to be a near exact match.
+	minTitleOnlySimilarity = 0.95
+)
+
+var (
+	// Version notes such as "(Remastered 2011)", "[Live]" or "- Radio Edit"
+	// differ between services and are ignored when comparing titles.
+	titleVersionPattern = regexp.MustCompile(`\s*[(\[][^)\]]*[)\]]|\s+-\s+.*$`)
+	featuringPattern    = regexp.MustCompile(`(?i)\s+(feat\.?|ft\.?|featuring)\s+.*$`)
+	artistSeparator     = regexp.MustCompile(`(?i)\s*(,|;|&|\s+x\s+|\s+feat\.?\s+|\s+ft\.?\s+|\s+featuring\s+)\s*`)
+)
+
+// matchPlaylistTrack finds the catalog track for a playlist entry. It returns
+// a nil track when nothing matches.
+func (u *collectionUsecase) matchPlaylistTrack(t playlistformat.Track) (*entities.Music, string, error) {
+	if t.SpotifyID != "" {
+		music, err := u.musicRepo.FindBySpotifyID(t.SpotifyID)
+		if err == nil {
+			return music, matchedBySpotifyID, nil
+		}
+		if !errors.Is(err, repositories.ErrNotFound) {
+			return nil, "", ErrFindingRecord
+		}
+	}
+
+	if t.ISRC != "" {
+		music, err := u.musicRepo.FindByISRC(t.ISRC)
+		if err != nil && !errors.Is(err, repositories.ErrNotFound) {
+			return nil, "", ErrFindingRecord
+		}
+		if len(music) > 0 {
+			return music[0], matchedByISRC, nil
+		}
+	}
+
+	title := baseTrackTitle(t.Title)
+	if title == "" {
+		return nil, "", nil
+	}
+	candidates, err := u.musicRepo.SearchByTitle(title, 0, fuzzyMatchCandidates)
+	if err != nil {
+		return nil, "", ErrFindingRecord
+	}
+	if music := bestFuzzyMatch(t, candidates); music != nil {
+		return music, matchedByFuzzy, nil
+	}
+	return nil, "", nil
+}
+
+// bestFuzzyMatch picks the candidate whose title and artists are closest to
+// the entry, or nil if none is close enough.
+func bestFuzzyMatch(t playlistformat.Track, candidates []*entities.Music) *entities.Music {
+	title := normalizeForMatching(baseTrackTitle(t.Title))
+	artists := splitArtists(t.Artist)
+
+	var best *entities.Music
+	bestScore := 0.0
+	for _, c := range candidates {
+		titleScore := similarity(title, normalizeForMatching(baseTrackTitle(c.Title)))
+		score := titleScore
+		if len(artists) == 0 {
+			if titleScore < minTitleOnlySimilarity {
+				continue
+			}
+		} else {
+			artistScore := 0.0
+			for _, a := range c.MusicArtistMapping {
+				for _, name := range artists {
+					artistScore = max(artistScore, similarity(name, normalizeForMatching(a.Artist.Name)))
+				}
+			}
+			if titleScore < minTitleSimilarity || artistScore < minArtistSimilarity {
+				continue
+			}
+			score += artistScore
+		}
+		if score > bestScore {
+			best, bestScore = c, score
+		}
+	}
+	return best
+}
+
+// baseTrackTitle strips version notes and featured artists from a title.
+func baseTrackTitle(title string) string {
+	title = featuringPattern.ReplaceAllString(title, "")
+	stripped := strings.TrimSpace(titleVersionPattern.ReplaceAllString(title, ""))
+	if stripped == "" {
+		// The whole title is in brackets, e.g. "(Untitled)".
+		return strings.TrimSpace(title)
+	}
+	return stripped
+}
+
+// splitArtists returns the credited artists along with the full credit, so
+// that names like "Simon & Garfunkel" still match as a whole.
+func splitArtists(artist string) []string {
+	names := []string{}
+	if full := normalizeForMatching(artist); full != "" {
+		names = append(names, full)
+	}
+	for _, name := range artistSeparator.Split(artist, -1) {
+		if name = normalizeForMatching(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// normalizeForMatching lowercases the string and drops punctuation, so that
+// "Don't Stop" and "dont stop" compare equal.
+func normalizeForMatching(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(s) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(r)
+		case unicode.IsSpace(r):
+			b.WriteRune(' ')
+		}
+	}
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+// similarity returns 1 for equal strings and approaches 0 as the edit
+// distance grows relative to the longer string.
+func similarity(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	longest := max(len(ra), len(rb))
+	if longest == 0 {
+		return 1
+	}
+	return 1 - float64(levenshtein(ra, rb))/float64(longest)
+}
+
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,447 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/contentfilter"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/logging"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/markdown"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"go.uber.org/zap"
+)
+
+type PostUsecase interface {
+	CreatePost(ctx context.Context, userID, communityID uint, input *CreatePostInput) (*PostOutput, error)
+	GetPost(postID uint) (*PostOutput, error)
+	PatchPost(ctx context.Context, userID, postID uint, input *PatchPostInput) (*PostOutput, error)
+	DeletePost(userID, postID uint) error
+	ListCommunityPosts(viewerID, communityID uint, sort string, limit, offset *int) (*ListPostsOutput, error)
+	ListPosts(viewerID uint, sort string, limit, offset *int) (*ListPostsOutput, error)
+	SearchPosts(viewerID uint, input *SearchPostsInput, limit, offset *int) (*ListPostsOutput, error)
+}
+
+const (
+	PostSearchTitle   = "title"
+	PostSearchContent = "content"
+)
+
+// maxLinkPreviews limits the previews generated per post, since each one is
+// fetched while the post is saved.
+const maxLinkPreviews = 3
+
+// linkPreviewTimeout limits the time spent fetching all of a post's previews,
+// so that slow sites add little to saving the post.
+const linkPreviewTimeout = 3 * time.Second
+
+type postUsecase struct {
+	postRepo      repositories.PostRepository
+	communityRepo repositories.GenresCommunityRepository
+	memberRepo    repositories.CommunityMemberRepository
+	previewRepo   repositories.LinkPreviewRepository
+	musicEmbedder *MusicEmbedder
+	screener      *ContentScreener
+	activities    *ActivityRecorder
+	notifier      *Notifier
+
+	markdown       markdown.Renderer
+	previewFetcher markdown.PreviewFetcher
+}
+
+func NewPostUsecase(postRepo repositories.PostRepository, communityRepo repositories.GenresCommunityRepository, memberRepo repositories.CommunityMemberRepository, previewRepo repositories.LinkPreviewRepository, musicEmbedder *MusicEmbedder, markdownRenderer markdown.Renderer, previewFetcher markdown.PreviewFetcher, screener *ContentScreener, activities *ActivityRecorder, notifier *Notifier) PostUsecase {
+	return &postUsecase{
+		postRepo:       postRepo,
+		communityRepo:  communityRepo,
+		memberRepo:     memberRepo,
+		previewRepo:    previewRepo,
+		musicEmbedder:  musicEmbedder,
+		markdown:       markdownRenderer,
+		previewFetcher: previewFetcher,
+		screener:       screener,
+		activities:     activities,
+		notifier:       notifier,
+	}
+}
+
+// CreatePost publishes a post in the community, with the music it references
+// attached. The Markdown content is rendered, and previews are generated for
+// the whitelisted sites it links to. Only members of the community can post
+// in it. The post is stored as the content filter masked it, and posts the
+// filter holds stay hidden until a moderator approves them. Other posts
+// notify the users they mention.
+func (u *postUsecase) CreatePost(ctx context.Context, userID, communityID uint, input *CreatePostInput) (*PostOutput, error) {
+	if _, err := u.communityRepo.FindByID(communityID); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrCommunityNotFound
+		}
+		return nil, ErrFindingRecord
+	}
+	if _, err := u.memberRepo.FindByCommunityIDAndUserID(communityID, userID); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrCommunityMembershipRequired
+		}
+		return nil, ErrFindingRecord
+	}
+	screened, err := u.screener.screen(contentfilter.Content{Kind: contentfilter.KindPost, UserID: userID, Title: input.Title, Body: input.Content})
+	if err != nil {
+		return nil, err
+	}
+	musicIDs, err := u.musicEmbedder.resolve(ctx, input.Music)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := u.renderContent(screened.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	post := &entities.Post{
+		UserID:           userID,
+		GenreCommunityID: communityID,
+		Title:            screened.Title,
+		Content:          screened.Body,
+		ContentHTML:      doc.HTML,
+		IsHidden:         screened.Action == contentfilter.ActionHold,
+	}
+	if err := u.postRepo.Create(post); err != nil {
+		return nil, ErrCreatingRecord
+	}
+	if post.IsHidden {
+		if err := u.screener.hold(entities.ReportTargetPost, post.ID, userID, communityID, post.Title+"\n\n"+post.Content, screened); err != nil {
+			return nil, err
+		}
+	}
+	// The post is stored by now, so it is kept without its track cards
+	// rather than failing the request.
+	if err := u.musicEmbedder.attachToPost(post.ID, musicIDs); err != nil {
+		logging.Log().Error("failed to attach music to post", zap.Error(err), zap.Uint("post_id", post.ID))
+	}
+	u.refreshLinkPreviews(ctx, post.ID, doc.Links)
+	u.activities.record(&entities.Activity{ActorID: userID, Type: entities.ActivityTypePost, PostID: &post.ID})
+	if !post.IsHidden {
+		u.notifier.notifyMentions(userID, post.Title+"\n"+post.Content, &post.ID, nil)
+	}
+	// Reload the post so that the output includes the author. GetPost cannot
+	// be used, since it does not return held posts.
+	post, err = u.postRepo.FindByID(post.ID)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	return u.toSinglePostOutput(post)
+}
+
+func (u *postUsecase) GetPost(postID uint) (*PostOutput, error) {
+	post, err := u.findPost(postID)
+	if err != nil {
+		return nil, err
+	}
+	return u.toSinglePostOutput(post)
+}
+
+// PatchPost updates the post's title, content and attached music. Only the
+// author can edit a post. The edited post goes through the content filter
+// like a new one, and users it newly mentions are notified.
+func (u *postUsecase) PatchPost(ctx context.Context, userID, postID uint, input *PatchPostInput) (*PostOutput, error) {
+	post, err := u.authoredPost(userID, postID)
+	if err != nil {
+		return nil, err
+	}
+	title, content := post.Title, post.Content
+	mentionedBefore := post.Title + "\n" + post.Content
+	if input.Title != nil {
+		title = *input.Title
+	}
+	if input.Content != nil {
+		content = *input.Content
+	}
+	screened, err := u.screener.screen(contentfilter.Content{Kind: contentfilter.KindPost, ID: post.ID, UserID: userID, Title: title, Body: content})
+	if err != nil {
+		return nil, err
+	}
+	var musicIDs []uint
+	if input.Music != nil {
+		if musicIDs, err = u.musicEmbedder.resolve(ctx, input.Music); err != nil {
+			return nil, err
+		}
+	}
+
+	var doc *markdown.Document
+	if input.Content != nil || screened.Body != post.Content {
+		if doc, err = u.renderContent(screened.Body); err != nil {
+			return nil, err
+		}
+	}
+
+	post.Title = screened.Title
+	if doc != nil {
+		post.Content = screened.Body
+		post.ContentHTML = doc.HTML
+	}
+	if screened.Action == contentfilter.ActionHold {
+		post.IsHidden = true
+	}
+	if err := u.postRepo.Update(post); err != nil {
+		return nil, ErrUpdatingRecord
+	}
+	if screened.Action == contentfilter.ActionHold {
+		if err := u.screener.hold(entities.ReportTargetPost, post.ID, userID, post.GenreCommunityID, post.Title+"\n\n"+post.Content, screened); err != nil {
+			return nil, err
+		}
+	}
+	if input.Music != nil {
+		if err := u.musicEmbedder.attachToPost(post.ID, musicIDs); err != nil {
+			logging.Log().Error("failed to attach music to post", zap.Error(err), zap.Uint("post_id", post.ID))
+		}
+	}
+	if doc != nil {
+		u.refreshLinkPreviews(ctx, post.ID, doc.Links)
+	}
+	if !post.IsHidden {
+		u.notifier.notifyNewMentions(userID, mentionedBefore, post.Title+"\n"+post.Content, &post.ID, nil)
+	}
+	return u.toSinglePostOutput(post)
+}
+
+// DeletePost deletes the post with its comments. Only the author can delete a
+// post.
+func (u *postUsecase) DeletePost(userID, postID uint) error {
+	post, err := u.authoredPost(userID, postID)
+	if err != nil {
+		return err
+	}
+	if err := u.postRepo.Delete(post.ID); err != nil {
+		return ErrDeletingRecord
+	}
+	return nil
+}
+
+// ListCommunityPosts returns the community's posts, leaving out those by users
+// the viewer blocked or who blocked the viewer.
+func (u *postUsecase) ListCommunityPosts(viewerID, communityID uint, sort string, limit, offset *int) (*ListPostsOutput, error) {
+	sort, err := postSort(sort)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := u.communityRepo.FindByID(communityID); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrCommunityNotFound
+		}
+		return nil, ErrFindingRecord
+	}
+
+	l, o := pagination(limit, offset)
+	viewer := repositories.Viewer{ID: viewerID}
+	posts, err := u.postRepo.FindByGenreCommunityID(viewer, communityID, sort, o, l)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	total, err := u.postRepo.CountByGenreCommunityID(viewer, communityID)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	return u.toListPostsOutput(posts, total)
+}
+
+// ListPosts returns posts from all communities as the viewer's feed, which
+// also leaves out the users the viewer muted.
+func (u *postUsecase) ListPosts(viewerID uint, sort string, limit, offset *int) (*ListPostsOutput, error) {
+	sort, err := postSort(sort)
+	if err != nil {
+		return nil, err
+	}
+
+	l, o := pagination(limit, offset)
+	viewer := repositories.Viewer{ID: viewerID, HideMuted: true}
+	posts, err := u.postRepo.FindAll(viewer, sort, o, l)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	total, err := u.postRepo.CountAll(viewer)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	return u.toListPostsOutput(posts, total)
+}
+
+// SearchPosts finds posts whose title, or content, contains the query, newest
+// first. The search can be limited to a single community, and leaves out posts
+// by users the viewer blocked or who blocked the viewer.
+func (u *postUsecase) SearchPosts(viewerID uint, input *SearchPostsInput, limit, offset *int) (*ListPostsOutput, error) {
+	search, count := u.postRepo.SearchByTitle, u.postRepo.CountByTitle
+	switch input.Field {
+	case "", PostSearchTitle:
+	case PostSearchContent:
+		search, count = u.postRepo.SearchByContent, u.postRepo.CountByContent
+	default:
+		return nil, ErrInvalidPostSearchField
+	}
+
+	l, o := pagination(limit, offset)
+	viewer := repositories.Viewer{ID: viewerID}
+	posts, err := search(viewer, input.Query, input.CommunityID, o, l)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	total, err := count(viewer, input.Query, input.CommunityID)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	return u.toListPostsOutput(posts, total)
+}
+
+func (u *postUsecase) renderContent(content string) (*markdown.Document, error) {
+	doc, err := u.markdown.Render(content)
+	if err != nil {
+		return nil, ErrRenderingContent
+	}
+	return doc, nil
+}
+
+// refreshLinkPreviews replaces the post's previews with those of the first
+// whitelisted links. Pages that cannot be previewed within linkPreviewTimeout
+// are skipped, and a failure to store the previews is only logged, so that
+// previews never block a post from being saved.
+func (u *postUsecase) refreshLinkPreviews(ctx context.Context, postID uint, links []string) {
+	ctx, cancel := context.WithTimeout(ctx, linkPreviewTimeout)
+	defer cancel()
+
+	previews := []*entities.LinkPreview{}
+	for _, link := range links {
+		if len(previews) == maxLinkPreviews {
+			break
+		}
+		if !u.previewFetcher.Allowed(link) {
+			continue
+		}
+		preview, err := u.previewFetcher.Fetch(ctx, link)
+		if err != nil {
+			logging.Log().Warn("failed to fetch link preview", zap.Error(err), zap.Uint("post_id", postID), zap.String("url", link))
+			continue
+		}
+		previews = append(previews, &entities.LinkPreview{
+			URL:         preview.URL,
+			Title:       preview.Title,
+			Description: preview.Description,
+			ImageURL:    preview.ImageURL,
+			SiteName:    preview.SiteName,
+		})
+	}
+
+	if err := u.previewRepo.ReplaceByPostID(postID, previews); err != nil {
+		logging.Log().Error("failed to save link previews", zap.Error(err), zap.Uint("post_id", postID))
+	}
+}
+
+// findPost returns the post unless a moderator has hidden it.
+func (u *postUsecase) findPost(postID uint) (*entities.Post, error) {
+	post, err := u.postRepo.FindByID(postID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrPostNotFound
+		}
+		return nil, ErrFindingRecord
+	}
+	if post.IsHidden {
+		return nil, ErrPostNotFound
+	}
+	return post, nil
+}
+
+func (u *postUsecase) authoredPost(userID, postID uint) (*entities.Post, error) {
+	post, err := u.findPost(postID)
+	if err != nil {
+		return nil, err
+	}
+	if post.UserID != userID {
+		return nil, ErrPostPermissionDenied
+	}
+	return post, nil
+}
+
+func (u *postUsecase) toSinglePostOutput(post *entities.Post) (*PostOutput, error) {
+	output, err := u.toListPostsOutput([]*entities.Post{post}, 1)
+	if err != nil {
+		return nil, err
+	}
+	return &output.Posts[0], nil
+}
+
+func (u *postUsecase) toListPostsOutput(posts []*entities.Post, total int64) (*ListPostsOutput, error) {
+	postIDs := make([]uint, len(posts))
+	for i, p := range posts {
+		postIDs[i] = p.ID
+	}
+	cards, err := u.musicEmbedder.postCards(postIDs)
+	if err != nil {
+		return nil, err
+	}
+	previews, err := u.previewRepo.FindByPostIDs(postIDs)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	previewsByPost := make(map[uint][]LinkPreview)
+	for _, p := range previews {
+		previewsByPost[p.PostID] = append(previewsByPost[p.PostID], LinkPreview{
+			URL:         p.URL,
+			Title:       p.Title,
+			Description: p.Description,
+			ImageURL:    p.ImageURL,
+			SiteName:    p.SiteName,
+		})
+	}
+
+	output := &ListPostsOutput{Posts: make([]PostOutput, len(posts)), Total: int(total)}
+	for i, p := range posts {
+		post, err := u.toPostOutput(p, cards[p.ID], previewsByPost[p.ID])
+		if err != nil {
+			return nil, err
+		}
+		output.Posts[i] = post
+	}
+	return output, nil
+}
+
+func (u *postUsecase) toPostOutput(p *entities.Post, tracks []TrackCard, previews []LinkPreview) (PostOutput, error) {
+	likes, dislikes, err := u.postRepo.CountLikesAndDislikesByID(p.ID)
+	if err != nil {
+		return PostOutput{}, ErrFindingRecord
+	}
+	// Posts written before Markdown support have no stored rendering.
+	contentHTML := p.ContentHTML
+	if contentHTML == "" && p.Content != "" {
+		doc, err := u.renderContent(p.Content)
+		if err != nil {
+			return PostOutput{}, err
+		}
+		contentHTML = doc.HTML
+	}
+	return PostOutput{
+		ID:               p.ID,
+		UserID:           p.UserID,
+		Nickname:         p.User.Nickname,
+		GenreCommunityID: p.GenreCommunityID,
+		Title:            p.Title,
+		Content:          p.Content,
+		ContentHTML:      contentHTML,
+		Likes:            likes,
+		Dislikes:         dislikes,
+		Tracks:           tracks,
+		LinkPreviews:     previews,
+		Hidden:           p.IsHidden,
+		CreatedAt:        p.CreatedAt,
+		UpdatedAt:        p.UpdatedAt,
+	}, nil
+}
+
+// postSort validates the sort, defaulting to the newest posts first.
+func postSort(sort string) (string, error) {
+	switch sort {
+	case "":
+		return repositories.PostSortNew, nil
+	case repositories.PostSortNew, repositories.PostSortTop, repositories.PostSortHot:
+		return sort, nil
+	}
+	return "", ErrInvalidPostSort
+}
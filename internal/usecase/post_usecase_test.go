@@ -0,0 +1,388 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/markdown"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase/mocks"
+	"github.com/myjinjin/sonic-odyssey-backend/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPostUsecase_CreatePost(t *testing.T) {
+	t.Run("MemberWithMusic", func(t *testing.T) {
+		// Setup
+		postRepo := &mocks.PostRepository{}
+		attachmentRepo := &mocks.MusicAttachmentRepository{}
+		previewRepo := &mocks.LinkPreviewRepository{}
+		previewFetcher := &mocks.PreviewFetcher{}
+		communityRepo := &mocks.GenresCommunityRepository{}
+		memberRepo := &mocks.CommunityMemberRepository{}
+		musicRepo := &mocks.MusicRepository{}
+		userLikeRepo := &mocks.UserLikeRepository{}
+
+		musicEmbedder := NewMusicEmbedder(nil, musicRepo, nil, nil, nil, nil, nil, nil, userLikeRepo, attachmentRepo)
+		postUsecase := NewPostUsecase(postRepo, communityRepo, memberRepo, previewRepo, musicEmbedder, markdown.New(), previewFetcher, unfilteredScreener, nil, nil)
+
+		music := &entities.Music{
+			ID:                 42,
+			Title:              "Wise Up",
+			AlbumID:            7,
+			Album:              &entities.Album{ID: 7, Name: "Magnolia", ImageURL: "https://example.com/cover.jpg"},
+			MusicArtistMapping: []entities.MusicArtistMapping{{Artist: entities.Artist{ID: 3, Name: "Aimee Mann"}}},
+		}
+
+		// Expectations
+		communityRepo.On("FindByID", uint(10)).Return(&entities.GenreCommunity{ID: 10}, nil)
+		memberRepo.On("FindByCommunityIDAndUserID", uint(10), uint(1)).Return(&entities.CommunityMember{Role: entities.CommunityRoleMember}, nil)
+		musicRepo.On("FindByID", uint(42)).Return(music, nil)
+		musicRepo.On("FindBySpotifyID", "4uLU6hMCjMI75M1A2tKUQC").Return(music, nil)
+		postRepo.On("Create", mock.MatchedBy(func(p *entities.Post) bool {
+			return p.UserID == 1 && p.GenreCommunityID == 10 && p.Title == "Hello"
+		})).Run(func(args mock.Arguments) {
+			args.Get(0).(*entities.Post).ID = 5
+		}).Return(nil)
+		attachmentRepo.On("ReplaceByPostID", uint(5), []uint{42}).Return(nil)
+		previewRepo.On("ReplaceByPostID", uint(5), []*entities.LinkPreview{}).Return(nil)
+		postRepo.On("FindByID", uint(5)).Return(&entities.Post{ID: 5, UserID: 1, User: entities.User{Nickname: "author"}, GenreCommunityID: 10, Title: "Hello"}, nil)
+		postRepo.On("CountLikesAndDislikesByID", uint(5)).Return(int64(0), int64(0), nil)
+		attachmentRepo.On("FindByPostIDs", []uint{5}).Return([]*entities.MusicAttachment{{MusicID: 42, Music: *music, PostID: utils.ToPtr(uint(5))}}, nil)
+		userLikeRepo.On("CountLikesAndDislikesByMusicID", uint(42)).Return(int64(4), int64(1), nil)
+		previewRepo.On("FindByPostIDs", []uint{5}).Return([]*entities.LinkPreview{}, nil)
+
+		// Execute
+		output, err := postUsecase.CreatePost(context.Background(), 1, 10, &CreatePostInput{
+			Title:   "Hello",
+			Content: "World",
+			Music:   []string{"42", "https://open.spotify.com/intl-ko/track/4uLU6hMCjMI75M1A2tKUQC?si=abc"},
+		})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, uint(5), output.ID)
+		assert.Equal(t, "author", output.Nickname)
+		assert.Equal(t, []TrackCard{{
+			ID:        42,
+			Title:     "Wise Up",
+			Artists:   []CatalogArtist{{ID: 3, Name: "Aimee Mann"}},
+			AlbumID:   7,
+			AlbumName: "Magnolia",
+			ImageURL:  "https://example.com/cover.jpg",
+			Likes:     4,
+			Dislikes:  1,
+		}}, output.Tracks)
+
+		// Verify
+		postRepo.AssertExpectations(t)
+		attachmentRepo.AssertExpectations(t)
+	})
+
+	t.Run("MarkdownWithLinkPreviews", func(t *testing.T) {
+		// Setup
+		postRepo := &mocks.PostRepository{}
+		attachmentRepo := &mocks.MusicAttachmentRepository{}
+		previewRepo := &mocks.LinkPreviewRepository{}
+		previewFetcher := &mocks.PreviewFetcher{}
+		communityRepo := &mocks.GenresCommunityRepository{}
+		memberRepo := &mocks.CommunityMemberRepository{}
+
+		postUsecase := NewPostUsecase(postRepo, communityRepo, memberRepo, previewRepo, &MusicEmbedder{attachmentRepo: attachmentRepo}, markdown.New(), previewFetcher, unfilteredScreener, nil, nil)
+
+		content := "**Live** at https://www.youtube.com/watch?v=abc, https://youtu.be/down and [blog](https://example.com/post)"
+		expectedHTML := `<p><strong>Live</strong> at <a href="https://www.youtube.com/watch?v=abc" rel="nofollow">https://www.youtube.com/watch?v=abc</a>, ` +
+			`<a href="https://youtu.be/down" rel="nofollow">https://youtu.be/down</a> and <a href="https://example.com/post" rel="nofollow">blog</a></p>` + "\n"
+
+		// Expectations
+		communityRepo.On("FindByID", uint(10)).Return(&entities.GenreCommunity{ID: 10}, nil)
+		memberRepo.On("FindByCommunityIDAndUserID", uint(10), uint(1)).Return(&entities.CommunityMember{Role: entities.CommunityRoleMember}, nil)
+		postRepo.On("Create", mock.MatchedBy(func(p *entities.Post) bool {
+			return p.Content == content && p.ContentHTML == expectedHTML
+		})).Run(func(args mock.Arguments) {
+			args.Get(0).(*entities.Post).ID = 5
+		}).Return(nil)
+		attachmentRepo.On("ReplaceByPostID", uint(5), []uint{}).Return(nil)
+		previewFetcher.On("Allowed", "https://www.youtube.com/watch?v=abc").Return(true)
+		previewFetcher.On("Allowed", "https://youtu.be/down").Return(true)
+		previewFetcher.On("Allowed", "https://example.com/post").Return(false)
+		// Previews are fetched within a short deadline.
+		withDeadline := mock.MatchedBy(func(ctx context.Context) bool {
+			deadline, ok := ctx.Deadline()
+			return ok && time.Until(deadline) <= linkPreviewTimeout
+		})
+		previewFetcher.On("Fetch", withDeadline, "https://www.youtube.com/watch?v=abc").
+			Return(&markdown.LinkPreview{URL: "https://www.youtube.com/watch?v=abc", Title: "Wise Up (Live)", SiteName: "YouTube"}, nil)
+		previewFetcher.On("Fetch", mock.Anything, "https://youtu.be/down").Return(nil, markdown.ErrPreviewUnavailable)
+		previewRepo.On("ReplaceByPostID", uint(5), []*entities.LinkPreview{
+			{URL: "https://www.youtube.com/watch?v=abc", Title: "Wise Up (Live)", SiteName: "YouTube"},
+		}).Return(nil)
+		postRepo.On("FindByID", uint(5)).Return(&entities.Post{ID: 5, Content: content, ContentHTML: expectedHTML}, nil)
+		postRepo.On("CountLikesAndDislikesByID", uint(5)).Return(int64(0), int64(0), nil)
+		attachmentRepo.On("FindByPostIDs", []uint{5}).Return([]*entities.MusicAttachment{}, nil)
+		previewRepo.On("FindByPostIDs", []uint{5}).Return([]*entities.LinkPreview{
+			{PostID: 5, URL: "https://www.youtube.com/watch?v=abc", Title: "Wise Up (Live)", SiteName: "YouTube"},
+		}, nil)
+
+		// Execute
+		output, err := postUsecase.CreatePost(context.Background(), 1, 10, &CreatePostInput{Title: "Hello", Content: content})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, expectedHTML, output.ContentHTML)
+		assert.Equal(t, []LinkPreview{{URL: "https://www.youtube.com/watch?v=abc", Title: "Wise Up (Live)", SiteName: "YouTube"}}, output.LinkPreviews)
+
+		// Verify
+		postRepo.AssertExpectations(t)
+		previewRepo.AssertExpectations(t)
+		previewFetcher.AssertExpectations(t)
+	})
+
+	t.Run("SecondaryWritesFail", func(t *testing.T) {
+		// Setup
+		postRepo := &mocks.PostRepository{}
+		attachmentRepo := &mocks.MusicAttachmentRepository{}
+		previewRepo := &mocks.LinkPreviewRepository{}
+		previewFetcher := &mocks.PreviewFetcher{}
+		communityRepo := &mocks.GenresCommunityRepository{}
+		memberRepo := &mocks.CommunityMemberRepository{}
+
+		postUsecase := NewPostUsecase(postRepo, communityRepo, memberRepo, previewRepo, &MusicEmbedder{attachmentRepo: attachmentRepo}, markdown.New(), previewFetcher, unfilteredScreener, nil, nil)
+
+		// Expectations
+		communityRepo.On("FindByID", uint(10)).Return(&entities.GenreCommunity{ID: 10}, nil)
+		memberRepo.On("FindByCommunityIDAndUserID", uint(10), uint(1)).Return(&entities.CommunityMember{Role: entities.CommunityRoleMember}, nil)
+		postRepo.On("Create", mock.Anything).Run(func(args mock.Arguments) {
+			args.Get(0).(*entities.Post).ID = 5
+		}).Return(nil)
+		attachmentRepo.On("ReplaceByPostID", uint(5), []uint{}).Return(repositories.ErrCreate)
+		previewRepo.On("ReplaceByPostID", uint(5), []*entities.LinkPreview{}).Return(repositories.ErrCreate)
+		postRepo.On("FindByID", uint(5)).Return(&entities.Post{ID: 5, Title: "Hello"}, nil)
+		postRepo.On("CountLikesAndDislikesByID", uint(5)).Return(int64(0), int64(0), nil)
+		attachmentRepo.On("FindByPostIDs", []uint{5}).Return([]*entities.MusicAttachment{}, nil)
+		previewRepo.On("FindByPostIDs", []uint{5}).Return([]*entities.LinkPreview{}, nil)
+
+		// Execute
+		output, err := postUsecase.CreatePost(context.Background(), 1, 10, &CreatePostInput{Title: "Hello", Content: "World"})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, uint(5), output.ID)
+
+		// Verify
+		postRepo.AssertExpectations(t)
+		attachmentRepo.AssertExpectations(t)
+		previewRepo.AssertExpectations(t)
+	})
+
+	t.Run("InvalidMusicReference", func(t *testing.T) {
+		// Setup
+		postRepo := &mocks.PostRepository{}
+		attachmentRepo := &mocks.MusicAttachmentRepository{}
+		previewRepo := &mocks.LinkPreviewRepository{}
+		previewFetcher := &mocks.PreviewFetcher{}
+		communityRepo := &mocks.GenresCommunityRepository{}
+		memberRepo := &mocks.CommunityMemberRepository{}
+
+		postUsecase := NewPostUsecase(postRepo, communityRepo, memberRepo, previewRepo, &MusicEmbedder{attachmentRepo: attachmentRepo}, markdown.New(), previewFetcher, unfilteredScreener, nil, nil)
+
+		// Expectations
+		communityRepo.On("FindByID", uint(10)).Return(&entities.GenreCommunity{ID: 10}, nil)
+		memberRepo.On("FindByCommunityIDAndUserID", uint(10), uint(1)).Return(&entities.CommunityMember{Role: entities.CommunityRoleMember}, nil)
+
+		// Execute
+		_, err := postUsecase.CreatePost(context.Background(), 1, 10, &CreatePostInput{Title: "Hello", Content: "World", Music: []string{"https://example.com/track/1"}})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidMusicReference)
+
+		// Verify
+		postRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+
+	t.Run("NotMember", func(t *testing.T) {
+		// Setup
+		postRepo := &mocks.PostRepository{}
+		attachmentRepo := &mocks.MusicAttachmentRepository{}
+		previewRepo := &mocks.LinkPreviewRepository{}
+		previewFetcher := &mocks.PreviewFetcher{}
+		communityRepo := &mocks.GenresCommunityRepository{}
+		memberRepo := &mocks.CommunityMemberRepository{}
+
+		postUsecase := NewPostUsecase(postRepo, communityRepo, memberRepo, previewRepo, &MusicEmbedder{attachmentRepo: attachmentRepo}, markdown.New(), previewFetcher, unfilteredScreener, nil, nil)
+
+		// Expectations
+		communityRepo.On("FindByID", uint(10)).Return(&entities.GenreCommunity{ID: 10}, nil)
+		memberRepo.On("FindByCommunityIDAndUserID", uint(10), uint(1)).Return(nil, repositories.ErrNotFound)
+
+		// Execute
+		_, err := postUsecase.CreatePost(context.Background(), 1, 10, &CreatePostInput{Title: "Hello", Content: "World"})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrCommunityMembershipRequired)
+
+		// Verify
+		postRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+}
+
+func TestPostUsecase_PatchPost(t *testing.T) {
+	t.Run("Author", func(t *testing.T) {
+		// Setup
+		postRepo := &mocks.PostRepository{}
+		attachmentRepo := &mocks.MusicAttachmentRepository{}
+		previewRepo := &mocks.LinkPreviewRepository{}
+		previewFetcher := &mocks.PreviewFetcher{}
+
+		postUsecase := NewPostUsecase(postRepo, nil, nil, previewRepo, &MusicEmbedder{attachmentRepo: attachmentRepo}, markdown.New(), previewFetcher, unfilteredScreener, nil, nil)
+
+		// Expectations
+		postRepo.On("FindByID", uint(5)).Return(&entities.Post{ID: 5, UserID: 1, Title: "Old", Content: "Kept"}, nil)
+		postRepo.On("Update", mock.MatchedBy(func(p *entities.Post) bool {
+			return p.Title == "New" && p.Content == "Kept"
+		})).Return(nil)
+		postRepo.On("CountLikesAndDislikesByID", uint(5)).Return(int64(3), int64(1), nil)
+		attachmentRepo.On("ReplaceByPostID", uint(5), []uint{}).Return(nil)
+		attachmentRepo.On("FindByPostIDs", mock.Anything).Return([]*entities.MusicAttachment{}, nil)
+		previewRepo.On("FindByPostIDs", mock.Anything).Return([]*entities.LinkPreview{}, nil)
+
+		// Execute
+		output, err := postUsecase.PatchPost(context.Background(), 1, 5, &PatchPostInput{Title: utils.ToPtr("New"), Music: []string{}})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "New", output.Title)
+		assert.Equal(t, int64(3), output.Likes)
+
+		// Verify
+		postRepo.AssertExpectations(t)
+		attachmentRepo.AssertExpectations(t)
+	})
+
+	t.Run("OtherUser", func(t *testing.T) {
+		// Setup
+		postRepo := &mocks.PostRepository{}
+		attachmentRepo := &mocks.MusicAttachmentRepository{}
+		previewRepo := &mocks.LinkPreviewRepository{}
+		previewFetcher := &mocks.PreviewFetcher{}
+
+		postUsecase := NewPostUsecase(postRepo, nil, nil, previewRepo, &MusicEmbedder{attachmentRepo: attachmentRepo}, markdown.New(), previewFetcher, unfilteredScreener, nil, nil)
+
+		// Expectations
+		postRepo.On("FindByID", uint(5)).Return(&entities.Post{ID: 5, UserID: 1}, nil)
+
+		// Execute
+		_, err := postUsecase.PatchPost(context.Background(), 2, 5, &PatchPostInput{Title: utils.ToPtr("New")})
+		deleteErr := postUsecase.DeletePost(2, 5)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrPostPermissionDenied)
+		assert.ErrorIs(t, deleteErr, ErrPostPermissionDenied)
+
+		// Verify
+		postRepo.AssertNotCalled(t, "Update", mock.Anything)
+		postRepo.AssertNotCalled(t, "Delete", mock.Anything)
+	})
+}
+
+func TestPostUsecase_GetPost_Hidden(t *testing.T) {
+	// Setup
+	postRepo := &mocks.PostRepository{}
+
+	postUsecase := NewPostUsecase(postRepo, nil, nil, nil, &MusicEmbedder{}, markdown.New(), nil, unfilteredScreener, nil, nil)
+
+	// Expectations
+	postRepo.On("FindByID", uint(5)).Return(&entities.Post{ID: 5, UserID: 1, IsHidden: true}, nil)
+
+	// Execute
+	output, err := postUsecase.GetPost(5)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrPostNotFound)
+	assert.Nil(t, output)
+}
+
+func TestPostUsecase_ListCommunityPosts(t *testing.T) {
+	testCases := []struct {
+		name         string
+		sort         string
+		expectedSort string
+		expectedErr  error
+	}{
+		{name: "DefaultsToNew", sort: "", expectedSort: repositories.PostSortNew},
+		{name: "Hot", sort: "hot", expectedSort: repositories.PostSortHot},
+		{name: "Invalid", sort: "random", expectedErr: ErrInvalidPostSort},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup
+			postRepo := &mocks.PostRepository{}
+			attachmentRepo := &mocks.MusicAttachmentRepository{}
+			previewRepo := &mocks.LinkPreviewRepository{}
+			previewFetcher := &mocks.PreviewFetcher{}
+			communityRepo := &mocks.GenresCommunityRepository{}
+
+			postUsecase := NewPostUsecase(postRepo, communityRepo, nil, previewRepo, &MusicEmbedder{attachmentRepo: attachmentRepo}, markdown.New(), previewFetcher, unfilteredScreener, nil, nil)
+
+			// Expectations
+			communityRepo.On("FindByID", uint(10)).Return(&entities.GenreCommunity{ID: 10}, nil)
+			postRepo.On("FindByGenreCommunityID", repositories.Viewer{ID: 1}, uint(10), tc.expectedSort, 20, 20).Return([]*entities.Post{{ID: 5}, {ID: 6}}, nil)
+			postRepo.On("CountByGenreCommunityID", repositories.Viewer{ID: 1}, uint(10)).Return(int64(22), nil)
+			postRepo.On("CountLikesAndDislikesByID", mock.Anything).Return(int64(1), int64(0), nil)
+			attachmentRepo.On("FindByPostIDs", mock.Anything).Return([]*entities.MusicAttachment{}, nil)
+			previewRepo.On("FindByPostIDs", mock.Anything).Return([]*entities.LinkPreview{}, nil)
+
+			// Execute
+			output, err := postUsecase.ListCommunityPosts(1, 10, tc.sort, nil, utils.ToPtr(20))
+
+			// Assert
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				postRepo.AssertNotCalled(t, "FindByGenreCommunityID", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, 22, output.Total)
+			assert.Len(t, output.Posts, 2)
+
+			// Verify
+			postRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPostUsecase_SearchPosts(t *testing.T) {
+	// Setup
+	postRepo := &mocks.PostRepository{}
+	attachmentRepo := &mocks.MusicAttachmentRepository{}
+	previewRepo := &mocks.LinkPreviewRepository{}
+	previewFetcher := &mocks.PreviewFetcher{}
+
+	postUsecase := NewPostUsecase(postRepo, nil, nil, previewRepo, &MusicEmbedder{attachmentRepo: attachmentRepo}, markdown.New(), previewFetcher, unfilteredScreener, nil, nil)
+
+	communityID := uint(10)
+
+	// Expectations
+	postRepo.On("SearchByContent", repositories.Viewer{ID: 1}, "jazz", &communityID, 0, 20).Return([]*entities.Post{{ID: 5}}, nil)
+	postRepo.On("CountByContent", repositories.Viewer{ID: 1}, "jazz", &communityID).Return(int64(1), nil)
+	postRepo.On("CountLikesAndDislikesByID", uint(5)).Return(int64(0), int64(0), nil)
+	attachmentRepo.On("FindByPostIDs", mock.Anything).Return([]*entities.MusicAttachment{}, nil)
+	previewRepo.On("FindByPostIDs", mock.Anything).Return([]*entities.LinkPreview{}, nil)
+
+	// Execute
+	output, err := postUsecase.SearchPosts(1, &SearchPostsInput{Query: "jazz", Field: PostSearchContent, CommunityID: &communityID}, nil, nil)
+	_, invalidErr := postUsecase.SearchPosts(1, &SearchPostsInput{Query: "jazz", Field: "author"}, nil, nil)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, output.Total)
+	assert.ErrorIs(t, invalidErr, ErrInvalidPostSearchField)
+
+	// Verify
+	postRepo.AssertExpectations(t)
+	postRepo.AssertNotCalled(t, "SearchByTitle", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,190 @@
+package usecase
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+)
+
+// Sections of the public profile that users can hide.
+const (
+	ProfileSectionBio         = "bio"
+	ProfileSectionWebsite     = "website"
+	ProfileSectionFollows     = "follows"
+	ProfileSectionTopsters    = "topsters"
+	ProfileSectionCollections = "collections"
+	ProfileSectionPosts       = "posts"
+)
+
+// profileSectionLimit caps how many topsters, collections and posts the public
+// profile shows. The full lists are paged through their own endpoints.
+const profileSectionLimit = 5
+
+type ProfileUsecase interface {
+	GetPublicProfile(viewerID uint, nickname string) (*PublicProfileOutput, error)
+}
+
+type profileUsecase struct {
+	userRepo       repositories.UserRepository
+	followRepo     repositories.UserFollowRepository
+	blockRepo      repositories.UserBlockRepository
+	topsterRepo    repositories.UserTopsterRepository
+	collectionRepo repositories.MusicCollectionRepository
+	postRepo       repositories.PostRepository
+}
+
+func NewProfileUsecase(userRepo repositories.UserRepository, followRepo repositories.UserFollowRepository, blockRepo repositories.UserBlockRepository, topsterRepo repositories.UserTopsterRepository, collectionRepo repositories.MusicCollectionRepository, postRepo repositories.PostRepository) ProfileUsecase {
+	return &profileUsecase{
+		userRepo:       userRepo,
+		followRepo:     followRepo,
+		blockRepo:      blockRepo,
+		topsterRepo:    topsterRepo,
+		collectionRepo: collectionRepo,
+		postRepo:       postRepo,
+	}
+}
+
+// GetPublicProfile returns what viewerID may see of the user with the given
+// nickname. Sections the owner hid are left empty for everyone but the owner,
+// and private fields such as email and name are never included. Users who
+// blocked each other cannot see each other's profile.
+func (u *profileUsecase) GetPublicProfile(viewerID uint, nickname string) (*PublicProfileOutput, error) {
+	user, err := u.userRepo.FindByNickname(nickname)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, ErrFindingRecord
+	}
+	isOwner := viewerID == user.ID
+	if !isOwner {
+		if err := checkNotBlocked(u.blockRepo, viewerID, user.ID); err != nil {
+			if errors.Is(err, ErrUserBlocked) {
+				return nil, ErrUserNotFound
+			}
+			return nil, err
+		}
+	}
+	profile := user.UserProfile
+	if profile == nil {
+		profile = &entities.UserProfile{}
+	}
+
+	output := &PublicProfileOutput{
+		ID:              user.ID,
+		Nickname:        user.Nickname,
+		ProfileImageURL: profile.ProfileImageURL,
+		IsOwner:         isOwner,
+		HiddenSections:  hiddenProfileSections(profile),
+	}
+	visible := func(hidden bool) bool { return isOwner || !hidden }
+
+	if visible(profile.HideBio) {
+		output.Bio = profile.Bio
+	}
+	if visible(profile.HideWebsite) {
+		output.Website = profile.Website
+	}
+	if visible(profile.HideFollows) {
+		viewer := repositories.Viewer{ID: viewerID}
+		followers, err := u.followRepo.CountFollowersByUserID(viewer, user.ID)
+		if err != nil {
+			return nil, ErrFindingRecord
+		}
+		followings, err := u.followRepo.CountFollowingsByUserID(viewer, user.ID)
+		if err != nil {
+			return nil, ErrFindingRecord
+		}
+		output.FollowerCount = &followers
+		output.FollowingCount = &followings
+	}
+	if !isOwner {
+		if output.Following, err = u.follows(viewerID, user.ID); err != nil {
+			return nil, err
+		}
+		if output.FollowsYou, err = u.follows(user.ID, viewerID); err != nil {
+			return nil, err
+		}
+	}
+	if visible(profile.HideTopsters) {
+		topsters, err := u.topsterRepo.FindByUserID(user.ID, isOwner, 0, profileSectionLimit)
+		if err != nil {
+			return nil, ErrFindingRecord
+		}
+		output.Topsters = make([]TopsterOutput, len(topsters))
+		for i, t := range topsters {
+			output.Topsters[i] = toTopsterOutput(t)
+		}
+	}
+	if visible(profile.HideCollections) {
+		collections, err := u.collectionRepo.FindByUserID(user.ID, isOwner, 0, profileSectionLimit)
+		if err != nil {
+			return nil, ErrFindingRecord
+		}
+		output.Collections = make([]CollectionOutput, len(collections))
+		for i, c := range collections {
+			output.Collections[i] = toCollectionOutput(c)
+		}
+	}
+	if visible(profile.HidePosts) {
+		posts, err := u.postRepo.FindByUserID(user.ID, 0, profileSectionLimit)
+		if err != nil {
+			return nil, ErrFindingRecord
+		}
+		output.RecentPosts = []ProfilePostOutput{}
+		for _, p := range posts {
+			if p.IsHidden {
+				continue
+			}
+			output.RecentPosts = append(output.RecentPosts, ProfilePostOutput{
+				ID:               p.ID,
+				GenreCommunityID: p.GenreCommunityID,
+				Title:            p.Title,
+				CreatedAt:        p.CreatedAt,
+			})
+		}
+	}
+	return output, nil
+}
+
+func (u *profileUsecase) follows(followerID, followingID uint) (bool, error) {
+	if _, err := u.followRepo.FindByFollowerIDAndFollowingID(followerID, followingID); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return false, nil
+		}
+		return false, ErrFindingRecord
+	}
+	return true, nil
+}
+
+// sectionHidden reports whether the user hid the profile section from viewerID.
+// The lists behind a section are hidden along with it, and owners always see
+// their own.
+func sectionHidden(viewerID uint, user *entities.User, section string) bool {
+	if viewerID == user.ID || user.UserProfile == nil {
+		return false
+	}
+	return slices.Contains(hiddenProfileSections(user.UserProfile), section)
+}
+
+func hiddenProfileSections(profile *entities.UserProfile) []string {
+	sections := []string{}
+	for _, s := range []struct {
+		name   string
+		hidden bool
+	}{
+		{ProfileSectionBio, profile.HideBio},
+		{ProfileSectionWebsite, profile.HideWebsite},
+		{ProfileSectionFollows, profile.HideFollows},
+		{ProfileSectionTopsters, profile.HideTopsters},
+		{ProfileSectionCollections, profile.HideCollections},
+		{ProfileSectionPosts, profile.HidePosts},
+	} {
+		if s.hidden {
+			sections = append(sections, s.name)
+		}
+	}
+	return sections
+}
@@ -0,0 +1,176 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestProfileUsecase_GetPublicProfile(t *testing.T) {
+	createdAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	newUser := func(profile entities.UserProfile) *entities.User {
+		profile.ProfileImageURL = "https://example.com/profile.png"
+		profile.Bio = "bio"
+		profile.Website = "https://example.com"
+		return &entities.User{ID: 2, Email: "encrypted", Name: "name", Nickname: "nickname", UserProfile: &profile}
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		// Setup
+		userRepo := &mocks.UserRepository{}
+		followRepo := &mocks.UserFollowRepository{}
+		blockRepo := &mocks.UserBlockRepository{}
+		topsterRepo := &mocks.UserTopsterRepository{}
+		collectionRepo := &mocks.MusicCollectionRepository{}
+		postRepo := &mocks.PostRepository{}
+
+		profileUsecase := NewProfileUsecase(userRepo, followRepo, blockRepo, topsterRepo, collectionRepo, postRepo)
+
+		// Expectations
+		userRepo.On("FindByNickname", "nickname").Return(newUser(entities.UserProfile{}), nil)
+		blockRepo.On("ExistsBetween", uint(1), uint(2)).Return(false, nil)
+		followRepo.On("CountFollowersByUserID", repositories.Viewer{ID: 1}, uint(2)).Return(int64(12), nil)
+		followRepo.On("CountFollowingsByUserID", repositories.Viewer{ID: 1}, uint(2)).Return(int64(3), nil)
+		followRepo.On("FindByFollowerIDAndFollowingID", uint(1), uint(2)).Return(&entities.UserFollow{ID: 5}, nil)
+		followRepo.On("FindByFollowerIDAndFollowingID", uint(2), uint(1)).Return(nil, repositories.ErrNotFound)
+		topsterRepo.On("FindByUserID", uint(2), false, 0, profileSectionLimit).Return([]*entities.UserTopster{{ID: 7, UserID: 2, IsPublic: true}}, nil)
+		collectionRepo.On("FindByUserID", uint(2), false, 0, profileSectionLimit).Return([]*entities.MusicCollection{{ID: 8, UserID: 2, IsPublic: true}}, nil)
+		postRepo.On("FindByUserID", uint(2), 0, profileSectionLimit).Return([]*entities.Post{
+			{ID: 10, GenreCommunityID: 4, Title: "visible", CreatedAt: createdAt},
+			{ID: 11, GenreCommunityID: 4, Title: "removed", IsHidden: true},
+		}, nil)
+
+		// Execute
+		output, err := profileUsecase.GetPublicProfile(1, "nickname")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, uint(2), output.ID)
+		assert.Equal(t, "bio", output.Bio)
+		assert.Equal(t, "https://example.com", output.Website)
+		assert.Equal(t, int64(12), *output.FollowerCount)
+		assert.Equal(t, int64(3), *output.FollowingCount)
+		assert.True(t, output.Following)
+		assert.False(t, output.FollowsYou)
+		assert.False(t, output.IsOwner)
+		assert.Len(t, output.Topsters, 1)
+		assert.Len(t, output.Collections, 1)
+		assert.Equal(t, []ProfilePostOutput{{ID: 10, GenreCommunityID: 4, Title: "visible", CreatedAt: createdAt}}, output.RecentPosts)
+		assert.Empty(t, output.HiddenSections)
+
+		// Verify
+		userRepo.AssertExpectations(t)
+		followRepo.AssertExpectations(t)
+		topsterRepo.AssertExpectations(t)
+		collectionRepo.AssertExpectations(t)
+		postRepo.AssertExpectations(t)
+	})
+
+	t.Run("HiddenSections", func(t *testing.T) {
+		// Setup
+		userRepo := &mocks.UserRepository{}
+		followRepo := &mocks.UserFollowRepository{}
+		blockRepo := &mocks.UserBlockRepository{}
+		topsterRepo := &mocks.UserTopsterRepository{}
+		collectionRepo := &mocks.MusicCollectionRepository{}
+		postRepo := &mocks.PostRepository{}
+
+		profileUsecase := NewProfileUsecase(userRepo, followRepo, blockRepo, topsterRepo, collectionRepo, postRepo)
+
+		// Expectations
+		userRepo.On("FindByNickname", "nickname").Return(newUser(entities.UserProfile{
+			HideBio:         true,
+			HideWebsite:     true,
+			HideFollows:     true,
+			HideTopsters:    true,
+			HideCollections: true,
+			HidePosts:       true,
+		}), nil)
+		blockRepo.On("ExistsBetween", uint(1), uint(2)).Return(false, nil)
+		followRepo.On("FindByFollowerIDAndFollowingID", uint(1), uint(2)).Return(nil, repositories.ErrNotFound)
+		followRepo.On("FindByFollowerIDAndFollowingID", uint(2), uint(1)).Return(nil, repositories.ErrNotFound)
+
+		// Execute
+		output, err := profileUsecase.GetPublicProfile(1, "nickname")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "nickname", output.Nickname)
+		assert.Equal(t, "https://example.com/profile.png", output.ProfileImageURL)
+		assert.Empty(t, output.Bio)
+		assert.Empty(t, output.Website)
+		assert.Nil(t, output.FollowerCount)
+		assert.Nil(t, output.FollowingCount)
+		assert.Nil(t, output.Topsters)
+		assert.Nil(t, output.Collections)
+		assert.Nil(t, output.RecentPosts)
+		assert.Equal(t, []string{
+			ProfileSectionBio, ProfileSectionWebsite, ProfileSectionFollows,
+			ProfileSectionTopsters, ProfileSectionCollections, ProfileSectionPosts,
+		}, output.HiddenSections)
+
+		// Verify
+		followRepo.AssertNotCalled(t, "CountFollowersByUserID", mock.Anything, mock.Anything)
+		topsterRepo.AssertNotCalled(t, "FindByUserID", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		collectionRepo.AssertNotCalled(t, "FindByUserID", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		postRepo.AssertNotCalled(t, "FindByUserID", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("OwnerSeesHiddenSections", func(t *testing.T) {
+		// Setup
+		userRepo := &mocks.UserRepository{}
+		followRepo := &mocks.UserFollowRepository{}
+		blockRepo := &mocks.UserBlockRepository{}
+		topsterRepo := &mocks.UserTopsterRepository{}
+		collectionRepo := &mocks.MusicCollectionRepository{}
+		postRepo := &mocks.PostRepository{}
+
+		profileUsecase := NewProfileUsecase(userRepo, followRepo, blockRepo, topsterRepo, collectionRepo, postRepo)
+
+		// Expectations
+		userRepo.On("FindByNickname", "nickname").Return(newUser(entities.UserProfile{HideBio: true, HideTopsters: true}), nil)
+		followRepo.On("CountFollowersByUserID", repositories.Viewer{ID: 2}, uint(2)).Return(int64(0), nil)
+		followRepo.On("CountFollowingsByUserID", repositories.Viewer{ID: 2}, uint(2)).Return(int64(0), nil)
+		topsterRepo.On("FindByUserID", uint(2), true, 0, profileSectionLimit).Return([]*entities.UserTopster{{ID: 7, UserID: 2}}, nil)
+		collectionRepo.On("FindByUserID", uint(2), true, 0, profileSectionLimit).Return([]*entities.MusicCollection{}, nil)
+		postRepo.On("FindByUserID", uint(2), 0, profileSectionLimit).Return([]*entities.Post{}, nil)
+
+		// Execute
+		output, err := profileUsecase.GetPublicProfile(2, "nickname")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.True(t, output.IsOwner)
+		assert.Equal(t, "bio", output.Bio)
+		assert.Len(t, output.Topsters, 1)
+		assert.Equal(t, []string{ProfileSectionBio, ProfileSectionTopsters}, output.HiddenSections)
+
+		// Verify
+		topsterRepo.AssertExpectations(t)
+		followRepo.AssertNotCalled(t, "FindByFollowerIDAndFollowingID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("UserNotFound", func(t *testing.T) {
+		// Setup
+		userRepo := &mocks.UserRepository{}
+
+		profileUsecase := NewProfileUsecase(userRepo, nil, nil, nil, nil, nil)
+
+		// Expectations
+		userRepo.On("FindByNickname", "unknown").Return(nil, repositories.ErrNotFound)
+
+		// Execute
+		_, err := profileUsecase.GetPublicProfile(1, "unknown")
+
+		// Assert
+		assert.ErrorIs(t, err, ErrUserNotFound)
+
+		// Verify
+		userRepo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,339 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/logging"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/spotifyclient"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"github.com/zmb3/spotify/v2"
+	"go.uber.org/zap"
+)
+
+const (
+	// Spotify accepts at most 100 tracks per playlist modification.
+	spotifyPlaylistBatchSize = 100
+	reconcileBatchSize       = 50
+)
+
+// ExportCollection creates a Spotify playlist from the collection, or syncs the
+// playlist it is already linked to.
+func (u *spotifyUsecase) ExportCollection(ctx context.Context, userID, collectionID uint) (*CollectionSyncOutput, error) {
+	collection, err := u.collectionRepo.FindByID(collectionID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrCollectionNotFound
+		}
+		return nil, ErrFindingRecord
+	}
+	if collection.UserID != userID {
+		return nil, ErrCollectionNotFound
+	}
+	if collection.SpotifyPlaylistID == spotifySavedTracksKey {
+		return nil, ErrCollectionNotExportable
+	}
+
+	account, err := u.findAccount(userID)
+	if err != nil {
+		return nil, err
+	}
+	sync, err := u.findSync(collection.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := u.syncWithAccount(ctx, account, collection, sync, true)
+	if err != nil {
+		u.recordSyncFailure(sync, err)
+		return nil, err
+	}
+	return output, nil
+}
+
+// ReconcileCollections syncs every exported collection whose playlist still
+// exists. Failures are recorded on the collection's sync state and do not
+// stop the run.
+func (u *spotifyUsecase) ReconcileCollections(ctx context.Context) (*ReconcileCollectionsOutput, error) {
+	output := &ReconcileCollectionsOutput{}
+	afterID := uint(0)
+	for {
+		syncs, err := u.syncRepo.FindReconcilable(afterID, reconcileBatchSize)
+		if err != nil {
+			return nil, ErrFindingRecord
+		}
+		for _, sync := range syncs {
+			afterID = sync.ID
+			result, err := u.reconcileCollection(ctx, sync)
+			if err != nil {
+				logging.Log().Error("failed to reconcile collection",
+					zap.Error(err),
+					zap.Uint("collection_id", sync.CollectionID),
+				)
+				u.recordSyncFailure(sync, err)
+				output.Failed++
+				continue
+			}
+			if result.Status == entities.SyncStatusRemoteDeleted {
+				output.RemoteDeleted++
+				continue
+			}
+			output.Synced++
+		}
+		if len(syncs) < reconcileBatchSize {
+			return output, nil
+		}
+	}
+}
+
+func (u *spotifyUsecase) reconcileCollection(ctx context.Context, sync *entities.CollectionSpotifySync) (*CollectionSyncOutput, error) {
+	collection, err := u.collectionRepo.FindByID(sync.CollectionID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrCollectionNotFound
+		}
+		return nil, ErrFindingRecord
+	}
+	account, err := u.findAccount(collection.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return u.syncWithAccount(ctx, account, collection, sync, false)
+}
+
+func (u *spotifyUsecase) syncWithAccount(ctx context.Context, account *entities.UserSocialAccount, collection *entities.MusicCollection, sync *entities.CollectionSpotifySync, create bool) (*CollectionSyncOutput, error) {
+	token, err := u.getToken(account)
+	if err != nil {
+		return nil, err
+	}
+	client := u.spotifyAuth.NewUserClient(ctx, token)
+
+	output, err := u.syncCollection(ctx, client, account, collection, sync, create)
+	if saveErr := u.saveRefreshedToken(client, account, token); err == nil {
+		err = saveErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+// syncCollection reconciles a collection with its Spotify playlist, using the
+// tracks recorded at the last sync as the common base:
+//   - a track added on one side since the last sync is added to the other;
+//   - a track removed on one side since the last sync is removed from the other;
+//   - the first sync never removes anything, so both sides are merged;
+//   - playlists owned by another Spotify user are read-only, so Spotify wins;
+//   - the collection's name, description and visibility win over the playlist's.
+//
+// A playlist deleted on Spotify is recreated when create is set. Otherwise the
+// sync is marked as remote-deleted and no longer reconciled.
+func (u *spotifyUsecase) syncCollection(ctx context.Context, client spotifyclient.UserClient, account *entities.UserSocialAccount, collection *entities.MusicCollection, sync *entities.CollectionSpotifySync, create bool) (*CollectionSyncOutput, error) {
+	output := &CollectionSyncOutput{CollectionID: collection.ID}
+
+	var playlist *spotify.FullPlaylist
+	if collection.SpotifyPlaylistID != "" {
+		var err error
+		playlist, err = client.GetPlaylist(ctx, spotify.ID(collection.SpotifyPlaylistID))
+		if err != nil && !isSpotifyNotFound(err) {
+			return nil, ErrFetchingSpotify
+		}
+	}
+	if playlist == nil && !create {
+		if collection.SpotifyPlaylistID == "" {
+			output.Status = sync.Status
+			return output, nil
+		}
+		sync.Status = entities.SyncStatusRemoteDeleted
+		sync.Error = ""
+		sync.Tracks = nil
+		if err := u.syncRepo.Save(sync); err != nil {
+			return nil, ErrUpdatingRecord
+		}
+		output.SpotifyPlaylistID = collection.SpotifyPlaylistID
+		output.Status = sync.Status
+		return output, nil
+	}
+
+	remote := []*spotify.FullTrack{}
+	if playlist == nil {
+		created, err := client.CreatePlaylist(ctx, account.ProviderUserID, collection.Name, collection.Description, collection.IsPublic)
+		if err != nil {
+			return nil, ErrUpdatingSpotify
+		}
+		playlist = created
+		collection.SpotifyPlaylistID = string(created.ID)
+		if err := u.collectionRepo.Update(collection); err != nil {
+			return nil, ErrUpdatingRecord
+		}
+		// The new playlist shares nothing with a previously deleted one.
+		sync.SyncedAt = nil
+		sync.Tracks = nil
+	} else {
+		var err error
+		remote, err = u.playlistTracks(ctx, client, playlist.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	local, err := u.musicRepo.FindByCollectionID(collection.ID)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	localIDs := []spotify.ID{}
+	localMusic := make(map[spotify.ID]uint)
+	for _, m := range local {
+		id := spotify.ID(m.SpotifyID)
+		if id == "" {
+			continue
+		}
+		if _, ok := localMusic[id]; !ok {
+			localIDs = append(localIDs, id)
+			localMusic[id] = m.ID
+		}
+	}
+	remoteIDs := []spotify.ID{}
+	remoteTracks := make(map[spotify.ID]*spotify.FullTrack)
+	for _, t := range remote {
+		if _, ok := remoteTracks[t.ID]; !ok {
+			remoteIDs = append(remoteIDs, t.ID)
+			remoteTracks[t.ID] = t
+		}
+	}
+	base := make(map[spotify.ID]bool, len(sync.Tracks))
+	for _, t := range sync.Tracks {
+		base[spotify.ID(t.SpotifyTrackID)] = true
+	}
+	firstSync := sync.SyncedAt == nil
+	owned := playlist.Owner.ID == account.ProviderUserID
+
+	var push, unpublish, pull, drop []spotify.ID
+	for _, id := range localIDs {
+		if _, ok := remoteTracks[id]; ok {
+			continue
+		}
+		if owned && (firstSync || !base[id]) {
+			push = append(push, id)
+		} else {
+			drop = append(drop, id)
+		}
+	}
+	for _, id := range remoteIDs {
+		if _, ok := localMusic[id]; ok {
+			continue
+		}
+		if !owned || firstSync || !base[id] {
+			pull = append(pull, id)
+		} else {
+			unpublish = append(unpublish, id)
+		}
+	}
+
+	snapshotID := playlist.SnapshotID
+	for _, batch := range batchIDs(unpublish) {
+		if snapshotID, err = client.RemoveTracksFromPlaylist(ctx, playlist.ID, batch...); err != nil {
+			return nil, ErrUpdatingSpotify
+		}
+	}
+	for _, batch := range batchIDs(push) {
+		if snapshotID, err = client.AddTracksToPlaylist(ctx, playlist.ID, batch...); err != nil {
+			return nil, ErrUpdatingSpotify
+		}
+	}
+	if owned && (playlist.Name != collection.Name || playlist.Description != collection.Description || playlist.IsPublic != collection.IsPublic) {
+		if err := client.UpdatePlaylistDetails(ctx, playlist.ID, collection.Name, collection.Description, collection.IsPublic); err != nil {
+			return nil, ErrUpdatingSpotify
+		}
+	}
+
+	synced := make(map[spotify.ID]bool, len(localIDs)+len(pull))
+	for _, id := range localIDs {
+		synced[id] = true
+	}
+	for _, id := range drop {
+		if err := u.collectionMusicRepo.DeleteByCollectionIDAndMusicID(collection.ID, localMusic[id]); err != nil {
+			return nil, ErrDeletingRecord
+		}
+		delete(synced, id)
+	}
+	inCollection := make(map[uint]bool, len(local))
+	for _, m := range local {
+		inCollection[m.ID] = true
+	}
+	for _, id := range pull {
+		music, err := u.ingester.ingestFullTrack(ctx, remoteTracks[id])
+		if err != nil {
+			if errors.Is(err, ErrAlbumNotFound) || errors.Is(err, ErrArtistNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		if !inCollection[music.ID] {
+			if err := u.collectionMusicRepo.Create(&entities.CollectionMusicMapping{CollectionID: collection.ID, MusicID: music.ID, AddedBy: &collection.UserID}); err != nil {
+				return nil, ErrCreatingRecord
+			}
+			inCollection[music.ID] = true
+		}
+		synced[id] = true
+		output.AddedToCollection++
+	}
+
+	syncedAt := time.Now()
+	sync.CollectionID = collection.ID
+	sync.Status = entities.SyncStatusSynced
+	sync.SnapshotID = snapshotID
+	sync.Error = ""
+	sync.SyncedAt = &syncedAt
+	sync.Tracks = make([]entities.CollectionSpotifySyncTrack, 0, len(synced))
+	for id := range synced {
+		sync.Tracks = append(sync.Tracks, entities.CollectionSpotifySyncTrack{SpotifyTrackID: string(id)})
+	}
+	if err := u.syncRepo.Save(sync); err != nil {
+		return nil, ErrUpdatingRecord
+	}
+
+	output.SpotifyPlaylistID = collection.SpotifyPlaylistID
+	output.Status = sync.Status
+	output.AddedToSpotify = len(push)
+	output.RemovedFromSpotify = len(unpublish)
+	output.RemovedFromCollection = len(drop)
+	output.SyncedAt = sync.SyncedAt
+	return output, nil
+}
+
+func (u *spotifyUsecase) findSync(collectionID uint) (*entities.CollectionSpotifySync, error) {
+	sync, err := u.syncRepo.FindByCollectionID(collectionID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return &entities.CollectionSpotifySync{CollectionID: collectionID}, nil
+		}
+		return nil, ErrFindingRecord
+	}
+	return sync, nil
+}
+
+// recordSyncFailure keeps the previous base tracks, so the next attempt
+// reconciles against the last successful sync.
+func (u *spotifyUsecase) recordSyncFailure(sync *entities.CollectionSpotifySync, cause error) {
+	sync.Status = entities.SyncStatusFailed
+	sync.Error = cause.Error()
+	if err := u.syncRepo.Save(sync); err != nil {
+		logging.Log().Error("failed to record collection sync failure",
+			zap.Error(err),
+			zap.Uint("collection_id", sync.CollectionID),
+		)
+	}
+}
+
+func batchIDs(ids []spotify.ID) [][]spotify.ID {
+	batches := [][]spotify.ID{}
+	for len(ids) > 0 {
+		n := min(len(ids), spotifyPlaylistBatchSize)
+		batches = append(batches, ids[:n])
+		ids = ids[n:]
+	}
+	return batches
+}
@@ -0,0 +1,274 @@
+package usecase
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase/mocks"
+	"github.com/myjinjin/sonic-odyssey-backend/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/zmb3/spotify/v2"
+	"golang.org/x/oauth2"
+)
+
+type spotifySyncMocks struct {
+	spotifyAuth         *mocks.UserAuthenticator
+	userClient          *mocks.UserClient
+	socialAccountRepo   *mocks.UserSocialAccountRepository
+	collectionRepo      *mocks.MusicCollectionRepository
+	collectionMusicRepo *mocks.CollectionMusicMappingRepository
+	syncRepo            *mocks.CollectionSpotifySyncRepository
+	musicRepo           *mocks.MusicRepository
+	encryptor           *mocks.Encryptor
+}
+
+// newSpotifySyncUsecase returns a usecase whose linked account (user 1,
+// Spotify user "wizzler") decrypts to a token the client keeps using.
+func newSpotifySyncUsecase() (SpotifyUsecase, *spotifySyncMocks) {
+	m := &spotifySyncMocks{
+		spotifyAuth:         &mocks.UserAuthenticator{},
+		userClient:          &mocks.UserClient{},
+		socialAccountRepo:   &mocks.UserSocialAccountRepository{},
+		collectionRepo:      &mocks.MusicCollectionRepository{},
+		collectionMusicRepo: &mocks.CollectionMusicMappingRepository{},
+		syncRepo:            &mocks.CollectionSpotifySyncRepository{},
+		musicRepo:           &mocks.MusicRepository{},
+		encryptor:           &mocks.Encryptor{},
+	}
+	account := &entities.UserSocialAccount{ID: 3, UserID: 1, Provider: entities.SocialProviderSpotify, ProviderUserID: "wizzler", AccessToken: "encrypted-access", RefreshToken: "encrypted-refresh"}
+	m.socialAccountRepo.On("FindByUserIDAndProvider", uint(1), entities.SocialProviderSpotify).Return(account, nil)
+	m.encryptor.On("Decrypt", "encrypted-access").Return("access", nil)
+	m.encryptor.On("Decrypt", "encrypted-refresh").Return("refresh", nil)
+	m.spotifyAuth.On("NewUserClient", mock.Anything, mock.Anything).Return(m.userClient)
+	m.userClient.On("Token").Return(&oauth2.Token{AccessToken: "access"}, nil)
+
+	spotifyUsecase := NewSpotifyUsecase(m.spotifyAuth, nil, m.socialAccountRepo, nil, nil, m.collectionRepo, m.collectionMusicRepo, m.syncRepo, m.musicRepo, nil, nil, nil, nil, nil, nil, m.encryptor)
+	return spotifyUsecase, m
+}
+
+func syncTrackIDs(sync *entities.CollectionSpotifySync) []string {
+	ids := []string{}
+	for _, t := range sync.Tracks {
+		ids = append(ids, t.SpotifyTrackID)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func playlistItems(ids ...spotify.ID) *spotify.PlaylistItemPage {
+	page := &spotify.PlaylistItemPage{}
+	for _, id := range ids {
+		page.Items = append(page.Items, spotify.PlaylistItem{Track: spotify.PlaylistItemTrack{Track: &spotify.FullTrack{SimpleTrack: spotify.SimpleTrack{ID: id}}}})
+	}
+	return page
+}
+
+func TestSpotifyUsecase_ExportCollection_CreatesPlaylist(t *testing.T) {
+	// Setup
+	spotifyUsecase, m := newSpotifySyncUsecase()
+	ctx := context.Background()
+
+	collection := &entities.MusicCollection{ID: 10, UserID: 1, Name: "Road Trip", Description: "Songs for the drive"}
+	var saved *entities.CollectionSpotifySync
+
+	// Expectations
+	m.collectionRepo.On("FindByID", uint(10)).Return(collection, nil)
+	m.syncRepo.On("FindByCollectionID", uint(10)).Return(nil, repositories.ErrNotFound)
+	m.userClient.On("CreatePlaylist", ctx, "wizzler", "Road Trip", "Songs for the drive", false).
+		Return(&spotify.FullPlaylist{SimplePlaylist: spotify.SimplePlaylist{ID: "playlist1", Name: "Road Trip", Description: "Songs for the drive", Owner: spotify.User{ID: "wizzler"}, SnapshotID: "s1"}}, nil)
+	m.collectionRepo.On("Update", mock.MatchedBy(func(c *entities.MusicCollection) bool {
+		return c.SpotifyPlaylistID == "playlist1"
+	})).Return(nil)
+	m.musicRepo.On("FindByCollectionID", uint(10)).Return([]*entities.Music{
+		{ID: 100, SpotifyID: "track1"},
+		{ID: 200, SpotifyID: "track2"},
+		{ID: 300},
+	}, nil)
+	m.userClient.On("AddTracksToPlaylist", ctx, spotify.ID("playlist1"), spotify.ID("track1"), spotify.ID("track2")).Return("s2", nil)
+	m.syncRepo.On("Save", mock.Anything).Run(func(args mock.Arguments) {
+		saved = args.Get(0).(*entities.CollectionSpotifySync)
+	}).Return(nil)
+
+	// Execute
+	output, err := spotifyUsecase.ExportCollection(ctx, 1, 10)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "playlist1", output.SpotifyPlaylistID)
+	assert.Equal(t, entities.SyncStatusSynced, output.Status)
+	assert.Equal(t, 2, output.AddedToSpotify)
+	assert.Equal(t, "s2", saved.SnapshotID)
+	assert.Equal(t, []string{"track1", "track2"}, syncTrackIDs(saved))
+
+	// Verify
+	m.userClient.AssertExpectations(t)
+	m.collectionRepo.AssertExpectations(t)
+}
+
+func TestSpotifyUsecase_ExportCollection_ThreeWayMerge(t *testing.T) {
+	// Setup
+	spotifyUsecase, m := newSpotifySyncUsecase()
+	ctx := context.Background()
+
+	syncedAt := time.Now().Add(-time.Hour)
+	collection := &entities.MusicCollection{ID: 10, UserID: 1, Name: "Road Trip", SpotifyPlaylistID: "playlist1"}
+	sync := &entities.CollectionSpotifySync{ID: 5, CollectionID: 10, Status: entities.SyncStatusSynced, SyncedAt: &syncedAt, Tracks: []entities.CollectionSpotifySyncTrack{
+		{SpotifyTrackID: "a"}, {SpotifyTrackID: "b"}, {SpotifyTrackID: "c"},
+	}}
+	var saved *entities.CollectionSpotifySync
+
+	// Expectations
+	m.collectionRepo.On("FindByID", uint(10)).Return(collection, nil)
+	m.syncRepo.On("FindByCollectionID", uint(10)).Return(sync, nil)
+	m.userClient.On("GetPlaylist", ctx, spotify.ID("playlist1")).
+		Return(&spotify.FullPlaylist{SimplePlaylist: spotify.SimplePlaylist{ID: "playlist1", Name: "Road Trip", Owner: spotify.User{ID: "wizzler"}, SnapshotID: "s1"}}, nil)
+	// Since the last sync, b was removed and e added on Spotify, while c was
+	// removed and d added locally.
+	m.userClient.On("GetPlaylistItems", ctx, spotify.ID("playlist1"), mock.Anything, mock.Anything).Return(playlistItems("a", "c", "e"), nil)
+	m.musicRepo.On("FindByCollectionID", uint(10)).Return([]*entities.Music{
+		{ID: 1, SpotifyID: "a"},
+		{ID: 2, SpotifyID: "b"},
+		{ID: 4, SpotifyID: "d"},
+	}, nil)
+	m.userClient.On("RemoveTracksFromPlaylist", ctx, spotify.ID("playlist1"), spotify.ID("c")).Return("s2", nil)
+	m.userClient.On("AddTracksToPlaylist", ctx, spotify.ID("playlist1"), spotify.ID("d")).Return("s3", nil)
+	m.collectionMusicRepo.On("DeleteByCollectionIDAndMusicID", uint(10), uint(2)).Return(nil)
+	m.musicRepo.On("FindBySpotifyID", "e").Return(&entities.Music{ID: 5, SpotifyID: "e"}, nil)
+	m.collectionMusicRepo.On("Create", &entities.CollectionMusicMapping{CollectionID: 10, MusicID: 5, AddedBy: utils.ToPtr(uint(1))}).Return(nil)
+	m.syncRepo.On("Save", mock.Anything).Run(func(args mock.Arguments) {
+		saved = args.Get(0).(*entities.CollectionSpotifySync)
+	}).Return(nil)
+
+	// Execute
+	output, err := spotifyUsecase.ExportCollection(ctx, 1, 10)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, output.AddedToSpotify)
+	assert.Equal(t, 1, output.RemovedFromSpotify)
+	assert.Equal(t, 1, output.AddedToCollection)
+	assert.Equal(t, 1, output.RemovedFromCollection)
+	assert.Equal(t, "s3", saved.SnapshotID)
+	assert.Equal(t, []string{"a", "d", "e"}, syncTrackIDs(saved))
+
+	// Verify
+	m.userClient.AssertExpectations(t)
+	m.collectionMusicRepo.AssertExpectations(t)
+	m.userClient.AssertNotCalled(t, "UpdatePlaylistDetails", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSpotifyUsecase_ExportCollection_NotOwnedPlaylistFollowsSpotify(t *testing.T) {
+	// Setup
+	spotifyUsecase, m := newSpotifySyncUsecase()
+	ctx := context.Background()
+
+	syncedAt := time.Now().Add(-time.Hour)
+	collection := &entities.MusicCollection{ID: 10, UserID: 1, Name: "Today's Top Hits", SpotifyPlaylistID: "playlist1"}
+	sync := &entities.CollectionSpotifySync{ID: 5, CollectionID: 10, Status: entities.SyncStatusSynced, SyncedAt: &syncedAt, Tracks: []entities.CollectionSpotifySyncTrack{
+		{SpotifyTrackID: "a"},
+	}}
+
+	// Expectations
+	m.collectionRepo.On("FindByID", uint(10)).Return(collection, nil)
+	m.syncRepo.On("FindByCollectionID", uint(10)).Return(sync, nil)
+	m.userClient.On("GetPlaylist", ctx, spotify.ID("playlist1")).
+		Return(&spotify.FullPlaylist{SimplePlaylist: spotify.SimplePlaylist{ID: "playlist1", Name: "Today's Top Hits", Owner: spotify.User{ID: "spotify"}}}, nil)
+	m.userClient.On("GetPlaylistItems", ctx, spotify.ID("playlist1"), mock.Anything, mock.Anything).Return(playlistItems("a"), nil)
+	m.musicRepo.On("FindByCollectionID", uint(10)).Return([]*entities.Music{
+		{ID: 1, SpotifyID: "a"},
+		{ID: 2, SpotifyID: "b"},
+	}, nil)
+	m.collectionMusicRepo.On("DeleteByCollectionIDAndMusicID", uint(10), uint(2)).Return(nil)
+	m.syncRepo.On("Save", mock.Anything).Return(nil)
+
+	// Execute
+	output, err := spotifyUsecase.ExportCollection(ctx, 1, 10)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 0, output.AddedToSpotify)
+	assert.Equal(t, 1, output.RemovedFromCollection)
+
+	// Verify
+	m.userClient.AssertNotCalled(t, "AddTracksToPlaylist", mock.Anything, mock.Anything, mock.Anything)
+	m.collectionMusicRepo.AssertExpectations(t)
+}
+
+func TestSpotifyUsecase_ExportCollection_OtherUsersCollection(t *testing.T) {
+	// Setup
+	spotifyUsecase, m := newSpotifySyncUsecase()
+
+	// Expectations
+	m.collectionRepo.On("FindByID", uint(10)).Return(&entities.MusicCollection{ID: 10, UserID: 2}, nil)
+
+	// Execute
+	output, err := spotifyUsecase.ExportCollection(context.Background(), 1, 10)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrCollectionNotFound)
+	assert.Nil(t, output)
+
+	// Verify
+	m.syncRepo.AssertNotCalled(t, "FindByCollectionID", mock.Anything)
+}
+
+func TestSpotifyUsecase_ReconcileCollections_RemoteDeleted(t *testing.T) {
+	// Setup
+	spotifyUsecase, m := newSpotifySyncUsecase()
+
+	syncedAt := time.Now().Add(-time.Hour)
+	sync := &entities.CollectionSpotifySync{ID: 5, CollectionID: 10, Status: entities.SyncStatusSynced, SyncedAt: &syncedAt, Tracks: []entities.CollectionSpotifySyncTrack{
+		{SpotifyTrackID: "a"},
+	}}
+
+	// Expectations
+	m.syncRepo.On("FindReconcilable", uint(0), reconcileBatchSize).Return([]*entities.CollectionSpotifySync{sync}, nil)
+	m.collectionRepo.On("FindByID", uint(10)).Return(&entities.MusicCollection{ID: 10, UserID: 1, SpotifyPlaylistID: "playlist1"}, nil)
+	m.userClient.On("GetPlaylist", mock.Anything, spotify.ID("playlist1")).Return(nil, spotify.Error{Status: http.StatusNotFound})
+	m.syncRepo.On("Save", mock.MatchedBy(func(s *entities.CollectionSpotifySync) bool {
+		return s.Status == entities.SyncStatusRemoteDeleted && len(s.Tracks) == 0
+	})).Return(nil)
+
+	// Execute
+	output, err := spotifyUsecase.ReconcileCollections(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, output.RemoteDeleted)
+	assert.Equal(t, 0, output.Synced)
+
+	// Verify
+	m.syncRepo.AssertExpectations(t)
+	m.userClient.AssertNotCalled(t, "CreatePlaylist", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSpotifyUsecase_ReconcileCollections_RecordsFailure(t *testing.T) {
+	// Setup
+	spotifyUsecase, m := newSpotifySyncUsecase()
+
+	sync := &entities.CollectionSpotifySync{ID: 5, CollectionID: 10, Status: entities.SyncStatusSynced}
+
+	// Expectations
+	m.syncRepo.On("FindReconcilable", uint(0), reconcileBatchSize).Return([]*entities.CollectionSpotifySync{sync}, nil)
+	m.collectionRepo.On("FindByID", uint(10)).Return(&entities.MusicCollection{ID: 10, UserID: 1, SpotifyPlaylistID: "playlist1"}, nil)
+	m.userClient.On("GetPlaylist", mock.Anything, spotify.ID("playlist1")).Return(nil, spotify.Error{Status: http.StatusInternalServerError})
+	m.syncRepo.On("Save", mock.MatchedBy(func(s *entities.CollectionSpotifySync) bool {
+		return s.Status == entities.SyncStatusFailed && s.Error == ErrFetchingSpotify.Error()
+	})).Return(nil)
+
+	// Execute
+	output, err := spotifyUsecase.ReconcileCollections(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, output.Failed)
+
+	// Verify
+	m.syncRepo.AssertExpectations(t)
+}
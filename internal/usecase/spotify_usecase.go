@@ -0,0 +1,449 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/encryption"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/logging"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/spotifyclient"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"github.com/zmb3/spotify/v2"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+)
+
+const (
+	spotifyLinkFlowTTL = 10 * time.Minute
+	spotifyPageLimit   = 50
+
+	// Saved tracks are imported as a collection of their own. Spotify playlist
+	// IDs are base62, so the key cannot collide with a real playlist.
+	spotifySavedTracksKey  = "saved-tracks"
+	spotifySavedTracksName = "Spotify Liked Songs"
+)
+
+type SpotifyUsecase interface {
+	StartLink(userID uint) (*StartSpotifyLinkOutput, error)
+	CompleteLink(ctx context.Context, userID uint, state, code string) (*CompleteSpotifyLinkOutput, error)
+	Unlink(userID uint) error
+	StartImport(userID uint) (*SpotifyImportJobOutput, error)
+	GetImportJob(userID, jobID uint) (*SpotifyImportJobOutput, error)
+	ExportCollection(ctx context.Context, userID, collectionID uint) (*CollectionSyncOutput, error)
+	ReconcileCollections(ctx context.Context) (*ReconcileCollectionsOutput, error)
+}
+
+type spotifyUsecase struct {
+	spotifyAuth spotifyclient.UserAuthenticator
+
+	socialAccountRepo   repositories.UserSocialAccountRepository
+	linkFlowRepo        repositories.SocialLinkFlowRepository
+	importJobRepo       repositories.SpotifyImportJobRepository
+	collectionRepo      repositories.MusicCollectionRepository
+	collectionMusicRepo repositories.CollectionMusicMappingRepository
+	syncRepo            repositories.CollectionSpotifySyncRepository
+	musicRepo           repositories.MusicRepository
+
+	tokenEncryptor encryption.Encryptor
+	ingester       *catalogIngester
+}
+
+func NewSpotifyUsecase(spotifyAuth spotifyclient.UserAuthenticator, spotifyClient spotifyclient.SpotifyClient, socialAccountRepo repositories.UserSocialAccountRepository, linkFlowRepo repositories.SocialLinkFlowRepository, importJobRepo repositories.SpotifyImportJobRepository, collectionRepo repositories.MusicCollectionRepository, collectionMusicRepo repositories.CollectionMusicMappingRepository, syncRepo repositories.CollectionSpotifySyncRepository, musicRepo repositories.MusicRepository, albumRepo repositories.AlbumRepository, artistRepo repositories.ArtistRepository, musicArtistRepo repositories.MusicArtistMappingRepository, genreRepo repositories.GenreRepository, genreAliasRepo repositories.GenreAliasRepository, musicGenreRepo repositories.MusicGenreMappingRepository, tokenEncryptor encryption.Encryptor) SpotifyUsecase {
+	return &spotifyUsecase{
+		spotifyAuth:         spotifyAuth,
+		socialAccountRepo:   socialAccountRepo,
+		linkFlowRepo:        linkFlowRepo,
+		importJobRepo:       importJobRepo,
+		collectionRepo:      collectionRepo,
+		collectionMusicRepo: collectionMusicRepo,
+		syncRepo:            syncRepo,
+		musicRepo:           musicRepo,
+		tokenEncryptor:      tokenEncryptor,
+		ingester: &catalogIngester{
+			spotifyClient:   spotifyClient,
+			musicRepo:       musicRepo,
+			albumRepo:       albumRepo,
+			artistRepo:      artistRepo,
+			musicArtistRepo: musicArtistRepo,
+			musicGenreRepo:  musicGenreRepo,
+			genreResolver:   &genreResolver{genreRepo: genreRepo, genreAliasRepo: genreAliasRepo},
+		},
+	}
+}
+
+// StartLink begins the authorization code flow. The returned URL carries a
+// one-time state that CompleteLink checks against the same user.
+func (u *spotifyUsecase) StartLink(userID uint) (*StartSpotifyLinkOutput, error) {
+	expiresAt := time.Now().Add(spotifyLinkFlowTTL)
+	flow := &entities.SocialLinkFlow{
+		UserID:    userID,
+		Provider:  entities.SocialProviderSpotify,
+		State:     generateFlowID(),
+		ExpiresAt: &expiresAt,
+	}
+	if err := u.linkFlowRepo.Create(flow); err != nil {
+		return nil, ErrCreatingRecord
+	}
+	return &StartSpotifyLinkOutput{AuthURL: u.spotifyAuth.AuthURL(flow.State)}, nil
+}
+
+func (u *spotifyUsecase) CompleteLink(ctx context.Context, userID uint, state, code string) (*CompleteSpotifyLinkOutput, error) {
+	flow, err := u.linkFlowRepo.FindByState(state)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrSpotifyLinkFlowNotFound
+		}
+		return nil, ErrFindingRecord
+	}
+	if flow.UserID != userID || flow.Provider != entities.SocialProviderSpotify {
+		return nil, ErrSpotifyLinkFlowNotFound
+	}
+	if flow.ExpiresAt.Before(time.Now()) {
+		return nil, ErrSpotifyLinkFlowExpired
+	}
+
+	token, err := u.spotifyAuth.Exchange(ctx, code)
+	if err != nil {
+		return nil, ErrSpotifyAuthorizationFailed
+	}
+	spotifyUser, err := u.spotifyAuth.NewUserClient(ctx, token).CurrentUser(ctx)
+	if err != nil {
+		return nil, ErrFetchingSpotify
+	}
+
+	linked, err := u.socialAccountRepo.FindByProviderAndProviderUserID(entities.SocialProviderSpotify, spotifyUser.ID)
+	if err != nil && !errors.Is(err, repositories.ErrNotFound) {
+		return nil, ErrFindingRecord
+	}
+	if linked != nil && linked.UserID != userID {
+		return nil, ErrSpotifyAccountAlreadyLinked
+	}
+
+	account, err := u.socialAccountRepo.FindByUserIDAndProvider(userID, entities.SocialProviderSpotify)
+	if err != nil {
+		if !errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrFindingRecord
+		}
+		account = &entities.UserSocialAccount{UserID: userID, Provider: entities.SocialProviderSpotify}
+	}
+	account.ProviderUserID = spotifyUser.ID
+	if err := u.setToken(account, token); err != nil {
+		return nil, err
+	}
+	if account.ID == 0 {
+		if err := u.socialAccountRepo.Create(account); err != nil {
+			return nil, ErrCreatingRecord
+		}
+	} else if err := u.socialAccountRepo.Update(account); err != nil {
+		return nil, ErrUpdatingRecord
+	}
+
+	if err := u.linkFlowRepo.DeleteByState(state); err != nil {
+		return nil, ErrDeletingRecord
+	}
+
+	return &CompleteSpotifyLinkOutput{
+		SpotifyUserID: spotifyUser.ID,
+		DisplayName:   spotifyUser.DisplayName,
+	}, nil
+}
+
+func (u *spotifyUsecase) Unlink(userID uint) error {
+	account, err := u.findAccount(userID)
+	if err != nil {
+		return err
+	}
+	if err := u.socialAccountRepo.Delete(account.ID); err != nil {
+		return ErrDeletingRecord
+	}
+	return nil
+}
+
+// StartImport queues an import of the user's playlists and saved tracks and
+// runs it in the background. Only one import per user runs at a time.
+func (u *spotifyUsecase) StartImport(userID uint) (*SpotifyImportJobOutput, error) {
+	account, err := u.findAccount(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = u.importJobRepo.FindActiveByUserID(userID)
+	if err == nil {
+		return nil, ErrSpotifyImportInProgress
+	}
+	if !errors.Is(err, repositories.ErrNotFound) {
+		return nil, ErrFindingRecord
+	}
+
+	job := &entities.SpotifyImportJob{UserID: userID, Status: entities.ImportJobStatusPending}
+	if err := u.importJobRepo.Create(job); err != nil {
+		return nil, ErrCreatingRecord
+	}
+	output := toSpotifyImportJobOutput(job)
+
+	go u.runImport(context.Background(), job, account)
+
+	return output, nil
+}
+
+func (u *spotifyUsecase) GetImportJob(userID, jobID uint) (*SpotifyImportJobOutput, error) {
+	job, err := u.importJobRepo.FindByID(jobID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrSpotifyImportJobNotFound
+		}
+		return nil, ErrFindingRecord
+	}
+	if job.UserID != userID {
+		return nil, ErrSpotifyImportJobNotFound
+	}
+	return toSpotifyImportJobOutput(job), nil
+}
+
+func (u *spotifyUsecase) runImport(ctx context.Context, job *entities.SpotifyImportJob, account *entities.UserSocialAccount) {
+	startedAt := time.Now()
+	job.Status = entities.ImportJobStatusRunning
+	job.StartedAt = &startedAt
+	if err := u.importJobRepo.Update(job); err != nil {
+		logging.Log().Error("failed to update spotify import job", zap.Error(err), zap.Uint("job_id", job.ID))
+	}
+
+	err := u.importLibrary(ctx, job, account)
+
+	finishedAt := time.Now()
+	job.FinishedAt = &finishedAt
+	job.Status = entities.ImportJobStatusCompleted
+	if err != nil {
+		job.Status = entities.ImportJobStatusFailed
+		job.Error = err.Error()
+		logging.Log().Error("spotify import failed", zap.Error(err), zap.Uint("job_id", job.ID), zap.Uint("user_id", job.UserID))
+	}
+	if err := u.importJobRepo.Update(job); err != nil {
+		logging.Log().Error("failed to update spotify import job", zap.Error(err), zap.Uint("job_id", job.ID))
+	}
+}
+
+func (u *spotifyUsecase) importLibrary(ctx context.Context, job *entities.SpotifyImportJob, account *entities.UserSocialAccount) error {
+	token, err := u.getToken(account)
+	if err != nil {
+		return err
+	}
+	client := u.spotifyAuth.NewUserClient(ctx, token)
+
+	// The client may have refreshed the token even if the import fails midway.
+	err = u.importFromClient(ctx, client, job)
+	if saveErr := u.saveRefreshedToken(client, account, token); err == nil {
+		err = saveErr
+	}
+	return err
+}
+
+func (u *spotifyUsecase) importFromClient(ctx context.Context, client spotifyclient.UserClient, job *entities.SpotifyImportJob) error {
+	for offset := 0; ; offset += spotifyPageLimit {
+		page, err := client.CurrentUsersPlaylists(ctx, spotify.Limit(spotifyPageLimit), spotify.Offset(offset))
+		if err != nil {
+			return ErrFetchingSpotify
+		}
+		for _, playlist := range page.Playlists {
+			tracks, err := u.playlistTracks(ctx, client, playlist.ID)
+			if err != nil {
+				return err
+			}
+			if err := u.importCollection(ctx, job, string(playlist.ID), playlist.Name, playlist.Description, playlist.IsPublic, tracks); err != nil {
+				return err
+			}
+		}
+		if page.Next == "" {
+			break
+		}
+	}
+
+	saved, err := u.savedTracks(ctx, client)
+	if err != nil {
+		return err
+	}
+	return u.importCollection(ctx, job, spotifySavedTracksKey, spotifySavedTracksName, "", false, saved)
+}
+
+func (u *spotifyUsecase) playlistTracks(ctx context.Context, client spotifyclient.UserClient, playlistID spotify.ID) ([]*spotify.FullTrack, error) {
+	tracks := []*spotify.FullTrack{}
+	for offset := 0; ; offset += spotifyPageLimit {
+		page, err := client.GetPlaylistItems(ctx, playlistID, spotify.Limit(spotifyPageLimit), spotify.Offset(offset))
+		if err != nil {
+			return nil, ErrFetchingSpotify
+		}
+		for _, item := range page.Items {
+			// Episodes, local files and tracks unavailable in the user's
+			// market have no catalog entry to import.
+			if item.IsLocal || item.Track.Track == nil || item.Track.Track.ID == "" {
+				continue
+			}
+			tracks = append(tracks, item.Track.Track)
+		}
+		if page.Next == "" {
+			return tracks, nil
+		}
+	}
+}
+
+func (u *spotifyUsecase) savedTracks(ctx context.Context, client spotifyclient.UserClient) ([]*spotify.FullTrack, error) {
+	tracks := []*spotify.FullTrack{}
+	for offset := 0; ; offset += spotifyPageLimit {
+		page, err := client.CurrentUsersTracks(ctx, spotify.Limit(spotifyPageLimit), spotify.Offset(offset))
+		if err != nil {
+			return nil, ErrFetchingSpotify
+		}
+		for i := range page.Tracks {
+			tracks = append(tracks, &page.Tracks[i].FullTrack)
+		}
+		if page.Next == "" {
+			return tracks, nil
+		}
+	}
+}
+
+// importCollection creates or refreshes the collection mirroring a Spotify
+// playlist and adds the tracks it does not contain yet. Re-running an import
+// never duplicates collections or tracks. Visibility is only taken from Spotify
+// when the collection is created, so later changes by the owner are kept.
+func (u *spotifyUsecase) importCollection(ctx context.Context, job *entities.SpotifyImportJob, key, name, description string, isPublic bool, tracks []*spotify.FullTrack) error {
+	collection, err := u.collectionRepo.FindByUserIDAndSpotifyPlaylistID(job.UserID, key)
+	if err != nil {
+		if !errors.Is(err, repositories.ErrNotFound) {
+			return ErrFindingRecord
+		}
+		collection = &entities.MusicCollection{
+			UserID:            job.UserID,
+			Name:              name,
+			Description:       description,
+			IsPublic:          isPublic,
+			SpotifyPlaylistID: key,
+		}
+		if err := u.collectionRepo.Create(collection); err != nil {
+			return ErrCreatingRecord
+		}
+	} else if collection.Name != name || collection.Description != description {
+		collection.Name = name
+		collection.Description = description
+		if err := u.collectionRepo.Update(collection); err != nil {
+			return ErrUpdatingRecord
+		}
+	}
+
+	mappings, err := u.collectionMusicRepo.FindByCollectionID(collection.ID)
+	if err != nil {
+		return ErrFindingRecord
+	}
+	inCollection := make(map[uint]bool, len(mappings))
+	for _, m := range mappings {
+		inCollection[m.MusicID] = true
+	}
+
+	for _, track := range tracks {
+		music, err := u.ingester.ingestFullTrack(ctx, track)
+		if err != nil {
+			if errors.Is(err, ErrAlbumNotFound) || errors.Is(err, ErrArtistNotFound) {
+				continue
+			}
+			return err
+		}
+		if inCollection[music.ID] {
+			continue
+		}
+		if err := u.collectionMusicRepo.Create(&entities.CollectionMusicMapping{CollectionID: collection.ID, MusicID: music.ID, AddedBy: &job.UserID}); err != nil {
+			return ErrCreatingRecord
+		}
+		inCollection[music.ID] = true
+		job.TracksImported++
+	}
+
+	job.CollectionsImported++
+	if err := u.importJobRepo.Update(job); err != nil {
+		return ErrUpdatingRecord
+	}
+	return nil
+}
+
+func (u *spotifyUsecase) findAccount(userID uint) (*entities.UserSocialAccount, error) {
+	account, err := u.socialAccountRepo.FindByUserIDAndProvider(userID, entities.SocialProviderSpotify)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrSpotifyNotLinked
+		}
+		return nil, ErrFindingRecord
+	}
+	return account, nil
+}
+
+func (u *spotifyUsecase) setToken(account *entities.UserSocialAccount, token *oauth2.Token) error {
+	accessToken, err := u.tokenEncryptor.Encrypt(token.AccessToken)
+	if err != nil {
+		return ErrEncryptingToken
+	}
+	// Spotify omits the refresh token from refresh responses that keep the
+	// previous one, so an empty value must not overwrite it.
+	if token.RefreshToken != "" {
+		refreshToken, err := u.tokenEncryptor.Encrypt(token.RefreshToken)
+		if err != nil {
+			return ErrEncryptingToken
+		}
+		account.RefreshToken = refreshToken
+	}
+	account.AccessToken = accessToken
+	account.TokenExpiresAt = &token.Expiry
+	return nil
+}
+
+func (u *spotifyUsecase) getToken(account *entities.UserSocialAccount) (*oauth2.Token, error) {
+	accessToken, err := u.tokenEncryptor.Decrypt(account.AccessToken)
+	if err != nil {
+		return nil, ErrDecryptingToken
+	}
+	refreshToken, err := u.tokenEncryptor.Decrypt(account.RefreshToken)
+	if err != nil {
+		return nil, ErrDecryptingToken
+	}
+	token := &oauth2.Token{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		TokenType:    "Bearer",
+	}
+	if account.TokenExpiresAt != nil {
+		token.Expiry = *account.TokenExpiresAt
+	}
+	return token, nil
+}
+
+// saveRefreshedToken stores the token the client ended up using when it had
+// to refresh the stored one.
+func (u *spotifyUsecase) saveRefreshedToken(client spotifyclient.UserClient, account *entities.UserSocialAccount, previous *oauth2.Token) error {
+	token, err := client.Token()
+	if err != nil {
+		return ErrSpotifyAuthorizationFailed
+	}
+	if token.AccessToken == previous.AccessToken {
+		return nil
+	}
+	if err := u.setToken(account, token); err != nil {
+		return err
+	}
+	if err := u.socialAccountRepo.Update(account); err != nil {
+		return ErrUpdatingRecord
+	}
+	return nil
+}
+
+func toSpotifyImportJobOutput(job *entities.SpotifyImportJob) *SpotifyImportJobOutput {
+	return &SpotifyImportJobOutput{
+		ID:                  job.ID,
+		Status:              job.Status,
+		CollectionsImported: job.CollectionsImported,
+		TracksImported:      job.TracksImported,
+		Error:               job.Error,
+		StartedAt:           job.StartedAt,
+		FinishedAt:          job.FinishedAt,
+		CreatedAt:           job.CreatedAt,
+	}
+}
@@ -0,0 +1,337 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase/mocks"
+	"github.com/myjinjin/sonic-odyssey-backend/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/zmb3/spotify/v2"
+	"golang.org/x/oauth2"
+)
+
+func TestSpotifyUsecase_StartLink(t *testing.T) {
+	// Setup
+	spotifyAuth := &mocks.UserAuthenticator{}
+	linkFlowRepo := &mocks.SocialLinkFlowRepository{}
+
+	spotifyUsecase := NewSpotifyUsecase(spotifyAuth, nil, nil, linkFlowRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	userID := uint(1)
+	var state string
+
+	// Expectations
+	linkFlowRepo.On("Create", mock.MatchedBy(func(f *entities.SocialLinkFlow) bool {
+		state = f.State
+		return f.UserID == userID && f.Provider == entities.SocialProviderSpotify && f.State != "" && f.ExpiresAt.After(time.Now())
+	})).Return(nil)
+	spotifyAuth.On("AuthURL", mock.AnythingOfType("string")).Return("https://accounts.spotify.com/authorize")
+
+	// Execute
+	output, err := spotifyUsecase.StartLink(userID)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "https://accounts.spotify.com/authorize", output.AuthURL)
+
+	// Verify
+	spotifyAuth.AssertCalled(t, "AuthURL", state)
+	linkFlowRepo.AssertExpectations(t)
+}
+
+func TestSpotifyUsecase_CompleteLink_Success(t *testing.T) {
+	// Setup
+	spotifyAuth := &mocks.UserAuthenticator{}
+	userClient := &mocks.UserClient{}
+	socialAccountRepo := &mocks.UserSocialAccountRepository{}
+	linkFlowRepo := &mocks.SocialLinkFlowRepository{}
+	encryptor := &mocks.Encryptor{}
+
+	spotifyUsecase := NewSpotifyUsecase(spotifyAuth, nil, socialAccountRepo, linkFlowRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, encryptor)
+
+	ctx := context.Background()
+	userID := uint(1)
+	expiresAt := time.Now().Add(time.Minute)
+	token := &oauth2.Token{AccessToken: "access", RefreshToken: "refresh", Expiry: time.Now().Add(time.Hour)}
+
+	// Expectations
+	linkFlowRepo.On("FindByState", "state").Return(&entities.SocialLinkFlow{UserID: userID, Provider: entities.SocialProviderSpotify, State: "state", ExpiresAt: &expiresAt}, nil)
+	spotifyAuth.On("Exchange", ctx, "code").Return(token, nil)
+	spotifyAuth.On("NewUserClient", ctx, token).Return(userClient)
+	userClient.On("CurrentUser", ctx).Return(&spotify.PrivateUser{User: spotify.User{ID: "wizzler", DisplayName: "JM Wizzler"}}, nil)
+	socialAccountRepo.On("FindByProviderAndProviderUserID", entities.SocialProviderSpotify, "wizzler").Return(nil, repositories.ErrNotFound)
+	socialAccountRepo.On("FindByUserIDAndProvider", userID, entities.SocialProviderSpotify).Return(nil, repositories.ErrNotFound)
+	encryptor.On("Encrypt", "access").Return("encrypted-access", nil)
+	encryptor.On("Encrypt", "refresh").Return("encrypted-refresh", nil)
+	socialAccountRepo.On("Create", mock.MatchedBy(func(a *entities.UserSocialAccount) bool {
+		return a.UserID == userID && a.ProviderUserID == "wizzler" &&
+			a.AccessToken == "encrypted-access" && a.RefreshToken == "encrypted-refresh" && a.TokenExpiresAt != nil
+	})).Return(nil)
+	linkFlowRepo.On("DeleteByState", "state").Return(nil)
+
+	// Execute
+	output, err := spotifyUsecase.CompleteLink(ctx, userID, "state", "code")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "wizzler", output.SpotifyUserID)
+	assert.Equal(t, "JM Wizzler", output.DisplayName)
+
+	// Verify
+	socialAccountRepo.AssertExpectations(t)
+	linkFlowRepo.AssertExpectations(t)
+	encryptor.AssertExpectations(t)
+}
+
+func TestSpotifyUsecase_CompleteLink_OtherUsersFlow(t *testing.T) {
+	// Setup
+	spotifyAuth := &mocks.UserAuthenticator{}
+	linkFlowRepo := &mocks.SocialLinkFlowRepository{}
+
+	spotifyUsecase := NewSpotifyUsecase(spotifyAuth, nil, nil, linkFlowRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	expiresAt := time.Now().Add(time.Minute)
+
+	// Expectations
+	linkFlowRepo.On("FindByState", "state").Return(&entities.SocialLinkFlow{UserID: 2, Provider: entities.SocialProviderSpotify, State: "state", ExpiresAt: &expiresAt}, nil)
+
+	// Execute
+	output, err := spotifyUsecase.CompleteLink(context.Background(), 1, "state", "code")
+
+	// Assert
+	assert.ErrorIs(t, err, ErrSpotifyLinkFlowNotFound)
+	assert.Nil(t, output)
+
+	// Verify
+	spotifyAuth.AssertNotCalled(t, "Exchange", mock.Anything, mock.Anything)
+}
+
+func TestSpotifyUsecase_CompleteLink_Expired(t *testing.T) {
+	// Setup
+	spotifyAuth := &mocks.UserAuthenticator{}
+	linkFlowRepo := &mocks.SocialLinkFlowRepository{}
+
+	spotifyUsecase := NewSpotifyUsecase(spotifyAuth, nil, nil, linkFlowRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	expiresAt := time.Now().Add(-time.Minute)
+
+	// Expectations
+	linkFlowRepo.On("FindByState", "state").Return(&entities.SocialLinkFlow{UserID: 1, Provider: entities.SocialProviderSpotify, State: "state", ExpiresAt: &expiresAt}, nil)
+
+	// Execute
+	output, err := spotifyUsecase.CompleteLink(context.Background(), 1, "state", "code")
+
+	// Assert
+	assert.ErrorIs(t, err, ErrSpotifyLinkFlowExpired)
+	assert.Nil(t, output)
+
+	// Verify
+	spotifyAuth.AssertNotCalled(t, "Exchange", mock.Anything, mock.Anything)
+}
+
+func TestSpotifyUsecase_CompleteLink_AlreadyLinkedToAnotherUser(t *testing.T) {
+	// Setup
+	spotifyAuth := &mocks.UserAuthenticator{}
+	userClient := &mocks.UserClient{}
+	socialAccountRepo := &mocks.UserSocialAccountRepository{}
+	linkFlowRepo := &mocks.SocialLinkFlowRepository{}
+
+	spotifyUsecase := NewSpotifyUsecase(spotifyAuth, nil, socialAccountRepo, linkFlowRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	ctx := context.Background()
+	expiresAt := time.Now().Add(time.Minute)
+	token := &oauth2.Token{AccessToken: "access"}
+
+	// Expectations
+	linkFlowRepo.On("FindByState", "state").Return(&entities.SocialLinkFlow{UserID: 1, Provider: entities.SocialProviderSpotify, State: "state", ExpiresAt: &expiresAt}, nil)
+	spotifyAuth.On("Exchange", ctx, "code").Return(token, nil)
+	spotifyAuth.On("NewUserClient", ctx, token).Return(userClient)
+	userClient.On("CurrentUser", ctx).Return(&spotify.PrivateUser{User: spotify.User{ID: "wizzler"}}, nil)
+	socialAccountRepo.On("FindByProviderAndProviderUserID", entities.SocialProviderSpotify, "wizzler").Return(&entities.UserSocialAccount{ID: 5, UserID: 2}, nil)
+
+	// Execute
+	output, err := spotifyUsecase.CompleteLink(ctx, 1, "state", "code")
+
+	// Assert
+	assert.ErrorIs(t, err, ErrSpotifyAccountAlreadyLinked)
+	assert.Nil(t, output)
+
+	// Verify
+	socialAccountRepo.AssertNotCalled(t, "Create", mock.Anything)
+	linkFlowRepo.AssertNotCalled(t, "DeleteByState", mock.Anything)
+}
+
+func TestSpotifyUsecase_StartImport_NotLinked(t *testing.T) {
+	// Setup
+	socialAccountRepo := &mocks.UserSocialAccountRepository{}
+	importJobRepo := &mocks.SpotifyImportJobRepository{}
+
+	spotifyUsecase := NewSpotifyUsecase(nil, nil, socialAccountRepo, nil, importJobRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	// Expectations
+	socialAccountRepo.On("FindByUserIDAndProvider", uint(1), entities.SocialProviderSpotify).Return(nil, repositories.ErrNotFound)
+
+	// Execute
+	output, err := spotifyUsecase.StartImport(1)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrSpotifyNotLinked)
+	assert.Nil(t, output)
+
+	// Verify
+	importJobRepo.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func TestSpotifyUsecase_StartImport_InProgress(t *testing.T) {
+	// Setup
+	socialAccountRepo := &mocks.UserSocialAccountRepository{}
+	importJobRepo := &mocks.SpotifyImportJobRepository{}
+
+	spotifyUsecase := NewSpotifyUsecase(nil, nil, socialAccountRepo, nil, importJobRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	// Expectations
+	socialAccountRepo.On("FindByUserIDAndProvider", uint(1), entities.SocialProviderSpotify).Return(&entities.UserSocialAccount{ID: 3, UserID: 1}, nil)
+	importJobRepo.On("FindActiveByUserID", uint(1)).Return(&entities.SpotifyImportJob{ID: 7, UserID: 1, Status: entities.ImportJobStatusRunning}, nil)
+
+	// Execute
+	output, err := spotifyUsecase.StartImport(1)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrSpotifyImportInProgress)
+	assert.Nil(t, output)
+
+	// Verify
+	importJobRepo.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func TestSpotifyUsecase_GetImportJob_OtherUser(t *testing.T) {
+	// Setup
+	importJobRepo := &mocks.SpotifyImportJobRepository{}
+
+	spotifyUsecase := NewSpotifyUsecase(nil, nil, nil, nil, importJobRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	// Expectations
+	importJobRepo.On("FindByID", uint(7)).Return(&entities.SpotifyImportJob{ID: 7, UserID: 2}, nil)
+
+	// Execute
+	output, err := spotifyUsecase.GetImportJob(1, 7)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrSpotifyImportJobNotFound)
+	assert.Nil(t, output)
+}
+
+func TestSpotifyUsecase_RunImport_Success(t *testing.T) {
+	// Setup
+	spotifyAuth := &mocks.UserAuthenticator{}
+	userClient := &mocks.UserClient{}
+	socialAccountRepo := &mocks.UserSocialAccountRepository{}
+	importJobRepo := &mocks.SpotifyImportJobRepository{}
+	collectionRepo := &mocks.MusicCollectionRepository{}
+	collectionMusicRepo := &mocks.CollectionMusicMappingRepository{}
+	musicRepo := &mocks.MusicRepository{}
+	encryptor := &mocks.Encryptor{}
+
+	spotifyUsecase := NewSpotifyUsecase(spotifyAuth, nil, socialAccountRepo, nil, importJobRepo, collectionRepo, collectionMusicRepo, nil, musicRepo, nil, nil, nil, nil, nil, nil, encryptor).(*spotifyUsecase)
+
+	ctx := context.Background()
+	account := &entities.UserSocialAccount{ID: 3, UserID: 1, AccessToken: "encrypted-access", RefreshToken: "encrypted-refresh"}
+	job := &entities.SpotifyImportJob{ID: 7, UserID: 1, Status: entities.ImportJobStatusPending}
+	refreshed := &oauth2.Token{AccessToken: "new-access", Expiry: time.Now().Add(time.Hour)}
+
+	playlists := &spotify.SimplePlaylistPage{Playlists: []spotify.SimplePlaylist{{ID: "playlist1", Name: "Road Trip"}}}
+	items := &spotify.PlaylistItemPage{Items: []spotify.PlaylistItem{
+		{Track: spotify.PlaylistItemTrack{Track: &spotify.FullTrack{SimpleTrack: spotify.SimpleTrack{ID: "track1"}}}},
+		{Track: spotify.PlaylistItemTrack{Track: &spotify.FullTrack{SimpleTrack: spotify.SimpleTrack{ID: "track2"}}}},
+		{IsLocal: true, Track: spotify.PlaylistItemTrack{Track: &spotify.FullTrack{}}},
+		{Track: spotify.PlaylistItemTrack{}},
+	}}
+	saved := &spotify.SavedTrackPage{Tracks: []spotify.SavedTrack{{FullTrack: spotify.FullTrack{SimpleTrack: spotify.SimpleTrack{ID: "track1"}}}}}
+
+	// Expectations
+	encryptor.On("Decrypt", "encrypted-access").Return("access", nil)
+	encryptor.On("Decrypt", "encrypted-refresh").Return("refresh", nil)
+	spotifyAuth.On("NewUserClient", ctx, mock.MatchedBy(func(t *oauth2.Token) bool {
+		return t.AccessToken == "access" && t.RefreshToken == "refresh"
+	})).Return(userClient)
+	userClient.On("CurrentUsersPlaylists", ctx, mock.Anything, mock.Anything).Return(playlists, nil)
+	userClient.On("GetPlaylistItems", ctx, spotify.ID("playlist1"), mock.Anything, mock.Anything).Return(items, nil)
+	userClient.On("CurrentUsersTracks", ctx, mock.Anything, mock.Anything).Return(saved, nil)
+	userClient.On("Token").Return(refreshed, nil)
+
+	collectionRepo.On("FindByUserIDAndSpotifyPlaylistID", uint(1), "playlist1").Return(&entities.MusicCollection{ID: 10, UserID: 1, Name: "Road Trip", SpotifyPlaylistID: "playlist1"}, nil)
+	collectionRepo.On("FindByUserIDAndSpotifyPlaylistID", uint(1), spotifySavedTracksKey).Return(nil, repositories.ErrNotFound)
+	collectionRepo.On("Create", mock.MatchedBy(func(c *entities.MusicCollection) bool {
+		return c.UserID == 1 && c.Name == spotifySavedTracksName && c.SpotifyPlaylistID == spotifySavedTracksKey
+	})).Run(func(args mock.Arguments) {
+		args.Get(0).(*entities.MusicCollection).ID = 11
+	}).Return(nil)
+
+	musicRepo.On("FindBySpotifyID", "track1").Return(&entities.Music{ID: 100}, nil)
+	musicRepo.On("FindBySpotifyID", "track2").Return(&entities.Music{ID: 200}, nil)
+
+	collectionMusicRepo.On("FindByCollectionID", uint(10)).Return([]*entities.CollectionMusicMapping{{CollectionID: 10, MusicID: 100}}, nil)
+	collectionMusicRepo.On("FindByCollectionID", uint(11)).Return([]*entities.CollectionMusicMapping{}, nil)
+	collectionMusicRepo.On("Create", &entities.CollectionMusicMapping{CollectionID: 10, MusicID: 200, AddedBy: utils.ToPtr(uint(1))}).Return(nil)
+	collectionMusicRepo.On("Create", &entities.CollectionMusicMapping{CollectionID: 11, MusicID: 100, AddedBy: utils.ToPtr(uint(1))}).Return(nil)
+
+	importJobRepo.On("Update", job).Return(nil)
+
+	encryptor.On("Encrypt", "new-access").Return("encrypted-new-access", nil)
+	socialAccountRepo.On("Update", mock.MatchedBy(func(a *entities.UserSocialAccount) bool {
+		return a.AccessToken == "encrypted-new-access" && a.RefreshToken == "encrypted-refresh"
+	})).Return(nil)
+
+	// Execute
+	spotifyUsecase.runImport(ctx, job, account)
+
+	// Assert
+	assert.Equal(t, entities.ImportJobStatusCompleted, job.Status)
+	assert.Equal(t, 2, job.CollectionsImported)
+	assert.Equal(t, 2, job.TracksImported)
+	assert.Empty(t, job.Error)
+	assert.NotNil(t, job.StartedAt)
+	assert.NotNil(t, job.FinishedAt)
+
+	// Verify
+	collectionRepo.AssertExpectations(t)
+	collectionMusicRepo.AssertExpectations(t)
+	socialAccountRepo.AssertExpectations(t)
+	collectionMusicRepo.AssertNumberOfCalls(t, "Create", 2)
+}
+
+func TestSpotifyUsecase_RunImport_SpotifyError(t *testing.T) {
+	// Setup
+	spotifyAuth := &mocks.UserAuthenticator{}
+	userClient := &mocks.UserClient{}
+	importJobRepo := &mocks.SpotifyImportJobRepository{}
+	encryptor := &mocks.Encryptor{}
+
+	spotifyUsecase := NewSpotifyUsecase(spotifyAuth, nil, nil, nil, importJobRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, encryptor).(*spotifyUsecase)
+
+	ctx := context.Background()
+	account := &entities.UserSocialAccount{ID: 3, UserID: 1, AccessToken: "encrypted-access", RefreshToken: "encrypted-refresh"}
+	job := &entities.SpotifyImportJob{ID: 7, UserID: 1, Status: entities.ImportJobStatusPending}
+
+	// Expectations
+	encryptor.On("Decrypt", "encrypted-access").Return("access", nil)
+	encryptor.On("Decrypt", "encrypted-refresh").Return("refresh", nil)
+	spotifyAuth.On("NewUserClient", ctx, mock.Anything).Return(userClient)
+	userClient.On("CurrentUsersPlaylists", ctx, mock.Anything, mock.Anything).Return(nil, errors.New("spotify unavailable"))
+	userClient.On("Token").Return(&oauth2.Token{AccessToken: "access"}, nil)
+	importJobRepo.On("Update", job).Return(nil)
+
+	// Execute
+	spotifyUsecase.runImport(ctx, job, account)
+
+	// Assert
+	assert.Equal(t, entities.ImportJobStatusFailed, job.Status)
+	assert.Equal(t, ErrFetchingSpotify.Error(), job.Error)
+	assert.NotNil(t, job.FinishedAt)
+}
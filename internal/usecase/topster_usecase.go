@@ -0,0 +1,542 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/logging"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/storage"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/topsterimage"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"go.uber.org/zap"
+)
+
+type TopsterUsecase interface {
+	CreateTopster(userID uint, input *CreateTopsterInput) (*TopsterOutput, error)
+	GetTopster(viewerID, topsterID uint) (*TopsterOutput, error)
+	PatchTopster(userID, topsterID uint, input *PatchTopsterInput) (*TopsterOutput, error)
+	DeleteTopster(userID, topsterID uint) error
+	UpdateLayout(userID, topsterID uint, albums []TopsterAlbumInput) (*TopsterOutput, error)
+	PreviewAutofill(userID, topsterID uint, input *AutofillTopsterInput) (*TopsterOutput, error)
+	ListUserTopsters(viewerID, ownerID uint, limit, offset *int) (*ListTopstersOutput, error)
+}
+
+const (
+	maxTopsterGridSize = 10
+
+	topsterRenderTimeout = 30 * time.Second
+
+	autofillSourceLikes      = "likes"
+	autofillSourceCollection = "collection"
+	autofillSourceGenre      = "genre"
+)
+
+// tieredTopsterTemplates holds the number of cells in each row of the tiered
+// templates.
+var tieredTopsterTemplates = map[string][]int{
+	entities.TopsterTemplateTop42: {5, 5, 6, 6, 10, 10},
+}
+
+type topsterUsecase struct {
+	topsterRepo      repositories.UserTopsterRepository
+	topsterAlbumRepo repositories.TopsterAlbumRepository
+	albumRepo        repositories.AlbumRepository
+	userRepo         repositories.UserRepository
+	blockRepo        repositories.UserBlockRepository
+	collectionRepo   repositories.MusicCollectionRepository
+	memberRepo       repositories.CollectionMemberRepository
+	genreRepo        repositories.GenreRepository
+	renderer         topsterimage.Renderer
+	storage          storage.Storage
+	activities       *ActivityRecorder
+}
+
+func NewTopsterUsecase(topsterRepo repositories.UserTopsterRepository, topsterAlbumRepo repositories.TopsterAlbumRepository, albumRepo repositories.AlbumRepository, userRepo repositories.UserRepository, blockRepo repositories.UserBlockRepository, collectionRepo repositories.MusicCollectionRepository, memberRepo repositories.CollectionMemberRepository, genreRepo repositories.GenreRepository, renderer topsterimage.Renderer, storage storage.Storage, activities *ActivityRecorder) TopsterUsecase {
+	return &topsterUsecase{
+		topsterRepo:      topsterRepo,
+		topsterAlbumRepo: topsterAlbumRepo,
+		albumRepo:        albumRepo,
+		userRepo:         userRepo,
+		blockRepo:        blockRepo,
+		collectionRepo:   collectionRepo,
+		memberRepo:       memberRepo,
+		genreRepo:        genreRepo,
+		renderer:         renderer,
+		storage:          storage,
+		activities:       activities,
+	}
+}
+
+// CreateTopster creates a topster from a template. An empty template stands
+// for a grid of the given rows and columns.
+func (u *topsterUsecase) CreateTopster(userID uint, input *CreateTopsterInput) (*TopsterOutput, error) {
+	topster := &entities.UserTopster{
+		UserID:      userID,
+		Title:       input.Title,
+		Description: input.Description,
+		IsPublic:    input.IsPublic,
+		ShowTitles:  input.ShowTitles,
+	}
+	template := input.Template
+	if template == "" {
+		template = entities.TopsterTemplateGrid
+	}
+	if err := setTopsterShape(topster, template, input.Rows, input.Cols); err != nil {
+		return nil, err
+	}
+	albums, err := u.buildLayout(topsterRowWidths(topster), input.Albums)
+	if err != nil {
+		return nil, err
+	}
+	topster.TopsterAlbums = albums
+	if err := u.topsterRepo.Create(topster); err != nil {
+		return nil, ErrCreatingRecord
+	}
+	u.renderImage(topster)
+	if topster.IsPublic {
+		u.activities.record(&entities.Activity{ActorID: userID, Type: entities.ActivityTypeTopster, TopsterID: &topster.ID})
+	}
+	output := toTopsterOutput(topster)
+	return &output, nil
+}
+
+// GetTopster returns the topster with its albums. Private topsters are only
+// visible to their owner.
+func (u *topsterUsecase) GetTopster(viewerID, topsterID uint) (*TopsterOutput, error) {
+	topster, err := u.findTopster(topsterID)
+	if err != nil {
+		return nil, err
+	}
+	if !topster.IsPublic && topster.UserID != viewerID {
+		return nil, ErrTopsterNotFound
+	}
+	output := toTopsterOutput(topster)
+	return &output, nil
+}
+
+// PatchTopster updates the topster's details. Setting rows or cols without a
+// template turns the topster into a grid. Changing the template or shrinking
+// the grid is rejected while albums are placed in cells that would be removed.
+func (u *topsterUsecase) PatchTopster(userID, topsterID uint, input *PatchTopsterInput) (*TopsterOutput, error) {
+	topster, err := u.ownedTopster(userID, topsterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Template != nil || input.Rows != nil || input.Cols != nil {
+		template, rows, cols := entities.TopsterTemplateGrid, topster.Rows, topster.Cols
+		if input.Template != nil {
+			template = *input.Template
+		}
+		if input.Rows != nil {
+			rows = *input.Rows
+		}
+		if input.Cols != nil {
+			cols = *input.Cols
+		}
+		if err := setTopsterShape(topster, template, rows, cols); err != nil {
+			return nil, err
+		}
+		widths := topsterRowWidths(topster)
+		for _, a := range topster.TopsterAlbums {
+			if !inLayout(a.Position, widths) {
+				return nil, ErrTopsterPositionOutOfBounds
+			}
+		}
+	}
+	if input.Title != nil {
+		topster.Title = *input.Title
+	}
+	if input.Description != nil {
+		topster.Description = *input.Description
+	}
+	published := input.IsPublic != nil && *input.IsPublic && !topster.IsPublic
+	if input.IsPublic != nil {
+		topster.IsPublic = *input.IsPublic
+	}
+	if input.ShowTitles != nil {
+		topster.ShowTitles = *input.ShowTitles
+	}
+	if err := u.topsterRepo.Update(topster); err != nil {
+		return nil, ErrUpdatingRecord
+	}
+	u.renderImage(topster)
+	if published {
+		u.activities.record(&entities.Activity{ActorID: userID, Type: entities.ActivityTypeTopster, TopsterID: &topster.ID})
+	}
+	output := toTopsterOutput(topster)
+	return &output, nil
+}
+
+func (u *topsterUsecase) DeleteTopster(userID, topsterID uint) error {
+	topster, err := u.ownedTopster(userID, topsterID)
+	if err != nil {
+		return err
+	}
+	if err := u.topsterRepo.Delete(topster.ID); err != nil {
+		return ErrDeletingRecord
+	}
+	return nil
+}
+
+// UpdateLayout replaces all albums of the topster with the given layout at
+// once. Albums left out of the layout are removed from the topster.
+func (u *topsterUsecase) UpdateLayout(userID, topsterID uint, albums []TopsterAlbumInput) (*TopsterOutput, error) {
+	topster, err := u.ownedTopster(userID, topsterID)
+	if err != nil {
+		return nil, err
+	}
+	layout, err := u.buildLayout(topsterRowWidths(topster), albums)
+	if err != nil {
+		return nil, err
+	}
+
+	topsterAlbums := make([]*entities.TopsterAlbum, len(layout))
+	for i := range layout {
+		topsterAlbums[i] = &layout[i]
+	}
+	if err := u.topsterAlbumRepo.ReplaceByTopsterID(topster.ID, topsterAlbums); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrTopsterNotFound
+		}
+		return nil, ErrUpdatingRecord
+	}
+	topster.TopsterAlbums = layout
+	u.renderImage(topster)
+	output := toTopsterOutput(topster)
+	return &output, nil
+}
+
+// PreviewAutofill proposes a layout that fills the topster's empty cells, in
+// reading order, with albums from the source. Albums already placed are kept
+// unless Overwrite is set. Nothing is saved: the preview is committed by
+// passing its albums to UpdateLayout.
+func (u *topsterUsecase) PreviewAutofill(userID, topsterID uint, input *AutofillTopsterInput) (*TopsterOutput, error) {
+	topster, err := u.ownedTopster(userID, topsterID)
+	if err != nil {
+		return nil, err
+	}
+	layout := topster.TopsterAlbums
+	if input.Overwrite {
+		layout = nil
+	}
+
+	widths := topsterRowWidths(topster)
+	cellCount := 0
+	for _, w := range widths {
+		cellCount += w
+	}
+	// Fetch enough albums to fill every cell even if some are already placed.
+	candidates, err := u.autofillAlbums(userID, input, cellCount+len(layout))
+	if err != nil {
+		return nil, err
+	}
+
+	cells := make(map[entities.TopsterPosition]bool, len(layout))
+	albumIDs := make(map[uint]bool, len(layout))
+	for _, a := range layout {
+		cells[a.Position] = true
+		albumIDs[a.AlbumID] = true
+	}
+	for row, w := range widths {
+		for col := 0; col < w && len(candidates) > 0; col++ {
+			position := entities.TopsterPosition{Row: row, Col: col}
+			if cells[position] {
+				continue
+			}
+			for len(candidates) > 0 && albumIDs[candidates[0].ID] {
+				candidates = candidates[1:]
+			}
+			if len(candidates) == 0 {
+				break
+			}
+			album := candidates[0]
+			candidates = candidates[1:]
+			albumIDs[album.ID] = true
+			layout = append(layout, entities.TopsterAlbum{TopsterID: topster.ID, AlbumID: album.ID, Position: position, Album: *album})
+		}
+	}
+	sort.Slice(layout, func(i, j int) bool {
+		if layout[i].Position.Row != layout[j].Position.Row {
+			return layout[i].Position.Row < layout[j].Position.Row
+		}
+		return layout[i].Position.Col < layout[j].Position.Col
+	})
+
+	preview := *topster
+	preview.TopsterAlbums = layout
+	preview.ImageURL = ""
+	output := toTopsterOutput(&preview)
+	return &output, nil
+}
+
+// autofillAlbums returns up to limit albums from the auto-fill source, best
+// candidates first.
+func (u *topsterUsecase) autofillAlbums(userID uint, input *AutofillTopsterInput, limit int) ([]*entities.Album, error) {
+	var albums []*entities.Album
+	var err error
+	switch input.Source {
+	case autofillSourceLikes:
+		albums, err = u.albumRepo.FindMostLikedByUserID(userID, limit)
+	case autofillSourceCollection:
+		if input.CollectionID == nil {
+			return nil, ErrInvalidAutofillSource
+		}
+		if err := u.checkCollectionReadable(userID, *input.CollectionID); err != nil {
+			return nil, err
+		}
+		albums, err = u.albumRepo.FindByCollectionID(*input.CollectionID, limit)
+	case autofillSourceGenre:
+		if input.GenreID == nil {
+			return nil, ErrInvalidAutofillSource
+		}
+		if _, err := u.genreRepo.FindByID(*input.GenreID); err != nil {
+			if errors.Is(err, repositories.ErrNotFound) {
+				return nil, ErrGenreNotFound
+			}
+			return nil, ErrFindingRecord
+		}
+		albums, err = u.albumRepo.FindMostLikedByGenreID(*input.GenreID, limit)
+	default:
+		return nil, ErrInvalidAutofillSource
+	}
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	return albums, nil
+}
+
+// checkCollectionReadable reports private collections the user is not a
+// member of as not found.
+func (u *topsterUsecase) checkCollectionReadable(userID, collectionID uint) error {
+	collection, err := u.collectionRepo.FindByID(collectionID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrCollectionNotFound
+		}
+		return ErrFindingRecord
+	}
+	if collection.IsPublic || collection.UserID == userID {
+		return nil
+	}
+	if _, err := u.memberRepo.FindByCollectionIDAndUserID(collectionID, userID); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrCollectionNotFound
+		}
+		return ErrFindingRecord
+	}
+	return nil
+}
+
+// ListUserTopsters lists the owner's topsters, newest first. Other users only
+// see public topsters, and none if either blocked the other or the owner hid
+// their topsters.
+func (u *topsterUsecase) ListUserTopsters(viewerID, ownerID uint, limit, offset *int) (*ListTopstersOutput, error) {
+	owner, err := findVisibleUser(u.userRepo, u.blockRepo, viewerID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	if sectionHidden(viewerID, owner, ProfileSectionTopsters) {
+		return &ListTopstersOutput{Topsters: []TopsterOutput{}}, nil
+	}
+
+	l, o := pagination(limit, offset)
+	includePrivate := viewerID == ownerID
+	topsters, err := u.topsterRepo.FindByUserID(ownerID, includePrivate, o, l)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	total, err := u.topsterRepo.CountByUserID(ownerID, includePrivate)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+
+	outputs := make([]TopsterOutput, len(topsters))
+	for i, t := range topsters {
+		outputs[i] = toTopsterOutput(t)
+	}
+	return &ListTopstersOutput{Topsters: outputs, Total: int(total)}, nil
+}
+
+func (u *topsterUsecase) findTopster(topsterID uint) (*entities.UserTopster, error) {
+	topster, err := u.topsterRepo.FindByID(topsterID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrTopsterNotFound
+		}
+		return nil, ErrFindingRecord
+	}
+	return topster, nil
+}
+
+// ownedTopster returns the topster if the user owns it. Other users' private
+// topsters are reported as not found, so they cannot be probed for.
+func (u *topsterUsecase) ownedTopster(userID, topsterID uint) (*entities.UserTopster, error) {
+	topster, err := u.findTopster(topsterID)
+	if err != nil {
+		return nil, err
+	}
+	if topster.UserID == userID {
+		return topster, nil
+	}
+	if !topster.IsPublic {
+		return nil, ErrTopsterNotFound
+	}
+	return nil, ErrTopsterPermissionDenied
+}
+
+// buildLayout validates that every album exists and sits in its own cell
+// inside the layout, and returns the albums to store.
+func (u *topsterUsecase) buildLayout(widths []int, albums []TopsterAlbumInput) ([]entities.TopsterAlbum, error) {
+	cells := make(map[entities.TopsterPosition]bool, len(albums))
+	albumIDs := make(map[uint]bool, len(albums))
+	layout := make([]entities.TopsterAlbum, len(albums))
+	for i, a := range albums {
+		position := entities.TopsterPosition{Row: a.Row, Col: a.Col}
+		if !inLayout(position, widths) {
+			return nil, ErrTopsterPositionOutOfBounds
+		}
+		if cells[position] {
+			return nil, ErrTopsterPositionConflict
+		}
+		if albumIDs[a.AlbumID] {
+			return nil, ErrDuplicateTopsterAlbum
+		}
+		cells[position] = true
+		albumIDs[a.AlbumID] = true
+		layout[i] = entities.TopsterAlbum{AlbumID: a.AlbumID, Position: position}
+	}
+
+	// Merged albums resolve to the album they were merged into, which may
+	// already be part of the layout under its own ID.
+	resolvedIDs := make(map[uint]bool, len(layout))
+	for i := range layout {
+		album, err := u.albumRepo.FindByID(layout[i].AlbumID)
+		if err != nil {
+			if errors.Is(err, repositories.ErrNotFound) {
+				return nil, ErrAlbumNotFound
+			}
+			return nil, ErrFindingRecord
+		}
+		if resolvedIDs[album.ID] {
+			return nil, ErrDuplicateTopsterAlbum
+		}
+		resolvedIDs[album.ID] = true
+		layout[i].AlbumID = album.ID
+		layout[i].Album = *album
+	}
+	return layout, nil
+}
+
+// renderImage renders the topster image and stores its URL on the topster.
+// The storage key carries the layout fingerprint, so unchanged layouts are not
+// rendered again. Failures are only logged since the topster is already saved.
+func (u *topsterUsecase) renderImage(topster *entities.UserTopster) {
+	layout := toTopsterLayout(topster)
+	key := fmt.Sprintf("topsters/%d/%s%s", topster.ID, u.renderer.Fingerprint(layout), u.renderer.Extension())
+	if strings.HasSuffix(topster.ImageURL, "/"+key) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), topsterRenderTimeout)
+	defer cancel()
+	data, err := u.renderer.Render(ctx, layout)
+	if err != nil {
+		logging.Log().Error("failed to render topster image", zap.Error(err), zap.Uint("topster_id", topster.ID))
+		return
+	}
+	url, err := u.storage.Put(ctx, key, data, u.renderer.ContentType())
+	if err != nil {
+		logging.Log().Error("failed to store topster image", zap.Error(err), zap.Uint("topster_id", topster.ID))
+		return
+	}
+
+	previousURL := topster.ImageURL
+	topster.ImageURL = url
+	if err := u.topsterRepo.Update(topster); err != nil {
+		logging.Log().Error("failed to update topster image url", zap.Error(err), zap.Uint("topster_id", topster.ID))
+		topster.ImageURL = previousURL
+	}
+}
+
+func toTopsterLayout(t *entities.UserTopster) *topsterimage.Layout {
+	albums := make([]topsterimage.Album, len(t.TopsterAlbums))
+	for i, a := range t.TopsterAlbums {
+		albums[i] = topsterimage.Album{
+			Row:      a.Position.Row,
+			Col:      a.Position.Col,
+			CoverURL: a.Album.ImageURL,
+			Title:    a.Album.Name,
+			Artist:   a.Album.Artist.Name,
+		}
+	}
+	return &topsterimage.Layout{RowWidths: topsterRowWidths(t), ShowTitles: t.ShowTitles, Albums: albums}
+}
+
+// setTopsterShape validates the template and dimensions and sets them on the
+// topster. Tiered templates have fixed dimensions, so rows and cols are
+// ignored for them.
+func setTopsterShape(t *entities.UserTopster, template string, rows, cols int) error {
+	if template == entities.TopsterTemplateGrid {
+		if rows < 1 || rows > maxTopsterGridSize || cols < 1 || cols > maxTopsterGridSize {
+			return ErrInvalidTopsterGridSize
+		}
+		t.Template, t.Rows, t.Cols = template, rows, cols
+		return nil
+	}
+	widths, ok := tieredTopsterTemplates[template]
+	if !ok {
+		return ErrInvalidTopsterTemplate
+	}
+	t.Template, t.Rows, t.Cols = template, len(widths), slices.Max(widths)
+	return nil
+}
+
+// topsterRowWidths returns the number of cells in each row of the topster.
+func topsterRowWidths(t *entities.UserTopster) []int {
+	if widths, ok := tieredTopsterTemplates[t.Template]; ok {
+		return widths
+	}
+	widths := make([]int, t.Rows)
+	for i := range widths {
+		widths[i] = t.Cols
+	}
+	return widths
+}
+
+func inLayout(p entities.TopsterPosition, widths []int) bool {
+	return p.Row >= 0 && p.Row < len(widths) && p.Col >= 0 && p.Col < widths[p.Row]
+}
+
+func toTopsterOutput(t *entities.UserTopster) TopsterOutput {
+	albums := make([]TopsterAlbumOutput, len(t.TopsterAlbums))
+	for i, a := range t.TopsterAlbums {
+		albums[i] = TopsterAlbumOutput{
+			Album: toCatalogAlbum(&a.Album),
+			Row:   a.Position.Row,
+			Col:   a.Position.Col,
+		}
+	}
+	return TopsterOutput{
+		ID:          t.ID,
+		UserID:      t.UserID,
+		Title:       t.Title,
+		Description: t.Description,
+		Template:    t.Template,
+		Rows:        t.Rows,
+		Cols:        t.Cols,
+		RowWidths:   topsterRowWidths(t),
+		ImageURL:    t.ImageURL,
+		IsPublic:    t.IsPublic,
+		ShowTitles:  t.ShowTitles,
+		Albums:      albums,
+		CreatedAt:   t.CreatedAt,
+		UpdatedAt:   t.UpdatedAt,
+	}
+}
@@ -0,0 +1,549 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/topsterimage"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/entities"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/domain/repositories"
+	"github.com/myjinjin/sonic-odyssey-backend/internal/usecase/mocks"
+	"github.com/myjinjin/sonic-odyssey-backend/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTopsterUsecase_CreateTopster_Success(t *testing.T) {
+	// Setup
+	topsterRepo := &mocks.UserTopsterRepository{}
+	albumRepo := &mocks.AlbumRepository{}
+
+	renderer := &mocks.Renderer{}
+	fileStorage := &mocks.Storage{}
+
+	topsterUsecase := NewTopsterUsecase(topsterRepo, nil, albumRepo, nil, nil, nil, nil, nil, renderer, fileStorage, nil)
+
+	// Expectations
+	albumRepo.On("FindByID", uint(5)).Return(&entities.Album{ID: 5, Name: "Discovery", ImageURL: "https://i.scdn.co/image/discovery"}, nil)
+	albumRepo.On("FindByID", uint(6)).Return(&entities.Album{ID: 6, Name: "Homework", Artist: entities.Artist{Name: "Daft Punk"}}, nil)
+	topsterRepo.On("Create", mock.MatchedBy(func(t *entities.UserTopster) bool {
+		return t.UserID == 1 && t.Template == entities.TopsterTemplateGrid && t.Rows == 3 && t.Cols == 4 && len(t.TopsterAlbums) == 2 &&
+			t.TopsterAlbums[1].Position == entities.TopsterPosition{Row: 2, Col: 1}
+	})).Run(func(args mock.Arguments) {
+		args.Get(0).(*entities.UserTopster).ID = 10
+	}).Return(nil)
+	renderer.On("Fingerprint", mock.Anything).Return("abc")
+	renderer.On("Extension").Return(".png")
+	renderer.On("ContentType").Return("image/png")
+	renderer.On("Render", mock.Anything, &topsterimage.Layout{
+		RowWidths:  []int{4, 4, 4},
+		ShowTitles: true,
+		Albums: []topsterimage.Album{
+			{Row: 0, Col: 0, CoverURL: "https://i.scdn.co/image/discovery", Title: "Discovery"},
+			{Row: 2, Col: 1, Title: "Homework", Artist: "Daft Punk"},
+		},
+	}).Return([]byte("image"), nil)
+	fileStorage.On("Put", mock.Anything, "topsters/10/abc.png", []byte("image"), "image/png").
+		Return("https://cdn.example.com/topsters/10/abc.png", nil)
+	topsterRepo.On("Update", mock.MatchedBy(func(t *entities.UserTopster) bool {
+		return t.ImageURL == "https://cdn.example.com/topsters/10/abc.png"
+	})).Return(nil)
+
+	// Execute
+	output, err := topsterUsecase.CreateTopster(1, &CreateTopsterInput{
+		Title:      "Daft Punk",
+		Rows:       3,
+		Cols:       4,
+		IsPublic:   true,
+		ShowTitles: true,
+		Albums:     []TopsterAlbumInput{{AlbumID: 5, Row: 0, Col: 0}, {AlbumID: 6, Row: 2, Col: 1}},
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, output.Albums, 2)
+	assert.Equal(t, "Homework", output.Albums[1].Album.Name)
+	assert.Equal(t, 2, output.Albums[1].Row)
+	assert.Equal(t, []int{4, 4, 4}, output.RowWidths)
+	assert.Equal(t, "https://cdn.example.com/topsters/10/abc.png", output.ImageURL)
+
+	// Verify
+	topsterRepo.AssertExpectations(t)
+	albumRepo.AssertExpectations(t)
+	renderer.AssertExpectations(t)
+	fileStorage.AssertExpectations(t)
+}
+
+func TestTopsterUsecase_CreateTopster_InvalidLayout(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		rows     int
+		cols     int
+		albums   []TopsterAlbumInput
+		expected error
+	}{
+		{"NoRows", "", 0, 3, nil, ErrInvalidTopsterGridSize},
+		{"TooManyCols", entities.TopsterTemplateGrid, 3, 11, nil, ErrInvalidTopsterGridSize},
+		{"UnknownTemplate", "top7", 0, 0, nil, ErrInvalidTopsterTemplate},
+		{"RowOutOfBounds", "", 3, 3, []TopsterAlbumInput{{AlbumID: 5, Row: 3, Col: 0}}, ErrTopsterPositionOutOfBounds},
+		{"NegativeCol", "", 3, 3, []TopsterAlbumInput{{AlbumID: 5, Row: 0, Col: -1}}, ErrTopsterPositionOutOfBounds},
+		{"OutsideTieredRow", entities.TopsterTemplateTop42, 0, 0, []TopsterAlbumInput{{AlbumID: 5, Row: 0, Col: 5}}, ErrTopsterPositionOutOfBounds},
+		{"Overlap", "", 3, 3, []TopsterAlbumInput{{AlbumID: 5, Row: 1, Col: 1}, {AlbumID: 6, Row: 1, Col: 1}}, ErrTopsterPositionConflict},
+		{"DuplicateAlbum", "", 3, 3, []TopsterAlbumInput{{AlbumID: 5, Row: 0, Col: 0}, {AlbumID: 5, Row: 0, Col: 1}}, ErrDuplicateTopsterAlbum},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			topsterUsecase := NewTopsterUsecase(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			// Execute
+			output, err := topsterUsecase.CreateTopster(1, &CreateTopsterInput{Title: "Invalid", Template: tt.template, Rows: tt.rows, Cols: tt.cols, Albums: tt.albums})
+
+			// Assert
+			assert.ErrorIs(t, err, tt.expected)
+			assert.Nil(t, output)
+		})
+	}
+}
+
+func TestTopsterUsecase_CreateTopster_AlbumNotFound(t *testing.T) {
+	// Setup
+	topsterRepo := &mocks.UserTopsterRepository{}
+	albumRepo := &mocks.AlbumRepository{}
+
+	topsterUsecase := NewTopsterUsecase(topsterRepo, nil, albumRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	// Expectations
+	albumRepo.On("FindByID", uint(5)).Return(nil, repositories.ErrNotFound)
+
+	// Execute
+	output, err := topsterUsecase.CreateTopster(1, &CreateTopsterInput{Title: "Missing", Rows: 3, Cols: 3, Albums: []TopsterAlbumInput{{AlbumID: 5}}})
+
+	// Assert
+	assert.ErrorIs(t, err, ErrAlbumNotFound)
+	assert.Nil(t, output)
+
+	// Verify
+	topsterRepo.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func TestTopsterUsecase_GetTopster_PrivateHiddenFromOthers(t *testing.T) {
+	// Setup
+	topsterRepo := &mocks.UserTopsterRepository{}
+
+	topsterUsecase := NewTopsterUsecase(topsterRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	// Expectations
+	topsterRepo.On("FindByID", uint(10)).Return(&entities.UserTopster{ID: 10, UserID: 1, IsPublic: false}, nil)
+
+	// Execute
+	output, err := topsterUsecase.GetTopster(1, 10)
+	_, otherErr := topsterUsecase.GetTopster(2, 10)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, uint(10), output.ID)
+	assert.ErrorIs(t, otherErr, ErrTopsterNotFound)
+}
+
+func TestTopsterUsecase_PatchTopster(t *testing.T) {
+	topster := func() *entities.UserTopster {
+		return &entities.UserTopster{
+			ID:            10,
+			UserID:        1,
+			Title:         "Old",
+			Template:      entities.TopsterTemplateGrid,
+			Rows:          5,
+			Cols:          5,
+			ImageURL:      "https://cdn.example.com/topsters/10/abc.png",
+			IsPublic:      true,
+			TopsterAlbums: []entities.TopsterAlbum{{AlbumID: 5, Position: entities.TopsterPosition{Row: 3, Col: 0}}},
+		}
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		// Setup
+		topsterRepo := &mocks.UserTopsterRepository{}
+		renderer := &mocks.Renderer{}
+
+		topsterUsecase := NewTopsterUsecase(topsterRepo, nil, nil, nil, nil, nil, nil, nil, renderer, nil, nil)
+
+		// Expectations
+		topsterRepo.On("FindByID", uint(10)).Return(topster(), nil)
+		topsterRepo.On("Update", mock.MatchedBy(func(t *entities.UserTopster) bool {
+			return t.Title == "New" && t.Rows == 4 && t.Cols == 5 && !t.IsPublic
+		})).Return(nil).Once()
+		renderer.On("Fingerprint", mock.Anything).Return("def")
+		renderer.On("Extension").Return(".png")
+		renderer.On("Render", mock.Anything, mock.Anything).Return(nil, errors.New("render failed"))
+
+		// Execute
+		output, err := topsterUsecase.PatchTopster(1, 10, &PatchTopsterInput{Title: utils.ToPtr("New"), Rows: utils.ToPtr(4), IsPublic: utils.ToPtr(false)})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 4, output.Rows)
+		assert.Equal(t, "https://cdn.example.com/topsters/10/abc.png", output.ImageURL)
+
+		// Verify
+		topsterRepo.AssertExpectations(t)
+		renderer.AssertExpectations(t)
+	})
+
+	t.Run("UnchangedLayoutNotRendered", func(t *testing.T) {
+		// Setup
+		topsterRepo := &mocks.UserTopsterRepository{}
+		renderer := &mocks.Renderer{}
+
+		topsterUsecase := NewTopsterUsecase(topsterRepo, nil, nil, nil, nil, nil, nil, nil, renderer, nil, nil)
+
+		// Expectations
+		topsterRepo.On("FindByID", uint(10)).Return(topster(), nil)
+		topsterRepo.On("Update", mock.Anything).Return(nil).Once()
+		renderer.On("Fingerprint", mock.Anything).Return("abc")
+		renderer.On("Extension").Return(".png")
+
+		// Execute
+		output, err := topsterUsecase.PatchTopster(1, 10, &PatchTopsterInput{Description: utils.ToPtr("Updated")})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "Updated", output.Description)
+
+		// Verify
+		topsterRepo.AssertExpectations(t)
+		renderer.AssertNotCalled(t, "Render", mock.Anything, mock.Anything)
+	})
+
+	t.Run("ShrinkingCutsOffAlbums", func(t *testing.T) {
+		// Setup
+		topsterRepo := &mocks.UserTopsterRepository{}
+
+		topsterUsecase := NewTopsterUsecase(topsterRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// Expectations
+		topsterRepo.On("FindByID", uint(10)).Return(topster(), nil)
+
+		// Execute
+		output, err := topsterUsecase.PatchTopster(1, 10, &PatchTopsterInput{Rows: utils.ToPtr(3), Cols: utils.ToPtr(3)})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrTopsterPositionOutOfBounds)
+		assert.Nil(t, output)
+
+		// Verify
+		topsterRepo.AssertNotCalled(t, "Update", mock.Anything)
+	})
+
+	t.Run("SwitchToTemplate", func(t *testing.T) {
+		// Setup
+		topsterRepo := &mocks.UserTopsterRepository{}
+		renderer := &mocks.Renderer{}
+
+		topsterUsecase := NewTopsterUsecase(topsterRepo, nil, nil, nil, nil, nil, nil, nil, renderer, nil, nil)
+
+		// Expectations
+		topsterRepo.On("FindByID", uint(10)).Return(topster(), nil)
+		topsterRepo.On("Update", mock.MatchedBy(func(t *entities.UserTopster) bool {
+			return t.Template == entities.TopsterTemplateTop42 && t.Rows == 6 && t.Cols == 10
+		})).Return(nil)
+		renderer.On("Fingerprint", mock.Anything).Return("abc")
+		renderer.On("Extension").Return(".png")
+
+		// Execute
+		output, err := topsterUsecase.PatchTopster(1, 10, &PatchTopsterInput{Template: utils.ToPtr(entities.TopsterTemplateTop42)})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []int{5, 5, 6, 6, 10, 10}, output.RowWidths)
+
+		// Verify
+		topsterRepo.AssertExpectations(t)
+	})
+
+	t.Run("NotOwner", func(t *testing.T) {
+		// Setup
+		topsterRepo := &mocks.UserTopsterRepository{}
+
+		topsterUsecase := NewTopsterUsecase(topsterRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// Expectations
+		topsterRepo.On("FindByID", uint(10)).Return(topster(), nil)
+
+		// Execute
+		output, err := topsterUsecase.PatchTopster(2, 10, &PatchTopsterInput{Title: utils.ToPtr("Mine")})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrTopsterPermissionDenied)
+		assert.Nil(t, output)
+	})
+}
+
+func TestTopsterUsecase_UpdateLayout(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// Setup
+		topsterRepo := &mocks.UserTopsterRepository{}
+		topsterAlbumRepo := &mocks.TopsterAlbumRepository{}
+		albumRepo := &mocks.AlbumRepository{}
+		renderer := &mocks.Renderer{}
+		fileStorage := &mocks.Storage{}
+
+		topsterUsecase := NewTopsterUsecase(topsterRepo, topsterAlbumRepo, albumRepo, nil, nil, nil, nil, nil, renderer, fileStorage, nil)
+
+		// Expectations
+		topsterRepo.On("FindByID", uint(10)).Return(&entities.UserTopster{ID: 10, UserID: 1, Template: entities.TopsterTemplateGrid, Rows: 3, Cols: 3}, nil)
+		albumRepo.On("FindByID", uint(5)).Return(&entities.Album{ID: 5}, nil)
+		albumRepo.On("FindByID", uint(6)).Return(&entities.Album{ID: 6}, nil)
+		topsterAlbumRepo.On("ReplaceByTopsterID", uint(10), mock.MatchedBy(func(a []*entities.TopsterAlbum) bool {
+			return len(a) == 2 && a[0].AlbumID == 6 && a[0].Position == entities.TopsterPosition{Row: 0, Col: 0}
+		})).Return(nil)
+		renderer.On("Fingerprint", mock.Anything).Return("abc")
+		renderer.On("Extension").Return(".jpg")
+		renderer.On("ContentType").Return("image/jpeg")
+		renderer.On("Render", mock.Anything, mock.Anything).Return([]byte("image"), nil)
+		fileStorage.On("Put", mock.Anything, "topsters/10/abc.jpg", []byte("image"), "image/jpeg").Return("/static/topsters/10/abc.jpg", nil)
+		topsterRepo.On("Update", mock.Anything).Return(nil)
+
+		// Execute
+		output, err := topsterUsecase.UpdateLayout(1, 10, []TopsterAlbumInput{{AlbumID: 6, Row: 0, Col: 0}, {AlbumID: 5, Row: 0, Col: 1}})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, output.Albums, 2)
+		assert.Equal(t, "/static/topsters/10/abc.jpg", output.ImageURL)
+
+		// Verify
+		topsterAlbumRepo.AssertExpectations(t)
+		fileStorage.AssertExpectations(t)
+	})
+
+	t.Run("MergedAlbumAlreadyPlaced", func(t *testing.T) {
+		// Setup
+		topsterRepo := &mocks.UserTopsterRepository{}
+		topsterAlbumRepo := &mocks.TopsterAlbumRepository{}
+		albumRepo := &mocks.AlbumRepository{}
+
+		topsterUsecase := NewTopsterUsecase(topsterRepo, topsterAlbumRepo, albumRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// Expectations
+		topsterRepo.On("FindByID", uint(10)).Return(&entities.UserTopster{ID: 10, UserID: 1, Template: entities.TopsterTemplateGrid, Rows: 3, Cols: 3}, nil)
+		albumRepo.On("FindByID", uint(5)).Return(&entities.Album{ID: 5}, nil)
+		albumRepo.On("FindByID", uint(7)).Return(&entities.Album{ID: 5}, nil)
+
+		// Execute
+		output, err := topsterUsecase.UpdateLayout(1, 10, []TopsterAlbumInput{{AlbumID: 5, Row: 0, Col: 0}, {AlbumID: 7, Row: 0, Col: 1}})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrDuplicateTopsterAlbum)
+		assert.Nil(t, output)
+
+		// Verify
+		topsterAlbumRepo.AssertNotCalled(t, "ReplaceByTopsterID", mock.Anything, mock.Anything)
+	})
+}
+
+func TestTopsterUsecase_PreviewAutofill(t *testing.T) {
+	topster := func() *entities.UserTopster {
+		return &entities.UserTopster{
+			ID:       10,
+			UserID:   1,
+			Template: entities.TopsterTemplateGrid,
+			Rows:     2,
+			Cols:     2,
+			ImageURL: "https://cdn.example.com/topsters/10/abc.png",
+			TopsterAlbums: []entities.TopsterAlbum{
+				{AlbumID: 5, Position: entities.TopsterPosition{Row: 0, Col: 1}, Album: entities.Album{ID: 5}},
+			},
+		}
+	}
+	positions := func(output *TopsterOutput) map[uint]TopsterAlbumInput {
+		m := make(map[uint]TopsterAlbumInput, len(output.Albums))
+		for _, a := range output.Albums {
+			m[a.Album.ID] = TopsterAlbumInput{AlbumID: a.Album.ID, Row: a.Row, Col: a.Col}
+		}
+		return m
+	}
+	albums := func(ids ...uint) []*entities.Album {
+		albums := make([]*entities.Album, len(ids))
+		for i, id := range ids {
+			albums[i] = &entities.Album{ID: id}
+		}
+		return albums
+	}
+
+	t.Run("FillsEmptyCellsFromLikes", func(t *testing.T) {
+		// Setup
+		topsterRepo := &mocks.UserTopsterRepository{}
+		albumRepo := &mocks.AlbumRepository{}
+
+		topsterUsecase := NewTopsterUsecase(topsterRepo, nil, albumRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// Expectations
+		topsterRepo.On("FindByID", uint(10)).Return(topster(), nil)
+		albumRepo.On("FindMostLikedByUserID", uint(1), 5).Return(albums(7, 5, 8), nil)
+
+		// Execute
+		output, err := topsterUsecase.PreviewAutofill(1, 10, &AutofillTopsterInput{Source: "likes"})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, map[uint]TopsterAlbumInput{
+			7: {AlbumID: 7, Row: 0, Col: 0},
+			5: {AlbumID: 5, Row: 0, Col: 1},
+			8: {AlbumID: 8, Row: 1, Col: 0},
+		}, positions(output))
+		assert.Empty(t, output.ImageURL)
+
+		// Verify
+		topsterRepo.AssertNotCalled(t, "Update", mock.Anything)
+	})
+
+	t.Run("OverwriteFromGenre", func(t *testing.T) {
+		// Setup
+		topsterRepo := &mocks.UserTopsterRepository{}
+		albumRepo := &mocks.AlbumRepository{}
+		genreRepo := &mocks.GenreRepository{}
+
+		topsterUsecase := NewTopsterUsecase(topsterRepo, nil, albumRepo, nil, nil, nil, nil, genreRepo, nil, nil, nil)
+
+		// Expectations
+		topsterRepo.On("FindByID", uint(10)).Return(topster(), nil)
+		genreRepo.On("FindByID", uint(3)).Return(&entities.Genre{ID: 3}, nil)
+		albumRepo.On("FindMostLikedByGenreID", uint(3), 4).Return(albums(8, 9), nil)
+
+		// Execute
+		output, err := topsterUsecase.PreviewAutofill(1, 10, &AutofillTopsterInput{Source: "genre", GenreID: utils.ToPtr(uint(3)), Overwrite: true})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, map[uint]TopsterAlbumInput{
+			8: {AlbumID: 8, Row: 0, Col: 0},
+			9: {AlbumID: 9, Row: 0, Col: 1},
+		}, positions(output))
+	})
+
+	t.Run("PrivateCollection", func(t *testing.T) {
+		// Setup
+		topsterRepo := &mocks.UserTopsterRepository{}
+		collectionRepo := &mocks.MusicCollectionRepository{}
+		memberRepo := &mocks.CollectionMemberRepository{}
+
+		topsterUsecase := NewTopsterUsecase(topsterRepo, nil, nil, nil, nil, collectionRepo, memberRepo, nil, nil, nil, nil)
+
+		// Expectations
+		topsterRepo.On("FindByID", uint(10)).Return(topster(), nil)
+		collectionRepo.On("FindByID", uint(4)).Return(&entities.MusicCollection{ID: 4, UserID: 2}, nil)
+		memberRepo.On("FindByCollectionIDAndUserID", uint(4), uint(1)).Return(nil, repositories.ErrNotFound)
+
+		// Execute
+		output, err := topsterUsecase.PreviewAutofill(1, 10, &AutofillTopsterInput{Source: "collection", CollectionID: utils.ToPtr(uint(4))})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrCollectionNotFound)
+		assert.Nil(t, output)
+	})
+
+	t.Run("MissingGenreID", func(t *testing.T) {
+		// Setup
+		topsterRepo := &mocks.UserTopsterRepository{}
+
+		topsterUsecase := NewTopsterUsecase(topsterRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// Expectations
+		topsterRepo.On("FindByID", uint(10)).Return(topster(), nil)
+
+		// Execute
+		output, err := topsterUsecase.PreviewAutofill(1, 10, &AutofillTopsterInput{Source: "genre"})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidAutofillSource)
+		assert.Nil(t, output)
+	})
+}
+
+func TestTopsterUsecase_ListUserTopsters(t *testing.T) {
+	tests := []struct {
+		name           string
+		viewerID       uint
+		includePrivate bool
+	}{
+		{"Owner", 1, true},
+		{"OtherUser", 2, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			topsterRepo := &mocks.UserTopsterRepository{}
+			userRepo := &mocks.UserRepository{}
+			blockRepo := &mocks.UserBlockRepository{}
+
+			topsterUsecase := NewTopsterUsecase(topsterRepo, nil, nil, userRepo, blockRepo, nil, nil, nil, nil, nil, nil)
+
+			// Expectations
+			userRepo.On("FindByID", uint(1)).Return(&entities.User{ID: 1}, nil)
+			blockRepo.On("ExistsBetween", tt.viewerID, uint(1)).Return(false, nil)
+			topsterRepo.On("FindByUserID", uint(1), tt.includePrivate, 0, 20).Return([]*entities.UserTopster{{ID: 10, UserID: 1}}, nil)
+			topsterRepo.On("CountByUserID", uint(1), tt.includePrivate).Return(int64(1), nil)
+
+			// Execute
+			output, err := topsterUsecase.ListUserTopsters(tt.viewerID, 1, nil, nil)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, 1, output.Total)
+			assert.Equal(t, uint(10), output.Topsters[0].ID)
+
+			// Verify
+			topsterRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTopsterUsecase_ListUserTopsters_Blocked(t *testing.T) {
+	// Setup
+	topsterRepo := &mocks.UserTopsterRepository{}
+	userRepo := &mocks.UserRepository{}
+	blockRepo := &mocks.UserBlockRepository{}
+
+	topsterUsecase := NewTopsterUsecase(topsterRepo, nil, nil, userRepo, blockRepo, nil, nil, nil, nil, nil, nil)
+
+	// Expectations
+	userRepo.On("FindByID", uint(1)).Return(&entities.User{ID: 1}, nil)
+	blockRepo.On("ExistsBetween", uint(2), uint(1)).Return(true, nil)
+
+	// Execute
+	output, err := topsterUsecase.ListUserTopsters(2, 1, nil, nil)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrUserNotFound)
+	assert.Nil(t, output)
+
+	// Verify
+	topsterRepo.AssertNotCalled(t, "FindByUserID", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestTopsterUsecase_ListUserTopsters_Hidden(t *testing.T) {
+	// Setup
+	topsterRepo := &mocks.UserTopsterRepository{}
+	userRepo := &mocks.UserRepository{}
+	blockRepo := &mocks.UserBlockRepository{}
+
+	topsterUsecase := NewTopsterUsecase(topsterRepo, nil, nil, userRepo, blockRepo, nil, nil, nil, nil, nil, nil)
+
+	// Expectations
+	userRepo.On("FindByID", uint(1)).Return(&entities.User{ID: 1, UserProfile: &entities.UserProfile{HideTopsters: true}}, nil)
+	blockRepo.On("ExistsBetween", uint(2), uint(1)).Return(false, nil)
+
+	// Execute
+	output, err := topsterUsecase.ListUserTopsters(2, 1, nil, nil)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, &ListTopstersOutput{Topsters: []TopsterOutput{}}, output)
+
+	// Verify
+	topsterRepo.AssertNotCalled(t, "FindByUserID", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -1,5 +1,7 @@
 package usecase
 
+import "time"
+
 type SignUpInput struct {
 	Email    string
 	Password string
@@ -19,6 +21,20 @@ type GetUserByIDOutput struct {
 	ProfileImageURL string
 	Bio             string
 	Website         string
+	FollowerCount   int64
+	FollowingCount  int64
+	Privacy         ProfilePrivacy
+}
+
+// ProfilePrivacy lists the sections of the public profile hidden from other
+// users.
+type ProfilePrivacy struct {
+	HideBio         bool
+	HideWebsite     bool
+	HideFollows     bool
+	HideTopsters    bool
+	HideCollections bool
+	HidePosts       bool
 }
 
 type PatchUserInput struct {
@@ -26,6 +42,16 @@ type PatchUserInput struct {
 	Nickname *string
 	Bio      *string
 	Website  *string
+	Privacy  *PatchProfilePrivacyInput
+}
+
+type PatchProfilePrivacyInput struct {
+	HideBio         *bool
+	HideWebsite     *bool
+	HideFollows     *bool
+	HideTopsters    *bool
+	HideCollections *bool
+	HidePosts       *bool
 }
 
 type UpdatePasswordInput struct {
@@ -49,3 +75,672 @@ type Artist struct {
 	ID   string
 	Name string
 }
+
+type SyncLastfmOutput struct {
+	MusicID   uint
+	LastfmID  string
+	Listeners int64
+	Playcount int64
+	Genres    []Genre
+}
+
+type Genre struct {
+	ID   uint
+	Name string
+}
+
+type ImportTaxonomyOutput struct {
+	GenresCreated      int
+	GenresUpdated      int
+	AliasesCreated     int
+	ConflictingAliases []string
+}
+
+type ListGenresOutput struct {
+	Genres []GenreNode
+}
+
+type GenreNode struct {
+	ID          uint
+	ParentID    *uint
+	Name        string
+	Description string
+	Aliases     []string
+	Children    []GenreNode
+}
+
+type GetGenreTracksOutput struct {
+	Tracks []CatalogTrack
+	Total  int
+}
+
+type CatalogTrack struct {
+	ID        uint
+	Title     string
+	AlbumID   uint
+	SpotifyID string
+	Artists   []CatalogArtist
+}
+
+type CatalogArtist struct {
+	ID   uint
+	Name string
+}
+
+type CatalogAlbum struct {
+	ID          uint
+	Name        string
+	SpotifyID   string
+	ImageURL    string
+	ReleaseDate time.Time
+	Artist      CatalogArtist
+}
+
+type GetTrackOutput struct {
+	Track           CatalogTrack
+	Album           CatalogAlbum
+	Genres          []Genre
+	LastfmListeners int64
+	LastfmPlaycount int64
+	Likes           int64
+	Dislikes        int64
+	CollectionCount int64
+	// Liked is nil when the caller has neither liked nor disliked the track.
+	Liked *bool
+}
+
+type GetAlbumOutput struct {
+	Album    CatalogAlbum
+	Tracks   []CatalogTrack
+	Likes    int64
+	Dislikes int64
+}
+
+type GetArtistOutput struct {
+	Artist      CatalogArtist
+	Description string
+	SpotifyID   string
+	Albums      []CatalogAlbum
+	Tracks      []CatalogTrack
+	Likes       int64
+	Dislikes    int64
+}
+
+type GetGenreCommunitiesOutput struct {
+	Communities []GenreCommunity
+}
+
+type GenreCommunity struct {
+	ID          uint
+	GenreID     uint
+	Name        string
+	Description string
+}
+
+type ReactionOutput struct {
+	Likes    int64
+	Dislikes int64
+	// Liked is nil when the caller has neither liked nor disliked the target.
+	Liked *bool
+}
+
+type GetLikedTracksOutput struct {
+	Tracks []CatalogTrack
+	Total  int
+}
+
+type MergeDuplicatesOutput struct {
+	DryRun bool
+	Albums []DuplicateGroup
+	Tracks []DuplicateGroup
+}
+
+// DuplicateGroup lists the rows sharing an ISRC or UPC (Key).
+type DuplicateGroup struct {
+	Key          string
+	CanonicalID  uint
+	DuplicateIDs []uint
+}
+
+type StartSpotifyLinkOutput struct {
+	AuthURL string
+}
+
+type CompleteSpotifyLinkOutput struct {
+	SpotifyUserID string
+	DisplayName   string
+}
+
+type SpotifyImportJobOutput struct {
+	ID                  uint
+	Status              string
+	CollectionsImported int
+	TracksImported      int
+	Error               string
+	StartedAt           *time.Time
+	FinishedAt          *time.Time
+	CreatedAt           time.Time
+}
+
+type CollectionSyncOutput struct {
+	CollectionID          uint
+	SpotifyPlaylistID     string
+	Status                string
+	AddedToSpotify        int
+	RemovedFromSpotify    int
+	AddedToCollection     int
+	RemovedFromCollection int
+	SyncedAt              *time.Time
+}
+
+type ReconcileCollectionsOutput struct {
+	Synced        int
+	Failed        int
+	RemoteDeleted int
+}
+
+type CreateCollectionInput struct {
+	Name        string
+	Description string
+	IsPublic    bool
+}
+
+type PatchCollectionInput struct {
+	Name        *string
+	Description *string
+	IsPublic    *bool
+}
+
+type CollectionOutput struct {
+	ID                uint
+	UserID            uint
+	Name              string
+	Description       string
+	IsPublic          bool
+	SpotifyPlaylistID string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+type GetCollectionOutput struct {
+	Collection CollectionOutput
+	Role       string // 조회한 유저의 역할 (멤버가 아니면 빈 문자열)
+	Tracks     []CollectionTrackOutput
+}
+
+type CollectionTrackOutput struct {
+	Track   CatalogTrack
+	AddedBy *uint
+}
+
+type AddCollectionTracksOutput struct {
+	Added int
+}
+
+type ListCollectionsOutput struct {
+	Collections []CollectionOutput
+	Total       int
+}
+
+type CreateCollectionInviteInput struct {
+	Role      string
+	ExpiresIn time.Duration
+}
+
+type CollectionInviteOutput struct {
+	CollectionID uint
+	Token        string
+	Role         string
+	ExpiresAt    time.Time
+}
+
+type CollectionMemberOutput struct {
+	CollectionID uint
+	UserID       uint
+	Nickname     string
+	Role         string
+	JoinedAt     time.Time
+}
+
+type ListCollectionMembersOutput struct {
+	Members []CollectionMemberOutput
+}
+
+type ExportCollectionOutput struct {
+	FileName    string
+	ContentType string
+	Data        []byte
+}
+
+type ImportCollectionInput struct {
+	Format string
+	Data   []byte
+	// CollectionID is the collection to append the tracks to. A new
+	// collection is created when it is nil.
+	CollectionID *uint
+	Name         string
+	IsPublic     bool
+}
+
+type ImportCollectionOutput struct {
+	CollectionID uint
+	Total        int
+	Matched      []MatchedPlaylistEntry
+	Unresolved   []UnresolvedPlaylistEntry
+	Added        int
+}
+
+type MatchedPlaylistEntry struct {
+	Line      int
+	MusicID   uint
+	MatchedBy string // spotify_id, isrc 또는 fuzzy
+}
+
+type UnresolvedPlaylistEntry struct {
+	Line   int
+	Title  string
+	Artist string
+}
+
+type TopsterAlbumInput struct {
+	AlbumID uint
+	Row     int
+	Col     int
+}
+
+type CreateTopsterInput struct {
+	Title       string
+	Description string
+	Template    string
+	Rows        int
+	Cols        int
+	IsPublic    bool
+	ShowTitles  bool
+	Albums      []TopsterAlbumInput
+}
+
+type PatchTopsterInput struct {
+	Title       *string
+	Description *string
+	Template    *string
+	Rows        *int
+	Cols        *int
+	IsPublic    *bool
+	ShowTitles  *bool
+}
+
+type TopsterOutput struct {
+	ID          uint
+	UserID      uint
+	Title       string
+	Description string
+	Template    string
+	Rows        int
+	Cols        int
+	RowWidths   []int
+	ImageURL    string
+	IsPublic    bool
+	ShowTitles  bool
+	Albums      []TopsterAlbumOutput
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type AutofillTopsterInput struct {
+	Source       string
+	CollectionID *uint
+	GenreID      *uint
+	Overwrite    bool
+}
+
+type TopsterAlbumOutput struct {
+	Album CatalogAlbum
+	Row   int
+	Col   int
+}
+
+type ListTopstersOutput struct {
+	Topsters []TopsterOutput // 목록에서는 Albums를 포함하지 않음
+	Total    int
+}
+
+type CreateCommunityInput struct {
+	GenreID     uint
+	Name        string
+	Description string
+	Rules       string
+}
+
+type PatchCommunityInput struct {
+	GenreID     *uint
+	Name        *string
+	Description *string
+	Rules       *string
+}
+
+type CommunityOutput struct {
+	ID          uint
+	GenreID     uint
+	Name        string
+	Description string
+	Rules       string
+	BannerURL   string
+	IconURL     string
+	MemberCount int64
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type ListCommunitiesOutput struct {
+	Communities []CommunityOutput
+	Total       int
+}
+
+type GetCommunityOutput struct {
+	Community  CommunityOutput
+	Role       string // 조회한 유저의 역할 (멤버가 아니면 빈 문자열)
+	Moderators []CommunityMemberOutput
+}
+
+type CommunityMemberOutput struct {
+	CommunityID uint
+	UserID      uint
+	Nickname    string
+	Role        string
+	JoinedAt    time.Time
+}
+
+type ListCommunityMembersOutput struct {
+	Members []CommunityMemberOutput
+}
+
+type CreatePostInput struct {
+	Title   string
+	Content string
+	// Music references tracks by local ID, Spotify track URL or
+	// "spotify:track:" URI.
+	Music []string
+}
+
+type PatchPostInput struct {
+	Title   *string
+	Content *string
+	Music   []string // nil이면 변경하지 않음, 빈 배열이면 모두 제거
+}
+
+type SearchPostsInput struct {
+	Query string
+	Field string // title (기본값), content
+	// CommunityID limits the search to a single community.
+	CommunityID *uint
+}
+
+type PostOutput struct {
+	ID               uint
+	UserID           uint
+	Nickname         string
+	GenreCommunityID uint
+	Title            string
+	Content          string // Markdown 원문
+	ContentHTML      string
+	Likes            int64
+	Dislikes         int64
+	Tracks           []TrackCard
+	LinkPreviews     []LinkPreview
+	Hidden           bool // 검토를 위해 숨겨진 글
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+type ListPostsOutput struct {
+	Posts []PostOutput
+	Total int
+}
+
+type CreateCommentInput struct {
+	Content  string
+	ParentID *uint
+	Music    []string // CreatePostInput.Music 참고
+}
+
+type PatchCommentInput struct {
+	Content *string
+	Music   []string // nil이면 변경하지 않음, 빈 배열이면 모두 제거
+}
+
+type CommentOutput struct {
+	ID         uint
+	PostID     uint
+	ParentID   *uint
+	UserID     uint // 삭제되었거나 차단 관계인 유저의 댓글은 0
+	Nickname   string
+	Content    string
+	Depth      int
+	ReplyCount int64
+	Deleted    bool
+	Hidden     bool
+	Blocked    bool
+	Tracks     []TrackCard
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	Replies    []CommentOutput
+}
+
+type LinkPreview struct {
+	URL         string
+	Title       string
+	Description string
+	ImageURL    string
+	SiteName    string
+}
+
+// TrackCard summarizes a track embedded in a post or comment.
+type TrackCard struct {
+	ID        uint
+	Title     string
+	SpotifyID string
+	Artists   []CatalogArtist
+	AlbumID   uint
+	AlbumName string
+	ImageURL  string
+	Likes     int64
+	Dislikes  int64
+}
+
+type ListCommentsOutput struct {
+	Comments []CommentOutput // 최상위 댓글, 답글은 Replies에 포함
+	Total    int             // 최상위 댓글 수
+}
+
+type CreateReportInput struct {
+	TargetType string
+	TargetID   uint
+	Reason     string
+	Details    string
+}
+
+type ReportOutput struct {
+	ID           uint
+	ReporterID   *uint
+	TargetType   string
+	TargetID     uint
+	TargetUserID uint
+	CommunityID  *uint
+	Reason       string
+	Details      string
+	Excerpt      string
+	Status       string
+	ResolvedByID *uint
+	ResolvedAt   *time.Time
+	CreatedAt    time.Time
+}
+
+type ListReportsOutput struct {
+	Reports []ReportOutput
+	Total   int
+}
+
+type ModerationActionInput struct {
+	Action string
+	Reason string
+}
+
+type ModerationActionOutput struct {
+	ID           uint
+	CommunityID  *uint
+	ReportID     *uint
+	ModeratorID  uint // 본인의 제재 기록에서는 0
+	TargetUserID uint
+	Action       string
+	TargetType   string
+	TargetID     uint
+	Excerpt      string
+	Reason       string
+	CreatedAt    time.Time
+}
+
+type ListModerationActionsOutput struct {
+	Actions []ModerationActionOutput
+	Total   int
+}
+
+type FollowOutput struct {
+	UserID        uint
+	Following     bool // 내가 팔로우 중인지
+	FollowsYou    bool // 상대가 나를 팔로우 중인지
+	FollowerCount int64
+}
+
+type FollowUserOutput struct {
+	UserID          uint
+	Nickname        string
+	ProfileImageURL string
+	Following       bool // 조회한 유저가 팔로우 중인지
+	FollowsYou      bool // 조회한 유저를 팔로우 중인지
+	FollowedAt      time.Time
+}
+
+type ListFollowsOutput struct {
+	Users []FollowUserOutput
+	Total int
+}
+
+// PublicProfileOutput is a user's profile as another user sees it. Hidden
+// sections are left empty unless the viewer owns the profile.
+type PublicProfileOutput struct {
+	ID              uint
+	Nickname        string
+	ProfileImageURL string
+	Bio             string
+	Website         string
+	FollowerCount   *int64 // 팔로우 정보를 숨긴 경우 nil
+	FollowingCount  *int64
+	Following       bool // 조회한 유저가 팔로우 중인지
+	FollowsYou      bool // 조회한 유저를 팔로우 중인지
+	IsOwner         bool
+	Topsters        []TopsterOutput // 숨긴 섹션은 nil
+	Collections     []CollectionOutput
+	RecentPosts     []ProfilePostOutput
+	HiddenSections  []string // 프로필 주인이 숨긴 섹션 (ProfileSection*)
+}
+
+type ProfilePostOutput struct {
+	ID               uint
+	GenreCommunityID uint
+	Title            string
+	CreatedAt        time.Time
+}
+
+// RestrictedUserOutput is a user the caller blocked or muted.
+type RestrictedUserOutput struct {
+	UserID          uint
+	Nickname        string
+	ProfileImageURL string
+	Since           time.Time // 차단 또는 뮤트한 시각
+}
+
+type ListRestrictedUsersOutput struct {
+	Users []RestrictedUserOutput
+	Total int
+}
+
+// FeedActivityOutput is an entry of the home feed. Likes and follows of the
+// same user close in time are grouped into one entry, such as "X liked 5
+// tracks".
+type FeedActivityOutput struct {
+	ID        uint   // 가장 최근 활동의 ID
+	Type      string // POST, TOPSTER, COLLECTION, TRACK_LIKE, FOLLOW
+	Actor     FeedUserOutput
+	Subjects  []FeedSubjectOutput // 활동 대상, 최신순
+	CreatedAt time.Time           // 가장 최근 활동 시각
+}
+
+type FeedUserOutput struct {
+	UserID          uint
+	Nickname        string
+	ProfileImageURL string
+}
+
+type FeedSubjectOutput struct {
+	ID       uint   // 게시글, 탑스터, 컬렉션, 트랙 또는 유저 ID
+	Title    string // 게시글 제목, 탑스터 제목, 컬렉션 이름, 트랙 제목 또는 닉네임
+	ImageURL string // 탑스터 이미지, 앨범 커버 또는 프로필 이미지
+}
+
+type FeedOutput struct {
+	Activities []FeedActivityOutput
+	NextCursor string // 다음 페이지 커서, 마지막 페이지면 빈 문자열
+}
+
+type NotificationOutput struct {
+	ID                 uint
+	Type               string                   // REPLY, POST_LIKE, FOLLOW, MENTION, MODERATION
+	Actor              *NotificationActorOutput // 운영 조치 알림이면 nil
+	Message            string
+	PostID             *uint
+	CommentID          *uint
+	ModerationActionID *uint
+	Read               bool
+	CreatedAt          time.Time
+}
+
+type NotificationActorOutput struct {
+	UserID          uint
+	Nickname        string
+	ProfileImageURL string
+}
+
+type ListNotificationsOutput struct {
+	Notifications []NotificationOutput
+	Total         int
+	UnreadCount   int
+}
+
+// MarkNotificationsReadInput selects the notifications to mark as read: the
+// ones in IDs, or all of them when All is set.
+type MarkNotificationsReadInput struct {
+	IDs []uint
+	All bool
+}
+
+type MarkNotificationsReadOutput struct {
+	UnreadCount int
+}
+
+type NotificationPreferenceOutput struct {
+	Type  string
+	InApp bool
+	Email bool
+}
+
+type NotificationPreferencesOutput struct {
+	Preferences []NotificationPreferenceOutput
+}
+
+// UpdateNotificationPreferenceInput changes the preference for Type. Nil
+// fields are left as they are.
+type UpdateNotificationPreferenceInput struct {
+	Type  string
+	InApp *bool
+	Email *bool
+}
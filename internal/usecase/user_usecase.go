@@ -3,10 +3,12 @@ package usecase
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 	"unicode"
 
 	"github.com/google/uuid"
+	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/contentfilter"
 	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/email"
 	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/encryption"
 	"github.com/myjinjin/sonic-odyssey-backend/infrastructure/hash"
@@ -16,6 +18,27 @@ import (
 	"go.uber.org/zap"
 )
 
+// reservedNicknames are the static segments routed under /users. Public
+// profiles are served from /users/:nickname, so users with these nicknames
+// could not be reached.
+var reservedNicknames = map[string]bool{
+	"me":       true,
+	"password": true,
+}
+
+// placeholderNicknamePrefix starts the nicknames given to profiles cleared by
+// moderators. Users cannot pick it, so that placeholders never collide.
+const placeholderNicknamePrefix = "user-"
+
+func nicknameReserved(nickname string) bool {
+	nickname = strings.ToLower(nickname)
+	return reservedNicknames[nickname] || strings.HasPrefix(nickname, placeholderNicknamePrefix)
+}
+
+func placeholderNickname(userID uint) string {
+	return fmt.Sprintf("%s%d", placeholderNicknamePrefix, userID)
+}
+
 type UserUsecase interface {
 	SignUp(SignUpInput) (*SignUpOutput, error)
 	SendPasswordRecoveryEmail(baseURL, email string) error
@@ -28,17 +51,21 @@ type UserUsecase interface {
 type userUsecase struct {
 	userRepo          repositories.UserRepository
 	passwordResetRepo repositories.PasswordResetFlowRepository
+	followRepo        repositories.UserFollowRepository
 
 	emailEncryptor encryption.Encryptor
 	emailSender    email.EmailSender
+	screener       *ContentScreener
 }
 
-func NewUserUsecase(userRepo repositories.UserRepository, passwordResetRepo repositories.PasswordResetFlowRepository, emailEncryptor encryption.Encryptor, emailSender email.EmailSender) UserUsecase {
+func NewUserUsecase(userRepo repositories.UserRepository, passwordResetRepo repositories.PasswordResetFlowRepository, followRepo repositories.UserFollowRepository, emailEncryptor encryption.Encryptor, emailSender email.EmailSender, screener *ContentScreener) UserUsecase {
 	return &userUsecase{
 		userRepo:          userRepo,
 		passwordResetRepo: passwordResetRepo,
+		followRepo:        followRepo,
 		emailEncryptor:    emailEncryptor,
 		emailSender:       emailSender,
+		screener:          screener,
 	}
 }
 
@@ -52,6 +79,9 @@ func (u *userUsecase) SignUp(input SignUpInput) (*SignUpOutput, error) {
 		return nil, ErrEmailAlreadyExists
 	}
 
+	if nicknameReserved(input.Nickname) {
+		return nil, ErrNicknameReserved
+	}
 	existingUser, err = u.userRepo.FindByNickname(input.Nickname)
 	if err != nil && errors.Is(err, repositories.ErrFind) {
 		return nil, ErrFindingRecord
@@ -59,6 +89,9 @@ func (u *userUsecase) SignUp(input SignUpInput) (*SignUpOutput, error) {
 	if existingUser != nil {
 		return nil, ErrNicknameAlreadyExists
 	}
+	if _, err := u.screener.screenProfile(contentfilter.KindNickname, 0, input.Nickname); err != nil {
+		return nil, err
+	}
 
 	if err := validatePassword(input.Password); err != nil {
 		return nil, err
@@ -295,6 +328,15 @@ func (u *userUsecase) GetUserByID(userID uint) (*GetUserByIDOutput, error) {
 	if err != nil {
 		return nil, ErrDecryptingEmail
 	}
+	viewer := repositories.Viewer{ID: user.ID}
+	followers, err := u.followRepo.CountFollowersByUserID(viewer, user.ID)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
+	followings, err := u.followRepo.CountFollowingsByUserID(viewer, user.ID)
+	if err != nil {
+		return nil, ErrFindingRecord
+	}
 
 	output := &GetUserByIDOutput{
 		ID:              user.ID,
@@ -304,6 +346,9 @@ func (u *userUsecase) GetUserByID(userID uint) (*GetUserByIDOutput, error) {
 		ProfileImageURL: user.UserProfile.ProfileImageURL,
 		Bio:             user.UserProfile.Bio,
 		Website:         user.UserProfile.Website,
+		FollowerCount:   followers,
+		FollowingCount:  followings,
+		Privacy:         toProfilePrivacy(user.UserProfile),
 	}
 
 	return output, nil
@@ -321,24 +366,37 @@ func (u *userUsecase) PatchUser(userID uint, input *PatchUserInput) error {
 	}
 
 	if input.Nickname != nil {
+		if nicknameReserved(*input.Nickname) {
+			return ErrNicknameReserved
+		}
 		existUser, err := u.userRepo.FindByNickname(*input.Nickname)
 		if errors.Is(err, repositories.ErrFind) {
 			return ErrFindingRecord
 		}
-		if existUser.ID != userID {
+		if existUser != nil && existUser.ID != userID {
 			return ErrNicknameAlreadyExists
 		}
+		if _, err := u.screener.screenProfile(contentfilter.KindNickname, userID, *input.Nickname); err != nil {
+			return err
+		}
 		user.Nickname = *input.Nickname
 	}
 	if input.Name != nil {
 		user.Name = *input.Name
 	}
 	if input.Bio != nil {
-		user.UserProfile.Bio = *input.Bio
+		bio, err := u.screener.screenProfile(contentfilter.KindBio, userID, *input.Bio)
+		if err != nil {
+			return err
+		}
+		user.UserProfile.Bio = bio
 	}
 	if input.Website != nil {
 		user.UserProfile.Website = *input.Website
 	}
+	if input.Privacy != nil {
+		patchProfilePrivacy(user.UserProfile, input.Privacy)
+	}
 
 	if err := u.userRepo.Update(user); err != nil {
 		return ErrUpdatingRecord
@@ -347,6 +405,35 @@ func (u *userUsecase) PatchUser(userID uint, input *PatchUserInput) error {
 	return nil
 }
 
+func toProfilePrivacy(profile *entities.UserProfile) ProfilePrivacy {
+	return ProfilePrivacy{
+		HideBio:         profile.HideBio,
+		HideWebsite:     profile.HideWebsite,
+		HideFollows:     profile.HideFollows,
+		HideTopsters:    profile.HideTopsters,
+		HideCollections: profile.HideCollections,
+		HidePosts:       profile.HidePosts,
+	}
+}
+
+func patchProfilePrivacy(profile *entities.UserProfile, input *PatchProfilePrivacyInput) {
+	for _, field := range []struct {
+		value *bool
+		dest  *bool
+	}{
+		{input.HideBio, &profile.HideBio},
+		{input.HideWebsite, &profile.HideWebsite},
+		{input.HideFollows, &profile.HideFollows},
+		{input.HideTopsters, &profile.HideTopsters},
+		{input.HideCollections, &profile.HideCollections},
+		{input.HidePosts, &profile.HidePosts},
+	} {
+		if field.value != nil {
+			*field.dest = *field.value
+		}
+	}
+}
+
 func generateFlowID() string {
 	currentTime := time.Now().Unix()
 	uuidValue := uuid.New()
@@ -22,7 +22,7 @@ func TestUserUsecase_SignUp_Success(t *testing.T) {
 	emailEncryptor := &mocks.Encryptor{}
 	emailSender := &mocks.EmailSender{}
 
-	userUsecase := NewUserUsecase(userRepo, passwordResetRepo, emailEncryptor, emailSender)
+	userUsecase := NewUserUsecase(userRepo, passwordResetRepo, nil, emailEncryptor, emailSender, unfilteredScreener)
 
 	input := SignUpInput{
 		Email:    "test@example.com",
@@ -71,7 +71,7 @@ func TestUserUsecase_SignUp_EmailAlreadyExists(t *testing.T) {
 	emailEncryptor := &mocks.Encryptor{}
 	emailSender := &mocks.EmailSender{}
 
-	userUsecase := NewUserUsecase(userRepo, passwordResetRepo, emailEncryptor, emailSender)
+	userUsecase := NewUserUsecase(userRepo, passwordResetRepo, nil, emailEncryptor, emailSender, unfilteredScreener)
 
 	input := SignUpInput{
 		Email:    "test@example.com",
@@ -111,7 +111,7 @@ func TestUserUsecase_SignUp_NicknameAlreadyExists(t *testing.T) {
 	emailEncryptor := &mocks.Encryptor{}
 	emailSender := &mocks.EmailSender{}
 
-	userUsecase := NewUserUsecase(userRepo, passwordResetRepo, emailEncryptor, emailSender)
+	userUsecase := NewUserUsecase(userRepo, passwordResetRepo, nil, emailEncryptor, emailSender, unfilteredScreener)
 
 	input := SignUpInput{
 		Email:    "test@example.com",
@@ -145,6 +145,33 @@ func TestUserUsecase_SignUp_NicknameAlreadyExists(t *testing.T) {
 	emailSender.AssertExpectations(t)
 }
 
+func TestUserUsecase_SignUp_NicknameReserved(t *testing.T) {
+	// Setup
+	userRepo := &mocks.UserRepository{}
+
+	userUsecase := NewUserUsecase(userRepo, nil, nil, nil, nil, unfilteredScreener)
+
+	input := SignUpInput{
+		Email:    "test@example.com",
+		Password: "Password123!",
+		Name:     "Test User",
+		Nickname: "Me",
+	}
+
+	// Expectations
+	userRepo.On("FindByEmailHash", hash.SHA256EmailHasher().HashEmail(input.Email)).Return(nil, nil)
+
+	// Execute
+	output, err := userUsecase.SignUp(input)
+
+	// Assert
+	assert.ErrorIs(t, err, ErrNicknameReserved)
+	assert.Nil(t, output)
+
+	// Verify
+	userRepo.AssertNotCalled(t, "Create", mock.Anything)
+}
+
 func TestUserUsecase_SignUp_InvalidPassword(t *testing.T) {
 	// Setup
 	userRepo := &mocks.UserRepository{}
@@ -152,7 +179,7 @@ func TestUserUsecase_SignUp_InvalidPassword(t *testing.T) {
 	emailEncryptor := &mocks.Encryptor{}
 	emailSender := &mocks.EmailSender{}
 
-	userUsecase := NewUserUsecase(userRepo, passwordResetRepo, emailEncryptor, emailSender)
+	userUsecase := NewUserUsecase(userRepo, passwordResetRepo, nil, emailEncryptor, emailSender, unfilteredScreener)
 
 	// Test cases for invalid passwords
 	invalidPasswords := []string{
@@ -198,7 +225,7 @@ func TestUserUsecase_SignUp_PasswordHashingFailed(t *testing.T) {
 	emailEncryptor := &mocks.Encryptor{}
 	emailSender := &mocks.EmailSender{}
 
-	userUsecase := NewUserUsecase(userRepo, passwordResetRepo, emailEncryptor, emailSender)
+	userUsecase := NewUserUsecase(userRepo, passwordResetRepo, nil, emailEncryptor, emailSender, unfilteredScreener)
 
 	input := SignUpInput{
 		Email:    "test@example.com",
@@ -232,7 +259,7 @@ func TestUserUsecase_SignUp_EmailEncryptionFailed(t *testing.T) {
 	emailEncryptor := &mocks.Encryptor{}
 	emailSender := &mocks.EmailSender{}
 
-	userUsecase := NewUserUsecase(userRepo, passwordResetRepo, emailEncryptor, emailSender)
+	userUsecase := NewUserUsecase(userRepo, passwordResetRepo, nil, emailEncryptor, emailSender, unfilteredScreener)
 
 	input := SignUpInput{
 		Email:    "test@example.com",
@@ -268,7 +295,7 @@ func TestUserUsecase_SignUp_UserCreationFailed(t *testing.T) {
 	emailEncryptor := &mocks.Encryptor{}
 	emailSender := &mocks.EmailSender{}
 
-	userUsecase := NewUserUsecase(userRepo, passwordResetRepo, emailEncryptor, emailSender)
+	userUsecase := NewUserUsecase(userRepo, passwordResetRepo, nil, emailEncryptor, emailSender, unfilteredScreener)
 
 	input := SignUpInput{
 		Email:    "test@example.com",
@@ -307,7 +334,7 @@ func TestUserUsecase_SendPasswordRecoveryEmail_Success(t *testing.T) {
 	emailEncryptor := &mocks.Encryptor{}
 	emailSender := &mocks.EmailSender{}
 
-	userUsecase := NewUserUsecase(userRepo, passwordResetRepo, emailEncryptor, emailSender)
+	userUsecase := NewUserUsecase(userRepo, passwordResetRepo, nil, emailEncryptor, emailSender, unfilteredScreener)
 
 	userEmail := "test@example.com"
 	baseURL := "http://localhost:8080"
@@ -336,7 +363,7 @@ func TestUserUsecase_SendPasswordRecoveryEmail_UserNotFound(t *testing.T) {
 	emailEncryptor := &mocks.Encryptor{}
 	emailSender := &mocks.EmailSender{}
 
-	userUsecase := NewUserUsecase(userRepo, passwordResetRepo, emailEncryptor, emailSender)
+	userUsecase := NewUserUsecase(userRepo, passwordResetRepo, nil, emailEncryptor, emailSender, unfilteredScreener)
 
 	userEmail := "test@example.com"
 	baseURL := "http://localhost:8080"
@@ -364,7 +391,7 @@ func TestUserUsecase_SendPasswordRecoveryEmail_CreateResetFlowError(t *testing.T
 	emailEncryptor := &mocks.Encryptor{}
 	emailSender := &mocks.EmailSender{}
 
-	userUsecase := NewUserUsecase(userRepo, passwordResetRepo, emailEncryptor, emailSender)
+	userUsecase := NewUserUsecase(userRepo, passwordResetRepo, nil, emailEncryptor, emailSender, unfilteredScreener)
 
 	userEmail := "test@example.com"
 	baseURL := "http://localhost:8080"
@@ -392,7 +419,7 @@ func TestUserUsecase_ResetPassword_Success(t *testing.T) {
 	emailEncryptor := &mocks.Encryptor{}
 	emailSender := &mocks.EmailSender{}
 
-	userUsecase := NewUserUsecase(userRepo, passwordResetRepo, emailEncryptor, emailSender)
+	userUsecase := NewUserUsecase(userRepo, passwordResetRepo, nil, emailEncryptor, emailSender, unfilteredScreener)
 
 	password := "newPassword123!"
 	flowID := "flow123"
@@ -430,7 +457,7 @@ func TestUserUsecase_ResetPassword_FlowNotFound(t *testing.T) {
 	emailEncryptor := &mocks.Encryptor{}
 	emailSender := &mocks.EmailSender{}
 
-	userUsecase := NewUserUsecase(userRepo, passwordResetRepo, emailEncryptor, emailSender)
+	userUsecase := NewUserUsecase(userRepo, passwordResetRepo, nil, emailEncryptor, emailSender, unfilteredScreener)
 
 	password := "newPassword123!"
 	flowID := "flow123"
@@ -454,7 +481,7 @@ func TestUserUsecase_ResetPassword_FlowExpired(t *testing.T) {
 	emailEncryptor := &mocks.Encryptor{}
 	emailSender := &mocks.EmailSender{}
 
-	userUsecase := NewUserUsecase(userRepo, passwordResetRepo, emailEncryptor, emailSender)
+	userUsecase := NewUserUsecase(userRepo, passwordResetRepo, nil, emailEncryptor, emailSender, unfilteredScreener)
 
 	password := "newPassword123!"
 	flowID := "flow123"
@@ -486,7 +513,7 @@ func TestUserUsecase_ResetPassword_InvalidPassword(t *testing.T) {
 	emailEncryptor := &mocks.Encryptor{}
 	emailSender := &mocks.EmailSender{}
 
-	userUsecase := NewUserUsecase(userRepo, passwordResetRepo, emailEncryptor, emailSender)
+	userUsecase := NewUserUsecase(userRepo, passwordResetRepo, nil, emailEncryptor, emailSender, unfilteredScreener)
 
 	password := "short"
 	flowID := "flow123"
@@ -518,7 +545,7 @@ func TestUserUsecase_ResetPassword_DeleteFlowError(t *testing.T) {
 	emailEncryptor := &mocks.Encryptor{}
 	emailSender := &mocks.EmailSender{}
 
-	userUsecase := NewUserUsecase(userRepo, passwordResetRepo, emailEncryptor, emailSender)
+	userUsecase := NewUserUsecase(userRepo, passwordResetRepo, nil, emailEncryptor, emailSender, unfilteredScreener)
 
 	password := "newPassword123!"
 	flowID := "flow123"
@@ -552,9 +579,10 @@ func TestUserUsecase_ResetPassword_DeleteFlowError(t *testing.T) {
 func TestUserUsecase_GetUserByID_Success(t *testing.T) {
 	// Setup
 	userRepo := &mocks.UserRepository{}
+	followRepo := &mocks.UserFollowRepository{}
 	emailEncryptor := &mocks.Encryptor{}
 
-	userUsecase := NewUserUsecase(userRepo, nil, emailEncryptor, nil)
+	userUsecase := NewUserUsecase(userRepo, nil, followRepo, emailEncryptor, nil, unfilteredScreener)
 
 	userID := uint(1)
 	encryptedEmail := "encrypted_email"
@@ -575,6 +603,8 @@ func TestUserUsecase_GetUserByID_Success(t *testing.T) {
 	// Expectations
 	userRepo.On("FindByID", userID).Return(user, nil)
 	emailEncryptor.On("Decrypt", encryptedEmail).Return(decryptedEmail, nil)
+	followRepo.On("CountFollowersByUserID", repositories.Viewer{ID: userID}, userID).Return(int64(12), nil)
+	followRepo.On("CountFollowingsByUserID", repositories.Viewer{ID: userID}, userID).Return(int64(3), nil)
 
 	// Execute
 	output, err := userUsecase.GetUserByID(userID)
@@ -589,10 +619,13 @@ func TestUserUsecase_GetUserByID_Success(t *testing.T) {
 	assert.Equal(t, user.UserProfile.ProfileImageURL, output.ProfileImageURL)
 	assert.Equal(t, user.UserProfile.Bio, output.Bio)
 	assert.Equal(t, user.UserProfile.Website, output.Website)
+	assert.Equal(t, int64(12), output.FollowerCount)
+	assert.Equal(t, int64(3), output.FollowingCount)
 
 	// Verify
 	userRepo.AssertExpectations(t)
 	emailEncryptor.AssertExpectations(t)
+	followRepo.AssertExpectations(t)
 }
 
 func TestUserUsecase_GetUserByID_UserNotFound(t *testing.T) {
@@ -600,7 +633,7 @@ func TestUserUsecase_GetUserByID_UserNotFound(t *testing.T) {
 	userRepo := &mocks.UserRepository{}
 	emailEncryptor := &mocks.Encryptor{}
 
-	userUsecase := NewUserUsecase(userRepo, nil, emailEncryptor, nil)
+	userUsecase := NewUserUsecase(userRepo, nil, nil, emailEncryptor, nil, unfilteredScreener)
 
 	userID := uint(1)
 
@@ -625,7 +658,7 @@ func TestUserUsecase_GetUserByID_FindError(t *testing.T) {
 	userRepo := &mocks.UserRepository{}
 	emailEncryptor := &mocks.Encryptor{}
 
-	userUsecase := NewUserUsecase(userRepo, nil, emailEncryptor, nil)
+	userUsecase := NewUserUsecase(userRepo, nil, nil, emailEncryptor, nil, unfilteredScreener)
 
 	userID := uint(1)
 
@@ -650,7 +683,7 @@ func TestUserUsecase_GetUserByID_DecryptionError(t *testing.T) {
 	userRepo := &mocks.UserRepository{}
 	emailEncryptor := &mocks.Encryptor{}
 
-	userUsecase := NewUserUsecase(userRepo, nil, emailEncryptor, nil)
+	userUsecase := NewUserUsecase(userRepo, nil, nil, emailEncryptor, nil, unfilteredScreener)
 
 	userID := uint(1)
 	encryptedEmail := "encrypted_email"
@@ -680,7 +713,7 @@ func TestUserUsecase_GetUserByID_DecryptionError(t *testing.T) {
 func TestUserUsecase_PatchUser_Success(t *testing.T) {
 	// Setup
 	userRepo := &mocks.UserRepository{}
-	userUsecase := NewUserUsecase(userRepo, nil, nil, nil)
+	userUsecase := NewUserUsecase(userRepo, nil, nil, nil, nil, unfilteredScreener)
 
 	userID := uint(1)
 	input := &PatchUserInput{
@@ -721,10 +754,80 @@ func TestUserUsecase_PatchUser_Success(t *testing.T) {
 	userRepo.AssertExpectations(t)
 }
 
+func TestUserUsecase_PatchUser_NewNicknameAndPrivacy(t *testing.T) {
+	// Setup
+	userRepo := &mocks.UserRepository{}
+	userUsecase := NewUserUsecase(userRepo, nil, nil, nil, nil, unfilteredScreener)
+
+	userID := uint(1)
+	input := &PatchUserInput{
+		Nickname: utils.ToPtr("freshnickname"),
+		Privacy: &PatchProfilePrivacyInput{
+			HideBio:   utils.ToPtr(false),
+			HidePosts: utils.ToPtr(true),
+		},
+	}
+
+	// Expectations
+	userRepo.On("FindByID", userID).Return(&entities.User{
+		ID:          userID,
+		Nickname:    "testuser",
+		UserProfile: &entities.UserProfile{HideBio: true, HideFollows: true},
+	}, nil)
+	userRepo.On("FindByNickname", *input.Nickname).Return(nil, repositories.ErrNotFound)
+	userRepo.On("Update", mock.MatchedBy(func(user *entities.User) bool {
+		return user.Nickname == *input.Nickname &&
+			!user.UserProfile.HideBio &&
+			user.UserProfile.HideFollows &&
+			user.UserProfile.HidePosts
+	})).Return(nil)
+
+	// Execute
+	err := userUsecase.PatchUser(userID, input)
+
+	// Assert
+	assert.NoError(t, err)
+
+	// Verify
+	userRepo.AssertExpectations(t)
+}
+
+func TestUserUsecase_PatchUser_NicknameReserved(t *testing.T) {
+	testCases := []struct {
+		name     string
+		nickname string
+	}{
+		{name: "RouteSegment", nickname: "password"},
+		{name: "Placeholder", nickname: "User-7"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup
+			userRepo := &mocks.UserRepository{}
+			userUsecase := NewUserUsecase(userRepo, nil, nil, nil, nil, unfilteredScreener)
+
+			userID := uint(1)
+
+			// Expectations
+			userRepo.On("FindByID", userID).Return(&entities.User{ID: userID, Nickname: "testuser", UserProfile: &entities.UserProfile{}}, nil)
+
+			// Execute
+			err := userUsecase.PatchUser(userID, &PatchUserInput{Nickname: utils.ToPtr(tc.nickname)})
+
+			// Assert
+			assert.ErrorIs(t, err, ErrNicknameReserved)
+
+			// Verify
+			userRepo.AssertNotCalled(t, "Update", mock.Anything)
+		})
+	}
+}
+
 func TestUserUsecase_PatchUser_UserNotFound(t *testing.T) {
 	// Setup
 	userRepo := &mocks.UserRepository{}
-	userUsecase := NewUserUsecase(userRepo, nil, nil, nil)
+	userUsecase := NewUserUsecase(userRepo, nil, nil, nil, nil, unfilteredScreener)
 
 	userID := uint(1)
 	input := &PatchUserInput{
@@ -748,7 +851,7 @@ func TestUserUsecase_PatchUser_UserNotFound(t *testing.T) {
 func TestUserUsecase_PatchUser_FindingRecordError(t *testing.T) {
 	// Setup
 	userRepo := &mocks.UserRepository{}
-	userUsecase := NewUserUsecase(userRepo, nil, nil, nil)
+	userUsecase := NewUserUsecase(userRepo, nil, nil, nil, nil, unfilteredScreener)
 
 	userID := uint(1)
 	input := &PatchUserInput{
@@ -772,7 +875,7 @@ func TestUserUsecase_PatchUser_FindingRecordError(t *testing.T) {
 func TestUserUsecase_PatchUser_UpdatingRecordError(t *testing.T) {
 	// Setup
 	userRepo := &mocks.UserRepository{}
-	userUsecase := NewUserUsecase(userRepo, nil, nil, nil)
+	userUsecase := NewUserUsecase(userRepo, nil, nil, nil, nil, unfilteredScreener)
 
 	userID := uint(1)
 	input := &PatchUserInput{
@@ -801,7 +904,7 @@ func TestUserUsecase_PatchUser_UpdatingRecordError(t *testing.T) {
 func TestUserUsecase_UpdatePassword_Success(t *testing.T) {
 	// Setup
 	userRepo := &mocks.UserRepository{}
-	userUsecase := NewUserUsecase(userRepo, nil, nil, nil)
+	userUsecase := NewUserUsecase(userRepo, nil, nil, nil, nil, unfilteredScreener)
 
 	userID := uint(1)
 	input := UpdatePasswordInput{
@@ -832,7 +935,7 @@ func TestUserUsecase_UpdatePassword_Success(t *testing.T) {
 func TestUserUsecase_UpdatePassword_UserNotFound(t *testing.T) {
 	// Setup
 	userRepo := &mocks.UserRepository{}
-	userUsecase := NewUserUsecase(userRepo, nil, nil, nil)
+	userUsecase := NewUserUsecase(userRepo, nil, nil, nil, nil, unfilteredScreener)
 
 	userID := uint(1)
 	input := UpdatePasswordInput{
@@ -858,7 +961,7 @@ func TestUserUsecase_UpdatePassword_UserNotFound(t *testing.T) {
 func TestUserUsecase_UpdatePassword_FindingRecordError(t *testing.T) {
 	// Setup
 	userRepo := &mocks.UserRepository{}
-	userUsecase := NewUserUsecase(userRepo, nil, nil, nil)
+	userUsecase := NewUserUsecase(userRepo, nil, nil, nil, nil, unfilteredScreener)
 
 	userID := uint(1)
 	input := UpdatePasswordInput{
@@ -884,7 +987,7 @@ func TestUserUsecase_UpdatePassword_FindingRecordError(t *testing.T) {
 func TestUserUsecase_UpdatePassword_PasswordNotMatched(t *testing.T) {
 	// Setup
 	userRepo := &mocks.UserRepository{}
-	userUsecase := NewUserUsecase(userRepo, nil, nil, nil)
+	userUsecase := NewUserUsecase(userRepo, nil, nil, nil, nil, unfilteredScreener)
 
 	userID := uint(1)
 	input := UpdatePasswordInput{
@@ -910,7 +1013,7 @@ func TestUserUsecase_UpdatePassword_PasswordNotMatched(t *testing.T) {
 func TestUserUsecase_UpdatePassword_PasswordHashingFailed(t *testing.T) {
 	// Setup
 	userRepo := &mocks.UserRepository{}
-	userUsecase := NewUserUsecase(userRepo, nil, nil, nil)
+	userUsecase := NewUserUsecase(userRepo, nil, nil, nil, nil, unfilteredScreener)
 
 	userID := uint(1)
 	input := UpdatePasswordInput{
@@ -938,7 +1041,7 @@ func TestUserUsecase_UpdatePassword_PasswordHashingFailed(t *testing.T) {
 func TestUserUsecase_UpdatePassword_InvalidPassword(t *testing.T) {
 	// Setup
 	userRepo := &mocks.UserRepository{}
-	userUsecase := NewUserUsecase(userRepo, nil, nil, nil)
+	userUsecase := NewUserUsecase(userRepo, nil, nil, nil, nil, unfilteredScreener)
 
 	userID := uint(1)
 	input := UpdatePasswordInput{
@@ -966,7 +1069,7 @@ func TestUserUsecase_UpdatePassword_InvalidPassword(t *testing.T) {
 func TestUserUsecase_UpdatePassword_UpdatingError(t *testing.T) {
 	// Setup
 	userRepo := &mocks.UserRepository{}
-	userUsecase := NewUserUsecase(userRepo, nil, nil, nil)
+	userUsecase := NewUserUsecase(userRepo, nil, nil, nil, nil, unfilteredScreener)
 
 	userID := uint(1)
 	input := UpdatePasswordInput{
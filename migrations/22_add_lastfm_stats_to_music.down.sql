@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS music_genre_mapping_music_id_genre_id_key;
+
+ALTER TABLE music
+    DROP COLUMN IF EXISTS lastfm_listeners,
+    DROP COLUMN IF EXISTS lastfm_playcount,
+    DROP COLUMN IF EXISTS lastfm_synced_at;
\ No newline at end of file
@@ -0,0 +1,6 @@
+ALTER TABLE music
+    ADD COLUMN lastfm_listeners BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN lastfm_playcount BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN lastfm_synced_at TIMESTAMP;
+
+CREATE UNIQUE INDEX music_genre_mapping_music_id_genre_id_key ON music_genre_mapping (music_id, genre_id);
\ No newline at end of file
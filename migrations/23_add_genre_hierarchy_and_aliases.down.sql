@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS genre_aliases;
+
+DROP INDEX IF EXISTS genres_parent_id_idx;
+
+ALTER TABLE genres DROP COLUMN IF EXISTS parent_id;
\ No newline at end of file
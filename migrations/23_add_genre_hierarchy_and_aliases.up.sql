@@ -0,0 +1,12 @@
+ALTER TABLE genres ADD COLUMN parent_id INTEGER REFERENCES genres(id) ON DELETE SET NULL;
+
+CREATE INDEX genres_parent_id_idx ON genres (parent_id);
+
+CREATE TABLE genre_aliases (
+    id SERIAL PRIMARY KEY,
+    genre_id INTEGER NOT NULL REFERENCES genres(id) ON DELETE CASCADE,
+    alias VARCHAR(100) UNIQUE NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX genre_aliases_genre_id_idx ON genre_aliases (genre_id);
\ No newline at end of file
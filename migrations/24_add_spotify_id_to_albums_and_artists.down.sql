@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS music_artist_mapping_music_id_artist_id_key;
+
+DROP INDEX IF EXISTS music_spotify_id_key;
+
+DROP INDEX IF EXISTS artists_spotify_id_key;
+
+DROP INDEX IF EXISTS albums_spotify_id_key;
+
+ALTER TABLE artists DROP COLUMN IF EXISTS spotify_id;
+
+ALTER TABLE albums DROP COLUMN IF EXISTS spotify_id;
\ No newline at end of file
@@ -0,0 +1,11 @@
+ALTER TABLE albums ADD COLUMN spotify_id VARCHAR(50);
+
+ALTER TABLE artists ADD COLUMN spotify_id VARCHAR(50);
+
+CREATE UNIQUE INDEX albums_spotify_id_key ON albums (spotify_id) WHERE spotify_id <> '';
+
+CREATE UNIQUE INDEX artists_spotify_id_key ON artists (spotify_id) WHERE spotify_id <> '';
+
+CREATE UNIQUE INDEX music_spotify_id_key ON music (spotify_id) WHERE spotify_id <> '';
+
+CREATE UNIQUE INDEX music_artist_mapping_music_id_artist_id_key ON music_artist_mapping (music_id, artist_id);
\ No newline at end of file
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS user_likes_user_id_comment_id_key;
+
+DROP INDEX IF EXISTS user_likes_user_id_post_id_key;
+
+DROP INDEX IF EXISTS user_likes_user_id_music_id_key;
\ No newline at end of file
@@ -0,0 +1,5 @@
+CREATE UNIQUE INDEX user_likes_user_id_music_id_key ON user_likes (user_id, music_id) WHERE music_id IS NOT NULL;
+
+CREATE UNIQUE INDEX user_likes_user_id_post_id_key ON user_likes (user_id, post_id) WHERE post_id IS NOT NULL;
+
+CREATE UNIQUE INDEX user_likes_user_id_comment_id_key ON user_likes (user_id, comment_id) WHERE comment_id IS NOT NULL;
\ No newline at end of file
@@ -0,0 +1,11 @@
+DROP TABLE IF EXISTS album_aliases;
+
+DROP TABLE IF EXISTS music_aliases;
+
+DROP INDEX IF EXISTS albums_upc_idx;
+
+DROP INDEX IF EXISTS music_isrc_idx;
+
+ALTER TABLE albums DROP COLUMN IF EXISTS upc;
+
+ALTER TABLE music DROP COLUMN IF EXISTS isrc;
\ No newline at end of file
@@ -0,0 +1,25 @@
+ALTER TABLE music ADD COLUMN isrc VARCHAR(12);
+
+ALTER TABLE albums ADD COLUMN upc VARCHAR(20);
+
+CREATE INDEX music_isrc_idx ON music (isrc);
+
+CREATE INDEX albums_upc_idx ON albums (upc);
+
+CREATE TABLE music_aliases (
+    id SERIAL PRIMARY KEY,
+    music_id INTEGER NOT NULL REFERENCES music(id) ON DELETE CASCADE,
+    old_music_id INTEGER UNIQUE NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX music_aliases_music_id_idx ON music_aliases (music_id);
+
+CREATE TABLE album_aliases (
+    id SERIAL PRIMARY KEY,
+    album_id INTEGER NOT NULL REFERENCES albums(id) ON DELETE CASCADE,
+    old_album_id INTEGER UNIQUE NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX album_aliases_album_id_idx ON album_aliases (album_id);
\ No newline at end of file
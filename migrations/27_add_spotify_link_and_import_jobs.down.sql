@@ -0,0 +1,17 @@
+DROP TABLE IF EXISTS spotify_import_jobs;
+
+DROP INDEX IF EXISTS music_collections_user_spotify_playlist_idx;
+
+ALTER TABLE music_collections DROP COLUMN IF EXISTS spotify_playlist_id;
+
+DROP TABLE IF EXISTS social_link_flows;
+
+DROP INDEX IF EXISTS user_social_accounts_provider_user_idx;
+
+DROP INDEX IF EXISTS user_social_accounts_user_provider_idx;
+
+ALTER TABLE user_social_accounts DROP COLUMN IF EXISTS token_expires_at;
+
+ALTER TABLE user_social_accounts DROP COLUMN IF EXISTS refresh_token;
+
+ALTER TABLE user_social_accounts DROP COLUMN IF EXISTS access_token;
\ No newline at end of file
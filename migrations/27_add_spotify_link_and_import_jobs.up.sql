@@ -0,0 +1,37 @@
+ALTER TABLE user_social_accounts ADD COLUMN access_token TEXT;
+
+ALTER TABLE user_social_accounts ADD COLUMN refresh_token TEXT;
+
+ALTER TABLE user_social_accounts ADD COLUMN token_expires_at TIMESTAMP;
+
+CREATE UNIQUE INDEX user_social_accounts_user_provider_idx ON user_social_accounts (user_id, provider) WHERE deleted_at IS NULL;
+
+CREATE UNIQUE INDEX user_social_accounts_provider_user_idx ON user_social_accounts (provider, provider_user_id) WHERE deleted_at IS NULL;
+
+CREATE TABLE social_link_flows (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    provider social_provider NOT NULL,
+    state VARCHAR(255) UNIQUE NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE music_collections ADD COLUMN spotify_playlist_id VARCHAR(50);
+
+CREATE UNIQUE INDEX music_collections_user_spotify_playlist_idx ON music_collections (user_id, spotify_playlist_id) WHERE spotify_playlist_id <> '';
+
+CREATE TABLE spotify_import_jobs (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING',
+    collections_imported INTEGER NOT NULL DEFAULT 0,
+    tracks_imported INTEGER NOT NULL DEFAULT 0,
+    error TEXT,
+    started_at TIMESTAMP,
+    finished_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX spotify_import_jobs_user_id_idx ON spotify_import_jobs (user_id);
\ No newline at end of file
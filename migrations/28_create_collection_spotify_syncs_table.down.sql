@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS collection_spotify_sync_tracks;
+
+DROP TABLE IF EXISTS collection_spotify_syncs;
\ No newline at end of file
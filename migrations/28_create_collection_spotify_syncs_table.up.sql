@@ -0,0 +1,19 @@
+CREATE TABLE collection_spotify_syncs (
+    id SERIAL PRIMARY KEY,
+    collection_id INTEGER UNIQUE NOT NULL REFERENCES music_collections(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL,
+    snapshot_id VARCHAR(100),
+    error TEXT,
+    synced_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX collection_spotify_syncs_status_idx ON collection_spotify_syncs (status);
+
+CREATE TABLE collection_spotify_sync_tracks (
+    id SERIAL PRIMARY KEY,
+    sync_id INTEGER NOT NULL REFERENCES collection_spotify_syncs(id) ON DELETE CASCADE,
+    spotify_track_id VARCHAR(50) NOT NULL,
+    UNIQUE (sync_id, spotify_track_id)
+);
\ No newline at end of file
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS collection_music_mapping_collection_position_idx;
+
+DROP INDEX IF EXISTS collection_music_mapping_collection_music_idx;
+
+ALTER TABLE collection_music_mapping DROP COLUMN IF EXISTS position;
+
+ALTER TABLE music_collections DROP COLUMN IF EXISTS is_public;
\ No newline at end of file
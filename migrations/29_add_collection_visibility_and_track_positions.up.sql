@@ -0,0 +1,19 @@
+ALTER TABLE music_collections ADD COLUMN is_public BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE collection_music_mapping ADD COLUMN position INTEGER NOT NULL DEFAULT 0;
+
+DELETE FROM collection_music_mapping a
+    USING collection_music_mapping b
+    WHERE a.collection_id = b.collection_id AND a.music_id = b.music_id AND a.id > b.id;
+
+UPDATE collection_music_mapping m
+    SET position = ordered.position
+    FROM (
+        SELECT id, ROW_NUMBER() OVER (PARTITION BY collection_id ORDER BY id) - 1 AS position
+        FROM collection_music_mapping
+    ) ordered
+    WHERE m.id = ordered.id;
+
+CREATE UNIQUE INDEX collection_music_mapping_collection_music_idx ON collection_music_mapping (collection_id, music_id);
+
+CREATE INDEX collection_music_mapping_collection_position_idx ON collection_music_mapping (collection_id, position);
\ No newline at end of file
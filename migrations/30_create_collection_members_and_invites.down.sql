@@ -0,0 +1,5 @@
+ALTER TABLE collection_music_mapping DROP COLUMN IF EXISTS added_by;
+
+DROP TABLE IF EXISTS collection_invites;
+
+DROP TABLE IF EXISTS collection_members;
\ No newline at end of file
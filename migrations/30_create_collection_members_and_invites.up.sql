@@ -0,0 +1,31 @@
+CREATE TABLE collection_members (
+    id SERIAL PRIMARY KEY,
+    collection_id INTEGER NOT NULL REFERENCES music_collections(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id),
+    role VARCHAR(10) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (collection_id, user_id)
+);
+
+CREATE INDEX collection_members_user_id_idx ON collection_members (user_id);
+
+INSERT INTO collection_members (collection_id, user_id, role)
+    SELECT id, user_id, 'OWNER' FROM music_collections WHERE user_id IS NOT NULL;
+
+CREATE TABLE collection_invites (
+    id SERIAL PRIMARY KEY,
+    collection_id INTEGER NOT NULL REFERENCES music_collections(id) ON DELETE CASCADE,
+    token VARCHAR(255) UNIQUE NOT NULL,
+    role VARCHAR(10) NOT NULL,
+    created_by INTEGER NOT NULL REFERENCES users(id),
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE collection_music_mapping ADD COLUMN added_by INTEGER REFERENCES users(id);
+
+UPDATE collection_music_mapping m
+    SET added_by = c.user_id
+    FROM music_collections c
+    WHERE m.collection_id = c.id;
\ No newline at end of file
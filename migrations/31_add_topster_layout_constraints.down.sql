@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS user_topsters_user_id_idx;
+DROP INDEX IF EXISTS topster_albums_topster_id_position_idx;
+DROP INDEX IF EXISTS topster_albums_topster_id_album_id_idx;
\ No newline at end of file
@@ -0,0 +1,10 @@
+DELETE FROM topster_albums d WHERE EXISTS (
+    SELECT 1 FROM topster_albums c
+    WHERE c.topster_id = d.topster_id AND c.id < d.id
+        AND (c.album_id = d.album_id
+            OR (c.position->>'row' = d.position->>'row' AND c.position->>'col' = d.position->>'col'))
+);
+
+CREATE UNIQUE INDEX topster_albums_topster_id_album_id_idx ON topster_albums (topster_id, album_id);
+CREATE UNIQUE INDEX topster_albums_topster_id_position_idx ON topster_albums (topster_id, (position->>'row'), (position->>'col'));
+CREATE INDEX user_topsters_user_id_idx ON user_topsters (user_id);
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE user_topsters DROP COLUMN IF EXISTS show_titles;
\ No newline at end of file
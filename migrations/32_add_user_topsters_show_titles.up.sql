@@ -0,0 +1 @@
+ALTER TABLE user_topsters ADD COLUMN show_titles BOOLEAN NOT NULL DEFAULT FALSE;
\ No newline at end of file
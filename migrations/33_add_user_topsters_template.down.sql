@@ -0,0 +1,6 @@
+ALTER TABLE user_topsters ADD COLUMN grid_size INTEGER;
+UPDATE user_topsters SET grid_size = GREATEST(rows, cols);
+ALTER TABLE user_topsters ALTER COLUMN grid_size SET NOT NULL;
+ALTER TABLE user_topsters DROP COLUMN cols;
+ALTER TABLE user_topsters DROP COLUMN rows;
+ALTER TABLE user_topsters DROP COLUMN template;
\ No newline at end of file
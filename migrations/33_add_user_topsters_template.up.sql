@@ -0,0 +1,5 @@
+ALTER TABLE user_topsters ADD COLUMN template VARCHAR(20) NOT NULL DEFAULT 'grid';
+ALTER TABLE user_topsters ADD COLUMN rows INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE user_topsters ADD COLUMN cols INTEGER NOT NULL DEFAULT 0;
+UPDATE user_topsters SET rows = grid_size, cols = grid_size;
+ALTER TABLE user_topsters DROP COLUMN grid_size;
\ No newline at end of file
@@ -0,0 +1,10 @@
+DROP TABLE IF EXISTS community_members;
+
+DROP INDEX IF EXISTS genre_communities_genre_id_idx;
+
+ALTER TABLE genre_communities DROP COLUMN IF EXISTS member_count;
+ALTER TABLE genre_communities DROP COLUMN IF EXISTS icon_url;
+ALTER TABLE genre_communities DROP COLUMN IF EXISTS banner_url;
+ALTER TABLE genre_communities DROP COLUMN IF EXISTS rules;
+
+ALTER TABLE users DROP COLUMN IF EXISTS is_admin;
\ No newline at end of file
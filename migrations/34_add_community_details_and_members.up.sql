@@ -0,0 +1,20 @@
+ALTER TABLE users ADD COLUMN is_admin BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE genre_communities ADD COLUMN rules TEXT NOT NULL DEFAULT '';
+ALTER TABLE genre_communities ADD COLUMN banner_url VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE genre_communities ADD COLUMN icon_url VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE genre_communities ADD COLUMN member_count INTEGER NOT NULL DEFAULT 0;
+
+CREATE INDEX genre_communities_genre_id_idx ON genre_communities (genre_id);
+
+CREATE TABLE community_members (
+    id SERIAL PRIMARY KEY,
+    community_id INTEGER NOT NULL REFERENCES genre_communities(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id),
+    role VARCHAR(10) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (community_id, user_id)
+);
+
+CREATE INDEX community_members_user_id_idx ON community_members (user_id);
\ No newline at end of file
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS user_likes_post_id_idx;
+
+DROP INDEX IF EXISTS posts_genre_community_id_created_at_idx;
\ No newline at end of file
@@ -0,0 +1,3 @@
+CREATE INDEX posts_genre_community_id_created_at_idx ON posts (genre_community_id, created_at DESC);
+
+CREATE INDEX user_likes_post_id_idx ON user_likes (post_id) WHERE post_id IS NOT NULL;
\ No newline at end of file
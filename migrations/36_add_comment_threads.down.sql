@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS comments_parent_id_idx;
+DROP INDEX IF EXISTS comments_post_id_path_idx;
+
+ALTER TABLE comments DROP COLUMN IF EXISTS is_deleted;
+ALTER TABLE comments DROP COLUMN IF EXISTS reply_count;
+ALTER TABLE comments DROP COLUMN IF EXISTS depth;
+ALTER TABLE comments DROP COLUMN IF EXISTS path;
+ALTER TABLE comments DROP COLUMN IF EXISTS parent_id;
\ No newline at end of file
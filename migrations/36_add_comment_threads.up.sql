@@ -0,0 +1,10 @@
+ALTER TABLE comments ADD COLUMN parent_id INTEGER REFERENCES comments(id);
+ALTER TABLE comments ADD COLUMN path TEXT NOT NULL DEFAULT '';
+ALTER TABLE comments ADD COLUMN depth INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE comments ADD COLUMN reply_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE comments ADD COLUMN is_deleted BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE comments SET path = LPAD(id::text, 10, '0') || '/';
+
+CREATE INDEX comments_post_id_path_idx ON comments (post_id, path text_pattern_ops);
+CREATE INDEX comments_parent_id_idx ON comments (parent_id);
\ No newline at end of file
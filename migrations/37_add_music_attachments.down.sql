@@ -0,0 +1 @@
+DROP TABLE IF EXISTS music_attachments;
\ No newline at end of file
@@ -0,0 +1,13 @@
+CREATE TABLE music_attachments (
+    id SERIAL PRIMARY KEY,
+    music_id INTEGER NOT NULL REFERENCES music(id),
+    post_id INTEGER REFERENCES posts(id) ON DELETE CASCADE,
+    comment_id INTEGER REFERENCES comments(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK ((post_id IS NULL) <> (comment_id IS NULL))
+);
+
+CREATE INDEX music_attachments_post_id_idx ON music_attachments (post_id);
+CREATE INDEX music_attachments_comment_id_idx ON music_attachments (comment_id);
+CREATE INDEX music_attachments_music_id_idx ON music_attachments (music_id);
\ No newline at end of file
@@ -0,0 +1,50 @@
+ALTER TABLE posts ADD COLUMN is_hidden BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE comments ADD COLUMN is_hidden BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE reports (
+    id SERIAL PRIMARY KEY,
+    reporter_id INTEGER NOT NULL REFERENCES users(id),
+    target_type VARCHAR(10) NOT NULL,
+    target_id INTEGER NOT NULL,
+    target_user_id INTEGER NOT NULL REFERENCES users(id),
+    community_id INTEGER REFERENCES genre_communities(id) ON DELETE CASCADE,
+    reason VARCHAR(20) NOT NULL,
+    details TEXT NOT NULL DEFAULT '',
+    excerpt TEXT NOT NULL DEFAULT '',
+    status VARCHAR(10) NOT NULL DEFAULT 'OPEN',
+    resolved_by_id INTEGER REFERENCES users(id),
+    resolved_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX reports_pending_reporter_target_idx ON reports (reporter_id, target_type, target_id) WHERE status IN ('OPEN', 'ESCALATED');
+CREATE INDEX reports_community_id_status_idx ON reports (community_id, status);
+CREATE INDEX reports_target_idx ON reports (target_type, target_id);
+
+CREATE TABLE community_bans (
+    id SERIAL PRIMARY KEY,
+    community_id INTEGER NOT NULL REFERENCES genre_communities(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id),
+    moderator_id INTEGER NOT NULL REFERENCES users(id),
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (community_id, user_id)
+);
+
+CREATE TABLE moderation_actions (
+    id SERIAL PRIMARY KEY,
+    community_id INTEGER REFERENCES genre_communities(id) ON DELETE CASCADE,
+    report_id INTEGER REFERENCES reports(id) ON DELETE SET NULL,
+    moderator_id INTEGER NOT NULL REFERENCES users(id),
+    target_user_id INTEGER NOT NULL REFERENCES users(id),
+    action VARCHAR(10) NOT NULL,
+    target_type VARCHAR(10) NOT NULL,
+    target_id INTEGER NOT NULL,
+    excerpt TEXT NOT NULL DEFAULT '',
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX moderation_actions_community_id_idx ON moderation_actions (community_id);
+CREATE INDEX moderation_actions_target_user_id_idx ON moderation_actions (target_user_id);
\ No newline at end of file
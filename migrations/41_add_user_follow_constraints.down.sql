@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_user_follows_following_id;
+
+ALTER TABLE user_follows
+    DROP CONSTRAINT IF EXISTS user_follows_no_self_follow,
+    DROP CONSTRAINT IF EXISTS user_follows_follower_id_following_id_key,
+    ALTER COLUMN following_id DROP NOT NULL,
+    ALTER COLUMN follower_id DROP NOT NULL;
\ No newline at end of file
@@ -0,0 +1,12 @@
+DELETE FROM user_follows a USING user_follows b
+WHERE a.id > b.id AND a.follower_id = b.follower_id AND a.following_id = b.following_id;
+DELETE FROM user_follows
+WHERE follower_id IS NULL OR following_id IS NULL OR follower_id = following_id;
+
+ALTER TABLE user_follows
+    ALTER COLUMN follower_id SET NOT NULL,
+    ALTER COLUMN following_id SET NOT NULL,
+    ADD CONSTRAINT user_follows_follower_id_following_id_key UNIQUE (follower_id, following_id),
+    ADD CONSTRAINT user_follows_no_self_follow CHECK (follower_id <> following_id);
+
+CREATE INDEX idx_user_follows_following_id ON user_follows (following_id, created_at);
\ No newline at end of file
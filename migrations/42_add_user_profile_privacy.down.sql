@@ -0,0 +1,7 @@
+ALTER TABLE user_profiles
+    DROP COLUMN IF EXISTS hide_posts,
+    DROP COLUMN IF EXISTS hide_collections,
+    DROP COLUMN IF EXISTS hide_topsters,
+    DROP COLUMN IF EXISTS hide_follows,
+    DROP COLUMN IF EXISTS hide_website,
+    DROP COLUMN IF EXISTS hide_bio;
\ No newline at end of file
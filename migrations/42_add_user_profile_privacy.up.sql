@@ -0,0 +1,7 @@
+ALTER TABLE user_profiles
+    ADD COLUMN hide_bio BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN hide_website BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN hide_follows BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN hide_topsters BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN hide_collections BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN hide_posts BOOLEAN NOT NULL DEFAULT FALSE;
\ No newline at end of file
@@ -0,0 +1,27 @@
+CREATE TABLE activities (
+    id SERIAL PRIMARY KEY,
+    actor_id INTEGER NOT NULL REFERENCES users(id),
+    type VARCHAR(20) NOT NULL,
+    post_id INTEGER REFERENCES posts(id) ON DELETE CASCADE,
+    topster_id INTEGER REFERENCES user_topsters(id) ON DELETE CASCADE,
+    collection_id INTEGER REFERENCES music_collections(id) ON DELETE CASCADE,
+    music_id INTEGER REFERENCES music(id) ON DELETE CASCADE,
+    target_user_id INTEGER REFERENCES users(id),
+    fanned_out BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT activities_subject_check CHECK (
+        (post_id IS NOT NULL)::INTEGER + (topster_id IS NOT NULL)::INTEGER +
+        (collection_id IS NOT NULL)::INTEGER + (music_id IS NOT NULL)::INTEGER +
+        (target_user_id IS NOT NULL)::INTEGER = 1
+    )
+);
+
+CREATE INDEX activities_actor_id_idx ON activities (actor_id, id DESC);
+
+CREATE TABLE feed_items (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id),
+    activity_id INTEGER NOT NULL REFERENCES activities(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (user_id, activity_id)
+);
\ No newline at end of file
@@ -10,3 +10,58 @@ package tools
 //go:generate mockery --dir ../internal/usecase --name UserUsecase --output ../internal/controller/http/mocks
 //go:generate mockery --dir ../internal/usecase --name MusicUsecase --output ../internal/controller/http/mocks
 //go:generate mockery --dir ../infrastructure/spotifyclient --name SpotifyClient --output ../internal/usecase/mocks
+//go:generate mockery --dir ../internal/domain/repositories --name MusicRepository --output ../internal/usecase/mocks
+//go:generate mockery --dir ../internal/domain/repositories --name GenreRepository --output ../internal/usecase/mocks
+//go:generate mockery --dir ../internal/domain/repositories --name MusicGenreMappingRepository --output ../internal/usecase/mocks
+//go:generate mockery --dir ../infrastructure/lastfmclient --name LastfmClient --output ../internal/usecase/mocks
+//go:generate mockery --dir ../internal/domain/repositories --name GenreAliasRepository --output ../internal/usecase/mocks
+//go:generate mockery --dir ../internal/domain/repositories --name GenresCommunityRepository --output ../internal/usecase/mocks
+//go:generate mockery --dir ../internal/usecase --name GenreUsecase --output ../internal/controller/http/mocks
+//go:generate mockery --dir ../internal/domain/repositories --name AlbumRepository --output ../internal/usecase/mocks
+//go:generate mockery --dir ../internal/domain/repositories --name ArtistRepository --output ../internal/usecase/mocks
+//go:generate mockery --dir ../internal/domain/repositories --name MusicArtistMappingRepository --output ../internal/usecase/mocks
+//go:generate mockery --dir ../internal/domain/repositories --name UserLikeRepository --output ../internal/usecase/mocks
+//go:generate mockery --dir ../internal/domain/repositories --name CollectionMusicMappingRepository --output ../internal/usecase/mocks
+//go:generate mockery --dir ../internal/domain/repositories --name PostRepository --output ../internal/usecase/mocks
+//go:generate mockery --dir ../internal/domain/repositories --name CommentRepository --output ../internal/usecase/mocks
+//go:generate mockery --dir ../internal/usecase --name LikeUsecase --output ../internal/controller/http/mocks
+
+//go:generate mockery --dir ../infrastructure/spotifyclient --name UserAuthenticator --output ../internal/usecase/mocks
+//go:generate mockery --dir ../infrastructure/spotifyclient --name UserClient --output ../internal/usecase/mocks
+//go:generate mockery --dir ../internal/domain/repositories --name UserSocialAccountRepository --output ../internal/usecase/mocks
+//go:generate mockery --dir ../internal/domain/repositories --name SocialLinkFlowRepository --output ../internal/usecase/mocks
+//go:generate mockery --dir ../internal/domain/repositories --name SpotifyImportJobRepository --output ../internal/usecase/mocks
+//go:generate mockery --dir ../internal/domain/repositories --name MusicCollectionRepository --output ../internal/usecase/mocks
+//go:generate mockery --dir ../internal/usecase --name SpotifyUsecase --output ../internal/controller/http/mocks
+//go:generate mockery --dir ../internal/domain/repositories --name CollectionSpotifySyncRepository --output ../internal/usecase/mocks
+//go:generate mockery --dir ../internal/usecase --name CollectionUsecase --output ../internal/controller/http/mocks
+//go:generate mockery --dir ../internal/domain/repositories --name CollectionMemberRepository --output ../internal/usecase/mocks
+//go:generate mockery --dir ../internal/domain/repositories --name CollectionInviteRepository --output ../internal/usecase/mocks
+//go:generate mockery --dir ../internal/domain/repositories --name UserTopsterRepository --output ../internal/usecase/mocks
+//go:generate mockery --dir ../internal/domain/repositories --name TopsterAlbumRepository --output ../internal/usecase/mocks
+//go:generate mockery --dir ../internal/usecase --name TopsterUsecase --output ../internal/controller/http/mocks
+//go:generate mockery --dir ../infrastructure/topsterimage --name Renderer --output ../internal/usecase/mocks
+//go:generate mockery --dir ../infrastructure/storage --name Storage --output ../internal/usecase/mocks
+//go:generate mockery --dir ../infrastructure/markdown --name PreviewFetcher --output ../internal/usecase/mocks
+//go:generate mockery --dir ../infrastructure/contentfilter --name History --output ../internal/usecase/mocks
+//go:generate mockery --dir ../internal/domain/repositories --name CommunityMemberRepository --output ../internal/usecase/mocks
+//go:generate mockery --dir ../internal/domain/repositories --name MusicAttachmentRepository --output ../internal/usecase/mocks
+//go:generate mockery --dir ../internal/domain/repositories --name LinkPreviewRepository --output ../internal/usecase/mocks
+//go:generate mockery --dir ../internal/usecase --name CommunityUsecase --output ../internal/controller/http/mocks
+//go:generate mockery --dir ../internal/usecase --name PostUsecase --output ../internal/controller/http/mocks
+//go:generate mockery --dir ../internal/usecase --name CommentUsecase --output ../internal/controller/http/mocks
+//go:generate mockery --dir ../internal/domain/repositories --name CommunityBanRepository --output ../internal/usecase/mocks
+//go:generate mockery --dir ../internal/domain/repositories --name ReportRepository --output ../internal/usecase/mocks
+//go:generate mockery --dir ../internal/domain/repositories --name ModerationActionRepository --output ../internal/usecase/mocks
+//go:generate mockery --dir ../internal/usecase --name ModerationUsecase --output ../internal/controller/http/mocks
+//go:generate mockery --dir ../internal/domain/repositories --name UserFollowRepository --output ../internal/usecase/mocks
+//go:generate mockery --dir ../internal/usecase --name FollowUsecase --output ../internal/controller/http/mocks
+//go:generate mockery --dir ../internal/usecase --name ProfileUsecase --output ../internal/controller/http/mocks
+//go:generate mockery --dir ../internal/domain/repositories --name UserBlockRepository --output ../internal/usecase/mocks
+//go:generate mockery --dir ../internal/domain/repositories --name UserMuteRepository --output ../internal/usecase/mocks
+//go:generate mockery --dir ../internal/usecase --name BlockUsecase --output ../internal/controller/http/mocks
+//go:generate mockery --dir ../internal/domain/repositories --name ActivityRepository --output ../internal/usecase/mocks
+//go:generate mockery --dir ../internal/usecase --name FeedUsecase --output ../internal/controller/http/mocks
+//go:generate mockery --dir ../internal/domain/repositories --name NotificationRepository --output ../internal/usecase/mocks
+//go:generate mockery --dir ../internal/domain/repositories --name NotificationPreferenceRepository --output ../internal/usecase/mocks
+//go:generate mockery --dir ../internal/usecase --name NotificationUsecase --output ../internal/controller/http/mocks